@@ -9,13 +9,13 @@ import (
 	"github.com/stackql/stackql/internal/stackql/constants"
 	"github.com/stackql/stackql/internal/stackql/handler"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
-	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/parserutil"
 	"github.com/stackql/stackql/internal/stackql/primitivegenerator"
 	"github.com/stackql/stackql/internal/stackql/sql_system"
 	"github.com/stackql/stackql/internal/stackql/symtab"
 	"github.com/stackql/stackql/internal/stackql/tablenamespace"
 
+	"github.com/stackql/go-openapistackql/openapistackql"
 	"github.com/stackql/stackql-parser/go/sqltypes"
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 )
@@ -738,20 +738,33 @@ func (v *indirectExpandAstVisitor) Visit(node sqlparser.SQLNode) error {
 			v.annotatedAST.SetSQLDataSource(node, sqlDataSource)
 		}
 		// OPTIMISTIC DOC PERSISTENCE AND VIEW DEFINITION
-		prov, err := v.handlerCtx.GetProvider(providerName)
+		var externalTables []openapistackql.SQLExternalTable
+		if hasSQLDataSource {
+			// Data sources that define their own tables need no provider document.
+			externalTables = sqlDataSource.GetExternalTables()
+		}
+		for _, tbl := range externalTables {
+			err := v.sqlSystem.RegisterExternalTable(providerName, tbl)
+			if err != nil {
+				return err
+			}
+		}
+		if len(externalTables) == 0 {
+			prov, err := v.handlerCtx.GetProvider(providerName)
 
-		if err != nil {
-			logging.GetLogger().Debugf("optimistic doc error: %s", err.Error())
-		} else {
-			if hasSQLDataSource {
-				err = prov.PersistStaticExternalSQLDataSource(v.handlerCtx.GetRuntimeContext())
-				if err != nil {
-					return err
-				}
+			if err != nil {
+				subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("optimistic doc error: %s", err.Error())
 			} else {
-				_, err = prov.GetServiceShard(serviceName, resourceName, v.handlerCtx.GetRuntimeContext())
-				if err != nil {
-					logging.GetLogger().Debugf("optimistic doc error: %s", err.Error())
+				if hasSQLDataSource {
+					err = prov.PersistStaticExternalSQLDataSource(v.handlerCtx.GetRuntimeContext(), sqlDataSource)
+					if err != nil {
+						return err
+					}
+				} else {
+					_, err = prov.GetServiceShard(serviceName, resourceName, v.handlerCtx.GetRuntimeContext())
+					if err != nil {
+						subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("optimistic doc error: %s", err.Error())
+					}
 				}
 			}
 		}
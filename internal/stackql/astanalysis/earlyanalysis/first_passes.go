@@ -13,6 +13,8 @@ import (
 	"github.com/stackql/stackql/internal/stackql/primitivegenerator"
 )
 
+const subsystem logging.Subsystem = "earlyanalysis"
+
 type InstructionType int
 
 const (
@@ -106,10 +108,10 @@ func (sp *standardInitialPasses) initialPasses(statement sqlparser.Statement, ha
 	}
 
 	// Before analysing AST, see if we can pass straight to SQL backend
-	opType, ok := handlerCtx.GetDBMSInternalRouter().CanRoute(ast)
+	opType, ok := handlerCtx.GetDBMSInternalRouter().CanRoute(handlerCtx.GetContext(), ast)
 	if ok {
 		sp.instructionType = InternallyRoutableInstruction
-		logging.GetLogger().Debugf("%v", opType)
+		subsystem.GetContextLogger(handlerCtx.GetContext()).Debugf("%v", opType)
 		pbi, err := planbuilderinput.NewPlanBuilderInput(
 			annotatedAST,
 			handlerCtx,
@@ -182,7 +184,7 @@ func (sp *standardInitialPasses) initialPasses(statement sqlparser.Statement, ha
 	// Extracts:
 	//   - parser objects representing tables.
 	//   - mapping of string aliases to tables.
-	tVis := astvisit.NewTableExtractAstVisitor(annotatedAST)
+	tVis := astvisit.NewTableExtractAstVisitor(handlerCtx.GetContext(), annotatedAST)
 	tVis.Visit(ast)
 
 	// Fourth pass AST analysis.
@@ -192,7 +194,7 @@ func (sp *standardInitialPasses) initialPasses(statement sqlparser.Statement, ha
 	//   - Col Refs; mapping columnar objects to tables.
 	//   - Alias Map; mapping the "TableName" objects
 	//     defining aliases to table objects.
-	aVis := astvisit.NewTableAliasAstVisitor(annotatedAST, tVis.GetTables())
+	aVis := astvisit.NewTableAliasAstVisitor(handlerCtx.GetContext(), annotatedAST, tVis.GetTables())
 	aVis.Visit(ast)
 
 	// Fifth pass AST analysis.
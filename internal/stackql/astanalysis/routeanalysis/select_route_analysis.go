@@ -123,6 +123,7 @@ func (sp *standardSelectRoutePass) RoutePass() error {
 
 	// TODO: There is god awful object <-> namespacing inside here: abstract it.
 	paramRouter := router.NewParameterRouter(
+		handlerCtx.GetContext(),
 		annotatedAST,
 		pbi.GetAliasedTables(),
 		pbi.GetAssignedAliasedColumns(),
@@ -1,7 +1,10 @@
 package astformat
 
 import (
+	"strings"
+
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/constants"
 )
 
 func DefaultSelectExprsFormatter(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
@@ -9,6 +12,25 @@ func DefaultSelectExprsFormatter(buf *sqlparser.TrackedBuffer, node sqlparser.SQ
 	case sqlparser.ColIdent:
 		formatColIdent(node, buf)
 		return
+	case *sqlparser.FuncExpr:
+		switch strings.ToLower(node.Name.GetRawVal()) {
+		case constants.SQLFuncJSONArrayContainsConformed:
+			if len(node.Exprs) == 2 {
+				sb := sqlparser.NewTrackedBuffer(DefaultSelectExprsFormatter)
+				sb.AstPrintf(node, "exists (select 1 from json_each(%v) as je where je.value = %v)", node.Exprs[0], node.Exprs[1])
+				buf.WriteString(sb.String())
+				return
+			}
+		case constants.SQLFuncParseTimestampConformed:
+			// Literal, because the format contains verbs.
+			sb := sqlparser.NewTrackedBuffer(DefaultSelectExprsFormatter)
+			sb.WriteString("strftime('%Y-%m-%d %H:%M:%f', ")
+			sb.AstPrintf(node, "%v)", node.Exprs)
+			buf.WriteString(sb.String())
+			return
+		}
+		node.Format(buf)
+		return
 
 	default:
 		node.Format(buf)
@@ -0,0 +1,46 @@
+package astformat
+
+import (
+	"strings"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/constants"
+)
+
+var (
+	_ sqlparser.NodeFormatter = DuckDBSelectExprsFormatter
+)
+
+func DuckDBSelectExprsFormatter(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+	switch node := node.(type) {
+	case *sqlparser.ColName:
+		formatColName(node, buf)
+	case sqlparser.ColIdent:
+		formatColIdent(node, buf)
+		return
+	case *sqlparser.FuncExpr:
+		if strings.ToLower(node.Name.GetRawVal()) == constants.SQLFuncParseTimestampConformed {
+			// Literal, because the format contains verbs.
+			sb := sqlparser.NewTrackedBuffer(DuckDBSelectExprsFormatter)
+			sb.AstPrintf(node, "strftime(cast(%v AS timestamp), ", node.Exprs)
+			sb.WriteString("'%Y-%m-%d %H:%M:%S.%g')")
+			buf.WriteString(sb.String())
+			return
+		}
+		node.Format(buf)
+		return
+	case *sqlparser.GroupConcatExpr:
+		separator := `','`
+		if node.Separator != "" {
+			separator = strings.TrimPrefix(node.Separator, " separator ")
+		}
+		sb := sqlparser.NewTrackedBuffer(DuckDBSelectExprsFormatter)
+		sb.AstPrintf(node, "%s(%s%v, %s%v)", constants.SQLFuncGroupConcatDuckDB, node.Distinct, node.Exprs, separator, node.OrderBy)
+		buf.WriteString(sb.String())
+		return
+
+	default:
+		node.Format(buf)
+		return
+	}
+}
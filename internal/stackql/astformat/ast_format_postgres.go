@@ -20,9 +20,16 @@ func PostgresSelectExprsFormatter(buf *sqlparser.TrackedBuffer, node sqlparser.S
 		formatColIdent(node, buf)
 		return
 	case *sqlparser.FuncExpr:
-		if strings.ToLower(node.Name.GetRawVal()) == constants.SQLFuncJSONExtractPostgres {
+		switch strings.ToLower(node.Name.GetRawVal()) {
+		case constants.SQLFuncJSONExtractPostgres, constants.SQLFuncJSONExtractPathPostgres, constants.SQLFuncJSONArrayLengthConformed:
+			formatPostgresJSONFunc(buf, node, "json")
+			return
+		case constants.SQLFuncJSONArrayContainsPostgres:
+			formatPostgresJSONFunc(buf, node, "jsonb")
+			return
+		case constants.SQLFuncParseTimestampConformed:
 			sb := sqlparser.NewTrackedBuffer(PostgresSelectExprsFormatter)
-			sb.AstPrintf(node, "%s(%v::json, %v)", constants.SQLFuncJSONExtractPostgres, node.Exprs[0], node.Exprs[1])
+			sb.AstPrintf(node, "to_char(timezone('UTC', cast(%v AS timestamptz)), 'YYYY-MM-DD HH24:MI:SS.MS')", node.Exprs)
 			buf.WriteString(sb.String())
 			return
 		}
@@ -46,6 +53,22 @@ func PostgresSelectExprsFormatter(buf *sqlparser.TrackedBuffer, node sqlparser.S
 	}
 }
 
+// formatPostgresJSONFunc casts the first argument of a JSON
+// function, because JSON is stored as text.
+func formatPostgresJSONFunc(buf *sqlparser.TrackedBuffer, node *sqlparser.FuncExpr, jsonType string) {
+	if len(node.Exprs) == 0 {
+		node.Format(buf)
+		return
+	}
+	sb := sqlparser.NewTrackedBuffer(PostgresSelectExprsFormatter)
+	sb.AstPrintf(node, "%s(%v::%s", node.Name.GetRawVal(), node.Exprs[0], jsonType)
+	for _, expr := range node.Exprs[1:] {
+		sb.AstPrintf(node, ", %v", expr)
+	}
+	sb.WriteString(")")
+	buf.WriteString(sb.String())
+}
+
 func formatColIdent(node sqlparser.ColIdent, buf *sqlparser.TrackedBuffer) {
 	if node.AtCount() > 0 {
 		sqlparser.FormatID(buf, node.String(), node.Lowered(), node.AtCount())
@@ -2,12 +2,23 @@ package astfuncrewrite
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 	"github.com/stackql/stackql/internal/stackql/constants"
 )
 
+var (
+	// Object keys and array indices of a JSON path, eg: '$.a[0].b'.
+	jsonPathTokenRegexp *regexp.Regexp = regexp.MustCompile(`\.?([^.\[\]]+)|\[(\d+)\]`)
+)
+
+// ASTFuncRewriter rewrites portable functions, named per the
+// `SQLFunc*Conformed` constants, into function calls of the
+// backend dialect.  Rewrites must be idempotent.  Where the
+// dialect requires syntax other than a function call, the
+// remainder is supplied by the dialect formatter in astformat.
 type ASTFuncRewriter interface {
 	RewriteFunc(funcExpr *sqlparser.FuncExpr) (*sqlparser.FuncExpr, error)
 }
@@ -16,6 +27,10 @@ func GetPostgresASTFuncRewriter() ASTFuncRewriter {
 	return &postgresFuncRewriter{}
 }
 
+func GetDuckDBASTFuncRewriter() ASTFuncRewriter {
+	return &duckDBFuncRewriter{}
+}
+
 func GetNopFuncRewriter() ASTFuncRewriter {
 	return &nopFuncRewriter{}
 }
@@ -53,20 +68,10 @@ func (fr *postgresFuncRewriter) rewriteJSONExtract(funcExpr *sqlparser.FuncExpr)
 	if len(funcExpr.Exprs) != 2 {
 		return nil, fmt.Errorf("cannot translate 'json_extract' function with arg count = %d", len(funcExpr.Exprs))
 	}
-
-	pathExpr, ok := funcExpr.Exprs[1].(*sqlparser.AliasedExpr)
-	if !ok {
-		return nil, fmt.Errorf("cannot accomodate 'json_extract' path expression of type = '%T'", pathExpr)
-	}
-	pathVal, ok := pathExpr.Expr.(*sqlparser.SQLVal)
-	if !ok {
-		return nil, fmt.Errorf("cannot accomodate 'json_extract' path val of type = '%T'", pathVal)
-	}
-	if pathVal.Type != sqlparser.StrVal {
-		return nil, fmt.Errorf("cannot accomodate 'json_extract' path val with value type = '%d'", pathVal.Type)
+	pathExprs, err := getJSONPathKeyExprs(constants.SQLFuncJSONExtractConformed, funcExpr.Exprs[1])
+	if err != nil {
+		return nil, err
 	}
-	pathStr := string(pathVal.Val)
-	pathSplit := strings.Split(pathStr, ".")
 	var newExprs sqlparser.SelectExprs
 
 	firstArg, err := fr.applyJSONConvIdempotent(funcExpr.Exprs[0])
@@ -75,15 +80,55 @@ func (fr *postgresFuncRewriter) rewriteJSONExtract(funcExpr *sqlparser.FuncExpr)
 	}
 
 	newExprs = append(newExprs, firstArg)
-	for i, j := range pathSplit {
-		if i == 0 && j == "$" {
-			continue
+	newExprs = append(newExprs, pathExprs...)
+	funcExpr.Exprs = newExprs
+	return funcExpr, nil
+}
+
+// rewriteJSONArrayLength navigates to the array with `json_extract_path()`,
+// because postgres `json_array_length()` does not accept a path.
+func (fr *postgresFuncRewriter) rewriteJSONArrayLength(funcExpr *sqlparser.FuncExpr) (*sqlparser.FuncExpr, error) {
+	switch len(funcExpr.Exprs) {
+	case 1:
+		return funcExpr, nil
+	case 2:
+		pathExprs, err := getJSONPathKeyExprs(constants.SQLFuncJSONArrayLengthConformed, funcExpr.Exprs[1])
+		if err != nil {
+			return nil, err
 		}
-		newVal := sqlparser.NewStrVal([]byte(j))
-		newExpr := &sqlparser.AliasedExpr{Expr: newVal}
-		newExprs = append(newExprs, newExpr)
+		extractPathExpr := &sqlparser.FuncExpr{
+			Name:  sqlparser.NewColIdent(constants.SQLFuncJSONExtractPathPostgres),
+			Exprs: append(sqlparser.SelectExprs{funcExpr.Exprs[0]}, pathExprs...),
+		}
+		funcExpr.Exprs = sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: extractPathExpr}}
+		return funcExpr, nil
+	default:
+		return nil, fmt.Errorf("cannot translate 'json_array_length' function with arg count = %d", len(funcExpr.Exprs))
+	}
+}
+
+func (fr *postgresFuncRewriter) rewriteJSONArrayContains(funcExpr *sqlparser.FuncExpr) (*sqlparser.FuncExpr, error) {
+	if len(funcExpr.Exprs) != 2 {
+		return nil, fmt.Errorf("cannot translate 'json_array_contains' function with arg count = %d", len(funcExpr.Exprs))
+	}
+	funcExpr.Name = sqlparser.NewColIdent(constants.SQLFuncJSONArrayContainsPostgres)
+	funcExpr.Exprs = sqlparser.SelectExprs{
+		funcExpr.Exprs[0],
+		&sqlparser.AliasedExpr{
+			Expr: &sqlparser.FuncExpr{
+				Name:  sqlparser.NewColIdent(constants.SQLFuncJSONBuildArrayPostgres),
+				Exprs: sqlparser.SelectExprs{funcExpr.Exprs[1]},
+			},
+		},
 	}
-	funcExpr.Exprs = newExprs
+	return funcExpr, nil
+}
+
+func (fr *postgresFuncRewriter) rewriteRegexpLike(funcExpr *sqlparser.FuncExpr) (*sqlparser.FuncExpr, error) {
+	if len(funcExpr.Exprs) != 2 {
+		return nil, fmt.Errorf("cannot translate 'regexp_like' function with arg count = %d", len(funcExpr.Exprs))
+	}
+	funcExpr.Name = sqlparser.NewColIdent(constants.SQLFuncRegexpLikePostgres)
 	return funcExpr, nil
 }
 
@@ -92,8 +137,97 @@ func (fr *postgresFuncRewriter) RewriteFunc(funcExpr *sqlparser.FuncExpr) (*sqlp
 		return nil, nil
 	}
 	funcNameLowered := strings.ToLower(funcExpr.Name.GetRawVal())
-	if funcNameLowered == constants.SQLFuncJSONExtractConformed {
+	switch funcNameLowered {
+	case constants.SQLFuncJSONExtractConformed:
+		return fr.rewriteJSONExtract(funcExpr)
+	case constants.SQLFuncJSONArrayLengthConformed:
+		return fr.rewriteJSONArrayLength(funcExpr)
+	case constants.SQLFuncJSONArrayContainsConformed:
+		return fr.rewriteJSONArrayContains(funcExpr)
+	case constants.SQLFuncRegexpLikeConformed:
+		return fr.rewriteRegexpLike(funcExpr)
+	}
+	return funcExpr, nil
+}
+
+// getJSONPathKeyExprs decomposes a JSON path literal,
+// eg: '$.a.b', into key literals, eg: 'a', 'b'.
+func getJSONPathKeyExprs(funcName string, pathArg sqlparser.SelectExpr) (sqlparser.SelectExprs, error) {
+	pathExpr, ok := pathArg.(*sqlparser.AliasedExpr)
+	if !ok {
+		return nil, fmt.Errorf("cannot accomodate '%s' path expression of type = '%T'", funcName, pathArg)
+	}
+	pathVal, ok := pathExpr.Expr.(*sqlparser.SQLVal)
+	if !ok {
+		return nil, fmt.Errorf("cannot accomodate '%s' path val of type = '%T'", funcName, pathExpr.Expr)
+	}
+	if pathVal.Type != sqlparser.StrVal {
+		return nil, fmt.Errorf("cannot accomodate '%s' path val with value type = '%d'", funcName, pathVal.Type)
+	}
+	pathStr := strings.TrimPrefix(string(pathVal.Val), "$")
+	var rv sqlparser.SelectExprs
+	for _, submatches := range jsonPathTokenRegexp.FindAllStringSubmatch(pathStr, -1) {
+		key := submatches[1]
+		if key == "" {
+			key = submatches[2]
+		}
+		newVal := sqlparser.NewStrVal([]byte(key))
+		newExpr := &sqlparser.AliasedExpr{Expr: newVal}
+		rv = append(rv, newExpr)
+	}
+	return rv, nil
+}
+
+// DuckDB `json_extract()` returns JSON, hence string scalars are quoted.
+// `json_extract_string()` aligns with SQLite semantics and accepts the same path syntax.
+type duckDBFuncRewriter struct{}
+
+func (fr *duckDBFuncRewriter) rewriteJSONExtract(funcExpr *sqlparser.FuncExpr) (*sqlparser.FuncExpr, error) {
+	if len(funcExpr.Exprs) != 2 {
+		return nil, fmt.Errorf("cannot translate 'json_extract' function with arg count = %d", len(funcExpr.Exprs))
+	}
+	funcExpr.Name = sqlparser.NewColIdent(constants.SQLFuncJSONExtractDuckDB)
+	return funcExpr, nil
+}
+
+func (fr *duckDBFuncRewriter) rewriteJSONArrayContains(funcExpr *sqlparser.FuncExpr) (*sqlparser.FuncExpr, error) {
+	if len(funcExpr.Exprs) != 2 {
+		return nil, fmt.Errorf("cannot translate 'json_array_contains' function with arg count = %d", len(funcExpr.Exprs))
+	}
+	funcExpr.Name = sqlparser.NewColIdent(constants.SQLFuncJSONArrayContainsDuckDB)
+	funcExpr.Exprs = sqlparser.SelectExprs{
+		funcExpr.Exprs[0],
+		&sqlparser.AliasedExpr{
+			Expr: &sqlparser.FuncExpr{
+				Name:  sqlparser.NewColIdent(constants.SQLFuncToJSONDuckDB),
+				Exprs: sqlparser.SelectExprs{funcExpr.Exprs[1]},
+			},
+		},
+	}
+	return funcExpr, nil
+}
+
+// DuckDB `regexp_matches()` is a partial match, as is SQLite `regexp_like()`.
+func (fr *duckDBFuncRewriter) rewriteRegexpLike(funcExpr *sqlparser.FuncExpr) (*sqlparser.FuncExpr, error) {
+	if len(funcExpr.Exprs) != 2 {
+		return nil, fmt.Errorf("cannot translate 'regexp_like' function with arg count = %d", len(funcExpr.Exprs))
+	}
+	funcExpr.Name = sqlparser.NewColIdent(constants.SQLFuncRegexpLikeDuckDB)
+	return funcExpr, nil
+}
+
+func (fr *duckDBFuncRewriter) RewriteFunc(funcExpr *sqlparser.FuncExpr) (*sqlparser.FuncExpr, error) {
+	if funcExpr == nil {
+		return nil, nil
+	}
+	funcNameLowered := strings.ToLower(funcExpr.Name.GetRawVal())
+	switch funcNameLowered {
+	case constants.SQLFuncJSONExtractConformed:
 		return fr.rewriteJSONExtract(funcExpr)
+	case constants.SQLFuncJSONArrayContainsConformed:
+		return fr.rewriteJSONArrayContains(funcExpr)
+	case constants.SQLFuncRegexpLikeConformed:
+		return fr.rewriteRegexpLike(funcExpr)
 	}
 	return funcExpr, nil
 }
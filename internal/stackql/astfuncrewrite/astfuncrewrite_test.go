@@ -0,0 +1,258 @@
+package astfuncrewrite_test
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	_ "github.com/marcboeker/go-duckdb"
+	_ "github.com/stackql/go-sqlite3"
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+
+	"github.com/stackql/stackql/internal/stackql/astformat"
+	"github.com/stackql/stackql/internal/stackql/astfuncrewrite"
+)
+
+type dialect struct {
+	name       string
+	driverName string
+	// initQuery prepares a connection, as does the backend.
+	initQuery string
+	// probeQuery fails where optional functions are unavailable.
+	probeQuery string
+	// jsonProbeQuery fails where JSON functions are unavailable.
+	jsonProbeQuery string
+	rewriter       astfuncrewrite.ASTFuncRewriter
+	formatter      sqlparser.NodeFormatter
+}
+
+var (
+	sqliteDialect = dialect{
+		name:           "sqlite",
+		driverName:     "sqlite3",
+		probeQuery:     "select regexp_like('a', 'a')",
+		jsonProbeQuery: "select json('{}')",
+		rewriter:       astfuncrewrite.GetNopFuncRewriter(),
+		formatter:      astformat.DefaultSelectExprsFormatter,
+	}
+	postgresDialect = dialect{
+		name:      "postgres",
+		rewriter:  astfuncrewrite.GetPostgresASTFuncRewriter(),
+		formatter: astformat.PostgresSelectExprsFormatter,
+	}
+	duckDBDialect = dialect{
+		name:           "duckdb",
+		driverName:     "duckdb",
+		initQuery:      "SET autoinstall_known_extensions = true; SET autoload_known_extensions = true;",
+		probeQuery:     "select regexp_matches('a', 'a')",
+		jsonProbeQuery: "select json_array_length('[]')",
+		rewriter:       astfuncrewrite.GetDuckDBASTFuncRewriter(),
+		formatter:      astformat.DuckDBSelectExprsFormatter,
+	}
+)
+
+// render rewrites the portable functions of a select expression
+// and formats it, as the select is formatted for the backend.
+func render(t *testing.T, d dialect, expr string) string {
+	t.Helper()
+	stmt, err := sqlparser.Parse("select " + expr)
+	if err != nil {
+		t.Fatalf("cannot parse '%s': %v", expr, err)
+	}
+	err = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		funcExpr, isFuncExpr := node.(*sqlparser.FuncExpr)
+		if !isFuncExpr {
+			return true, nil
+		}
+		newNode, err := d.rewriter.RewriteFunc(funcExpr)
+		if err != nil {
+			return false, err
+		}
+		funcExpr.Name = newNode.Name
+		funcExpr.Exprs = newNode.Exprs
+		return true, nil
+	}, stmt)
+	if err != nil {
+		t.Fatalf("cannot rewrite '%s' for %s: %v", expr, d.name, err)
+	}
+	return astformat.String(stmt.(*sqlparser.Select).SelectExprs, d.formatter)
+}
+
+func TestRewriteFunc(t *testing.T) {
+	testCases := []struct {
+		expr string
+		want map[string]string
+	}{
+		{
+			expr: `json_extract(j, '$.a[0].b')`,
+			want: map[string]string{
+				"sqlite":   `json_extract("j", '$.a[0].b')`,
+				"postgres": `json_extract_path_text("j"::json, 'a', '0', 'b')`,
+				"duckdb":   `json_extract_string("j", '$.a[0].b')`,
+			},
+		},
+		{
+			expr: `json_array_length(j, '$.a')`,
+			want: map[string]string{
+				"sqlite":   `json_array_length("j", '$.a')`,
+				"postgres": `json_array_length(json_extract_path("j"::json, 'a')::json)`,
+				"duckdb":   `json_array_length("j", '$.a')`,
+			},
+		},
+		{
+			expr: `json_array_contains(j, 'x')`,
+			want: map[string]string{
+				"sqlite":   `exists (select 1 from json_each("j") as je where je.value = 'x')`,
+				"postgres": `jsonb_contains("j"::jsonb, jsonb_build_array('x'))`,
+				"duckdb":   `json_contains("j", to_json('x'))`,
+			},
+		},
+		{
+			expr: `regexp_like(s, '^a')`,
+			want: map[string]string{
+				"sqlite":   `regexp_like("s", '^a')`,
+				"postgres": `textregexeq("s", '^a')`,
+				"duckdb":   `regexp_matches("s", '^a')`,
+			},
+		},
+		{
+			expr: `split_part(s, '/', 2)`,
+			want: map[string]string{
+				"sqlite":   `split_part("s", '/', 2)`,
+				"postgres": `split_part("s", '/', 2)`,
+				"duckdb":   `split_part("s", '/', 2)`,
+			},
+		},
+		{
+			expr: `parse_timestamp(s)`,
+			want: map[string]string{
+				"sqlite":   `strftime('%Y-%m-%d %H:%M:%f', "s")`,
+				"postgres": `to_char(timezone('UTC', cast("s" AS timestamptz)), 'YYYY-MM-DD HH24:MI:SS.MS')`,
+				"duckdb":   `strftime(cast("s" AS timestamp), '%Y-%m-%d %H:%M:%S.%g')`,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		for _, d := range []dialect{sqliteDialect, postgresDialect, duckDBDialect} {
+			t.Run(fmt.Sprintf("%s %s", d.name, tc.expr), func(t *testing.T) {
+				if got := render(t, d, tc.expr); got != tc.want[d.name] {
+					t.Fatalf("rendered = %s, want %s", got, tc.want[d.name])
+				}
+			})
+		}
+	}
+}
+
+func TestRewriteFuncArgCount(t *testing.T) {
+	for _, expr := range []string{`json_extract(j)`, `json_array_contains(j)`, `regexp_like(s)`} {
+		for _, rewriter := range []astfuncrewrite.ASTFuncRewriter{postgresDialect.rewriter, duckDBDialect.rewriter} {
+			stmt, err := sqlparser.Parse("select " + expr)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			funcExpr := stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr.(*sqlparser.FuncExpr)
+			if _, err := rewriter.RewriteFunc(funcExpr); err == nil {
+				t.Fatalf("RewriteFunc(%s) error = nil, want arg count error", expr)
+			}
+		}
+	}
+}
+
+// queryValue evaluates an expression in the embedded backend
+// over a single row of text columns, as acquired data is stored,
+// presenting booleans as integers, as does SQLite.
+func queryValue(db *sql.DB, query string, j string, s string) (string, error) {
+	if _, err := db.Exec("delete from t"); err != nil {
+		return "", err
+	}
+	if _, err := db.Exec("insert into t (j, s) values (?, ?)", j, s); err != nil {
+		return "", err
+	}
+	var rv interface{}
+	if err := db.QueryRow(query).Scan(&rv); err != nil {
+		return "", err
+	}
+	switch rv := rv.(type) {
+	case bool:
+		if rv {
+			return "1", nil
+		}
+		return "0", nil
+	case []byte:
+		return string(rv), nil
+	case nil:
+		return "NULL", nil
+	default:
+		return fmt.Sprintf("%v", rv), nil
+	}
+}
+
+// TestConformance evaluates the portable functions
+// in each embedded backend, for identical results.
+// Backends built without the optional functions are skipped.
+func TestConformance(t *testing.T) {
+	testCases := []struct {
+		expr string
+		j    string
+		s    string
+		want string
+	}{
+		{expr: `json_extract(j, '$.a[0].b')`, j: `{"a": [{"b": "x"}]}`, want: "x"},
+		{expr: `json_extract(j, '$.a[0].b')`, j: `{"a": [{"b": 2}]}`, want: "2"},
+		{expr: `json_extract(j, '$.c')`, j: `{"a": {"b": "x"}}`, want: "NULL"},
+		{expr: `json_array_length(j)`, j: `[1, 2, 3]`, want: "3"},
+		{expr: `json_array_length(j, '$.a')`, j: `{"a": [1, 2]}`, want: "2"},
+		{expr: `json_array_contains(j, 'b')`, j: `["a", "b"]`, want: "1"},
+		{expr: `json_array_contains(j, 'c')`, j: `["a", "b"]`, want: "0"},
+		{expr: `json_array_contains(json_extract(j, '$.a'), 'x')`, j: `{"a": ["x"]}`, want: "1"},
+		{expr: `regexp_like(s, '^inst')`, s: "instance-1", want: "1"},
+		{expr: `regexp_like(s, 'stan')`, s: "instance-1", want: "1"},
+		{expr: `regexp_like(s, '-c$')`, s: "instance-1", want: "0"},
+		{expr: `regexp_replace(s, 'instance', 'vm')`, s: "instance-1-instance", want: "vm-1-instance"},
+		{expr: `split_part(s, '/', 2)`, s: "a/b/c", want: "b"},
+		{expr: `split_part(s, '/', 4)`, s: "a/b/c", want: ""},
+		{expr: `split_part(s, '-', 1)`, s: "", want: ""},
+		{expr: `parse_timestamp(s)`, s: "2022-06-12T10:00:00.123+10:00", want: "2022-06-12 00:00:00.123"},
+		{expr: `parse_timestamp(s)`, s: "2022-06-12T00:00:00Z", want: "2022-06-12 00:00:00.000"},
+		{expr: `parse_timestamp(s) < '2022-06-12 01:00:00.000'`, s: "2022-06-12T10:30:00+10:00", want: "1"},
+	}
+	for _, d := range []dialect{sqliteDialect, duckDBDialect} {
+		t.Run(d.name, func(t *testing.T) {
+			db, err := sql.Open(d.driverName, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer db.Close()
+			db.SetMaxOpenConns(1)
+			if d.initQuery != "" {
+				if _, err := db.Exec(d.initQuery); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			if _, err := db.Exec(d.probeQuery); err != nil {
+				t.Skipf("portable functions unavailable in this build of %s: %v", d.name, err)
+			}
+			_, jsonErr := db.Exec(d.jsonProbeQuery)
+			if jsonErr != nil {
+				t.Logf("skipping JSON functions, unavailable in this build of %s: %v", d.name, jsonErr)
+			}
+			if _, err := db.Exec("create table t (j text, s text)"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, tc := range testCases {
+				if jsonErr != nil && strings.HasPrefix(tc.expr, "json") {
+					continue
+				}
+				query := "select " + render(t, d, tc.expr) + " from t"
+				got, err := queryValue(db, query, tc.j, tc.s)
+				if err != nil {
+					t.Fatalf("cannot evaluate '%s': %v", query, err)
+				}
+				if got != tc.want {
+					t.Fatalf("'%s' = %q, want %q", query, got, tc.want)
+				}
+			}
+		})
+	}
+}
@@ -1,13 +1,13 @@
 package astvisit
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 
 	"github.com/stackql/stackql/internal/stackql/astanalysis/annotatedast"
-	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/parserutil"
 	"github.com/stackql/stackql/internal/stackql/taxonomy"
 )
@@ -23,6 +23,7 @@ type LeftoverReferencesAstVisitor interface {
 }
 
 type standardLeftoverReferencesAstVisitor struct {
+	ctx                               context.Context
 	colRefs                           parserutil.ColTableMap
 	tableToAnnotationCtx              map[sqlparser.TableExpr]taxonomy.AnnotationCtx
 	thisIterationTableToAnnotationCtx map[sqlparser.TableExpr]taxonomy.AnnotationCtx
@@ -30,6 +31,7 @@ type standardLeftoverReferencesAstVisitor struct {
 }
 
 func NewLeftoverReferencesAstVisitor(
+	ctx context.Context,
 	annotatedAST annotatedast.AnnotatedAst,
 	colRefs parserutil.ColTableMap,
 	tableToAnnotationCtx map[sqlparser.TableExpr]taxonomy.AnnotationCtx,
@@ -39,6 +41,7 @@ func NewLeftoverReferencesAstVisitor(
 		copyColRefs[k] = v
 	}
 	return &standardLeftoverReferencesAstVisitor{
+		ctx:                               ctx,
 		annotatedAST:                      annotatedAST,
 		colRefs:                           copyColRefs,
 		tableToAnnotationCtx:              tableToAnnotationCtx,
@@ -296,7 +299,7 @@ func (v *standardLeftoverReferencesAstVisitor) Visit(node sqlparser.SQLNode) err
 		numParams := len(node.Params)
 		if numParams != 0 {
 			for i, p := range node.Params {
-				logging.GetLogger().Debugf("%v\n", p)
+				subsystem.GetContextLogger(v.ctx).Debugf("%v\n", p)
 				if i != 0 {
 				}
 			}
@@ -417,7 +420,7 @@ func (v *standardLeftoverReferencesAstVisitor) Visit(node sqlparser.SQLNode) err
 
 	case sqlparser.Columns:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case sqlparser.Partitions:
@@ -425,7 +428,7 @@ func (v *standardLeftoverReferencesAstVisitor) Visit(node sqlparser.SQLNode) err
 			return nil
 		}
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case sqlparser.TableExprs:
@@ -497,7 +500,7 @@ func (v *standardLeftoverReferencesAstVisitor) Visit(node sqlparser.SQLNode) err
 		if len(node.Indexes) == 0 {
 		} else {
 			for _, n := range node.Indexes {
-				logging.GetLogger().Debugf("%v\n", n)
+				subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 			}
 		}
 
@@ -509,7 +512,7 @@ func (v *standardLeftoverReferencesAstVisitor) Visit(node sqlparser.SQLNode) err
 
 	case sqlparser.Exprs:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case *sqlparser.AndExpr:
@@ -682,7 +685,7 @@ func (v *standardLeftoverReferencesAstVisitor) Visit(node sqlparser.SQLNode) err
 		if node.Expr != nil {
 		}
 		for _, when := range node.Whens {
-			logging.GetLogger().Debugf("%v\n", when)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", when)
 		}
 		if node.Else != nil {
 		}
@@ -695,17 +698,17 @@ func (v *standardLeftoverReferencesAstVisitor) Visit(node sqlparser.SQLNode) err
 
 	case sqlparser.GroupBy:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case sqlparser.OrderBy:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case *sqlparser.Order:
 		if node, ok := node.Expr.(*sqlparser.NullVal); ok {
-			logging.GetLogger().Debugf("%v\n", node)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", node)
 			return nil
 		}
 		if node, ok := node.Expr.(*sqlparser.FuncExpr); ok {
@@ -723,19 +726,19 @@ func (v *standardLeftoverReferencesAstVisitor) Visit(node sqlparser.SQLNode) err
 
 	case sqlparser.Values:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case sqlparser.UpdateExprs:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case *sqlparser.UpdateExpr:
 
 	case sqlparser.SetExprs:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case *sqlparser.SetExpr:
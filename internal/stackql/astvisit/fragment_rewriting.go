@@ -1057,7 +1057,11 @@ func (v *standardFramentRewriteAstVisitor) Visit(node sqlparser.SQLNode) error {
 					colz = append(colz, fmt.Sprintf(`%s.%s`, v.sqlSystem.DelimitGroupByColumn(n.Qualifier.GetRawVal()), v.sqlSystem.DelimitGroupByColumn(n.Name.GetRawVal())))
 				}
 			default:
-				colz = append(colz, sqlparser.String(n))
+				s, err := v.formatPortableExpr(n)
+				if err != nil {
+					return err
+				}
+				colz = append(colz, s)
 			}
 		}
 		if len(colz) > 0 {
@@ -1077,7 +1081,11 @@ func (v *standardFramentRewriteAstVisitor) Visit(node sqlparser.SQLNode) error {
 					colz = append(colz, fmt.Sprintf(`%s.%s %s`, v.sqlSystem.DelimitOrderByColumn(n.Qualifier.GetRawVal()), v.sqlSystem.DelimitOrderByColumn(n.Name.GetRawVal()), orderNode.Direction))
 				}
 			default:
-				colz = append(colz, fmt.Sprintf("%s %s", sqlparser.String(n), orderNode.Direction))
+				s, err := v.formatPortableExpr(n)
+				if err != nil {
+					return err
+				}
+				colz = append(colz, fmt.Sprintf("%s %s", s, orderNode.Direction))
 			}
 		}
 		if len(colz) > 0 {
@@ -1185,3 +1193,25 @@ func (v *standardFramentRewriteAstVisitor) Visit(node sqlparser.SQLNode) error {
 	}
 	return nil
 }
+
+// formatPortableExpr formats a grouping or ordering expression
+// in the backend dialect, rewriting any portable functions.
+func (v *standardFramentRewriteAstVisitor) formatPortableExpr(expr sqlparser.Expr) (string, error) {
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		funcExpr, isFuncExpr := node.(*sqlparser.FuncExpr)
+		if !isFuncExpr {
+			return true, nil
+		}
+		newNode, err := v.sqlSystem.GetASTFuncRewriter().RewriteFunc(funcExpr)
+		if err != nil {
+			return false, err
+		}
+		funcExpr.Name = newNode.Name
+		funcExpr.Exprs = newNode.Exprs
+		return true, nil
+	}, expr)
+	if err != nil {
+		return "", err
+	}
+	return astformat.String(expr, v.formatter), nil
+}
@@ -1,6 +1,7 @@
 package astvisit
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -29,6 +30,7 @@ type FromRewriteAstVisitor interface {
 
 // Need not be view-aware.
 type standardFromRewriteAstVisitor struct {
+	ctx                    context.Context
 	iDColumnName           string
 	rewrittenQuery         string
 	shouldCollectTables    bool
@@ -43,6 +45,7 @@ type standardFromRewriteAstVisitor struct {
 }
 
 func NewFromRewriteAstVisitor(
+	ctx context.Context,
 	annotatedAST annotatedast.AnnotatedAst,
 	iDColumnName string,
 	shouldCollectTables bool,
@@ -53,6 +56,7 @@ func NewFromRewriteAstVisitor(
 	dc drm.DRMConfig,
 ) FromRewriteAstVisitor {
 	return &standardFromRewriteAstVisitor{
+		ctx:                 ctx,
 		annotatedAST:        annotatedAST,
 		iDColumnName:        iDColumnName,
 		shouldCollectTables: shouldCollectTables,
@@ -655,7 +659,7 @@ func (v *standardFromRewriteAstVisitor) Visit(node sqlparser.SQLNode) error {
 					if isSQLDataSource && !v.isAvoidSQLSourceNaming {
 						tblStr = anCtx.GetHIDs().GetStackQLTableName()
 					} else {
-						dbTbl, err := v.dc.GetCurrentTable(anCtx.GetHIDs())
+						dbTbl, err := v.dc.GetCurrentTable(v.ctx, anCtx.GetHIDs())
 						if err != nil {
 							return err
 						}
@@ -723,13 +727,13 @@ func (v *standardFromRewriteAstVisitor) Visit(node sqlparser.SQLNode) error {
 		v.rewrittenQuery = buf.String()
 
 	case *sqlparser.JoinTableExpr:
-		lVis := NewFromRewriteAstVisitor(v.annotatedAST, "", true, v.sqlSystem, v.formatter, v.namespaceCollection, v.annotations, v.dc)
+		lVis := NewFromRewriteAstVisitor(v.ctx, v.annotatedAST, "", true, v.sqlSystem, v.formatter, v.namespaceCollection, v.annotations, v.dc)
 		lVis.SetAvoidSQLSourceNaming(v.isAvoidSQLSourceNaming)
 		node.LeftExpr.Accept(lVis)
-		rVis := NewFromRewriteAstVisitor(v.annotatedAST, "", true, v.sqlSystem, v.formatter, v.namespaceCollection, v.annotations, v.dc)
+		rVis := NewFromRewriteAstVisitor(v.ctx, v.annotatedAST, "", true, v.sqlSystem, v.formatter, v.namespaceCollection, v.annotations, v.dc)
 		rVis.SetAvoidSQLSourceNaming(v.isAvoidSQLSourceNaming)
 		node.RightExpr.Accept(rVis)
-		conditionVis := NewFromRewriteAstVisitor(v.annotatedAST, "", true, v.sqlSystem, v.formatter, v.namespaceCollection, v.annotations, v.dc)
+		conditionVis := NewFromRewriteAstVisitor(v.ctx, v.annotatedAST, "", true, v.sqlSystem, v.formatter, v.namespaceCollection, v.annotations, v.dc)
 		conditionVis.SetAvoidSQLSourceNaming(v.isAvoidSQLSourceNaming)
 		node.Condition.Accept(conditionVis)
 		buf.AstPrintf(node, "%s %s %s %s", lVis.GetRewrittenQuery(), node.Join, rVis.GetRewrittenQuery(), conditionVis.GetRewrittenQuery())
@@ -782,9 +786,9 @@ func (v *standardFromRewriteAstVisitor) Visit(node sqlparser.SQLNode) error {
 		v.rewrittenQuery = buf.String()
 
 	case *sqlparser.ComparisonExpr:
-		lVis := NewFromRewriteAstVisitor(v.annotatedAST, "", true, v.sqlSystem, v.formatter, v.namespaceCollection, v.annotations, v.dc)
+		lVis := NewFromRewriteAstVisitor(v.ctx, v.annotatedAST, "", true, v.sqlSystem, v.formatter, v.namespaceCollection, v.annotations, v.dc)
 		node.Left.Accept(lVis)
-		rVis := NewFromRewriteAstVisitor(v.annotatedAST, "", true, v.sqlSystem, v.formatter, v.namespaceCollection, v.annotations, v.dc)
+		rVis := NewFromRewriteAstVisitor(v.ctx, v.annotatedAST, "", true, v.sqlSystem, v.formatter, v.namespaceCollection, v.annotations, v.dc)
 		node.Right.Accept(rVis)
 		buf.AstPrintf(node, "%s %s %s", lVis.GetRewrittenQuery(), node.Operator, rVis.GetRewrittenQuery())
 		if node.Escape != nil {
@@ -21,6 +21,8 @@ import (
 	"github.com/stackql/stackql/internal/stackql/taxonomy"
 )
 
+const subsystem logging.Subsystem = "astvisit"
+
 var (
 	_ QueryRewriteAstVisitor = &standardQueryRewriteAstVisitor{}
 )
@@ -206,7 +208,7 @@ func (v *standardQueryRewriteAstVisitor) Visit(node sqlparser.SQLNode) error {
 			if err != nil {
 				return err
 			}
-			fromVis := NewFromRewriteAstVisitor(v.annotatedAST, "", true, v.handlerCtx.GetSQLSystem(), v.formatter, v.namespaceCollection, v.annotations, v.dc)
+			fromVis := NewFromRewriteAstVisitor(v.handlerCtx.GetContext(), v.annotatedAST, "", true, v.handlerCtx.GetSQLSystem(), v.formatter, v.namespaceCollection, v.annotations, v.dc)
 			fromVis.SetAvoidSQLSourceNaming(true)
 			if node.From != nil {
 				node.From.Accept(fromVis)
@@ -415,7 +417,7 @@ func (v *standardQueryRewriteAstVisitor) Visit(node sqlparser.SQLNode) error {
 		numParams := len(node.Params)
 		if numParams != 0 {
 			for i, p := range node.Params {
-				logging.GetLogger().Debugf("%v\n", p)
+				subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", p)
 				if i != 0 {
 				}
 			}
@@ -594,7 +596,7 @@ func (v *standardQueryRewriteAstVisitor) Visit(node sqlparser.SQLNode) error {
 			relationalColumn = relationalColumn.WithAlias(col.Alias)
 			relationalColumn = relationalColumn.WithQualifier(col.Qualifier)
 			v.relationalColumns = append(v.relationalColumns, relationalColumn)
-			logging.GetLogger().Debugf("sqlDataSource = '%v'\n", sqlDataSource)
+			subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("sqlDataSource = '%v'\n", sqlDataSource)
 			return nil
 		}
 		schema, err := tbl.GetSelectableObjectSchema()
@@ -617,7 +619,7 @@ func (v *standardQueryRewriteAstVisitor) Visit(node sqlparser.SQLNode) error {
 
 	case sqlparser.Columns:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", n)
 		}
 
 	case sqlparser.Partitions:
@@ -625,7 +627,7 @@ func (v *standardQueryRewriteAstVisitor) Visit(node sqlparser.SQLNode) error {
 			return nil
 		}
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", n)
 		}
 
 	case sqlparser.TableExprs:
@@ -706,7 +708,7 @@ func (v *standardQueryRewriteAstVisitor) Visit(node sqlparser.SQLNode) error {
 		if len(node.Indexes) == 0 {
 		} else {
 			for _, n := range node.Indexes {
-				logging.GetLogger().Debugf("%v\n", n)
+				subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", n)
 			}
 		}
 
@@ -718,7 +720,7 @@ func (v *standardQueryRewriteAstVisitor) Visit(node sqlparser.SQLNode) error {
 
 	case sqlparser.Exprs:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", n)
 		}
 
 	case *sqlparser.AndExpr:
@@ -857,7 +859,7 @@ func (v *standardQueryRewriteAstVisitor) Visit(node sqlparser.SQLNode) error {
 		if node.Expr != nil {
 		}
 		for _, when := range node.Whens {
-			logging.GetLogger().Debugf("%v\n", when)
+			subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", when)
 		}
 		if node.Else != nil {
 		}
@@ -870,17 +872,17 @@ func (v *standardQueryRewriteAstVisitor) Visit(node sqlparser.SQLNode) error {
 
 	case sqlparser.GroupBy:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", n)
 		}
 
 	case sqlparser.OrderBy:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", n)
 		}
 
 	case *sqlparser.Order:
 		if node, ok := node.Expr.(*sqlparser.NullVal); ok {
-			logging.GetLogger().Debugf("%v\n", node)
+			subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", node)
 			return nil
 		}
 		if node, ok := node.Expr.(*sqlparser.FuncExpr); ok {
@@ -898,19 +900,19 @@ func (v *standardQueryRewriteAstVisitor) Visit(node sqlparser.SQLNode) error {
 
 	case sqlparser.Values:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", n)
 		}
 
 	case sqlparser.UpdateExprs:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", n)
 		}
 
 	case *sqlparser.UpdateExpr:
 
 	case sqlparser.SetExprs:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", n)
 		}
 
 	case *sqlparser.SetExpr:
@@ -1,13 +1,13 @@
 package astvisit
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 
 	"github.com/stackql/stackql/internal/stackql/astanalysis/annotatedast"
-	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/parserutil"
 )
 
@@ -23,6 +23,7 @@ type ParserTableAliasPairingAstVisitor interface {
 
 // TODO: must be view-aware **but** scoped at statement level.
 type standardParserTableAliasPairingAstVisitor struct {
+	ctx            context.Context
 	aliasedColumns parserutil.TableExprMap
 	aliasMap       parserutil.TableAliasMap
 	colRefs        parserutil.ColTableMap
@@ -30,8 +31,9 @@ type standardParserTableAliasPairingAstVisitor struct {
 	annotatedAST   annotatedast.AnnotatedAst
 }
 
-func NewTableAliasAstVisitor(annotatedAST annotatedast.AnnotatedAst, tables sqlparser.TableExprs) ParserTableAliasPairingAstVisitor {
+func NewTableAliasAstVisitor(ctx context.Context, annotatedAST annotatedast.AnnotatedAst, tables sqlparser.TableExprs) ParserTableAliasPairingAstVisitor {
 	return &standardParserTableAliasPairingAstVisitor{
+		ctx:            ctx,
 		aliasedColumns: make(parserutil.TableExprMap),
 		aliasMap:       make(parserutil.TableAliasMap),
 		colRefs:        make(parserutil.ColTableMap),
@@ -301,7 +303,7 @@ func (v *standardParserTableAliasPairingAstVisitor) Visit(node sqlparser.SQLNode
 		numParams := len(node.Params)
 		if numParams != 0 {
 			for i, p := range node.Params {
-				logging.GetLogger().Debugf("%v\n", p)
+				subsystem.GetContextLogger(v.ctx).Debugf("%v\n", p)
 				if i != 0 {
 				}
 			}
@@ -422,7 +424,7 @@ func (v *standardParserTableAliasPairingAstVisitor) Visit(node sqlparser.SQLNode
 
 	case sqlparser.Columns:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case sqlparser.Partitions:
@@ -430,7 +432,7 @@ func (v *standardParserTableAliasPairingAstVisitor) Visit(node sqlparser.SQLNode
 			return nil
 		}
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case sqlparser.TableExprs:
@@ -502,7 +504,7 @@ func (v *standardParserTableAliasPairingAstVisitor) Visit(node sqlparser.SQLNode
 		if len(node.Indexes) == 0 {
 		} else {
 			for _, n := range node.Indexes {
-				logging.GetLogger().Debugf("%v\n", n)
+				subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 			}
 		}
 
@@ -514,7 +516,7 @@ func (v *standardParserTableAliasPairingAstVisitor) Visit(node sqlparser.SQLNode
 
 	case sqlparser.Exprs:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case *sqlparser.AndExpr:
@@ -675,7 +677,7 @@ func (v *standardParserTableAliasPairingAstVisitor) Visit(node sqlparser.SQLNode
 		if node.Expr != nil {
 		}
 		for _, when := range node.Whens {
-			logging.GetLogger().Debugf("%v\n", when)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", when)
 		}
 		if node.Else != nil {
 		}
@@ -688,17 +690,17 @@ func (v *standardParserTableAliasPairingAstVisitor) Visit(node sqlparser.SQLNode
 
 	case sqlparser.GroupBy:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case sqlparser.OrderBy:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case *sqlparser.Order:
 		if node, ok := node.Expr.(*sqlparser.NullVal); ok {
-			logging.GetLogger().Debugf("%v\n", node)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", node)
 			return nil
 		}
 		if node, ok := node.Expr.(*sqlparser.FuncExpr); ok {
@@ -716,19 +718,19 @@ func (v *standardParserTableAliasPairingAstVisitor) Visit(node sqlparser.SQLNode
 
 	case sqlparser.Values:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case sqlparser.UpdateExprs:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case *sqlparser.UpdateExpr:
 
 	case sqlparser.SetExprs:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case *sqlparser.SetExpr:
@@ -1,13 +1,13 @@
 package astvisit
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 
 	"github.com/stackql/stackql/internal/stackql/astanalysis/annotatedast"
-	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/parserutil"
 )
 
@@ -23,13 +23,15 @@ type ParserTableExtractAstVisitor interface {
 }
 
 type standardParserTableExtractAstVisitor struct {
+	ctx          context.Context
 	tables       sqlparser.TableExprs
 	tableAiases  parserutil.TableAliasMap
 	annotatedAST annotatedast.AnnotatedAst
 }
 
-func NewTableExtractAstVisitor(annotatedAST annotatedast.AnnotatedAst) ParserTableExtractAstVisitor {
+func NewTableExtractAstVisitor(ctx context.Context, annotatedAST annotatedast.AnnotatedAst) ParserTableExtractAstVisitor {
 	return &standardParserTableExtractAstVisitor{
+		ctx:          ctx,
 		annotatedAST: annotatedAST,
 		tableAiases:  make(parserutil.TableAliasMap),
 	}
@@ -267,7 +269,7 @@ func (v *standardParserTableExtractAstVisitor) Visit(node sqlparser.SQLNode) err
 		numParams := len(node.Params)
 		if numParams != 0 {
 			for i, p := range node.Params {
-				logging.GetLogger().Debugf("%v\n", p)
+				subsystem.GetContextLogger(v.ctx).Debugf("%v\n", p)
 				if i != 0 {
 				}
 			}
@@ -378,7 +380,7 @@ func (v *standardParserTableExtractAstVisitor) Visit(node sqlparser.SQLNode) err
 
 	case sqlparser.Columns:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case sqlparser.Partitions:
@@ -386,7 +388,7 @@ func (v *standardParserTableExtractAstVisitor) Visit(node sqlparser.SQLNode) err
 			return nil
 		}
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case sqlparser.TableExprs:
@@ -468,7 +470,7 @@ func (v *standardParserTableExtractAstVisitor) Visit(node sqlparser.SQLNode) err
 		if len(node.Indexes) == 0 {
 		} else {
 			for _, n := range node.Indexes {
-				logging.GetLogger().Debugf("%v\n", n)
+				subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 			}
 		}
 
@@ -480,7 +482,7 @@ func (v *standardParserTableExtractAstVisitor) Visit(node sqlparser.SQLNode) err
 
 	case sqlparser.Exprs:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case *sqlparser.AndExpr:
@@ -620,7 +622,7 @@ func (v *standardParserTableExtractAstVisitor) Visit(node sqlparser.SQLNode) err
 		if node.Expr != nil {
 		}
 		for _, when := range node.Whens {
-			logging.GetLogger().Debugf("%v\n", when)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", when)
 		}
 		if node.Else != nil {
 		}
@@ -633,17 +635,17 @@ func (v *standardParserTableExtractAstVisitor) Visit(node sqlparser.SQLNode) err
 
 	case sqlparser.GroupBy:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case sqlparser.OrderBy:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case *sqlparser.Order:
 		if node, ok := node.Expr.(*sqlparser.NullVal); ok {
-			logging.GetLogger().Debugf("%v\n", node)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", node)
 			return nil
 		}
 		if node, ok := node.Expr.(*sqlparser.FuncExpr); ok {
@@ -661,19 +663,19 @@ func (v *standardParserTableExtractAstVisitor) Visit(node sqlparser.SQLNode) err
 
 	case sqlparser.Values:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case sqlparser.UpdateExprs:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case *sqlparser.UpdateExpr:
 
 	case sqlparser.SetExprs:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.ctx).Debugf("%v\n", n)
 		}
 
 	case *sqlparser.SetExpr:
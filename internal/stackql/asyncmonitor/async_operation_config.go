@@ -0,0 +1,227 @@
+package asyncmonitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/PaesslerAG/jsonpath"
+	"github.com/stackql/go-openapistackql/openapistackql"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	ExtensionKeyAsyncOperation string = "x-stackQL-asyncOperation"
+)
+
+// AsyncOperationConfig is the declarative description of a long running
+// operation, supplied in provider documents under the
+// "x-stackQL-asyncOperation" extension at either operation or service level.
+//
+// Example:
+//
+//	x-stackQL-asyncOperation:
+//	  statusURL:
+//	    headers: [ Azure-AsyncOperation, Location ]
+//	  statePath: $.status
+//	  successValues: [ Succeeded ]
+//	  failureValues: [ Failed, Canceled ]
+//	  errorPath: $.error
+//	  pollIntervalSeconds: 5
+type AsyncOperationConfig struct {
+	StatusURL           AsyncOperationStatusURL `json:"statusURL" yaml:"statusURL"`
+	StatePath           string                  `json:"statePath" yaml:"statePath"`
+	SuccessValues       []string                `json:"successValues" yaml:"successValues"`
+	FailureValues       []string                `json:"failureValues" yaml:"failureValues"`
+	ErrorPath           string                  `json:"errorPath" yaml:"errorPath"`
+	IDPath              string                  `json:"idPath" yaml:"idPath"`
+	PollIntervalSeconds int                     `json:"pollIntervalSeconds" yaml:"pollIntervalSeconds"`
+}
+
+// AsyncOperationStatusURL nominates where the URL to poll is found.
+// Headers are tried in order, then the response body path.
+type AsyncOperationStatusURL struct {
+	Headers  []string `json:"headers" yaml:"headers"`
+	BodyPath string   `json:"bodyPath" yaml:"bodyPath"`
+}
+
+// GetAsyncOperationConfig returns the async operation config for
+// a method, preferring operation level over service level.
+// Config that is present but malformed is an error,
+// rather than a silent fallback to default monitoring.
+func GetAsyncOperationConfig(m *openapistackql.OperationStore) (*AsyncOperationConfig, bool, error) {
+	if m == nil {
+		return nil, false, nil
+	}
+	if m.OperationRef != nil && m.OperationRef.Value != nil {
+		if raw, ok := m.OperationRef.Value.Extensions[ExtensionKeyAsyncOperation]; ok {
+			return getAsyncOperationConfig(m, raw)
+		}
+	}
+	if m.Service != nil && m.Service.T != nil {
+		if raw, ok := m.Service.Extensions[ExtensionKeyAsyncOperation]; ok {
+			return getAsyncOperationConfig(m, raw)
+		}
+	}
+	return nil, false, nil
+}
+
+func getAsyncOperationConfig(m *openapistackql.OperationStore, raw interface{}) (*AsyncOperationConfig, bool, error) {
+	rv, err := extractAsyncOperationConfig(raw)
+	if err == nil {
+		err = rv.validate()
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("malformed '%s' config for method '%s': %w", ExtensionKeyAsyncOperation, m.GetName(), err)
+	}
+	return rv, true, nil
+}
+
+// IsAwaitable reports whether the AWAIT directive may be applied to a method.
+func IsAwaitable(m *openapistackql.OperationStore) (bool, error) {
+	if m == nil {
+		return false, nil
+	}
+	_, ok, err := GetAsyncOperationConfig(m)
+	if err != nil {
+		return false, err
+	}
+	if ok {
+		return true, nil
+	}
+	return m.IsAwaitable(), nil
+}
+
+func extractAsyncOperationConfig(raw interface{}) (*AsyncOperationConfig, error) {
+	var b []byte
+	var err error
+	switch rs := raw.(type) {
+	case json.RawMessage:
+		b, err = rs.MarshalJSON()
+	default:
+		b, err = yaml.Marshal(raw)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var rv AsyncOperationConfig
+	err = yaml.Unmarshal(b, &rv)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+func (ac *AsyncOperationConfig) validate() error {
+	if len(ac.StatusURL.Headers) == 0 && ac.StatusURL.BodyPath == "" && ac.StatePath == "" {
+		return fmt.Errorf("one of 'statusURL' or 'statePath' is required")
+	}
+	if ac.StatePath != "" && len(ac.SuccessValues) == 0 {
+		return fmt.Errorf("'successValues' is required alongside 'statePath'")
+	}
+	if ac.PollIntervalSeconds < 0 {
+		return fmt.Errorf("'pollIntervalSeconds' must not be negative")
+	}
+	for k, path := range map[string]string{
+		"statusURL.bodyPath": ac.StatusURL.BodyPath,
+		"statePath":          ac.StatePath,
+		"errorPath":          ac.ErrorPath,
+		"idPath":             ac.IDPath,
+	} {
+		if path == "" {
+			continue
+		}
+		if _, err := jsonpath.New(path); err != nil {
+			return fmt.Errorf("'%s' is not a valid JSON path: %w", k, err)
+		}
+	}
+	return nil
+}
+
+func (ac *AsyncOperationConfig) GetPollIntervalSeconds() int {
+	if ac.PollIntervalSeconds > 0 {
+		return ac.PollIntervalSeconds
+	}
+	return MonitorPollIntervalSeconds
+}
+
+func (ac *AsyncOperationConfig) GetStatusURL(headers http.Header, body map[string]interface{}) (string, bool) {
+	for _, h := range ac.StatusURL.Headers {
+		if v := headers.Get(h); v != "" {
+			return v, true
+		}
+	}
+	if ac.StatusURL.BodyPath != "" && body != nil {
+		if v, ok := lookupString(ac.StatusURL.BodyPath, body); ok && v != "" {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+func (ac *AsyncOperationConfig) GetState(body map[string]interface{}) (string, bool) {
+	if ac.StatePath == "" || body == nil {
+		return "", false
+	}
+	return lookupString(ac.StatePath, body)
+}
+
+func (ac *AsyncOperationConfig) IsSuccessState(state string) bool {
+	return containsFold(ac.SuccessValues, state)
+}
+
+func (ac *AsyncOperationConfig) IsFailureState(state string) bool {
+	return containsFold(ac.FailureValues, state)
+}
+
+func (ac *AsyncOperationConfig) GetOperationID(body map[string]interface{}, statusURL string) string {
+	if ac.IDPath != "" && body != nil {
+		if v, ok := lookupString(ac.IDPath, body); ok && v != "" {
+			return v
+		}
+	}
+	return statusURL
+}
+
+func (ac *AsyncOperationConfig) GetError(body map[string]interface{}) error {
+	if ac.ErrorPath == "" || body == nil {
+		return nil
+	}
+	v, err := jsonpath.Get(ac.ErrorPath, body)
+	if err != nil || v == nil {
+		return nil
+	}
+	switch v := v.(type) {
+	case string:
+		return fmt.Errorf("%s", v)
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("%v", v)
+		}
+		return fmt.Errorf("%s", string(b))
+	}
+}
+
+func lookupString(path string, body map[string]interface{}) (string, bool) {
+	v, err := jsonpath.Get(path, body)
+	if err != nil || v == nil {
+		return "", false
+	}
+	switch v := v.(type) {
+	case string:
+		return v, true
+	default:
+		return fmt.Sprintf("%v", v), true
+	}
+}
+
+func containsFold(candidates []string, s string) bool {
+	for _, c := range candidates {
+		if strings.EqualFold(c, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,179 @@
+package asyncmonitor_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stackql/go-openapistackql/openapistackql"
+	"github.com/stackql/stackql/internal/stackql/asyncmonitor"
+)
+
+func methodWithAsyncConfig(raw interface{}) *openapistackql.OperationStore {
+	op := &openapi3.Operation{OperationID: "create"}
+	if raw != nil {
+		op.Extensions = map[string]interface{}{
+			asyncmonitor.ExtensionKeyAsyncOperation: raw,
+		}
+	}
+	return &openapistackql.OperationStore{
+		MethodKey:    "create",
+		OperationRef: &openapistackql.OperationRef{Value: op},
+	}
+}
+
+func TestGetAsyncOperationConfig(t *testing.T) {
+	testCases := []struct {
+		name    string
+		raw     interface{}
+		wantOK  bool
+		wantErr bool
+	}{
+		{
+			name: "absent",
+		},
+		{
+			name:   "status header",
+			raw:    json.RawMessage(`{"statusURL": {"headers": ["Location"]}}`),
+			wantOK: true,
+		},
+		{
+			name:   "state path with success values",
+			raw:    json.RawMessage(`{"statePath": "$.status", "successValues": ["Succeeded"]}`),
+			wantOK: true,
+		},
+		{
+			name:    "unparseable",
+			raw:     json.RawMessage(`{"statusURL": "not an object"}`),
+			wantErr: true,
+		},
+		{
+			name:    "nothing to poll or await",
+			raw:     json.RawMessage(`{"pollIntervalSeconds": 5}`),
+			wantErr: true,
+		},
+		{
+			name:    "state path without success values",
+			raw:     json.RawMessage(`{"statePath": "$.status"}`),
+			wantErr: true,
+		},
+		{
+			name:    "negative poll interval",
+			raw:     json.RawMessage(`{"statusURL": {"headers": ["Location"]}, "pollIntervalSeconds": -1}`),
+			wantErr: true,
+		},
+		{
+			name:    "invalid json path",
+			raw:     json.RawMessage(`{"statePath": "$.[", "successValues": ["Succeeded"]}`),
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := methodWithAsyncConfig(tc.raw)
+			cfg, ok, err := asyncmonitor.GetAsyncOperationConfig(m)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("err = %v, want error %t", err, tc.wantErr)
+			}
+			if ok != tc.wantOK {
+				t.Fatalf("ok = %t, want %t", ok, tc.wantOK)
+			}
+			if ok && cfg == nil {
+				t.Fatalf("nil config when ok")
+			}
+			isAwaitable, err := asyncmonitor.IsAwaitable(m)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("IsAwaitable() err = %v, want error %t", err, tc.wantErr)
+			}
+			if isAwaitable != tc.wantOK {
+				t.Fatalf("IsAwaitable() = %t, want %t", isAwaitable, tc.wantOK)
+			}
+		})
+	}
+}
+
+func TestAsyncOperationConfigAccessors(t *testing.T) {
+	cfg := &asyncmonitor.AsyncOperationConfig{
+		StatusURL: asyncmonitor.AsyncOperationStatusURL{
+			Headers:  []string{"Azure-AsyncOperation", "Location"},
+			BodyPath: "$.selfLink",
+		},
+		StatePath:     "$.status",
+		SuccessValues: []string{"Succeeded"},
+		FailureValues: []string{"Failed", "Canceled"},
+		ErrorPath:     "$.error.message",
+		IDPath:        "$.id",
+	}
+	headers := http.Header{}
+	headers.Set("Location", "https://example.com/location")
+	testCases := []struct {
+		name          string
+		headers       http.Header
+		body          map[string]interface{}
+		wantURL       string
+		wantState     string
+		wantSuccess   bool
+		wantFailure   bool
+		wantErr       string
+		wantOperation string
+	}{
+		{
+			name:          "header wins over body",
+			headers:       headers,
+			body:          map[string]interface{}{"selfLink": "https://example.com/self", "status": "InProgress"},
+			wantURL:       "https://example.com/location",
+			wantState:     "InProgress",
+			wantOperation: "https://example.com/location",
+		},
+		{
+			name:          "body path and case insensitive success",
+			headers:       http.Header{},
+			body:          map[string]interface{}{"selfLink": "https://example.com/self", "status": "succeeded", "id": "op-1"},
+			wantURL:       "https://example.com/self",
+			wantState:     "succeeded",
+			wantSuccess:   true,
+			wantOperation: "op-1",
+		},
+		{
+			name:          "failure with error",
+			headers:       http.Header{},
+			body:          map[string]interface{}{"status": "Canceled", "error": map[string]interface{}{"message": "quota exceeded"}},
+			wantState:     "Canceled",
+			wantFailure:   true,
+			wantErr:       "quota exceeded",
+			wantOperation: "",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			url, _ := cfg.GetStatusURL(tc.headers, tc.body)
+			if url != tc.wantURL {
+				t.Fatalf("status URL = '%s', want '%s'", url, tc.wantURL)
+			}
+			state, _ := cfg.GetState(tc.body)
+			if state != tc.wantState {
+				t.Fatalf("state = '%s', want '%s'", state, tc.wantState)
+			}
+			if got := cfg.IsSuccessState(state); got != tc.wantSuccess {
+				t.Fatalf("success = %t, want %t", got, tc.wantSuccess)
+			}
+			if got := cfg.IsFailureState(state); got != tc.wantFailure {
+				t.Fatalf("failure = %t, want %t", got, tc.wantFailure)
+			}
+			gotErr := ""
+			if err := cfg.GetError(tc.body); err != nil {
+				gotErr = err.Error()
+			}
+			if gotErr != tc.wantErr {
+				t.Fatalf("error = '%s', want '%s'", gotErr, tc.wantErr)
+			}
+			if got := cfg.GetOperationID(tc.body, url); got != tc.wantOperation {
+				t.Fatalf("operation ID = '%s', want '%s'", got, tc.wantOperation)
+			}
+		})
+	}
+	if got := cfg.GetPollIntervalSeconds(); got != asyncmonitor.MonitorPollIntervalSeconds {
+		t.Fatalf("default poll interval = %d, want %d", got, asyncmonitor.MonitorPollIntervalSeconds)
+	}
+}
@@ -3,6 +3,7 @@ package asyncmonitor
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -10,16 +11,26 @@ import (
 	"github.com/stackql/stackql/internal/stackql/httpmiddleware"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
 	"github.com/stackql/stackql/internal/stackql/logging"
+	"github.com/stackql/stackql/internal/stackql/parserutil"
 	"github.com/stackql/stackql/internal/stackql/primitive"
 	"github.com/stackql/stackql/internal/stackql/provider"
+	"github.com/stackql/stackql/internal/stackql/sessionctx"
 	"github.com/stackql/stackql/internal/stackql/tablemetadata"
 	"github.com/stackql/stackql/internal/stackql/util"
 
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 )
 
+const subsystem logging.Subsystem = "asyncmonitor"
+
 var MonitorPollIntervalSeconds int = 10
 
+const (
+	awaitDirective         string = "AWAIT"
+	awaitTimeoutDirective  string = "AWAIT.timeout"
+	awaitIntervalDirective string = "AWAIT.interval"
+)
+
 type IAsyncMonitor interface {
 	GetMonitorPrimitive(heirarchy tablemetadata.HeirarchyObjects, precursor primitive.IPrimitive, initialCtx primitive.IPrimitiveCtx, comments sqlparser.CommentDirectives) (primitive.IPrimitive, error)
 }
@@ -31,11 +42,16 @@ type AsyncHttpMonitorPrimitive struct {
 	precursor           primitive.IPrimitive
 	transferPayload     map[string]interface{}
 	executor            func(pc primitive.IPrimitiveCtx, initalBody interface{}) internaldto.ExecutorOutput
+	headerExecutor      func(pc primitive.IPrimitiveCtx, requestURL *url.URL, headers http.Header, initialBody map[string]interface{}) internaldto.ExecutorOutput
 	elapsedSeconds      int
 	pollIntervalSeconds int
-	noStatus            bool
-	id                  int64
-	comments            sqlparser.CommentDirectives
+	// intervalDirectiveSeconds is the poll interval set by the
+	// caller, if any, beyond which Retry-After does not extend.
+	intervalDirectiveSeconds int
+	timeoutSeconds           int
+	noStatus                 bool
+	id                       int64
+	comments                 sqlparser.CommentDirectives
 }
 
 func (pr *AsyncHttpMonitorPrimitive) SetTxnId(id int) {
@@ -53,7 +69,93 @@ func (pr *AsyncHttpMonitorPrimitive) Optimise() error {
 	return nil
 }
 
+// applyDirectives honours the AWAIT(timeout=<seconds>, interval=<seconds>)
+// and NOSTATUS comment directives.
+func (asm *AsyncHttpMonitorPrimitive) applyDirectives(comments sqlparser.CommentDirectives) {
+	if comments == nil {
+		return
+	}
+	asm.noStatus = comments.IsSet("NOSTATUS")
+	if interval, ok := parserutil.GetIntCommentDirective(comments, awaitIntervalDirective); ok && interval > 0 {
+		asm.pollIntervalSeconds = interval
+		asm.intervalDirectiveSeconds = interval
+	}
+	if timeout, ok := parserutil.GetIntCommentDirective(comments, awaitTimeoutDirective); ok && timeout > 0 {
+		asm.timeoutSeconds = timeout
+	}
+}
+
+// awaitNextPoll blocks for the poll interval.  It returns an error naming the
+// operation if the AWAIT timeout elapses or the wait is cancelled,
+// since the operation may well continue server side.
+func (asm *AsyncHttpMonitorPrimitive) awaitNextPoll(operationID string) error {
+	interval := asm.pollIntervalSeconds
+	if asm.timeoutSeconds > 0 {
+		if asm.elapsedSeconds >= asm.timeoutSeconds {
+			return fmt.Errorf("timed out after %d seconds awaiting operation '%s', which may still be in progress", asm.elapsedSeconds, operationID)
+		}
+		if asm.elapsedSeconds+interval > asm.timeoutSeconds {
+			interval = asm.timeoutSeconds - asm.elapsedSeconds
+		}
+	}
+	select {
+	case <-asm.handlerCtx.GetContext().Done():
+		return fmt.Errorf("cancelled after %d seconds awaiting operation '%s', which may still be in progress", asm.elapsedSeconds, operationID)
+	case <-time.After(time.Duration(interval) * time.Second):
+	}
+	asm.elapsedSeconds += interval
+	return nil
+}
+
+// applyRetryAfter adopts the poll interval requested by the server,
+// clamped to the interval directive of the caller, if any.
+func (asm *AsyncHttpMonitorPrimitive) applyRetryAfter(retryAfterSeconds int) {
+	if retryAfterSeconds <= 0 {
+		return
+	}
+	if asm.intervalDirectiveSeconds > 0 && retryAfterSeconds > asm.intervalDirectiveSeconds {
+		retryAfterSeconds = asm.intervalDirectiveSeconds
+	}
+	asm.pollIntervalSeconds = retryAfterSeconds
+}
+
+func (asm *AsyncHttpMonitorPrimitive) reportProgress(pc primitive.IPrimitiveCtx, operationDescriptor string, status string) {
+	if asm.noStatus {
+		return
+	}
+	if status == "" {
+		asm.writeStatus(pc, fmt.Sprintf("%s in progress, %d seconds elapsed", operationDescriptor, asm.elapsedSeconds))
+		return
+	}
+	asm.writeStatus(pc, fmt.Sprintf("%s in progress, status = '%s', %d seconds elapsed", operationDescriptor, status, asm.elapsedSeconds))
+}
+
+// writeStatus relays the status to the client as a notice in server
+// mode, else writes it to stderr.
+func (asm *AsyncHttpMonitorPrimitive) writeStatus(pc primitive.IPrimitiveCtx, status string) {
+	if sessionctx.Notify(asm.handlerCtx.GetContext(), status) {
+		return
+	}
+	pc.GetErrWriter().Write([]byte(status + fmt.Sprintln("")))
+}
+
 func (asm *AsyncHttpMonitorPrimitive) Execute(pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
+	if asm.headerExecutor != nil {
+		if pc == nil {
+			pc = asm.initialCtx
+		}
+		pr := asm.precursor.Execute(pc)
+		if pr.Err != nil {
+			return pr
+		}
+		asyP := internaldto.NewBasicPrimitiveContext(
+			asm.initialCtx.GetAuthContext,
+			pc.GetWriter(),
+			pc.GetErrWriter(),
+		).WithContext(pc.GetContext())
+		requestURL, _ := pr.GetRequestURL()
+		return asm.headerExecutor(asyP, requestURL, pr.GetResponseHeaders(), pr.GetOutputBody())
+	}
 	if asm.executor != nil {
 		if pc == nil {
 			pc = asm.initialCtx
@@ -73,7 +175,7 @@ func (asm *AsyncHttpMonitorPrimitive) Execute(pc primitive.IPrimitiveCtx) intern
 			asm.initialCtx.GetAuthContext,
 			pc.GetWriter(),
 			pc.GetErrWriter(),
-		)
+		).WithContext(pc.GetContext())
 		return asm.executor(asyP, pr.GetOutputBody())
 	}
 	return internaldto.NewExecutorOutput(nil, nil, nil, nil, nil)
@@ -97,7 +199,7 @@ func NewAsyncMonitor(handlerCtx handler.HandlerContext, prov provider.IProvider)
 	case "google":
 		return newGoogleAsyncMonitor(handlerCtx, prov, prov.GetVersion())
 	}
-	return nil, fmt.Errorf("async operation monitor for provider = '%s', api version = '%s' currently not supported", prov.GetProviderString(), prov.GetVersion())
+	return newGenericAsyncMonitor(handlerCtx, prov)
 }
 
 func newGoogleAsyncMonitor(handlerCtx handler.HandlerContext, prov provider.IProvider, version string) (IAsyncMonitor, error) {
@@ -117,6 +219,18 @@ type DefaultGoogleAsyncMonitor struct {
 }
 
 func (gm *DefaultGoogleAsyncMonitor) GetMonitorPrimitive(heirarchy tablemetadata.HeirarchyObjects, precursor primitive.IPrimitive, initialCtx primitive.IPrimitiveCtx, comments sqlparser.CommentDirectives) (primitive.IPrimitive, error) {
+	_, ok, err := GetAsyncOperationConfig(heirarchy.GetMethod())
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		// declarative config in provider docs takes precedence
+		genericMonitor := &DefaultGenericAsyncMonitor{
+			handlerCtx: gm.handlerCtx,
+			provider:   gm.provider,
+		}
+		return genericMonitor.GetMonitorPrimitive(heirarchy, precursor, initialCtx, comments)
+	}
 	switch strings.ToLower(heirarchy.GetProvider().GetVersion()) {
 	default:
 		return gm.getV1Monitor(heirarchy, precursor, initialCtx, comments)
@@ -141,6 +255,22 @@ func getOperationDescriptor(body map[string]interface{}) string {
 	return operationDescriptor
 }
 
+func getGoogleOperationID(body map[string]interface{}) string {
+	for _, k := range []string{"name", "selfLink"} {
+		if v, ok := body[k].(string); ok && v != "" {
+			return v
+		}
+	}
+	return "unknown"
+}
+
+func getGoogleOperationStatus(body map[string]interface{}) string {
+	if v, ok := body["status"].(string); ok {
+		return v
+	}
+	return ""
+}
+
 func (gm *DefaultGoogleAsyncMonitor) getV1Monitor(heirarchy tablemetadata.HeirarchyObjects, precursor primitive.IPrimitive, initialCtx primitive.IPrimitiveCtx, comments sqlparser.CommentDirectives) (primitive.IPrimitive, error) {
 	asyncPrim := AsyncHttpMonitorPrimitive{
 		handlerCtx:          gm.handlerCtx,
@@ -151,9 +281,7 @@ func (gm *DefaultGoogleAsyncMonitor) getV1Monitor(heirarchy tablemetadata.Heirar
 		pollIntervalSeconds: MonitorPollIntervalSeconds,
 		comments:            comments,
 	}
-	if comments != nil {
-		asyncPrim.noStatus = comments.IsSet("NOSTATUS")
-	}
+	asyncPrim.applyDirectives(comments)
 	m := heirarchy.GetMethod()
 	if m.IsAwaitable() {
 		asyncPrim.executor = func(pc primitive.IPrimitiveCtx, bd interface{}) internaldto.ExecutorOutput {
@@ -167,14 +295,14 @@ func (gm *DefaultGoogleAsyncMonitor) getV1Monitor(heirarchy tablemetadata.Heirar
 			if body == nil {
 				return internaldto.NewExecutorOutput(nil, nil, nil, nil, fmt.Errorf("cannot execute monitor: no body present"))
 			}
-			logging.GetLogger().Infoln(fmt.Sprintf("body = %v", body))
+			subsystem.GetContextLogger(pc.GetContext()).Infoln(fmt.Sprintf("body = %v", body))
 
 			operationDescriptor := getOperationDescriptor(body)
 			endTime, endTimeOk := body["endTime"]
 			if endTimeOk && endTime != "" {
 				return prepareReultSet(&asyncPrim, pc, body, operationDescriptor)
 			}
-			url, ok := body["selfLink"]
+			selfLink, ok := body["selfLink"]
 			if !ok {
 				return internaldto.NewExecutorOutput(nil, nil, nil, nil, fmt.Errorf("cannot execute monitor: no 'selfLink' property present"))
 			}
@@ -186,16 +314,16 @@ func (gm *DefaultGoogleAsyncMonitor) getV1Monitor(heirarchy tablemetadata.Heirar
 			if authCtx == nil {
 				return internaldto.NewExecutorOutput(nil, nil, nil, nil, fmt.Errorf("cannot execute monitor: no auth context"))
 			}
-			time.Sleep(time.Duration(asyncPrim.pollIntervalSeconds) * time.Second)
-			asyncPrim.elapsedSeconds += asyncPrim.pollIntervalSeconds
-			if !asyncPrim.noStatus {
-				pc.GetWriter().Write([]byte(fmt.Sprintf("%s in progress, %d seconds elapsed", operationDescriptor, asyncPrim.elapsedSeconds) + fmt.Sprintln("")))
+			err = asyncPrim.awaitNextPoll(getGoogleOperationID(body))
+			if err != nil {
+				return internaldto.NewExecutorOutput(nil, nil, nil, nil, err)
 			}
-			req, err := getMonitorRequest(url.(string))
+			asyncPrim.reportProgress(pc, operationDescriptor, getGoogleOperationStatus(body))
+			req, err := getMonitorRequest(nil, selfLink.(string))
 			if err != nil {
 				return internaldto.NewExecutorOutput(nil, nil, nil, nil, err)
 			}
-			response, apiErr := httpmiddleware.HttpApiCallFromRequest(gm.handlerCtx.Clone(), gm.provider, m, req)
+			response, apiErr := httpmiddleware.HttpApiCallFromRequest(httpmiddleware.WithParentSpan(gm.handlerCtx, pc.GetContext()), gm.provider, m, req)
 			if apiErr != nil {
 				return internaldto.NewExecutorOutput(nil, nil, nil, nil, apiErr)
 			}
@@ -208,7 +336,7 @@ func (gm *DefaultGoogleAsyncMonitor) getV1Monitor(heirarchy tablemetadata.Heirar
 				pc.GetAuthContext,
 				pc.GetWriter(),
 				pc.GetErrWriter(),
-			),
+			).WithContext(pc.GetContext()),
 				target)
 		}
 		return &asyncPrim, nil
@@ -226,15 +354,25 @@ func prepareReultSet(prim *AsyncHttpMonitorPrimitive, pc primitive.IPrimitiveCtx
 		Err:         nil,
 	}
 	if !prim.noStatus {
-		pc.GetWriter().Write([]byte(fmt.Sprintf("%s complete", operationDescriptor) + fmt.Sprintln("")))
+		prim.writeStatus(pc, fmt.Sprintf("%s complete, %d seconds elapsed", operationDescriptor, prim.elapsedSeconds))
 	}
 	return util.PrepareResultSet(payload)
 }
 
-func getMonitorRequest(urlStr string) (*http.Request, error) {
+// getMonitorRequest returns a request to poll the status URL,
+// which, if relative, is resolved against that of the request
+// from which it was obtained.
+func getMonitorRequest(requestURL *url.URL, urlStr string) (*http.Request, error) {
+	statusURL, err := url.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+	if requestURL != nil {
+		statusURL = requestURL.ResolveReference(statusURL)
+	}
 	return http.NewRequest(
 		"GET",
-		urlStr,
+		statusURL.String(),
 		nil,
 	)
 }
@@ -0,0 +1,164 @@
+package asyncmonitor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/handler"
+	"github.com/stackql/stackql/internal/stackql/httpmiddleware"
+	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
+	"github.com/stackql/stackql/internal/stackql/primitive"
+	"github.com/stackql/stackql/internal/stackql/provider"
+	"github.com/stackql/stackql/internal/stackql/tablemetadata"
+)
+
+// DefaultGenericAsyncMonitor polls long running operations
+// as described by a method's AsyncOperationConfig.
+type DefaultGenericAsyncMonitor struct {
+	handlerCtx handler.HandlerContext
+	provider   provider.IProvider
+}
+
+func newGenericAsyncMonitor(handlerCtx handler.HandlerContext, prov provider.IProvider) (IAsyncMonitor, error) {
+	return &DefaultGenericAsyncMonitor{
+		handlerCtx: handlerCtx,
+		provider:   prov,
+	}, nil
+}
+
+func (gm *DefaultGenericAsyncMonitor) GetMonitorPrimitive(heirarchy tablemetadata.HeirarchyObjects, precursor primitive.IPrimitive, initialCtx primitive.IPrimitiveCtx, comments sqlparser.CommentDirectives) (primitive.IPrimitive, error) {
+	m := heirarchy.GetMethod()
+	cfg, ok, err := GetAsyncOperationConfig(m)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("async operation monitor for provider = '%s', method = '%s' currently not supported: no '%s' config present", gm.provider.GetProviderString(), m.GetName(), ExtensionKeyAsyncOperation)
+	}
+	asyncPrim := AsyncHttpMonitorPrimitive{
+		handlerCtx:          gm.handlerCtx,
+		heirarchy:           heirarchy,
+		initialCtx:          initialCtx,
+		precursor:           precursor,
+		elapsedSeconds:      0,
+		pollIntervalSeconds: cfg.GetPollIntervalSeconds(),
+		comments:            comments,
+	}
+	asyncPrim.applyDirectives(comments)
+	asyncPrim.headerExecutor = func(pc primitive.IPrimitiveCtx, requestURL *url.URL, headers http.Header, body map[string]interface{}) internaldto.ExecutorOutput {
+		if pc == nil {
+			return internaldto.NewErroneousExecutorOutput(fmt.Errorf("cannot execute monitor: nil plan primitive"))
+		}
+		operationDescriptor := fmt.Sprintf("%s operation", m.GetName())
+		statusURL, hasStatusURL := cfg.GetStatusURL(headers, body)
+		for {
+			if state, ok := cfg.GetState(body); ok {
+				if cfg.IsSuccessState(state) {
+					return prepareReultSet(&asyncPrim, pc, body, operationDescriptor)
+				}
+				if cfg.IsFailureState(state) {
+					return internaldto.NewErroneousExecutorOutput(gm.getFailure(cfg, body, state, statusURL))
+				}
+			}
+			if !hasStatusURL {
+				if _, ok := cfg.GetState(body); !ok {
+					// nothing to poll and no state to await
+					return prepareReultSet(&asyncPrim, pc, body, operationDescriptor)
+				}
+				return internaldto.NewErroneousExecutorOutput(fmt.Errorf("cannot execute monitor: no status URL present for operation"))
+			}
+			err := asyncPrim.awaitNextPoll(cfg.GetOperationID(body, statusURL))
+			if err != nil {
+				return internaldto.NewErroneousExecutorOutput(err)
+			}
+			state, _ := cfg.GetState(body)
+			asyncPrim.reportProgress(pc, operationDescriptor, state)
+			req, err := getMonitorRequest(requestURL, statusURL)
+			if err != nil {
+				return internaldto.NewErroneousExecutorOutput(err)
+			}
+			// status URLs in poll responses are relative to the poll
+			requestURL = req.URL
+			response, apiErr := httpmiddleware.HttpApiCallFromRequest(httpmiddleware.WithParentSpan(gm.handlerCtx, pc.GetContext()), gm.provider, m, req)
+			if apiErr != nil {
+				return internaldto.NewErroneousExecutorOutput(apiErr)
+			}
+			body, err = readMonitorResponseBody(pc.GetContext(), response)
+			gm.handlerCtx.LogHTTPResponseMap(body)
+			if err != nil {
+				return internaldto.NewErroneousExecutorOutput(err)
+			}
+			if response.StatusCode >= 400 {
+				return internaldto.NewErroneousExecutorOutput(gm.getFailure(cfg, body, response.Status, statusURL))
+			}
+			if retryAfter, ok := getRetryAfterSeconds(response.Header, time.Now()); ok {
+				asyncPrim.applyRetryAfter(retryAfter)
+			}
+			if nextURL, ok := cfg.GetStatusURL(response.Header, nil); ok {
+				statusURL = nextURL
+			}
+			if _, ok := cfg.GetState(body); !ok && response.StatusCode != http.StatusAccepted {
+				// location style polling signals completion by ceasing to return 202
+				return prepareReultSet(&asyncPrim, pc, body, operationDescriptor)
+			}
+		}
+	}
+	return &asyncPrim, nil
+}
+
+func (gm *DefaultGenericAsyncMonitor) getFailure(cfg *AsyncOperationConfig, body map[string]interface{}, state string, statusURL string) error {
+	if err := cfg.GetError(body); err != nil {
+		return fmt.Errorf("operation '%s' failed with state '%s': %s", cfg.GetOperationID(body, statusURL), state, err.Error())
+	}
+	return fmt.Errorf("operation '%s' failed with state '%s'", cfg.GetOperationID(body, statusURL), state)
+}
+
+// getRetryAfterSeconds reads the Retry-After header,
+// which holds either a count of seconds or an HTTP date.
+func getRetryAfterSeconds(header http.Header, now time.Time) (int, bool) {
+	retryAfter := strings.TrimSpace(header.Get("Retry-After"))
+	if retryAfter == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(retryAfter); err == nil {
+		return seconds, seconds > 0
+	}
+	retryTime, err := http.ParseTime(retryAfter)
+	if err != nil {
+		return 0, false
+	}
+	seconds := int(math.Ceil(retryTime.Sub(now).Seconds()))
+	return seconds, seconds > 0
+}
+
+// readMonitorResponseBody decodes status responses generically,
+// since they need not conform to the method's response schema.
+func readMonitorResponseBody(ctx context.Context, response *http.Response) (map[string]interface{}, error) {
+	if response.Body == nil {
+		return nil, nil
+	}
+	defer response.Body.Close()
+	b, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, nil
+	}
+	var target map[string]interface{}
+	err = json.Unmarshal(b, &target)
+	if err != nil {
+		subsystem.GetContextLogger(ctx).Infoln(fmt.Sprintf("async monitor could not process response body: %s", err.Error()))
+		return nil, nil
+	}
+	return target, nil
+}
@@ -0,0 +1,102 @@
+package asyncmonitor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/stackql/stackql/internal/stackql/dto"
+	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
+	"github.com/stackql/stackql/internal/stackql/logging"
+	"github.com/stackql/stackql/internal/stackql/primitive"
+	"github.com/stackql/stackql/internal/stackql/provider"
+	"github.com/stackql/stackql/internal/stackql/sessionctx"
+	"github.com/stackql/stackql/internal/stackql/tablemetadata"
+)
+
+type monitorProvider struct {
+	provider.IProvider
+}
+
+func (p *monitorProvider) GetProviderString() string {
+	return "google"
+}
+
+type monitorHeirarchy struct {
+	tablemetadata.HeirarchyObjects
+}
+
+func (h *monitorHeirarchy) GetProvider() provider.IProvider {
+	return &monitorProvider{}
+}
+
+func TestMonitorPollLogsCarryQueryID(t *testing.T) {
+	std := logrus.StandardLogger()
+	priorOut, priorFormatter, priorLevel := std.Out, std.Formatter, std.GetLevel()
+	t.Cleanup(func() {
+		std.SetOutput(priorOut)
+		logging.SetLogger(priorLevel.String(), logging.FormatText, "")
+		std.SetFormatter(priorFormatter)
+	})
+	buf := &bytes.Buffer{}
+	std.SetOutput(buf)
+	logging.SetLogger("info", logging.FormatJSON, "")
+
+	ctx := sessionctx.WithQueryID(context.Background(), "q1")
+	initialCtx := internaldto.NewBasicPrimitiveContext(
+		func(string) (*dto.AuthCtx, error) { return &dto.AuthCtx{}, nil },
+		io.Discard,
+		io.Discard,
+	).WithContext(ctx)
+	precursor := primitive.NewLocalPrimitive(func(pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
+		return internaldto.NewExecutorOutput(nil, map[string]interface{}{"name": "op1"}, nil, nil, nil)
+	})
+	for _, tc := range []struct {
+		name string
+		prim *AsyncHttpMonitorPrimitive
+	}{
+		{
+			name: "status url",
+			prim: &AsyncHttpMonitorPrimitive{
+				headerExecutor: func(pc primitive.IPrimitiveCtx, requestURL *url.URL, headers http.Header, body map[string]interface{}) internaldto.ExecutorOutput {
+					_, err := readMonitorResponseBody(pc.GetContext(), &http.Response{Body: io.NopCloser(strings.NewReader("not json"))})
+					return internaldto.NewExecutorOutput(nil, nil, nil, nil, err)
+				},
+			},
+		},
+		{
+			name: "operation body",
+			prim: &AsyncHttpMonitorPrimitive{
+				heirarchy: &monitorHeirarchy{},
+				executor: func(pc primitive.IPrimitiveCtx, body interface{}) internaldto.ExecutorOutput {
+					subsystem.GetContextLogger(pc.GetContext()).Infoln(fmt.Sprintf("body = %v", body))
+					return internaldto.NewExecutorOutput(nil, nil, nil, nil, nil)
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			buf.Reset()
+			tc.prim.initialCtx = initialCtx
+			tc.prim.precursor = precursor
+			if output := tc.prim.Execute(initialCtx); output.Err != nil {
+				t.Fatalf("unexpected error: %v", output.Err)
+			}
+			line := make(map[string]interface{})
+			if err := json.Unmarshal(bytes.TrimSpace(buf.Bytes()), &line); err != nil {
+				t.Fatalf("cannot parse log line '%s': %v", buf.String(), err)
+			}
+			if line[logging.QueryIDKey] != "q1" || line[logging.SubsystemKey] != string(subsystem) {
+				t.Fatalf("poll log line = %v, want query ID q1 from subsystem %s", line, subsystem)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package asyncmonitor
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestGetMonitorRequest(t *testing.T) {
+	requestURL, err := url.Parse("https://management.azure.com/subscriptions/s1/providers/Microsoft.Compute/virtualMachines/vm1?api-version=2022-08-01")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	testCases := []struct {
+		name       string
+		requestURL *url.URL
+		statusURL  string
+		want       string
+	}{
+		{
+			name:       "absolute",
+			requestURL: requestURL,
+			statusURL:  "https://management.azure.com/operations/op1?api-version=2022-08-01",
+			want:       "https://management.azure.com/operations/op1?api-version=2022-08-01",
+		},
+		{
+			name:       "host relative",
+			requestURL: requestURL,
+			statusURL:  "/operations/op1?api-version=2022-08-01",
+			want:       "https://management.azure.com/operations/op1?api-version=2022-08-01",
+		},
+		{
+			name:       "path relative",
+			requestURL: requestURL,
+			statusURL:  "vm1/operations/op1",
+			want:       "https://management.azure.com/subscriptions/s1/providers/Microsoft.Compute/virtualMachines/vm1/operations/op1",
+		},
+		{
+			name:      "absolute absent a request URL",
+			statusURL: "https://compute.googleapis.com/compute/v1/projects/p1/zones/z1/operations/op1",
+			want:      "https://compute.googleapis.com/compute/v1/projects/p1/zones/z1/operations/op1",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := getMonitorRequest(tc.requestURL, tc.statusURL)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := req.URL.String(); got != tc.want {
+				t.Fatalf("getMonitorRequest() URL = %s, want %s", got, tc.want)
+			}
+			if req.URL.Host == "" {
+				t.Fatalf("getMonitorRequest() URL %s has no host", req.URL)
+			}
+		})
+	}
+}
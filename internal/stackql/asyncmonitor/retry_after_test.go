@@ -0,0 +1,64 @@
+package asyncmonitor
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestGetRetryAfterSeconds(t *testing.T) {
+	now := time.Date(2023, time.March, 1, 10, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name        string
+		retryAfter  string
+		wantSeconds int
+		wantOk      bool
+	}{
+		{name: "absent"},
+		{name: "seconds", retryAfter: "7", wantSeconds: 7, wantOk: true},
+		{name: "zero seconds", retryAfter: "0"},
+		{name: "negative seconds", retryAfter: "-3", wantSeconds: -3},
+		{name: "http date", retryAfter: "Wed, 01 Mar 2023 10:00:30 GMT", wantSeconds: 30, wantOk: true},
+		{name: "http date in the past", retryAfter: "Wed, 01 Mar 2023 09:59:00 GMT", wantSeconds: -60},
+		{name: "malformed", retryAfter: "soon"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			header := http.Header{}
+			if tc.retryAfter != "" {
+				header.Set("Retry-After", tc.retryAfter)
+			}
+			seconds, ok := getRetryAfterSeconds(header, now)
+			if ok != tc.wantOk || (ok && seconds != tc.wantSeconds) {
+				t.Fatalf("getRetryAfterSeconds() = (%d, %t), want (%d, %t)", seconds, ok, tc.wantSeconds, tc.wantOk)
+			}
+		})
+	}
+}
+
+func TestApplyRetryAfter(t *testing.T) {
+	testCases := []struct {
+		name              string
+		pollInterval      int
+		intervalDirective int
+		retryAfter        int
+		wantPollInterval  int
+	}{
+		{name: "no directive", pollInterval: 10, retryAfter: 30, wantPollInterval: 30},
+		{name: "shorter than directive", pollInterval: 20, intervalDirective: 20, retryAfter: 5, wantPollInterval: 5},
+		{name: "clamped to directive", pollInterval: 20, intervalDirective: 20, retryAfter: 120, wantPollInterval: 20},
+		{name: "ignored when not positive", pollInterval: 10, retryAfter: 0, wantPollInterval: 10},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			asm := &AsyncHttpMonitorPrimitive{
+				pollIntervalSeconds:      tc.pollInterval,
+				intervalDirectiveSeconds: tc.intervalDirective,
+			}
+			asm.applyRetryAfter(tc.retryAfter)
+			if asm.pollIntervalSeconds != tc.wantPollInterval {
+				t.Fatalf("poll interval = %d, want %d", asm.pollIntervalSeconds, tc.wantPollInterval)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package auditlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/stackql/stackql/internal/stackql/constants"
+	"github.com/stackql/stackql/internal/stackql/dto"
+	"github.com/stackql/stackql/internal/stackql/sql_system"
+)
+
+const (
+	// TimestampLayout is fixed width, so that timestamps sort lexically.
+	TimestampLayout string = "2006-01-02T15:04:05.000000Z"
+)
+
+var (
+	_ AuditLog = &standardAuditLog{}
+	_ sink     = &fileSink{}
+	_ sink     = &tableSink{}
+
+	// tableColumns are in the order of Entry.values().
+	tableColumns []string = []string{
+		"timestamp",
+		"session",
+		"user",
+		"provider",
+		"service",
+		"resource",
+		"method",
+		"statement",
+		"http_method",
+		"url",
+		"request_body",
+		"status",
+		"operation_id",
+		"error",
+	}
+)
+
+// Entry records a single provider API call that is not a GET.
+// The request body is redacted before it is recorded.
+type Entry struct {
+	Timestamp   string          `json:"timestamp"`
+	Session     string          `json:"session,omitempty"`
+	User        string          `json:"user,omitempty"`
+	Provider    string          `json:"provider"`
+	Service     string          `json:"service"`
+	Resource    string          `json:"resource"`
+	Method      string          `json:"method"`
+	Statement   string          `json:"statement"`
+	HTTPMethod  string          `json:"httpMethod"`
+	URL         string          `json:"url"`
+	RequestBody json.RawMessage `json:"requestBody,omitempty"`
+	Status      int             `json:"status"`
+	OperationID string          `json:"operationId,omitempty"`
+	Error       string          `json:"error,omitempty"`
+}
+
+func (e Entry) values() []interface{} {
+	return []interface{}{
+		e.Timestamp,
+		e.Session,
+		e.User,
+		e.Provider,
+		e.Service,
+		e.Resource,
+		e.Method,
+		e.Statement,
+		e.HTTPMethod,
+		e.URL,
+		string(e.RequestBody),
+		e.Status,
+		e.OperationID,
+		e.Error,
+	}
+}
+
+// AuditLog appends entries to a JSONL file, a backend
+// table, or both, as per "--audit.file" and "--audit.table".
+type AuditLog interface {
+	IsEnabled() bool
+	Write(Entry) error
+}
+
+type sink interface {
+	write(Entry) error
+}
+
+// NewAuditLog opens the file and creates the table, if absent,
+// so that misconfiguration is apparent before any call is made.
+func NewAuditLog(runtimeCtx dto.RuntimeCtx, sqlSystem sql_system.SQLSystem) (AuditLog, error) {
+	rv := &standardAuditLog{}
+	if runtimeCtx.AuditFile != "" {
+		s, err := newFileSink(runtimeCtx.AuditFile)
+		if err != nil {
+			return nil, err
+		}
+		rv.sinks = append(rv.sinks, s)
+	}
+	if runtimeCtx.AuditTable != "" {
+		s, err := newTableSink(runtimeCtx.AuditTable, sqlSystem)
+		if err != nil {
+			return nil, err
+		}
+		rv.sinks = append(rv.sinks, s)
+	}
+	return rv, nil
+}
+
+type standardAuditLog struct {
+	sinks []sink
+}
+
+func (al *standardAuditLog) IsEnabled() bool {
+	return len(al.sinks) > 0
+}
+
+func (al *standardAuditLog) Write(entry Entry) error {
+	var errs []string
+	for _, s := range al.sinks {
+		if err := s.write(entry); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("cannot write audit log: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+type fileSink struct {
+	file  *os.File
+	mutex sync.Mutex
+}
+
+func newFileSink(filePath string) (sink, error) {
+	f, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open audit log: %w", err)
+	}
+	return &fileSink{
+		file: f,
+	}, nil
+}
+
+func (fs *fileSink) write(entry Entry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+	// a single write per line, so that concurrent
+	// processes appending to the file do not interleave
+	_, err = fs.file.Write(append(b, '\n'))
+	return err
+}
+
+type tableSink struct {
+	sqlSystem sql_system.SQLSystem
+	dml       string
+}
+
+func newTableSink(tableName string, sqlSystem sql_system.SQLSystem) (sink, error) {
+	fqTableName, err := sqlSystem.GetFullyQualifiedTableName(tableName)
+	if err != nil {
+		return nil, err
+	}
+	var columnDefinitions, columnNames, placeholders []string
+	for i, col := range tableColumns {
+		colType := "text"
+		if col == "status" {
+			colType = "integer"
+		}
+		columnDefinitions = append(columnDefinitions, fmt.Sprintf(`"%s" %s`, col, colType))
+		columnNames = append(columnNames, fmt.Sprintf(`"%s"`, col))
+		switch sqlSystem.GetName() {
+		case constants.SQLDialectPostgres, constants.SQLDialectDuckDB:
+			placeholders = append(placeholders, fmt.Sprintf("$%d", i+1))
+		default:
+			placeholders = append(placeholders, "?")
+		}
+	}
+	ddl := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s ( %s )`, fqTableName, strings.Join(columnDefinitions, ", "))
+	if _, err := sqlSystem.GetSQLEngine().Exec(ddl); err != nil {
+		return nil, fmt.Errorf("cannot create audit table '%s': %w", tableName, err)
+	}
+	return &tableSink{
+		sqlSystem: sqlSystem,
+		dml:       fmt.Sprintf(`INSERT INTO %s ( %s ) VALUES ( %s )`, fqTableName, strings.Join(columnNames, ", "), strings.Join(placeholders, ", ")),
+	}, nil
+}
+
+func (ts *tableSink) write(entry Entry) error {
+	_, err := ts.sqlSystem.GetSQLEngine().Exec(ts.dml, entry.values()...)
+	return err
+}
+
+// GetOperationID returns the first of "operationId", "id"
+// or "name" present at the top level of a JSON response body.
+func GetOperationID(responseBody []byte) string {
+	var body map[string]interface{}
+	if err := json.Unmarshal(responseBody, &body); err != nil {
+		return ""
+	}
+	for _, k := range []string{"operationId", "id", "name"} {
+		if v, ok := body[k]; ok && v != nil {
+			switch v := v.(type) {
+			case string:
+				return v
+			case float64:
+				return fmt.Sprintf("%.0f", v)
+			default:
+				return fmt.Sprintf("%v", v)
+			}
+		}
+	}
+	return ""
+}
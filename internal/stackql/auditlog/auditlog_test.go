@@ -0,0 +1,100 @@
+package auditlog_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stackql/stackql/internal/stackql/auditlog"
+	"github.com/stackql/stackql/internal/stackql/dto"
+)
+
+func TestNewAuditLogDisabled(t *testing.T) {
+	al, err := auditlog.NewAuditLog(dto.RuntimeCtx{}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if al.IsEnabled() {
+		t.Fatalf("IsEnabled() = true without a file or table")
+	}
+	if err := al.Write(auditlog.Entry{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestFileAuditLog(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "audit.jsonl")
+	al, err := auditlog.NewAuditLog(dto.RuntimeCtx{AuditFile: filePath}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !al.IsEnabled() {
+		t.Fatalf("IsEnabled() = false with a file")
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := al.Write(auditlog.Entry{
+				Provider:    "google",
+				Method:      "compute.instances.insert",
+				Statement:   "insert into t(a) select ?",
+				RequestBody: json.RawMessage(`{"password":"[REDACTED]"}`),
+				Status:      200,
+			}); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	f, err := os.Open(filePath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer f.Close()
+	lineCount := 0
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry auditlog.Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("line %d is not an entry: %v", lineCount+1, err)
+		}
+		if entry.Method != "compute.instances.insert" || string(entry.RequestBody) != `{"password":"[REDACTED]"}` || entry.Session != "" {
+			t.Fatalf("line %d = %s", lineCount+1, scanner.Text())
+		}
+		lineCount++
+	}
+	if lineCount != 20 {
+		t.Fatalf("audit log has %d lines, want 20", lineCount)
+	}
+}
+
+func TestNewAuditLogUnwritableFile(t *testing.T) {
+	if _, err := auditlog.NewAuditLog(dto.RuntimeCtx{AuditFile: filepath.Join(t.TempDir(), "absent", "audit.jsonl")}, nil); err == nil {
+		t.Fatalf("NewAuditLog() of an unwritable file error = nil")
+	}
+}
+
+func TestGetOperationID(t *testing.T) {
+	testCases := []struct {
+		body string
+		want string
+	}{
+		{body: `{"operationId": "op-1", "id": "2", "name": "n"}`, want: "op-1"},
+		{body: `{"id": 12345678901, "name": "n"}`, want: "12345678901"},
+		{body: `{"name": "operation-1"}`, want: "operation-1"},
+		{body: `{"id": null, "name": true}`, want: "true"},
+		{body: `{"status": "DONE"}`},
+		{body: `[]`},
+		{body: `not json`},
+	}
+	for _, tc := range testCases {
+		if got := auditlog.GetOperationID([]byte(tc.body)); got != tc.want {
+			t.Fatalf("GetOperationID(%s) = %s, want %s", tc.body, got, tc.want)
+		}
+	}
+}
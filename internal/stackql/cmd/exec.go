@@ -17,8 +17,10 @@ package cmd
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"os"
+	"os/signal"
 	"runtime/pprof"
 
 	"github.com/spf13/cobra"
@@ -75,6 +77,10 @@ stackql exec -i iqlscripts/create-disk.iql --credentialsfilepath /mnt/c/tmp/stac
 		handlerCtx, err := entryutil.BuildHandlerContext(runtimeCtx, rdr, queryCache, inputBundle)
 		iqlerror.PrintErrorAndExitOneIfError(err)
 		iqlerror.PrintErrorAndExitOneIfNil(handlerCtx, "Handler context error")
+		// interrupt cancels in flight work, allowing cleanup before exit
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		handlerCtx.SetContext(ctx)
 		RunCommand(handlerCtx, nil, nil)
 	},
 }
@@ -0,0 +1,108 @@
+/*
+Copyright © 2019 stackql info@stackql.io
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+
+	"github.com/spf13/cobra"
+
+	"github.com/stackql/stackql/internal/stackql/driver"
+	"github.com/stackql/stackql/internal/stackql/entryutil"
+	"github.com/stackql/stackql/internal/stackql/handler"
+	"github.com/stackql/stackql/internal/stackql/iqlerror"
+	"github.com/stackql/stackql/internal/stackql/mutationplan"
+	"github.com/stackql/stackql/internal/stackql/writer"
+)
+
+// planCmd represents the plan command
+var planCmd = &cobra.Command{
+	Use:   "plan [file]",
+	Short: "Plan the mutations of a stackql script, without making them",
+	Long: `Run every query of a stackql script, rendering templates, and write as JSON
+the INSERT, UPDATE, DELETE and EXEC calls that it would make, in order and
+with their request bodies.  No mutating calls are made.  For example:
+
+stackql plan iqlscripts/create-disk.iql --credentialsfilepath /mnt/c/tmp/stackql-demo.json -f plan.json
+
+The plan may then be reviewed, and made with "stackql apply plan.json".
+`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		infilePath := runtimeCtx.InfilePath
+		if len(args) > 0 {
+			infilePath = args[0]
+		}
+		if infilePath == "stdin" {
+			cmd.Help()
+			os.Exit(0)
+		}
+		rdr, err := os.Open(infilePath)
+		iqlerror.PrintErrorAndExitOneIfError(err)
+		inputBundle, err := entryutil.BuildInputBundle(runtimeCtx)
+		iqlerror.PrintErrorAndExitOneIfError(err)
+		handlerCtx, err := entryutil.BuildHandlerContext(runtimeCtx, rdr, queryCache, inputBundle)
+		iqlerror.PrintErrorAndExitOneIfError(err)
+		iqlerror.PrintErrorAndExitOneIfNil(handlerCtx, "Handler context error")
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		handlerCtx.SetContext(ctx)
+		runPlanCommand(handlerCtx, driver.ProcessPlan)
+	},
+}
+
+// applyCmd represents the apply command
+var applyCmd = &cobra.Command{
+	Use:   "apply <plan>",
+	Short: "Make the mutations of a plan, refusing if live state has drifted",
+	Long: `Make exactly the calls of a plan written by "stackql plan".  The script
+of the plan is first planned afresh, and nothing is done should either the
+query results or the calls differ from the plan.  For example:
+
+stackql apply plan.json --credentialsfilepath /mnt/c/tmp/stackql-demo.json
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		b, err := os.ReadFile(args[0])
+		iqlerror.PrintErrorAndExitOneIfError(err)
+		plan, err := mutationplan.Unmarshal(b)
+		iqlerror.PrintErrorAndExitOneIfError(err)
+		inputBundle, err := entryutil.BuildInputBundle(runtimeCtx)
+		iqlerror.PrintErrorAndExitOneIfError(err)
+		// the script of the plan is already rendered
+		handlerCtx, err := entryutil.BuildHandlerContextNoPreProcess(runtimeCtx, queryCache, inputBundle)
+		iqlerror.PrintErrorAndExitOneIfError(err)
+		iqlerror.PrintErrorAndExitOneIfNil(handlerCtx, "Handler context error")
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		handlerCtx.SetContext(ctx)
+		runPlanCommand(handlerCtx, func(hc handler.HandlerContext) {
+			driver.ProcessApply(hc, plan)
+		})
+	},
+}
+
+func runPlanCommand(handlerCtx handler.HandlerContext, process func(handler.HandlerContext)) {
+	outErrFile, _ := getOutputFile(writer.StdErrStr)
+	defer iqlerror.HandlePanic(outErrFile)
+	outfile, err := getOutputFile(handlerCtx.GetRuntimeContext().OutfilePath)
+	iqlerror.PrintErrorAndExitOneIfError(err)
+	handlerCtx.SetOutfile(outfile)
+	handlerCtx.SetOutErrFile(outErrFile)
+	process(handlerCtx)
+}
@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path"
@@ -25,6 +26,8 @@ import (
 	"github.com/stackql/stackql/internal/stackql/constants"
 	"github.com/stackql/stackql/internal/stackql/dto"
 	"github.com/stackql/stackql/internal/stackql/logging"
+	"github.com/stackql/stackql/internal/stackql/redaction"
+	"github.com/stackql/stackql/internal/stackql/tracing"
 
 	"github.com/magiconair/properties"
 	"github.com/spf13/cobra"
@@ -34,6 +37,8 @@ import (
 	lrucache "github.com/stackql/stackql-parser/go/cache"
 )
 
+const subsystem logging.Subsystem = "cmd"
+
 var (
 	BuildMajorVersion   string = ""
 	BuildMinorVersion   string = ""
@@ -54,6 +59,7 @@ var (
 	runtimeCtx      dto.RuntimeCtx
 	queryCache      *lrucache.LRUCache
 	replicateCtrMgr bool = false
+	tracingShutdown func(context.Context) error
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -72,6 +78,18 @@ Cloud asset management and automation using SQL. For example:
 
 SELECT name, status FROM google.compute.instances
 WHERE project = 'my-project' AND zone = 'us-west1-b';`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		var err error
+		tracingShutdown, err = tracing.Init(runtimeCtx)
+		return err
+	},
+	PersistentPostRun: func(cmd *cobra.Command, args []string) {
+		if tracingShutdown != nil {
+			if err := tracingShutdown(context.Background()); err != nil {
+				subsystem.GetLogger().Errorln(fmt.Sprintf("tracing shutdown error: %s", err.Error()))
+			}
+		}
+	},
 	Run: func(cmd *cobra.Command, args []string) {
 		// in the root command is executed with no arguments, print the help message
 		usagemsg := cmd.Long + "\n\n" + cmd.UsageString()
@@ -115,6 +133,29 @@ func init() {
 	rootCmd.PersistentFlags().IntVar(&runtimeCtx.HTTPPageLimit, dto.HTTPPAgeLimitKey, 20, "Max pages of results that will be returned per resource, any number <=0 results in no limitation")
 	rootCmd.PersistentFlags().IntVar(&runtimeCtx.HTTPProxyPort, dto.HTTPProxyPortKey, -1, "http proxy port, any number <=0 will result in the default port for a given scheme (eg: http -> 80)")
 	rootCmd.PersistentFlags().IntVar(&runtimeCtx.ExecutionConcurrencyLimit, dto.ExecutionConcurrencyLimitKey, 1, "concurrency limit for query execution")
+	rootCmd.PersistentFlags().IntVar(&runtimeCtx.BulkInsertBatchSize, dto.BulkInsertBatchSizeKey, 500, "Max rows per batch when writing acquired rows to the SQL backend, any number <=0 results in one batch per page")
+	rootCmd.PersistentFlags().IntVar(&runtimeCtx.StatementTimeout, dto.StatementTimeoutKey, 0, "Statement timeout in seconds, 0 for no timeout")
+	rootCmd.PersistentFlags().IntVar(&runtimeCtx.MaxHTTPRequestsPerQuery, dto.MaxHTTPRequestsPerQueryKey, 0, "Max http requests issued per query, any number <=0 results in no limitation")
+	rootCmd.PersistentFlags().IntVar(&runtimeCtx.MaxRowsAcquired, dto.MaxRowsAcquiredKey, 0, "Max rows acquired from providers per query, any number <=0 results in no limitation")
+	rootCmd.PersistentFlags().BoolVar(&runtimeCtx.ReadOnly, dto.ReadOnlyKey, false, "Refuse INSERT, UPDATE, DELETE and EXEC of mutating methods for all providers")
+	rootCmd.PersistentFlags().BoolVar(&runtimeCtx.SafetyDeleteRequiresKey, dto.SafetyDeleteRequiresKeyKey, true, "Refuse DELETE whose WHERE clause does not constrain the key of the resource")
+	rootCmd.PersistentFlags().IntVar(&runtimeCtx.SafetyMaxAffectedRows, dto.SafetyMaxAffectedRowsKey, 0, "Max rows affected by a single INSERT, UPDATE, DELETE or EXEC, any number <=0 results in no limitation")
+	rootCmd.PersistentFlags().IntVar(&runtimeCtx.SafetyConfirmAffectedRows, dto.SafetyConfirmAffectedRowsKey, 0, "Rows affected by a single statement above which the shell prompts for confirmation, any number <=0 results in no prompt")
+	rootCmd.PersistentFlags().StringVar(&runtimeCtx.AuditFile, dto.AuditFileKey, "", "File to which provider calls other than GET are appended as JSON lines")
+	rootCmd.PersistentFlags().StringVar(&runtimeCtx.AuditTable, dto.AuditTableKey, "", "SQL backend table to which provider calls other than GET are appended")
+	rootCmd.PersistentFlags().StringVar(&runtimeCtx.RedactFields, dto.RedactFieldsKey, redaction.DefaultFields, "Comma separated field names, irrespective of case, whose values are redacted from logs, errors and the audit log")
+	rootCmd.PersistentFlags().StringVar(&runtimeCtx.RedactHeaders, dto.RedactHeadersKey, redaction.DefaultHeaders, "Comma separated http header names, irrespective of case, whose values are redacted from logs")
+	rootCmd.PersistentFlags().StringVar(&runtimeCtx.RedactQueryKeys, dto.RedactQueryKeysKey, redaction.DefaultQueryKeys, "Comma separated query parameter names, irrespective of case, whose values are redacted from logs, errors and the audit log, in addition to those of '--redact.fields'")
+	rootCmd.PersistentFlags().StringVar(&runtimeCtx.TracingExporter, dto.TracingExporterKey, "", "OpenTelemetry trace exporter, must be (otlp | file), none if empty")
+	rootCmd.PersistentFlags().StringVar(&runtimeCtx.TracingEndpoint, dto.TracingEndpointKey, "", "OTLP/HTTP endpoint for traces, eg: 'http://localhost:4318', OTEL_EXPORTER_OTLP_* environment variables apply if empty")
+	rootCmd.PersistentFlags().StringVar(&runtimeCtx.TracingFile, dto.TracingFileKey, "", "File to which spans are appended as JSON lines, for the 'file' trace exporter")
+	rootCmd.PersistentFlags().StringVar(&runtimeCtx.ExportMode, dto.ExportModeKey, "append", "Mode for writing results into sql data source tables, must be (append | replace | upsert)")
+	rootCmd.PersistentFlags().StringVar(&runtimeCtx.ExportKey, dto.ExportKeyKey, "", "Comma separated key columns for upsert into sql data source tables")
+	rootCmd.PersistentFlags().StringVar(&runtimeCtx.HistoryTables, dto.HistoryTablesKey, "", "Comma separated tables, of the form '<provider>.<service>.<resource>' and possibly with '*' wildcards, for which acquired rows are appended to history tables")
+	rootCmd.PersistentFlags().StringVar(&runtimeCtx.JSONFlatten, dto.JSONFlattenKey, "", "Comma separated tables, of the form '<provider>.<service>.<resource>' and possibly with '*' wildcards, whose nested object properties are presented as flattened columns")
+	rootCmd.PersistentFlags().BoolVar(&runtimeCtx.JSONFlattenArrays, dto.JSONFlattenArraysKey, false, "Present array columns of flattened tables as child tables, of the form '<resource><separator><column>'")
+	rootCmd.PersistentFlags().IntVar(&runtimeCtx.JSONFlattenDepth, dto.JSONFlattenDepthKey, 2, "Max nested levels presented as flattened columns")
+	rootCmd.PersistentFlags().StringVar(&runtimeCtx.JSONFlattenSeparator, dto.JSONFlattenSeparatorKey, "_", "Separator of property names in flattened column names, eg '_' or '.'")
 	rootCmd.PersistentFlags().StringVar(&runtimeCtx.HTTPProxyHost, dto.HTTPProxyHostKey, "", "http proxy host, empty means no proxy")
 	rootCmd.PersistentFlags().StringVar(&runtimeCtx.HTTPProxyScheme, dto.HTTPProxySchemeKey, "http", "http proxy scheme, eg 'http'")
 	rootCmd.PersistentFlags().StringVar(&runtimeCtx.HTTPProxyPassword, dto.HTTPProxyPasswordKey, "", "http proxy password")
@@ -135,12 +176,16 @@ func init() {
 	rootCmd.PersistentFlags().BoolVar(&runtimeCtx.TestWithoutApiCalls, dto.TestWithoutApiCallsKey, false, "Flag to omit api calls for testing")
 	rootCmd.PersistentFlags().BoolVar(&runtimeCtx.UseNonPreferredAPIs, dto.UseNonPreferredAPIsKEy, false, "Flag to enable non-preferred APIs")
 	rootCmd.PersistentFlags().StringVar(&runtimeCtx.LogLevelStr, dto.LogLevelStrKey, config.GetDefaultLogLevelString(), fmt.Sprintf(`Log level`))
+	rootCmd.PersistentFlags().StringVar(&runtimeCtx.LogFormat, dto.LogFormatKey, logging.FormatText, "Log format, must be (text | json)")
+	rootCmd.PersistentFlags().StringVar(&runtimeCtx.LogLevels, dto.LogLevelsKey, "", "Comma separated log levels per subsystem, overriding '--loglevel', eg: 'httpmiddleware=debug,primitivebuilder=info', subsystems being packages")
 	rootCmd.PersistentFlags().StringVar(&runtimeCtx.ErrorPresentation, dto.ErrorPresentationKey, config.GetDefaultErrorPresentationString(), fmt.Sprintf(`Error presentation, options are: {"stderr", "record"}`))
 
 	rootCmd.PersistentFlags().StringVar(&runtimeCtx.PGSrvAddress, dto.PgSrvAddressKey, "0.0.0.0", "server address, for server mode only")
 	rootCmd.PersistentFlags().StringVar(&runtimeCtx.PGSrvLogLevel, dto.PgSrvLogLevelKey, "WARN", "Log level, for server mode only")
 	rootCmd.PersistentFlags().StringVar(&runtimeCtx.PGSrvRawTLSCfg, dto.PgSrvRawTLSCfgKey, "", "tls config for server, for server mode only")
 	rootCmd.PersistentFlags().IntVar(&runtimeCtx.PGSrvPort, dto.PgSrvPortKey, 5466, "TCP server port, for server mode only")
+	rootCmd.PersistentFlags().StringVar(&runtimeCtx.MetricsAddress, dto.MetricsAddressKey, "0.0.0.0", "Prometheus metrics and health check server address, for server mode only")
+	rootCmd.PersistentFlags().IntVar(&runtimeCtx.MetricsPort, dto.MetricsPortKey, 0, "Prometheus metrics and health check server port, for server mode only, none if zero")
 
 	rootCmd.PersistentFlags().MarkHidden(dto.TestWithoutApiCallsKey)
 	rootCmd.PersistentFlags().MarkHidden(dto.ViperCfgFileNameKey)
@@ -153,7 +198,12 @@ func init() {
 	queryCache = lrucache.NewLRUCache(int64(runtimeCtx.QueryCacheSize))
 
 	rootCmd.CompletionOptions.DisableDefaultCmd = true
+	execCmd.Flags().StringVar(&runtimeCtx.ExportDSN, dto.ExportDSNKey, "", "URL style dsn of a postgres, snowflake or sqlite database into which results are written in place of output, eg: 'sqlite:///path/to/file.db'")
+	execCmd.Flags().StringVar(&runtimeCtx.ExportTable, dto.ExportTableKey, "", "Table, of the form '[<schema>.]<table>', into which results are written; created if absent")
+
 	rootCmd.AddCommand(execCmd)
+	rootCmd.AddCommand(planCmd)
+	rootCmd.AddCommand(applyCmd)
 	rootCmd.AddCommand(shellCmd)
 	rootCmd.AddCommand(registryCmd)
 	rootCmd.AddCommand(srvCmd)
@@ -177,7 +227,7 @@ func initConfig() {
 
 	mergeConfigFromFile(&runtimeCtx, *rootCmd.PersistentFlags())
 
-	logging.SetLogger(runtimeCtx.LogLevelStr)
+	logging.SetLogger(runtimeCtx.LogLevelStr, runtimeCtx.LogFormat, runtimeCtx.LogLevels)
 	config.CreateDirIfNotExists(runtimeCtx.ApplicationFilesRootPath, os.FileMode(runtimeCtx.ApplicationFilesRootPathMode))
 	config.CreateDirIfNotExists(path.Join(runtimeCtx.ApplicationFilesRootPath, runtimeCtx.ProviderStr), os.FileMode(runtimeCtx.ApplicationFilesRootPathMode))
 	config.CreateDirIfNotExists(config.GetReadlineDirPath(runtimeCtx), os.FileMode(runtimeCtx.ApplicationFilesRootPathMode))
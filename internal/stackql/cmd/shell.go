@@ -16,8 +16,11 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"os"
+	"os/signal"
 	"runtime"
 	"strconv"
 	"strings"
@@ -28,7 +31,6 @@ import (
 	"github.com/stackql/stackql/internal/stackql/entryutil"
 	"github.com/stackql/stackql/internal/stackql/handler"
 	"github.com/stackql/stackql/internal/stackql/iqlerror"
-	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/provider"
 	"github.com/stackql/stackql/internal/stackql/writer"
 
@@ -138,7 +140,7 @@ var shellCmd = &cobra.Command{
 				fmt.Fprintln(outErrFile, fmt.Sprintf("Error setting up AUTH for provider '%s'", handlerCtx.GetRuntimeContext().ProviderStr))
 			}
 			if pErr == nil {
-				prov.ShowAuth(authCtx)
+				prov.ShowAuth(handlerCtx.GetContext(), authCtx)
 			} else {
 				fmt.Fprintln(outErrFile, fmt.Sprintf("Error setting up API for provider '%s'", handlerCtx.GetRuntimeContext().ProviderStr))
 			}
@@ -174,6 +176,17 @@ var shellCmd = &cobra.Command{
 		}
 		defer l.Close()
 
+		// statements affecting many rows are confirmed at the prompt
+		handlerCtx.GetSafetyPolicy().SetConfirmer(func(prompt string) bool {
+			l.SetPrompt(prompt)
+			answer, err := l.Readline()
+			if err != nil {
+				return false
+			}
+			answer = strings.ToLower(strings.TrimSpace(answer))
+			return answer == "y" || answer == "yes"
+		})
+
 		fmt.Fprintln(
 			outErrFile,
 			getIntroAuthMsg(authCtx, prov),
@@ -202,7 +215,7 @@ var shellCmd = &cobra.Command{
 				goto exit
 			case line == "":
 			default:
-				logging.GetLogger().Debugln("you said:", strconv.Quote(line))
+				subsystem.GetLogger().Debugln("you said:", strconv.Quote(line))
 				inlineCommentIdx := strings.Index(line, "--")
 				if inlineCommentIdx > -1 {
 					line = line[:inlineCommentIdx]
@@ -219,7 +232,12 @@ var shellCmd = &cobra.Command{
 					}
 					handlerCtx.SetRawQuery(queryToExecute)
 					l.WriteToHistory(rawQuery)
+					// interrupt cancels the running command only, not the session
+					ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+					handlerCtx.SetContext(ctx)
 					RunCommand(handlerCtx, outfile, outErrFile)
+					stop()
+					handlerCtx.SetContext(context.Background())
 					sb.Reset()
 					sb.WriteString(line[semiColonIdx+1:])
 				} else {
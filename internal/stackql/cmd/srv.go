@@ -16,11 +16,17 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/stackql/stackql/internal/stackql/driver"
 	"github.com/stackql/stackql/internal/stackql/entryutil"
+	"github.com/stackql/stackql/internal/stackql/handler"
 	"github.com/stackql/stackql/internal/stackql/iqlerror"
+	"github.com/stackql/stackql/internal/stackql/metrics"
 	"github.com/stackql/stackql/internal/stackql/psqlwire"
 )
 
@@ -29,6 +35,8 @@ const MAX = 100
 
 const DEFAULT_PORT_NO = 3406
 
+const readinessTimeout = 5 * time.Second
+
 var srvCmd = &cobra.Command{
 	Use:   "srv",
 	Short: "run postgres wire server",
@@ -45,6 +53,31 @@ var srvCmd = &cobra.Command{
 		iqlerror.PrintErrorAndExitOneIfError(err)
 		server, err := psqlwire.MakeWireServer(sbe, runtimeCtx)
 		iqlerror.PrintErrorAndExitOneIfError(err)
+		if runtimeCtx.MetricsPort > 0 {
+			err = metrics.Serve(
+				fmt.Sprintf("%s:%d", runtimeCtx.MetricsAddress, runtimeCtx.MetricsPort),
+				handlerCtx.GetSQLSystem().GetDBSize,
+				func() error {
+					return checkReadiness(handlerCtx, server)
+				},
+			)
+			iqlerror.PrintErrorAndExitOneIfError(err)
+		}
 		server.Serve()
 	},
 }
+
+// checkReadiness requires that the postgres wire server
+// is accepting connections and that the backend DB responds.
+func checkReadiness(handlerCtx handler.HandlerContext, server psqlwire.IWireServer) error {
+	if !server.IsListening() {
+		return fmt.Errorf("postgres wire server is not listening")
+	}
+	db, err := handlerCtx.GetSQLEngine().GetDB()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), readinessTimeout)
+	defer cancel()
+	return db.PingContext(ctx)
+}
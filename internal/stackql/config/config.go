@@ -9,6 +9,8 @@ import (
 	"github.com/stackql/stackql/internal/stackql/logging"
 )
 
+const subsystem logging.Subsystem = "config"
+
 const defaultConfigCacheDir = ".stackql"
 
 const defaultNixConfigCacheDirFileMode uint32 = 0755
@@ -36,7 +38,7 @@ func GetDefaultErrorPresentationString() string {
 func GetWorkingDir() string {
 	dir, err := os.Getwd()
 	if err != nil {
-		logging.GetLogger().Fatal(err)
+		subsystem.GetLogger().Fatal(err)
 	}
 	return dir
 }
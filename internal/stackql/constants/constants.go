@@ -9,7 +9,7 @@ const (
 	GoogleV1ProviderCacheName          string = "google_provider_v_0_3_7"
 	stackqlKeyTmplStr                  string = "__KEY_TEMPLATE__"
 	stackqlPathKey                     string = "name"
-	ServiceAccountRevokeErrStr         string = `[INFO] Only interactive login credentials can be revoked, to authenticate with a different service account change the credentialsfilepath in the .stackqlrc file or reauthenticate with a different service account using the AUTH command`
+	RevokedAuthErrStr                  string = `[INFO] Credentials for this provider have been revoked for the session, use the AUTH command to authenticate again.`
 	ServiceAccountPathErrStr           string = `[ERROR] credentialsfilepath not supplied or key file does not exist.`
 	OAuthInteractiveAuthErrStr         string = `[INFO] Interactive credentials must be revoked before logging in with a different user, use the AUTH REVOKE command before attempting to authenticate again.`
 	NotAuthenticatedShowStr            string = `[INFO] Not authenticated, use the AUTH command to authenticate to a provider.`
@@ -22,18 +22,39 @@ const (
 	DbEngineSQLite3Embedded            string = "sqlite3_embedded"
 	DbEnginePostgresTCP                string = "postgres_tcp"
 	DbEngineSnowflakeTCP               string = "snowflake_tcp"
+	DbEngineDuckDBEmbedded             string = "duckdb_embedded"
 	DbEngineDefault                    string = DbEngineSQLite3Embedded
 	SQLDialectSQLite3                  string = "sqlite3"
 	SQLDialectPostgres                 string = "postgres"
 	SQLDialectSnowflake                string = "snowflake"
+	SQLDialectDuckDB                   string = "duckdb"
 	SQLDbNameSnowflake                 string = "snowflake"
+	SQLDbNamePostgres                  string = "postgres"
+	SQLDbNameMySQL                     string = "mysql"
+	SQLDbNameSQLServer                 string = "sqlserver"
+	SQLDbNameDuckDB                    string = "duckdb"
+	SQLDbNameSQLite                    string = "sqlite"
 	SQLDialectDefault                  string = SQLDialectSQLite3
 	SQLFuncJSONExtractSQLite           string = "json_extract"
 	SQLFuncJSONExtractPostgres         string = "json_extract_path_text"
+	SQLFuncJSONExtractDuckDB           string = "json_extract_string"
 	SQLFuncJSONExtractConformed        string = SQLFuncJSONExtractSQLite
 	SQLFuncGroupConcatSQLite           string = "group_concat"
 	SQLFuncGroupConcatPostgres         string = "string_agg"
+	SQLFuncGroupConcatDuckDB           string = "string_agg"
 	SQLFuncGroupConcatConformed        string = SQLFuncGroupConcatSQLite
+	SQLFuncJSONExtractPathPostgres     string = "json_extract_path"
+	SQLFuncJSONArrayLengthConformed    string = "json_array_length"
+	SQLFuncJSONArrayContainsConformed  string = "json_array_contains"
+	SQLFuncJSONArrayContainsPostgres   string = "jsonb_contains"
+	SQLFuncJSONArrayContainsDuckDB     string = "json_contains"
+	SQLFuncJSONBuildArrayPostgres      string = "jsonb_build_array"
+	SQLFuncToJSONDuckDB                string = "to_json"
+	SQLFuncRegexpLikeConformed         string = "regexp_like"
+	SQLFuncRegexpLikePostgres          string = "textregexeq"
+	SQLFuncRegexpLikeDuckDB            string = "regexp_matches"
+	SQLFuncRegexpReplaceConformed      string = "regexp_replace"
+	SQLFuncParseTimestampConformed     string = "parse_timestamp"
 	DefaulHttpBodyFormat               string = JsonStr
 	RequestBodyKeyPrefix               string = "data"
 	RequestBodyKeyDelimiter            string = "__"
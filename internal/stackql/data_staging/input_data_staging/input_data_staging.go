@@ -1,6 +1,7 @@
 package input_data_staging
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
@@ -16,16 +17,34 @@ type NativeResultSetPreparator interface {
 }
 
 type naiveNativeResultSetPreparator struct {
+	ctx                        context.Context
 	rows                       *sql.Rows
 	insertPreparedStatementCtx drm.PreparedStatementCtx
 	drmCfg                     drm.DRMConfig
+	bulkInsertBatchSize        int
+	isTextOnly                 bool
 }
 
-func NewNaiveNativeResultSetPreparator(rows *sql.Rows, drmCfg drm.DRMConfig, insertPreparedStatementCtx drm.PreparedStatementCtx) NativeResultSetPreparator {
+// Rows are written to the table of a non-nil insertPreparedStatementCtx
+// in batches of bulkInsertBatchSize, all within the one transaction.
+func NewNaiveNativeResultSetPreparator(ctx context.Context, rows *sql.Rows, drmCfg drm.DRMConfig, insertPreparedStatementCtx drm.PreparedStatementCtx, bulkInsertBatchSize int) NativeResultSetPreparator {
 	return &naiveNativeResultSetPreparator{
+		ctx:                        ctx,
 		rows:                       rows,
 		insertPreparedStatementCtx: insertPreparedStatementCtx,
 		drmCfg:                     drmCfg,
+		bulkInsertBatchSize:        bulkInsertBatchSize,
+	}
+}
+
+// NewTextNativeResultSetPreparator presents every column as text,
+// consistent with the presentation of data staged from sql data sources.
+func NewTextNativeResultSetPreparator(ctx context.Context, rows *sql.Rows, drmCfg drm.DRMConfig) NativeResultSetPreparator {
+	return &naiveNativeResultSetPreparator{
+		ctx:        ctx,
+		rows:       rows,
+		drmCfg:     drmCfg,
+		isTextOnly: true,
 	}
 }
 
@@ -58,7 +77,7 @@ func (np *naiveNativeResultSetPreparator) PrepareNativeResultSet() internaldto.E
 		return nativeProtect(internaldto.NewErroneousExecutorOutput(err), []string{"error"})
 	}
 
-	columns := getColumnArr(colTypes)
+	columns := getColumnArr(colTypes, np.isTextOnly)
 
 	var colz []string
 
@@ -68,29 +87,46 @@ func (np *naiveNativeResultSetPreparator) PrepareNativeResultSet() internaldto.E
 
 	var outRows []sqldata.ISQLRow
 
+	var bulkInserter drm.BulkInserter
+	if np.insertPreparedStatementCtx != nil {
+		bulkInserter, err = np.drmCfg.NewBulkInserter(np.ctx, np.drmCfg.GetSQLSystem().GetSQLEngine(), np.insertPreparedStatementCtx, np.bulkInsertBatchSize)
+		if err != nil {
+			return nativeProtect(internaldto.NewErroneousExecutorOutput(err), []string{"error"})
+		}
+	}
+
 	for {
 		hasNext := rows.Next()
 		if !hasNext {
 			break
 		}
-		rowPtr := getRowPointers(colTypes)
+		rowPtr := getRowPointers(colTypes, np.isTextOnly)
 		err = rows.Scan(rowPtr...)
 		if err != nil {
+			if bulkInserter != nil {
+				bulkInserter.Rollback()
+			}
 			return nativeProtect(internaldto.NewErroneousExecutorOutput(err), []string{"error"})
 		}
 		dataArr := sqldata.NewSQLRow(rowPtr)
 		outRows = append(outRows, dataArr)
-		if np.insertPreparedStatementCtx != nil {
+		if bulkInserter != nil {
 			insertInputMap, err := getRowDict(colz, dataArr.GetRowDataForPgWire())
-			if err != nil {
-				return nativeProtect(internaldto.NewErroneousExecutorOutput(err), []string{"error"})
+			if err == nil {
+				err = bulkInserter.Add(insertInputMap, "")
 			}
-			_, err = np.drmCfg.ExecuteInsertDML(np.drmCfg.GetSQLSystem().GetSQLEngine(), np.insertPreparedStatementCtx, insertInputMap, "")
 			if err != nil {
+				bulkInserter.Rollback()
 				return nativeProtect(internaldto.NewErroneousExecutorOutput(err), []string{"error"})
 			}
 		}
 	}
+	if bulkInserter != nil {
+		err = bulkInserter.Commit()
+		if err != nil {
+			return nativeProtect(internaldto.NewErroneousExecutorOutput(err), []string{"error"})
+		}
+	}
 	resultStream := sqldata.NewChannelSQLResultStream()
 	rv := internaldto.NewExecutorOutput(
 		resultStream,
@@ -110,10 +146,14 @@ func (np *naiveNativeResultSetPreparator) PrepareNativeResultSet() internaldto.E
 	return rv
 }
 
-func getRowPointers(colTypes []*sql.ColumnType) []any {
+func getRowPointers(colTypes []*sql.ColumnType, isTextOnly bool) []any {
 	var rowPtr []any
 
 	for _, col := range colTypes {
+		if isTextOnly {
+			rowPtr = append(rowPtr, new(sql.NullString))
+			continue
+		}
 		rowPtr = append(rowPtr, getScannableObjectForNativeResult(col))
 	}
 	return rowPtr
@@ -121,13 +161,13 @@ func getRowPointers(colTypes []*sql.ColumnType) []any {
 
 func getScannableObjectForNativeResult(colSchema *sql.ColumnType) any {
 	switch strings.ToLower(colSchema.DatabaseTypeName()) {
-	case "int", "int32", "smallint", "tinyint":
+	case "int", "int32", "smallint", "tinyint", "mediumint":
 		return new(sql.NullInt32)
 	case "uint", "uint32":
 		return new(sql.NullInt64)
 	case "int64", "bigint":
 		return new(sql.NullInt64)
-	case "numeric", "decimal", "float", "float32", "float64":
+	case "numeric", "decimal", "float", "float32", "float64", "double", "real", "money", "smallmoney":
 		return new(sql.NullFloat64)
 	case "bool":
 		return new(sql.NullBool)
@@ -136,12 +176,16 @@ func getScannableObjectForNativeResult(colSchema *sql.ColumnType) any {
 	}
 }
 
-func getColumnArr(colTypes []*sql.ColumnType) []sqldata.ISQLColumn {
+func getColumnArr(colTypes []*sql.ColumnType, isTextOnly bool) []sqldata.ISQLColumn {
 	var columns []sqldata.ISQLColumn
 
 	table := sqldata.NewSQLTable(0, "meta_table")
 
 	for _, col := range colTypes {
+		if isTextOnly {
+			columns = append(columns, getPlaceholderColumn(table, col.Name(), oid.T_text))
+			continue
+		}
 		columns = append(columns, getPlaceholderColumnForNativeResult(table, col.Name(), col))
 	}
 	return columns
@@ -1,6 +1,7 @@
 package output_data_staging
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"sort"
@@ -17,16 +18,20 @@ import (
 	"github.com/stackql/stackql/internal/stackql/util"
 )
 
+const subsystem logging.Subsystem = "output_data_staging"
+
 type Source interface {
 	SourceSQLRows() (*sql.Rows, error)
 }
 
 func NewNaiveSource(
+	ctx context.Context,
 	querier sqlmachinery.Querier,
 	stmtCtx drm.PreparedStatementParameterized,
 	drmCfg drm.DRMConfig,
 ) Source {
 	return &naiveSource{
+		ctx:     ctx,
 		querier: querier,
 		stmtCtx: stmtCtx,
 		drmCfg:  drmCfg,
@@ -34,6 +39,7 @@ func NewNaiveSource(
 }
 
 type naiveSource struct {
+	ctx     context.Context
 	querier sqlmachinery.Querier
 	stmtCtx drm.PreparedStatementParameterized
 	drmCfg  drm.DRMConfig
@@ -41,6 +47,7 @@ type naiveSource struct {
 
 func (st *naiveSource) SourceSQLRows() (*sql.Rows, error) {
 	r, sqlErr := st.drmCfg.QueryDML(
+		st.ctx,
 		st.querier,
 		st.stmtCtx,
 	)
@@ -51,8 +58,9 @@ type PacketPreparator interface {
 	PrepareOutputPacket() (dto.OutputPacket, error)
 }
 
-func NewNaivePacketPreparator(source Source, nonControlColumns []internaldto.ColumnMetadata, stream streaming.MapStream, drmCfg drm.DRMConfig) PacketPreparator {
+func NewNaivePacketPreparator(ctx context.Context, source Source, nonControlColumns []internaldto.ColumnMetadata, stream streaming.MapStream, drmCfg drm.DRMConfig) PacketPreparator {
 	return &naivePacketPreparator{
+		ctx:               ctx,
 		source:            source,
 		nonControlColumns: nonControlColumns,
 		stream:            stream,
@@ -61,6 +69,7 @@ func NewNaivePacketPreparator(source Source, nonControlColumns []internaldto.Col
 }
 
 type naivePacketPreparator struct {
+	ctx               context.Context
 	source            Source
 	nonControlColumns []internaldto.ColumnMetadata
 	stream            streaming.MapStream
@@ -69,11 +78,11 @@ type naivePacketPreparator struct {
 
 func (st *naivePacketPreparator) PrepareOutputPacket() (dto.OutputPacket, error) {
 	r, err := st.source.SourceSQLRows()
-	logging.GetLogger().Infoln(fmt.Sprintf("select result = %v, error = %v", r, err))
+	subsystem.GetContextLogger(st.ctx).Infoln(fmt.Sprintf("select result = %v, error = %v", r, err))
 	if err != nil {
 		return nil, err
 	}
-	rowDicts, rawRows := st.drmCfg.ExtractObjectFromSQLRows(r, st.nonControlColumns, st.stream)
+	rowDicts, rawRows := st.drmCfg.ExtractObjectFromSQLRows(st.ctx, r, st.nonControlColumns, st.stream)
 	var cNames []string
 	var colOIDs []oid.Oid
 	for _, v := range st.nonControlColumns {
@@ -1,6 +1,7 @@
 package dataflow
 
 import (
+	"context"
 	"fmt"
 	"sync"
 
@@ -11,6 +12,8 @@ import (
 	"gonum.org/v1/gonum/graph/topo"
 )
 
+const subsystem logging.Subsystem = "dataflow"
+
 type DataFlowUnit interface {
 	iDataFlowUnit()
 }
@@ -27,8 +30,9 @@ type DataFlowCollection interface {
 	Vertices() []DataFlowVertex
 }
 
-func NewStandardDataFlowCollection() DataFlowCollection {
+func NewStandardDataFlowCollection(ctx context.Context) DataFlowCollection {
 	return &standardDataFlowCollection{
+		ctx:                   ctx,
 		idMutex:               &sync.Mutex{},
 		g:                     simple.NewWeightedDirectedGraph(0.0, 0.0),
 		vertices:              make(map[DataFlowVertex]struct{}),
@@ -37,6 +41,7 @@ func NewStandardDataFlowCollection() DataFlowCollection {
 }
 
 type standardDataFlowCollection struct {
+	ctx                    context.Context
 	idMutex                *sync.Mutex
 	maxId                  int64
 	g                      *simple.WeightedDirectedGraph
@@ -74,14 +79,14 @@ func (dc *standardDataFlowCollection) AddOrUpdateEdge(
 	dc.AddVertex(dest)
 	existingEdge := dc.g.WeightedEdge(source.ID(), dest.ID())
 	if existingEdge == nil {
-		edge := NewStandardDataFlowEdge(source, dest, comparisonExpr, sourceExpr, destColumn)
+		edge := NewStandardDataFlowEdge(dc.ctx, source, dest, comparisonExpr, sourceExpr, destColumn)
 		dc.edges = append(dc.edges, edge)
 		dc.g.SetWeightedEdge(edge)
 		return nil
 	}
 	switch existingEdge := existingEdge.(type) {
 	case DataFlowEdge:
-		existingEdge.AddRelation(NewStandardDataFlowRelation(comparisonExpr, destColumn, sourceExpr))
+		existingEdge.AddRelation(NewStandardDataFlowRelation(dc.ctx, comparisonExpr, destColumn, sourceExpr))
 	default:
 		return fmt.Errorf("cannnot accomodate data flow edge of type: '%T'", existingEdge)
 	}
@@ -151,7 +156,7 @@ func (dc *standardDataFlowCollection) optimise() error {
 	for _, node := range dc.sorted {
 		switch node := node.(type) {
 		case DataFlowVertex:
-			logging.GetLogger().Debugf("%v\n", node)
+			subsystem.GetContextLogger(dc.ctx).Debugf("%v\n", node)
 			inDegree := dc.g.To(node.ID()).Len()
 			outDegree := dc.g.From(node.ID()).Len()
 			if inDegree == 0 && outDegree == 0 {
@@ -1,6 +1,8 @@
 package dataflow
 
 import (
+	"context"
+
 	"github.com/stackql/go-openapistackql/openapistackql"
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 	"gonum.org/v1/gonum/graph"
@@ -23,6 +25,7 @@ type standardDataFlowEdge struct {
 }
 
 func NewStandardDataFlowEdge(
+	ctx context.Context,
 	source DataFlowVertex,
 	dest DataFlowVertex,
 	comparisonExpr *sqlparser.ComparisonExpr,
@@ -34,6 +37,7 @@ func NewStandardDataFlowEdge(
 		dest:   dest,
 		relations: []DataFlowRelation{
 			NewStandardDataFlowRelation(
+				ctx,
 				comparisonExpr,
 				destColumn,
 				sourceExpr,
@@ -1,12 +1,12 @@
 package dataflow
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 
 	"github.com/stackql/go-openapistackql/openapistackql"
-	"github.com/stackql/stackql/internal/stackql/logging"
 )
 
 type DataFlowRelation interface {
@@ -17,17 +17,20 @@ type DataFlowRelation interface {
 }
 
 type standardDataFlowRelation struct {
+	ctx            context.Context
 	comparisonExpr *sqlparser.ComparisonExpr
 	destColumn     *sqlparser.ColName
 	sourceExpr     sqlparser.Expr
 }
 
 func NewStandardDataFlowRelation(
+	ctx context.Context,
 	comparisonExpr *sqlparser.ComparisonExpr,
 	destColumn *sqlparser.ColName,
 	sourceExpr sqlparser.Expr,
 ) DataFlowRelation {
 	return &standardDataFlowRelation{
+		ctx:            ctx,
 		comparisonExpr: comparisonExpr,
 		destColumn:     destColumn,
 		sourceExpr:     sourceExpr,
@@ -48,7 +51,7 @@ func (dr *standardDataFlowRelation) IsSQL() bool {
 	case *sqlparser.ColName:
 		return false
 	default:
-		logging.GetLogger().Infof("%v\n", se)
+		subsystem.GetContextLogger(dr.ctx).Infof("%v\n", se)
 		return true
 	}
 }
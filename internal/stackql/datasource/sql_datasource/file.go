@@ -0,0 +1,637 @@
+package sql_datasource
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/marcboeker/go-duckdb"
+	"github.com/stackql/go-openapistackql/openapistackql"
+	_ "github.com/stackql/go-sqlite3"
+	"gopkg.in/yaml.v2"
+
+	"github.com/stackql/stackql/internal/stackql/constants"
+	"github.com/stackql/stackql/internal/stackql/dto"
+)
+
+const (
+	// Delimited, parquet and JSON files are
+	// all exposed under this schema.
+	fileDataSourceSchemaName string = "files"
+	fileColumnTypeBigint     string = "BIGINT"
+	fileColumnTypeDouble     string = "DOUBLE"
+	fileColumnTypeBoolean    string = "BOOLEAN"
+	fileColumnTypeVarchar    string = "VARCHAR"
+)
+
+var (
+	_ SQLDataSource = &fileSQLDataSource{}
+)
+
+// fileSQLDataSource exposes local files as tables.
+// Files are loaded into (or, where DuckDB can read them directly,
+// viewed from) an in-memory DuckDB database, which is then
+// queried exactly as for any other SQL data source.
+// Column types are normalised onto BIGINT, DOUBLE, BOOLEAN
+// and VARCHAR, subject to the schema override file.
+type fileSQLDataSource struct {
+	*genericSQLDataSource
+	overrides      map[string]openapistackql.SQLExternalTable
+	usedOverrides  map[string]struct{}
+	externalTables []openapistackql.SQLExternalTable
+}
+
+func newFileSQLDataSource(authCtx *dto.AuthCtx) (SQLDataSource, error) {
+	sqlCfg, hasSQLCfg := authCtx.GetSQLCfg()
+	if !hasSQLCfg {
+		return nil, fmt.Errorf("cannot init file data source with empty SQL config")
+	}
+	location := sqlCfg.GetDSN()
+	if location == "" {
+		return nil, fmt.Errorf("cannot init file data source with empty dsn")
+	}
+	filePaths, err := getDataSourceFilePaths(location)
+	if err != nil {
+		return nil, err
+	}
+	overrides, err := loadFileSchemaOverrides(sqlCfg.GetSchemaOverrideFilePath())
+	if err != nil {
+		return nil, err
+	}
+	connector, err := duckdb.NewConnector("", nil)
+	if err != nil {
+		return nil, err
+	}
+	schemaType := sqlCfg.GetSchemaType()
+	if schemaType == "" {
+		schemaType = constants.SQLDataSourceSchemaDefault
+	}
+	ds := &fileSQLDataSource{
+		genericSQLDataSource: &genericSQLDataSource{
+			db:         sql.OpenDB(connector),
+			dbName:     constants.SQLDbNameDuckDB,
+			schemaType: schemaType,
+		},
+		overrides:     overrides,
+		usedOverrides: make(map[string]struct{}),
+	}
+	for _, filePath := range filePaths {
+		err = ds.loadFile(filePath)
+		if err != nil {
+			ds.db.Close()
+			return nil, fmt.Errorf("cannot load file data source '%s': %s", filePath, err.Error())
+		}
+	}
+	for k := range ds.overrides {
+		if _, ok := ds.usedOverrides[k]; !ok {
+			ds.db.Close()
+			return nil, fmt.Errorf("schema override supplied for unknown table '%s'", k)
+		}
+	}
+	return ds, nil
+}
+
+func (ds *fileSQLDataSource) GetExternalTables() []openapistackql.SQLExternalTable {
+	return ds.externalTables
+}
+
+func getDataSourceFilePaths(location string) ([]string, error) {
+	absLocation, err := filepath.Abs(location)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := os.Stat(absLocation)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return []string{absLocation}, nil
+	}
+	entries, err := os.ReadDir(absLocation)
+	if err != nil {
+		return nil, err
+	}
+	var rv []string
+	for _, entry := range entries {
+		if entry.IsDir() || !isSupportedDataSourceFile(entry.Name()) {
+			continue
+		}
+		rv = append(rv, filepath.Join(absLocation, entry.Name()))
+	}
+	sort.Strings(rv)
+	return rv, nil
+}
+
+func isSupportedDataSourceFile(fileName string) bool {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".csv", ".tsv", ".parquet", ".json", ".ndjson", ".jsonl", ".sqlite", ".sqlite3", ".db":
+		return true
+	default:
+		return false
+	}
+}
+
+// The override file maps "<schema>.<table>" to a table
+// definition, of which only the columns are considered.
+func loadFileSchemaOverrides(overrideFilePath string) (map[string]openapistackql.SQLExternalTable, error) {
+	rv := make(map[string]openapistackql.SQLExternalTable)
+	if overrideFilePath == "" {
+		return rv, nil
+	}
+	b, err := os.ReadFile(overrideFilePath)
+	if err != nil {
+		return nil, err
+	}
+	var overrideDoc openapistackql.SQLExternalConnection
+	err = yaml.Unmarshal(b, &overrideDoc)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse schema override file '%s': %s", overrideFilePath, err.Error())
+	}
+	for k, v := range overrideDoc.Tables {
+		rv[k] = v
+	}
+	return rv, nil
+}
+
+func (ds *fileSQLDataSource) loadFile(filePath string) error {
+	baseName := filepath.Base(filePath)
+	ext := filepath.Ext(baseName)
+	tableName := strings.TrimSuffix(baseName, ext)
+	sourcePath := strings.ReplaceAll(filepath.ToSlash(filePath), `'`, `''`)
+	switch strings.ToLower(ext) {
+	case ".csv":
+		return ds.createView(fileDataSourceSchemaName, tableName, fmt.Sprintf(`read_csv_auto('%s', header = true%s)`, sourcePath, ds.getCSVTypesArg(fileDataSourceSchemaName, tableName)))
+	case ".tsv":
+		return ds.createView(fileDataSourceSchemaName, tableName, fmt.Sprintf(`read_csv_auto('%s', header = true, delim = '\t'%s)`, sourcePath, ds.getCSVTypesArg(fileDataSourceSchemaName, tableName)))
+	case ".parquet":
+		return ds.createView(fileDataSourceSchemaName, tableName, fmt.Sprintf(`read_parquet('%s')`, sourcePath))
+	case ".json", ".ndjson", ".jsonl":
+		return ds.loadJSONFile(filePath, fileDataSourceSchemaName, tableName)
+	case ".sqlite", ".sqlite3", ".db":
+		return ds.loadSQLiteFile(filePath, tableName)
+	default:
+		return fmt.Errorf("unsupported file type '%s'", ext)
+	}
+}
+
+// Overridden types are also supplied to the CSV reader,
+// so that values are read as such rather than cast
+// from the inferred type.
+func (ds *fileSQLDataSource) getCSVTypesArg(schemaName, tableName string) string {
+	override, hasOverride := ds.overrides[fmt.Sprintf("%s.%s", schemaName, tableName)]
+	if !hasOverride || len(override.Columns) == 0 {
+		return ""
+	}
+	var typeEntries []string
+	for _, col := range override.Columns {
+		typeEntries = append(typeEntries, fmt.Sprintf(`'%s': '%s'`, strings.ReplaceAll(col.Name, `'`, `''`), getFileColumnType(col.Type)))
+	}
+	return fmt.Sprintf(", types = {%s}", strings.Join(typeEntries, ", "))
+}
+
+func (ds *fileSQLDataSource) ensureSchema(schemaName string) error {
+	_, err := ds.db.Exec(fmt.Sprintf(`CREATE SCHEMA IF NOT EXISTS "%s"`, schemaName))
+	return err
+}
+
+// applyOverrides returns the column types for a table,
+// with any overridden types substituted.
+func (ds *fileSQLDataSource) applyOverrides(schemaName, tableName string, columnNames []string, columnTypes []string) ([]string, error) {
+	key := fmt.Sprintf("%s.%s", schemaName, tableName)
+	override, hasOverride := ds.overrides[key]
+	if !hasOverride {
+		return columnTypes, nil
+	}
+	ds.usedOverrides[key] = struct{}{}
+	rv := make([]string, len(columnTypes))
+	copy(rv, columnTypes)
+	for _, col := range override.Columns {
+		found := false
+		for i, columnName := range columnNames {
+			if columnName == col.Name {
+				rv[i] = getFileColumnType(col.Type)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("schema override supplied for unknown column '%s' in table '%s'", col.Name, key)
+		}
+	}
+	return rv, nil
+}
+
+func (ds *fileSQLDataSource) createView(schemaName, tableName, source string) error {
+	rows, err := ds.db.Query(fmt.Sprintf(`DESCRIBE SELECT * FROM %s`, source))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	var columnNames, columnTypes []string
+	for rows.Next() {
+		var columnName, columnType string
+		var isNullable, key, defaultVal, extra sql.NullString
+		err = rows.Scan(&columnName, &columnType, &isNullable, &key, &defaultVal, &extra)
+		if err != nil {
+			return err
+		}
+		columnNames = append(columnNames, columnName)
+		columnTypes = append(columnTypes, getFileColumnType(columnType))
+	}
+	err = rows.Err()
+	if err != nil {
+		return err
+	}
+	columnTypes, err = ds.applyOverrides(schemaName, tableName, columnNames, columnTypes)
+	if err != nil {
+		return err
+	}
+	var selectList []string
+	for i, columnName := range columnNames {
+		selectList = append(selectList, fmt.Sprintf(`CAST("%s" AS %s) AS "%s"`, columnName, columnTypes[i], columnName))
+	}
+	err = ds.ensureSchema(schemaName)
+	if err != nil {
+		return err
+	}
+	_, err = ds.db.Exec(fmt.Sprintf(`CREATE VIEW "%s"."%s" AS SELECT %s FROM %s`, schemaName, tableName, strings.Join(selectList, ", "), source))
+	if err != nil {
+		return err
+	}
+	return ds.registerTable(schemaName, tableName)
+}
+
+// createAndPopulateTable is used where DuckDB cannot
+// read the source directly.  Row values are ordered as
+// per the column names.
+func (ds *fileSQLDataSource) createAndPopulateTable(schemaName, tableName string, columnNames []string, columnTypes []string, rows [][]interface{}) error {
+	if len(columnNames) == 0 {
+		return fmt.Errorf("cannot create table '%s.%s' with zero columns", schemaName, tableName)
+	}
+	columnTypes, err := ds.applyOverrides(schemaName, tableName, columnNames, columnTypes)
+	if err != nil {
+		return err
+	}
+	err = ds.ensureSchema(schemaName)
+	if err != nil {
+		return err
+	}
+	var columnDefs, quotedColumnNames []string
+	for i, columnName := range columnNames {
+		columnDefs = append(columnDefs, fmt.Sprintf(`"%s" %s`, columnName, columnTypes[i]))
+		quotedColumnNames = append(quotedColumnNames, fmt.Sprintf(`"%s"`, columnName))
+	}
+	_, err = ds.db.Exec(fmt.Sprintf(`CREATE TABLE "%s"."%s" (%s)`, schemaName, tableName, strings.Join(columnDefs, ", ")))
+	if err != nil {
+		return err
+	}
+	tx, err := ds.db.Begin()
+	if err != nil {
+		return err
+	}
+	insertQuery := fmt.Sprintf(
+		`INSERT INTO "%s"."%s" (%s) VALUES (%s)`,
+		schemaName,
+		tableName,
+		strings.Join(quotedColumnNames, ", "),
+		strings.TrimSuffix(strings.Repeat("?, ", len(columnNames)), ", "),
+	)
+	for _, row := range rows {
+		args := make([]interface{}, len(row))
+		for i, v := range row {
+			args[i] = getFileColumnValue(v, columnTypes[i])
+		}
+		_, err = tx.Exec(insertQuery, args...)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	return ds.registerTable(schemaName, tableName)
+}
+
+func (ds *fileSQLDataSource) registerTable(schemaName, tableName string) error {
+	for _, tbl := range ds.externalTables {
+		if tbl.SchemaName == schemaName && tbl.Name == tableName {
+			return fmt.Errorf("duplicate table '%s.%s'", schemaName, tableName)
+		}
+	}
+	tableMeta, err := ds.GetTableMetadata(schemaName, tableName)
+	if err != nil {
+		return err
+	}
+	tbl := openapistackql.SQLExternalTable{
+		SchemaName: schemaName,
+		Name:       tableName,
+	}
+	for _, col := range tableMeta.GetColumns() {
+		colOID, _ := col.GetOID()
+		tbl.Columns = append(tbl.Columns, openapistackql.SQLExternalColumn{
+			Name:  col.GetName(),
+			Type:  col.GetType(),
+			Oid:   uint32(colOID),
+			Width: col.GetWidth(),
+		})
+	}
+	ds.externalTables = append(ds.externalTables, tbl)
+	return nil
+}
+
+// loadJSONFile accepts any sequence of JSON values, so that
+// a single object, an array of objects and newline delimited
+// objects are all handled alike.  Columns are the union of
+// object keys, in order of first appearance.
+// Nested objects and arrays are stored as JSON text.
+func (ds *fileSQLDataSource) loadJSONFile(filePath, schemaName, tableName string) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	decoder := json.NewDecoder(f)
+	decoder.UseNumber()
+	var records []map[string]interface{}
+	for {
+		var v interface{}
+		err = decoder.Decode(&v)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		switch vt := v.(type) {
+		case map[string]interface{}:
+			records = append(records, vt)
+		case []interface{}:
+			for _, item := range vt {
+				record, ok := item.(map[string]interface{})
+				if !ok {
+					return fmt.Errorf("expected JSON object but got '%T'", item)
+				}
+				records = append(records, record)
+			}
+		default:
+			return fmt.Errorf("expected JSON object or array but got '%T'", v)
+		}
+	}
+	var columnNames []string
+	columnTypes := make(map[string]string)
+	for _, record := range records {
+		keys := make([]string, 0, len(record))
+		for k := range record {
+			keys = append(keys, k)
+		}
+		// Go maps are unordered, so new keys
+		// within a single record are sorted.
+		sort.Strings(keys)
+		for _, k := range keys {
+			existingType, seen := columnTypes[k]
+			if !seen {
+				columnNames = append(columnNames, k)
+			}
+			columnTypes[k] = mergeFileColumnTypes(existingType, getJSONValueColumnType(record[k]))
+		}
+	}
+	var orderedTypes []string
+	for _, columnName := range columnNames {
+		columnType := columnTypes[columnName]
+		if columnType == "" {
+			columnType = fileColumnTypeVarchar
+		}
+		orderedTypes = append(orderedTypes, columnType)
+	}
+	var rows [][]interface{}
+	for _, record := range records {
+		row := make([]interface{}, len(columnNames))
+		for i, columnName := range columnNames {
+			row[i] = record[columnName]
+		}
+		rows = append(rows, row)
+	}
+	return ds.createAndPopulateTable(schemaName, tableName, columnNames, orderedTypes, rows)
+}
+
+func getJSONValueColumnType(v interface{}) string {
+	switch vt := v.(type) {
+	case nil:
+		return ""
+	case bool:
+		return fileColumnTypeBoolean
+	case json.Number:
+		if _, err := vt.Int64(); err == nil {
+			return fileColumnTypeBigint
+		}
+		return fileColumnTypeDouble
+	default:
+		return fileColumnTypeVarchar
+	}
+}
+
+// An empty type denotes that only nulls have been seen.
+func mergeFileColumnTypes(existingType, newType string) string {
+	switch {
+	case existingType == "":
+		return newType
+	case newType == "", newType == existingType:
+		return existingType
+	case (existingType == fileColumnTypeBigint && newType == fileColumnTypeDouble) || (existingType == fileColumnTypeDouble && newType == fileColumnTypeBigint):
+		return fileColumnTypeDouble
+	default:
+		return fileColumnTypeVarchar
+	}
+}
+
+// loadSQLiteFile copies each table and view of a SQLite
+// database into a schema named for the file.
+func (ds *fileSQLDataSource) loadSQLiteFile(filePath, schemaName string) error {
+	sqliteDB, err := sql.Open("sqlite3", getSQLiteFileURI(filePath))
+	if err != nil {
+		return err
+	}
+	defer sqliteDB.Close()
+	tableNames, err := getSQLiteTableNames(sqliteDB)
+	if err != nil {
+		return err
+	}
+	for _, tableName := range tableNames {
+		err = ds.copySQLiteTable(sqliteDB, schemaName, tableName)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getSQLiteFileURI escapes the path, so that characters
+// such as '?' and '#' are not read as URI delimiters.
+func getSQLiteFileURI(filePath string) string {
+	uriPath := filepath.ToSlash(filePath)
+	if !strings.HasPrefix(uriPath, "/") {
+		uriPath = "/" + uriPath
+	}
+	return (&url.URL{Scheme: "file", Path: uriPath, RawQuery: "mode=ro"}).String()
+}
+
+func getSQLiteTableNames(sqliteDB *sql.DB) ([]string, error) {
+	rows, err := sqliteDB.Query(`SELECT name FROM sqlite_master WHERE type IN ('table', 'view') AND name NOT LIKE 'sqlite_%' ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var rv []string
+	for rows.Next() {
+		var tableName string
+		err = rows.Scan(&tableName)
+		if err != nil {
+			return nil, err
+		}
+		rv = append(rv, tableName)
+	}
+	return rv, rows.Err()
+}
+
+func (ds *fileSQLDataSource) copySQLiteTable(sqliteDB *sql.DB, schemaName, tableName string) error {
+	quotedTableName := strings.ReplaceAll(tableName, `"`, `""`)
+	infoRows, err := sqliteDB.Query(fmt.Sprintf(`SELECT name, type FROM pragma_table_info('%s') ORDER BY cid`, strings.ReplaceAll(tableName, `'`, `''`)))
+	if err != nil {
+		return err
+	}
+	var columnNames, columnTypes []string
+	for infoRows.Next() {
+		var columnName, declaredType string
+		err = infoRows.Scan(&columnName, &declaredType)
+		if err != nil {
+			infoRows.Close()
+			return err
+		}
+		columnNames = append(columnNames, columnName)
+		columnTypes = append(columnTypes, getSQLiteAffinityColumnType(declaredType))
+	}
+	infoRows.Close()
+	rows, err := sqliteDB.Query(fmt.Sprintf(`SELECT * FROM "%s"`, quotedTableName))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	var data [][]interface{}
+	for rows.Next() {
+		row := make([]interface{}, len(columnNames))
+		rowPtr := make([]interface{}, len(columnNames))
+		for i := range row {
+			rowPtr[i] = &row[i]
+		}
+		err = rows.Scan(rowPtr...)
+		if err != nil {
+			return err
+		}
+		data = append(data, row)
+	}
+	err = rows.Err()
+	if err != nil {
+		return err
+	}
+	return ds.createAndPopulateTable(schemaName, tableName, columnNames, columnTypes, data)
+}
+
+// As per https://www.sqlite.org/datatype3.html#determination_of_column_affinity
+func getSQLiteAffinityColumnType(declaredType string) string {
+	declaredType = strings.ToUpper(declaredType)
+	switch {
+	case strings.Contains(declaredType, "INT"):
+		return fileColumnTypeBigint
+	case strings.Contains(declaredType, "CHAR"), strings.Contains(declaredType, "CLOB"), strings.Contains(declaredType, "TEXT"):
+		return fileColumnTypeVarchar
+	case strings.Contains(declaredType, "BOOL"):
+		return fileColumnTypeBoolean
+	case strings.Contains(declaredType, "REAL"), strings.Contains(declaredType, "FLOA"), strings.Contains(declaredType, "DOUB"),
+		strings.Contains(declaredType, "NUMERIC"), strings.Contains(declaredType, "DECIMAL"):
+		return fileColumnTypeDouble
+	default:
+		return fileColumnTypeVarchar
+	}
+}
+
+// getFileColumnType maps both DuckDB types and the portable
+// type names used in provider documents and override files.
+func getFileColumnType(columnType string) string {
+	columnType = strings.ToLower(strings.TrimSpace(columnType))
+	if idx := strings.Index(columnType, "("); idx >= 0 {
+		columnType = strings.TrimSpace(columnType[:idx])
+	}
+	switch columnType {
+	case "tinyint", "smallint", "integer", "int", "bigint", "int1", "int2", "int4", "int8", "hugeint",
+		"utinyint", "usmallint", "uinteger", "ubigint", "long", "short", "signed":
+		return fileColumnTypeBigint
+	case "float", "float4", "float8", "real", "double", "double precision", "decimal", "numeric":
+		return fileColumnTypeDouble
+	case "bool", "boolean", "logical":
+		return fileColumnTypeBoolean
+	default:
+		return fileColumnTypeVarchar
+	}
+}
+
+// getFileColumnValue converts a decoded value for insertion
+// into a column of the given type.  Values not handled here
+// are left to DuckDB implicit casting.
+func getFileColumnValue(v interface{}, columnType string) interface{} {
+	switch vt := v.(type) {
+	case nil:
+		return nil
+	case json.Number:
+		switch columnType {
+		case fileColumnTypeBigint:
+			if i, err := vt.Int64(); err == nil {
+				return i
+			}
+		case fileColumnTypeDouble:
+			if f, err := vt.Float64(); err == nil {
+				return f
+			}
+		}
+		return vt.String()
+	case map[string]interface{}, []interface{}:
+		b, err := json.Marshal(vt)
+		if err != nil {
+			return fmt.Sprintf("%v", vt)
+		}
+		return string(b)
+	case []byte:
+		return string(vt)
+	case time.Time:
+		if columnType == fileColumnTypeVarchar {
+			return vt.Format(time.RFC3339Nano)
+		}
+		return vt
+	case bool:
+		if columnType == fileColumnTypeVarchar {
+			return strconv.FormatBool(vt)
+		}
+		return vt
+	case int64:
+		if columnType == fileColumnTypeVarchar {
+			return strconv.FormatInt(vt, 10)
+		}
+		return vt
+	case float64:
+		if columnType == fileColumnTypeVarchar {
+			return strconv.FormatFloat(vt, 'f', -1, 64)
+		}
+		return vt
+	default:
+		return vt
+	}
+}
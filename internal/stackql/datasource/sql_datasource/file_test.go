@@ -0,0 +1,308 @@
+package sql_datasource
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/marcboeker/go-duckdb"
+	"github.com/stackql/go-openapistackql/openapistackql"
+
+	"github.com/stackql/stackql/internal/stackql/dto"
+)
+
+func newTestFileDataSource(t *testing.T, location string, overrideFilePath string) (*fileSQLDataSource, error) {
+	t.Helper()
+	ds, err := newFileSQLDataSource(&dto.AuthCtx{
+		Type:   "sql_data_source::file",
+		SQLCfg: &dto.SQLBackendCfg{DSN: location, SchemaOverrideFilePath: overrideFilePath},
+	})
+	if err != nil {
+		return nil, err
+	}
+	fileDS := ds.(*fileSQLDataSource)
+	t.Cleanup(func() { fileDS.db.Close() })
+	return fileDS, nil
+}
+
+func writeTestFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	filePath := filepath.Join(dir, name)
+	if err := os.WriteFile(filePath, []byte(content), 0o600); err != nil {
+		t.Fatalf("cannot write '%s': %v", filePath, err)
+	}
+	return filePath
+}
+
+func getColumnTypes(tbl openapistackql.SQLExternalTable) []string {
+	var rv []string
+	for _, col := range tbl.Columns {
+		rv = append(rv, fmt.Sprintf("%s %s", col.Name, col.Type))
+	}
+	return rv
+}
+
+// queryRows renders each row as text, nulls as "NULL",
+// so that rows of any shape compare as string slices.
+func queryRows(t *testing.T, ds *fileSQLDataSource, query string) []string {
+	t.Helper()
+	rows, err := ds.Query(query)
+	if err != nil {
+		t.Fatalf("cannot query '%s': %v", query, err)
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var rv []string
+	for rows.Next() {
+		row := make([]sql.NullString, len(columns))
+		rowPtr := make([]interface{}, len(columns))
+		for i := range row {
+			rowPtr[i] = &row[i]
+		}
+		if err := rows.Scan(rowPtr...); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var vals []string
+		for _, v := range row {
+			if !v.Valid {
+				vals = append(vals, "NULL")
+				continue
+			}
+			vals = append(vals, v.String)
+		}
+		rv = append(rv, strings.Join(vals, "|"))
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return rv
+}
+
+func TestMergeFileColumnTypes(t *testing.T) {
+	for _, tc := range []struct {
+		values []interface{}
+		want   string
+	}{
+		{values: []interface{}{json.Number("1"), json.Number("2")}, want: fileColumnTypeBigint},
+		{values: []interface{}{json.Number("1"), json.Number("2.5")}, want: fileColumnTypeDouble},
+		{values: []interface{}{json.Number("2.5"), nil, json.Number("1")}, want: fileColumnTypeDouble},
+		{values: []interface{}{true, nil, false}, want: fileColumnTypeBoolean},
+		{values: []interface{}{json.Number("1"), "a"}, want: fileColumnTypeVarchar},
+		{values: []interface{}{true, json.Number("1")}, want: fileColumnTypeVarchar},
+		{values: []interface{}{map[string]interface{}{"a": "b"}}, want: fileColumnTypeVarchar},
+		{values: []interface{}{nil, nil}, want: ""},
+	} {
+		got := ""
+		for _, v := range tc.values {
+			got = mergeFileColumnTypes(got, getJSONValueColumnType(v))
+		}
+		if got != tc.want {
+			t.Fatalf("merged type of %v = '%s', want '%s'", tc.values, got, tc.want)
+		}
+	}
+}
+
+func TestGetSQLiteAffinityColumnType(t *testing.T) {
+	for declaredType, want := range map[string]string{
+		"INTEGER":          fileColumnTypeBigint,
+		"unsigned big int": fileColumnTypeBigint,
+		"VARCHAR(255)":     fileColumnTypeVarchar,
+		"TEXT":             fileColumnTypeVarchar,
+		"BOOLEAN":          fileColumnTypeBoolean,
+		"REAL":             fileColumnTypeDouble,
+		"DECIMAL(10,5)":    fileColumnTypeDouble,
+		"BLOB":             fileColumnTypeVarchar,
+		"":                 fileColumnTypeVarchar,
+	} {
+		if got := getSQLiteAffinityColumnType(declaredType); got != want {
+			t.Fatalf("getSQLiteAffinityColumnType(%q) = '%s', want '%s'", declaredType, got, want)
+		}
+	}
+}
+
+func TestLoadJSONFile(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		fileName    string
+		content     string
+		wantColumns []string
+		wantRows    []string
+		wantErr     string
+	}{
+		{
+			name:        "single object",
+			fileName:    "t.json",
+			content:     `{"id": 1, "name": "a", "tags": ["x"]}`,
+			wantColumns: []string{"id bigint", "name text", "tags text"},
+			wantRows:    []string{`1|a|["x"]`},
+		},
+		{
+			name:        "array",
+			fileName:    "t.json",
+			content:     `[{"id": 1, "score": 1}, {"id": 2, "score": 2.5, "note": null}, {"id": 3, "active": true}]`,
+			wantColumns: []string{"id bigint", "score double precision", "note text", "active boolean"},
+			wantRows:    []string{"1|1|NULL|NULL", "2|2.5|NULL|NULL", "3|NULL|NULL|true"},
+		},
+		{
+			name:        "newline delimited",
+			fileName:    "t.ndjson",
+			content:     "{\"id\": 1, \"value\": 1}\n{\"id\": 2, \"value\": \"b\"}\n",
+			wantColumns: []string{"id bigint", "value text"},
+			wantRows:    []string{"1|1", "2|b"},
+		},
+		{
+			name:     "non-object array element",
+			fileName: "t.json",
+			content:  `[{"id": 1}, 2]`,
+			wantErr:  "expected JSON object but got 'json.Number'",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			filePath := writeTestFile(t, t.TempDir(), tc.fileName, tc.content)
+			ds, err := newTestFileDataSource(t, filePath, "")
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("error = %v, want '%s'", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tables := ds.GetExternalTables()
+			if len(tables) != 1 || tables[0].SchemaName != fileDataSourceSchemaName || tables[0].Name != "t" {
+				t.Fatalf("tables = %v, want files.t", tables)
+			}
+			if got := getColumnTypes(tables[0]); !reflect.DeepEqual(got, tc.wantColumns) {
+				t.Fatalf("columns = %v, want %v", got, tc.wantColumns)
+			}
+			if got := queryRows(t, ds, `SELECT * FROM "files"."t" ORDER BY 1`); !reflect.DeepEqual(got, tc.wantRows) {
+				t.Fatalf("rows = %v, want %v", got, tc.wantRows)
+			}
+		})
+	}
+}
+
+func TestLoadDelimitedFilesWithOverride(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, "people.csv", "id,zip\n1,02134\n2,10001\n")
+	writeTestFile(t, dir, "pets.tsv", "name\tlegs\ncat\t4\n")
+	overrideFilePath := writeTestFile(t, t.TempDir(), "override.yaml", `
+tables:
+  files.people:
+    columns:
+      - name: zip
+        type: text
+`)
+	ds, err := newTestFileDataSource(t, dir, overrideFilePath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tables := ds.GetExternalTables()
+	if len(tables) != 2 {
+		t.Fatalf("tables = %v, want people and pets", tables)
+	}
+	for i, want := range [][]string{{"id bigint", "zip text"}, {"name text", "legs bigint"}} {
+		if got := getColumnTypes(tables[i]); !reflect.DeepEqual(got, want) {
+			t.Fatalf("columns of %s = %v, want %v", tables[i].Name, got, want)
+		}
+	}
+	if got, want := queryRows(t, ds, `SELECT zip FROM "files"."people" ORDER BY id`), []string{"02134", "10001"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("overridden rows = %v, want %v", got, want)
+	}
+	_, err = newTestFileDataSource(t, filepath.Join(dir, "pets.tsv"), overrideFilePath)
+	if err == nil || !strings.Contains(err.Error(), "unknown table 'files.people'") {
+		t.Fatalf("error = %v, want unknown table", err)
+	}
+}
+
+func TestLoadSQLiteFile(t *testing.T) {
+	// The file name exercises escaping of the SQLite URI.
+	filePath := filepath.Join(t.TempDir(), "inv?entory#1.sqlite")
+	sqliteDB, err := sql.Open("sqlite3", (&url.URL{Scheme: "file", Path: filePath}).String())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, stmt := range []string{
+		`CREATE TABLE items (id INTEGER, name VARCHAR(32), price REAL, in_stock BOOLEAN, extra)`,
+		`INSERT INTO items VALUES (1, 'bolt', 0.25, 1, 'x'), (2, 'nut', 0.1, 0, 7)`,
+		`CREATE VIEW cheap_items AS SELECT id, name FROM items WHERE price < 0.2`,
+	} {
+		if _, err := sqliteDB.Exec(stmt); err != nil {
+			t.Fatalf("cannot execute '%s': %v", stmt, err)
+		}
+	}
+	sqliteDB.Close()
+	ds, err := newTestFileDataSource(t, filePath, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tables := ds.GetExternalTables()
+	if len(tables) != 2 {
+		t.Fatalf("tables = %v, want items and cheap_items", tables)
+	}
+	for i, want := range []struct {
+		name    string
+		columns []string
+	}{
+		{name: "cheap_items", columns: []string{"id bigint", "name text"}},
+		{name: "items", columns: []string{"id bigint", "name text", "price double precision", "in_stock boolean", "extra text"}},
+	} {
+		if tables[i].SchemaName != "inv?entory#1" || tables[i].Name != want.name {
+			t.Fatalf("table %d = %s.%s, want inv?entory#1.%s", i, tables[i].SchemaName, tables[i].Name, want.name)
+		}
+		if got := getColumnTypes(tables[i]); !reflect.DeepEqual(got, want.columns) {
+			t.Fatalf("columns of %s = %v, want %v", want.name, got, want.columns)
+		}
+	}
+	for query, want := range map[string][]string{
+		`SELECT * FROM "inv?entory#1"."items" ORDER BY id`:       {"1|bolt|0.25|true|x", "2|nut|0.1|false|7"},
+		`SELECT * FROM "inv?entory#1"."cheap_items" ORDER BY id`: {"2|nut"},
+	} {
+		if got := queryRows(t, ds, query); !reflect.DeepEqual(got, want) {
+			t.Fatalf("rows of '%s' = %v, want %v", query, got, want)
+		}
+	}
+}
+
+func TestLoadParquetFile(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "measurements.parquet")
+	connector, err := duckdb.NewConnector("", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	writerDB := sql.OpenDB(connector)
+	_, err = writerDB.Exec(fmt.Sprintf(
+		`COPY (SELECT * FROM (VALUES (1::INTEGER, 'a', 1.5::FLOAT, true), (2::INTEGER, NULL, 2.25::FLOAT, false)) v(id, label, reading, ok)) TO '%s' (FORMAT PARQUET)`,
+		filePath,
+	))
+	writerDB.Close()
+	if err != nil {
+		t.Fatalf("cannot write parquet file: %v", err)
+	}
+	ds, err := newTestFileDataSource(t, filePath, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tables := ds.GetExternalTables()
+	if len(tables) != 1 || tables[0].Name != "measurements" {
+		t.Fatalf("tables = %v, want measurements", tables)
+	}
+	wantColumns := []string{"id bigint", "label text", "reading double precision", "ok boolean"}
+	if got := getColumnTypes(tables[0]); !reflect.DeepEqual(got, wantColumns) {
+		t.Fatalf("columns = %v, want %v", got, wantColumns)
+	}
+	wantRows := []string{"1|a|1.5|true", "2|NULL|2.25|false"}
+	if got := queryRows(t, ds, `SELECT * FROM "files"."measurements" ORDER BY id`); !reflect.DeepEqual(got, wantRows) {
+		t.Fatalf("rows = %v, want %v", got, wantRows)
+	}
+}
@@ -3,14 +3,21 @@ package sql_datasource
 import (
 	"database/sql"
 	"fmt"
+	"math"
+	"strings"
 
+	_ "github.com/go-sql-driver/mysql"
 	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/lib/pq/oid"
+	_ "github.com/microsoft/go-mssqldb"
 	_ "github.com/snowflakedb/gosnowflake"
+	"github.com/stackql/go-openapistackql/openapistackql"
 
 	"github.com/stackql/stackql/internal/stackql/constants"
 	"github.com/stackql/stackql/internal/stackql/datasource/sql_table"
 	"github.com/stackql/stackql/internal/stackql/db_util"
 	"github.com/stackql/stackql/internal/stackql/dto"
+	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/relationaldto"
 )
 
 var (
@@ -51,6 +58,10 @@ func (ds *genericSQLDataSource) GetDBName() string {
 	return ds.dbName
 }
 
+func (ds *genericSQLDataSource) GetExternalTables() []openapistackql.SQLExternalTable {
+	return nil
+}
+
 func (ds *genericSQLDataSource) Exec(query string, args ...interface{}) (sql.Result, error) {
 	return ds.db.Exec(query, args...)
 }
@@ -67,22 +78,123 @@ func (ds *genericSQLDataSource) Begin() (*sql.Tx, error) {
 	return ds.db.Begin()
 }
 
+func (ds *genericSQLDataSource) GetDB() (*sql.DB, error) {
+	return ds.db, nil
+}
+
+// GetTableMetadata discovers columns from the information schema
+// of the data source.  Args are, in order, the (optional) schema name
+// and the table name.  If the schema name is omitted, then the
+// current schema of the connection is assumed.
 func (ds *genericSQLDataSource) GetTableMetadata(args ...string) (sql_table.SQLTable, error) {
+	var schemaName, tableName string
+	switch len(args) {
+	case 1:
+		tableName = args[0]
+	case 2:
+		schemaName, tableName = args[0], args[1]
+	default:
+		return nil, fmt.Errorf("could not obtain sql data source table metadata for args = '%v'", args)
+	}
+	query, queryArgs, err := ds.getColumnsQuery(schemaName, tableName)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := ds.db.Query(query, queryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var colz []relationaldto.RelationalColumn
+	for rows.Next() {
+		var columnName, dataType string
+		var colWidth sql.NullInt64
+		err = rows.Scan(&columnName, &dataType, &colWidth)
+		if err != nil {
+			return nil, err
+		}
+		relationalType := ds.getPortableRelationalType(dataType)
+		width := 0
+		if colWidth.Valid && colWidth.Int64 > 0 && colWidth.Int64 <= math.MaxInt32 {
+			width = int(colWidth.Int64)
+		}
+		// Staged values are read back as text, irrespective of
+		// relational type, so the presented type is always text.
+		colz = append(colz, relationaldto.NewRelationalColumn(columnName, relationalType).WithWidth(width).WithOID(oid.T_text))
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	if len(colz) == 0 {
+		return nil, fmt.Errorf("could not obtain sql data source table metadata for table = '%s': no columns found", tableName)
+	}
+	return sql_table.NewStandardSQLTable(colz)
+}
+
+func (ds *genericSQLDataSource) getColumnsQuery(schemaName, tableName string) (string, []interface{}, error) {
+	var schemaPlaceholder, tablePlaceholder, currentSchema string
+	switch ds.dbName {
+	case constants.SQLDbNamePostgres:
+		schemaPlaceholder, tablePlaceholder, currentSchema = "$1", "$2", "current_schema()"
+	case constants.SQLDbNameMySQL:
+		schemaPlaceholder, tablePlaceholder, currentSchema = "?", "?", "DATABASE()"
+	case constants.SQLDbNameSQLServer:
+		schemaPlaceholder, tablePlaceholder, currentSchema = "@p1", "@p2", "SCHEMA_NAME()"
+	case constants.SQLDbNameSnowflake:
+		schemaPlaceholder, tablePlaceholder, currentSchema = "?", "?", "CURRENT_SCHEMA()"
+	case constants.SQLDbNameDuckDB:
+		schemaPlaceholder, tablePlaceholder, currentSchema = "?", "?", "current_schema()"
+	case constants.SQLDbNameSQLite:
+		return getSQLiteColumnsQuery(schemaName, tableName)
+	default:
+		return "", nil, fmt.Errorf("column discovery not supported for sql data source '%s'", ds.dbName)
+	}
+	queryTemplate := `
+	SELECT
+		column_name,
+		data_type,
+		character_maximum_length
+	FROM
+		information_schema.columns
+	WHERE
+		table_schema = %s
+		AND
+		table_name = %s
+	ORDER BY ordinal_position
+	`
+	if schemaName == "" {
+		return fmt.Sprintf(queryTemplate, currentSchema, schemaPlaceholder), []interface{}{tableName}, nil
+	}
+	return fmt.Sprintf(queryTemplate, schemaPlaceholder, tablePlaceholder), []interface{}{schemaName, tableName}, nil
+}
 
-	return nil, fmt.Errorf("could not obtain sql data source table metadata for args = '%v'", args)
+// SQLite has no information schema; the declared type is
+// mapped as for any other backend and there is no width.
+func getSQLiteColumnsQuery(schemaName, tableName string) (string, []interface{}, error) {
+	if schemaName == "" {
+		return `SELECT name, type, NULL FROM pragma_table_info(?) ORDER BY cid`, []interface{}{tableName}, nil
+	}
+	return `SELECT name, type, NULL FROM pragma_table_info(?, ?) ORDER BY cid`, []interface{}{tableName, schemaName}, nil
 }
 
-// func (ds *genericSQLDataSource) getPostgresTableMetadata(schemaName, tableName string) (sql_table.SQLTable, error) {
-// 	queryTemplate := `
-// 	SELECT
-// 		column_name,
-// 		data_type
-// 	FROM
-// 		information_schema.columns
-// 	WHERE
-// 		table_name = ?
-// 		AND
-// 		table_schema = ?;
-// 	`
-// 	return nil, fmt.Errorf("could not obtain sql data source table metadata for table = '%s'", tableName)
-// }
+// Source types are mapped onto a small set of types
+// that are valid for all SQL backends, so that data
+// may be staged in the backend irrespective of source.
+// MySQL BIT(n) is a bit field rather than a boolean.
+func (ds *genericSQLDataSource) getPortableRelationalType(dataType string) string {
+	dataType = strings.ToLower(dataType)
+	if dataType == "bit" && ds.dbName == constants.SQLDbNameMySQL {
+		return "text"
+	}
+	switch dataType {
+	case "tinyint", "smallint", "mediumint", "int", "integer", "bigint", "int2", "int4", "int8", "serial", "bigserial":
+		return "bigint"
+	case "decimal", "numeric", "number", "float", "float4", "float8", "real", "double", "double precision", "money", "smallmoney":
+		return "double precision"
+	case "bool", "boolean", "bit":
+		return "boolean"
+	default:
+		return "text"
+	}
+}
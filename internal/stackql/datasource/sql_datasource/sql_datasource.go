@@ -4,6 +4,8 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/stackql/go-openapistackql/openapistackql"
+
 	"github.com/stackql/stackql/internal/stackql/constants"
 	"github.com/stackql/stackql/internal/stackql/datasource/sql_table"
 	"github.com/stackql/stackql/internal/stackql/dto"
@@ -11,12 +13,16 @@ import (
 
 type SQLDataSource interface {
 	Begin() (*sql.Tx, error)
+	GetDB() (*sql.DB, error)
 	Exec(string, ...interface{}) (sql.Result, error)
 	Query(string, ...interface{}) (*sql.Rows, error)
 	QueryRow(string, ...any) *sql.Row
 	GetTableMetadata(...string) (sql_table.SQLTable, error)
 	GetSchemaType() string
 	GetDBName() string
+	// GetExternalTables returns tables that are defined by
+	// the data source itself rather than by a provider document.
+	GetExternalTables() []openapistackql.SQLExternalTable
 }
 
 func NewDataSource(authCtx *dto.AuthCtx) (SQLDataSource, error) {
@@ -24,10 +30,22 @@ func NewDataSource(authCtx *dto.AuthCtx) (SQLDataSource, error) {
 		return nil, fmt.Errorf("cannot create sql data source from nil auth context")
 	}
 	if authCtx.Type == fmt.Sprintf("%s%s%s", constants.AuthTypeSQLDataSourcePrefix, constants.AuthTypeDelimiter, "snowflake") {
-		return newGenericSQLDataSource(authCtx, "snowflake", "snowflake")
+		return newGenericSQLDataSource(authCtx, "snowflake", constants.SQLDbNameSnowflake)
 	}
 	if authCtx.Type == fmt.Sprintf("%s%s%s", constants.AuthTypeSQLDataSourcePrefix, constants.AuthTypeDelimiter, "postgres") {
-		return newGenericSQLDataSource(authCtx, "pgx", "postgres")
+		return newGenericSQLDataSource(authCtx, "pgx", constants.SQLDbNamePostgres)
+	}
+	if authCtx.Type == fmt.Sprintf("%s%s%s", constants.AuthTypeSQLDataSourcePrefix, constants.AuthTypeDelimiter, "mysql") {
+		return newGenericSQLDataSource(authCtx, "mysql", constants.SQLDbNameMySQL)
+	}
+	if authCtx.Type == fmt.Sprintf("%s%s%s", constants.AuthTypeSQLDataSourcePrefix, constants.AuthTypeDelimiter, "mssql") {
+		return newGenericSQLDataSource(authCtx, "sqlserver", constants.SQLDbNameSQLServer)
+	}
+	if authCtx.Type == fmt.Sprintf("%s%s%s", constants.AuthTypeSQLDataSourcePrefix, constants.AuthTypeDelimiter, "sqlite") {
+		return newGenericSQLDataSource(authCtx, "sqlite3", constants.SQLDbNameSQLite)
+	}
+	if authCtx.Type == fmt.Sprintf("%s%s%s", constants.AuthTypeSQLDataSourcePrefix, constants.AuthTypeDelimiter, "file") {
+		return newFileSQLDataSource(authCtx)
 	}
 	return nil, fmt.Errorf("sql data source of type '%s' not supported", authCtx.Type)
 }
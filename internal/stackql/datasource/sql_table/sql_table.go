@@ -18,6 +18,7 @@ type standardSQLTable struct {
 func NewStandardSQLTable(colz []relationaldto.RelationalColumn) (SQLTable, error) {
 	rv := &standardSQLTable{
 		symTab: symtab.NewHashMapTreeSymTab(),
+		colz:   colz,
 	}
 	return rv, nil
 }
@@ -9,6 +9,8 @@ import (
 	"github.com/stackql/stackql/internal/stackql/logging"
 )
 
+const subsystem logging.Subsystem = "db_util"
+
 func GetDB(driverName string, dbName string, cfg dto.SQLBackendCfg) (*sql.DB, error) {
 	dsn := cfg.GetDSN()
 	if dsn == "" {
@@ -27,7 +29,7 @@ func GetDB(driverName string, dbName string, cfg dto.SQLBackendCfg) (*sql.DB, er
 	if err != nil {
 		return nil, fmt.Errorf("%s db object setup error = '%s'", driverName, err.Error())
 	}
-	logging.GetLogger().Debugln(fmt.Sprintf("opened %s TCP db with connection string = '%s' and err  = '%v'", dbName, dsn, err))
+	subsystem.GetLogger().Debugln(fmt.Sprintf("opened %s TCP db with connection string = '%s' and err  = '%v'", dbName, dsn, err))
 	pingErr := db.Ping()
 	retryCount = 0
 	for {
@@ -41,6 +43,6 @@ func GetDB(driverName string, dbName string, cfg dto.SQLBackendCfg) (*sql.DB, er
 	if pingErr != nil {
 		return nil, fmt.Errorf("%s connection setup ping error = '%s'", dbName, pingErr.Error())
 	}
-	logging.GetLogger().Debugln(fmt.Sprintf("opened and pinged %s TCP db with connection string = '%s' and err  = '%v'", dbName, dsn, err))
+	subsystem.GetLogger().Debugln(fmt.Sprintf("opened and pinged %s TCP db with connection string = '%s' and err  = '%v'", dbName, dsn, err))
 	return db, nil
 }
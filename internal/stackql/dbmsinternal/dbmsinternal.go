@@ -1,6 +1,7 @@
 package dbmsinternal
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
@@ -10,6 +11,8 @@ import (
 	"github.com/stackql/stackql/internal/stackql/sql_system"
 )
 
+const subsystem logging.Subsystem = "dbmsinternal"
+
 var (
 	_                      DBMSInternalRouter = &standardDBMSInternalRouter{}
 	internalTableRegexp    *regexp.Regexp     = regexp.MustCompile(`(?i)^(?:public\.)?(?:pg_type|pg_namespace|pg_catalog.*|current_schema)`)
@@ -19,7 +22,7 @@ var (
 )
 
 type DBMSInternalRouter interface {
-	CanRoute(node sqlparser.SQLNode) (constants.BackendQueryType, bool)
+	CanRoute(ctx context.Context, node sqlparser.SQLNode) (constants.BackendQueryType, bool)
 	ExprIsRoutable(node sqlparser.SQLNode) bool
 }
 
@@ -72,13 +75,17 @@ type standardDBMSInternalRouter struct {
 	funcNameRegexp *regexp.Regexp
 }
 
-func (pgr *standardDBMSInternalRouter) CanRoute(node sqlparser.SQLNode) (constants.BackendQueryType, bool) {
+func (pgr *standardDBMSInternalRouter) CanRoute(ctx context.Context, node sqlparser.SQLNode) (constants.BackendQueryType, bool) {
+	subsystem.GetContextLogger(ctx).Debugf("node = %v\n", node)
+	return pgr.canRoute(node)
+}
+
+func (pgr *standardDBMSInternalRouter) canRoute(node sqlparser.SQLNode) (constants.BackendQueryType, bool) {
 	if pgr.sqlSystem.GetName() != constants.SQLDialectPostgres {
 		return pgr.negative()
 	}
 	switch node := node.(type) {
 	case *sqlparser.Select:
-		logging.GetLogger().Debugf("node = %v\n", node)
 		return pgr.analyzeSelect(node)
 	case *sqlparser.Set:
 		return pgr.affirmativeExec()
@@ -169,7 +176,7 @@ func (pgr *standardDBMSInternalRouter) analyzeTableExpr(node sqlparser.TableExpr
 		case sqlparser.TableName:
 			return pgr.analyzeTableName(expr)
 		case *sqlparser.Subquery:
-			_, rv := pgr.CanRoute(expr.Select)
+			_, rv := pgr.canRoute(expr.Select)
 			return rv
 		}
 	case *sqlparser.JoinTableExpr:
@@ -9,7 +9,6 @@ import (
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 	"github.com/stackql/stackql/internal/stackql/astanalysis/annotatedast"
 	"github.com/stackql/stackql/internal/stackql/astvisit"
-	"github.com/stackql/stackql/internal/stackql/constants"
 	"github.com/stackql/stackql/internal/stackql/dataflow"
 	"github.com/stackql/stackql/internal/stackql/docparser"
 	"github.com/stackql/stackql/internal/stackql/drm"
@@ -19,6 +18,7 @@ import (
 	"github.com/stackql/stackql/internal/stackql/parserutil"
 	"github.com/stackql/stackql/internal/stackql/primitivebuilder"
 	"github.com/stackql/stackql/internal/stackql/primitivecomposer"
+	"github.com/stackql/stackql/internal/stackql/sqlpushdown"
 	"github.com/stackql/stackql/internal/stackql/sqlrewrite"
 	"github.com/stackql/stackql/internal/stackql/sqlstream"
 	"github.com/stackql/stackql/internal/stackql/streaming"
@@ -27,6 +27,8 @@ import (
 	"github.com/stackql/stackql/internal/stackql/util"
 )
 
+const subsystem logging.Subsystem = "dependencyplanner"
+
 type DependencyPlanner interface {
 	Plan() error
 	GetBldr() primitivebuilder.Builder
@@ -114,7 +116,7 @@ func (dp *standardDependencyPlanner) Plan() error {
 			outDegree := dp.dataflowCollection.OutDegree(unit)
 			if inDegree == 0 && outDegree > 0 {
 				// TODO: start builder
-				logging.GetLogger().Infof("\n")
+				subsystem.GetContextLogger(dp.handlerCtx.GetContext()).Infof("\n")
 			}
 			if inDegree != 0 || outDegree != 0 {
 				return fmt.Errorf("cannot currently execute data dependent tables with inDegree = %d and/or outDegree = %d", inDegree, outDegree)
@@ -143,12 +145,12 @@ func (dp *standardDependencyPlanner) Plan() error {
 			if err != nil {
 				return err
 			}
-			logging.GetLogger().Infof("%v\n", orderedNodes)
+			subsystem.GetContextLogger(dp.handlerCtx.GetContext()).Infof("%v\n", orderedNodes)
 			edges, err := unit.GetEdges()
 			if err != nil {
 				return err
 			}
-			logging.GetLogger().Infof("%v\n", edges)
+			subsystem.GetContextLogger(dp.handlerCtx.GetContext()).Infof("%v\n", edges)
 			edgeCount := len(edges)
 			if edgeCount > 1 {
 				return fmt.Errorf("data flow: cannot accomodate table dependencies of this complexity: supplied = %d, max = 1", edgeCount)
@@ -209,7 +211,7 @@ func (dp *standardDependencyPlanner) Plan() error {
 	if err != nil {
 		return err
 	}
-	logging.GetLogger().Debugf("rewrittenWhereStr = '%s'", rewrittenWhereStr)
+	subsystem.GetContextLogger(dp.handlerCtx.GetContext()).Debugf("rewrittenWhereStr = '%s'", rewrittenWhereStr)
 	drmCfg, err := drm.GetDRMConfig(dp.handlerCtx.GetSQLSystem(), dp.handlerCtx.GetNamespaceCollection(), dp.handlerCtx.GetControlAttributes())
 	if err != nil {
 		return err
@@ -269,12 +271,12 @@ func (dp *standardDependencyPlanner) processOrphan(sqlNode sqlparser.SQLNode, an
 		if err != nil {
 			return nil, nil, err
 		}
-		err = dp.handlerCtx.GetSQLEngine().ExecInTxn(ddl)
+		_, err = dp.handlerCtx.GetSQLEngine().ExecInTxn(ddl)
 		if err != nil {
 			return nil, nil, err
 		}
 	}
-	insPsc, err := dp.primitiveComposer.GetDRMConfig().GenerateInsertDML(anTab, opStore, tcc)
+	insPsc, err := dp.primitiveComposer.GetDRMConfig().GenerateInsertDML(dp.handlerCtx.GetContext(), anTab, opStore, tcc)
 	return insPsc, tcc, err
 }
 
@@ -291,29 +293,16 @@ func (dp *standardDependencyPlanner) orchestrate(
 	sqlDataSource, isSQLDataSource := annotationCtx.GetTableMeta().GetSQLDataSource()
 	var builder primitivebuilder.Builder
 	if isSQLDataSource {
-		// TODO: generate query properly with ordered columns
-		// starColNames := range insPsc.GetNonControlColumns()
-		var colNames []string
-		colz := insPsc.GetNonControlColumns()
-		for _, col := range colz {
-			//
-			colNames = append(colNames, fmt.Sprintf(`"%s"`, col.GetIdentifier()))
-		}
-		projectionStr := strings.Join(colNames, ", ")
-		tableObj, err := dp.handlerCtx.GetDrmConfig().GetCurrentTable(annotationCtx.GetHIDs())
-		if err != nil {
-			return err
-		}
-		tableName := tableObj.GetName()
-		// discoverID = tableObj.GetDiscoveryID()
-		if err != nil {
-			return err
-		}
-		tableName = annotationCtx.GetHIDs().GetSQLDataSourceTableName()
-		// targetTableName := annotationCtx.GetHIDs().GetStackQLTableName()
-		query := fmt.Sprintf(`SELECT %s FROM %s`, projectionStr, tableName)
-		if sqlDataSource.GetDBName() == constants.SQLDbNameSnowflake {
-			query = strings.ReplaceAll(query, `"`, ``)
+		tableName := annotationCtx.GetHIDs().GetSQLDataSourceTableName()
+		var query string
+		if sqlpushdown.IsSupportedDialect(sqlDataSource.GetDBName()) {
+			query = dp.getSQLDataSourceQuery(annotationCtx, insPsc, sqlDataSource.GetDBName(), tableName)
+		} else {
+			var colNames []string
+			for _, col := range insPsc.GetNonControlColumns() {
+				colNames = append(colNames, fmt.Sprintf(`"%s"`, col.GetIdentifier()))
+			}
+			query = fmt.Sprintf(`SELECT %s FROM %s`, strings.Join(colNames, ", "), tableName)
 		}
 		builder = primitivebuilder.NewSQLDataSourceSingleSelectAcquire(
 			dp.primitiveComposer.GetGraph(),
@@ -383,7 +372,7 @@ func (dp *standardDependencyPlanner) processAcquire(
 	}
 	anTab := util.NewAnnotatedTabulation(tab, annotationCtx.GetHIDs(), inputTableName, annotationCtx.GetTableMeta().GetAlias())
 
-	discoGenId, err := docparser.OpenapiStackQLTabulationsPersistor(m, []util.AnnotatedTabulation{anTab}, dp.primitiveComposer.GetSQLEngine(), prov.Name, dp.handlerCtx.GetNamespaceCollection(), dp.handlerCtx.GetControlAttributes(), dp.handlerCtx.GetSQLSystem())
+	discoGenId, err := docparser.OpenapiStackQLTabulationsPersistor(dp.handlerCtx.GetContext(), m, []util.AnnotatedTabulation{anTab}, dp.primitiveComposer.GetSQLEngine(), prov.Name, dp.handlerCtx.GetNamespaceCollection(), dp.handlerCtx.GetControlAttributes(), dp.handlerCtx.GetSQLSystem())
 	if err != nil {
 		return util.NewAnnotatedTabulation(nil, nil, "", ""), nil, err
 	}
@@ -411,7 +400,7 @@ func (dp *standardDependencyPlanner) getStreamFromEdge(e dataflow.DataFlowEdge,
 		if err != nil {
 			return nil, err
 		}
-		return sqlstream.NewSimpleSQLMapStream(selectCtx, insertContainer, dp.handlerCtx.GetDrmConfig(), dp.handlerCtx.GetSQLEngine()), nil
+		return sqlstream.NewSimpleSQLMapStream(dp.handlerCtx.GetContext(), selectCtx, insertContainer, dp.handlerCtx.GetDrmConfig(), dp.handlerCtx.GetSQLEngine()), nil
 	}
 	projection, err := e.GetProjection()
 	if err != nil {
@@ -430,7 +419,7 @@ func (dp *standardDependencyPlanner) getStreamFromEdge(e dataflow.DataFlowEdge,
 		}
 	}
 	if len(staticParams) > 0 {
-		staticParams, err = util.TransformSQLRawParameters(staticParams)
+		staticParams, err = util.TransformSQLRawParameters(dp.handlerCtx.GetContext(), staticParams)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,185 @@
+package dependencyplanner
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/drm"
+	"github.com/stackql/stackql/internal/stackql/sqlpushdown"
+	"github.com/stackql/stackql/internal/stackql/taxonomy"
+)
+
+// getSQLDataSourceQuery renders the acquisition query for an external
+// sql data source table.  Only referenced columns are projected.
+// Predicates that refer only to this table are pushed down
+// and, for single table queries whose predicates are all pushed exactly,
+// so is the row limit.
+// Pushed predicates are retained in the local query.
+func (dp *standardDependencyPlanner) getSQLDataSourceQuery(
+	annotationCtx taxonomy.AnnotationCtx,
+	insPsc drm.PreparedStatementCtx,
+	dbName string,
+	tableName string,
+) string {
+	var colNames []string
+	columns := make(map[string]string)
+	for _, col := range insPsc.GetNonControlColumns() {
+		colNames = append(colNames, col.GetIdentifier())
+		columns[strings.ToLower(col.GetIdentifier())] = col.GetRelationalType()
+	}
+	sel, isSelect := dp.sqlStatement.(*sqlparser.Select)
+	if !isSelect {
+		return sqlpushdown.RenderTableQuery(dbName, tableName, colNames, nil, -1)
+	}
+	tableMeta := annotationCtx.GetTableMeta()
+	var node *sqlparser.AliasedTableExpr
+	uniqueTables := make(map[interface{}]struct{})
+	for k, v := range dp.tblz {
+		uniqueTables[v] = struct{}{}
+		if v == tableMeta {
+			if aliased, ok := k.(*sqlparser.AliasedTableExpr); ok {
+				node = aliased
+			}
+		}
+	}
+	alias := tableMeta.GetAlias()
+	if alias == "" && node != nil {
+		if tableName, ok := node.Expr.(sqlparser.TableName); ok {
+			alias = tableName.Name.GetRawVal()
+		}
+	}
+	projection := getReferencedColumns(sel, alias, colNames)
+	if node == nil || !isRowPreserved(sel.From, node) || sel.Where == nil {
+		return sqlpushdown.RenderTableQuery(dbName, tableName, projection, nil, -1)
+	}
+	isSoleTable := len(uniqueTables) == 1
+	predicates, isExact, isComplete := sqlpushdown.RenderTableFilter(
+		dbName,
+		sel.Where.Expr,
+		sqlpushdown.Table{
+			Node:    node,
+			Name:    tableName,
+			Alias:   alias,
+			Columns: columns,
+		},
+		isSoleTable,
+	)
+	limit := -1
+	if isSoleTable && isExact && isComplete {
+		limit = getPushableLimit(sel)
+	}
+	return sqlpushdown.RenderTableQuery(dbName, tableName, projection, predicates, limit)
+}
+
+// getReferencedColumns errs on the side of inclusion;
+// any column name mentioned anywhere in the statement is projected.
+func getReferencedColumns(sel *sqlparser.Select, alias string, colNames []string) []string {
+	for _, selectExpr := range sel.SelectExprs {
+		if star, ok := selectExpr.(*sqlparser.StarExpr); ok {
+			if star.TableName.IsEmpty() || strings.EqualFold(star.TableName.Name.GetRawVal(), alias) {
+				return colNames
+			}
+		}
+	}
+	referenced := make(map[string]struct{})
+	sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, ok := node.(*sqlparser.ColName); ok {
+			referenced[col.Name.Lowered()] = struct{}{}
+		}
+		return true, nil
+	}, sel)
+	var rv []string
+	for _, colName := range colNames {
+		if _, ok := referenced[strings.ToLower(colName)]; ok {
+			rv = append(rv, colName)
+		}
+	}
+	// Row cardinality must survive, eg: for count(*).
+	if len(rv) == 0 && len(colNames) > 0 {
+		rv = append(rv, colNames[0])
+	}
+	return rv
+}
+
+// isRowPreserved reports whether the table expression is reached from the
+// FROM clause through inner joins or the preserved side of outer joins only.
+// Predicates must not be pushed to the null supplying side of outer joins.
+func isRowPreserved(tableExprs sqlparser.TableExprs, target *sqlparser.AliasedTableExpr) bool {
+	for _, tableExpr := range tableExprs {
+		if isRowPreservedTableExpr(tableExpr, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func isRowPreservedTableExpr(tableExpr sqlparser.TableExpr, target *sqlparser.AliasedTableExpr) bool {
+	switch tableExpr := tableExpr.(type) {
+	case *sqlparser.AliasedTableExpr:
+		return tableExpr == target
+	case *sqlparser.ParenTableExpr:
+		return isRowPreserved(tableExpr.Exprs, target)
+	case *sqlparser.JoinTableExpr:
+		switch tableExpr.Join {
+		case sqlparser.JoinStr:
+			return isRowPreservedTableExpr(tableExpr.LeftExpr, target) || isRowPreservedTableExpr(tableExpr.RightExpr, target)
+		case sqlparser.LeftJoinStr:
+			return isRowPreservedTableExpr(tableExpr.LeftExpr, target)
+		case sqlparser.RightJoinStr:
+			return isRowPreservedTableExpr(tableExpr.RightExpr, target)
+		default:
+			return false
+		}
+	default:
+		return false
+	}
+}
+
+// getPushableLimit returns the number of rows sufficient
+// to satisfy the statement's LIMIT, or -1 where the limit
+// cannot be applied ahead of local processing.
+func getPushableLimit(sel *sqlparser.Select) int {
+	if sel.Limit == nil || sel.Distinct || len(sel.GroupBy) > 0 || sel.Having != nil || len(sel.OrderBy) > 0 {
+		return -1
+	}
+	hasAggregate := false
+	sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if node.IsAggregate() {
+				hasAggregate = true
+			}
+		case *sqlparser.GroupConcatExpr:
+			hasAggregate = true
+		}
+		return !hasAggregate, nil
+	}, sel.SelectExprs)
+	if hasAggregate {
+		return -1
+	}
+	limit, ok := getIntLiteral(sel.Limit.Rowcount)
+	if !ok {
+		return -1
+	}
+	if sel.Limit.Offset != nil {
+		offset, ok := getIntLiteral(sel.Limit.Offset)
+		if !ok {
+			return -1
+		}
+		limit += offset
+	}
+	return limit
+}
+
+func getIntLiteral(expr sqlparser.Expr) (int, bool) {
+	val, ok := expr.(*sqlparser.SQLVal)
+	if !ok || val.Type != sqlparser.IntVal {
+		return 0, false
+	}
+	rv, err := strconv.Atoi(string(val.Val))
+	if err != nil || rv < 0 {
+		return 0, false
+	}
+	return rv, true
+}
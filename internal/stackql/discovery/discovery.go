@@ -2,7 +2,10 @@ package discovery
 
 import (
 	"fmt"
+	"sync"
 
+	"github.com/stackql/stackql/internal/stackql/datasource/sql_datasource"
+	"github.com/stackql/stackql/internal/stackql/datasource/sql_table"
 	"github.com/stackql/stackql/internal/stackql/docparser"
 	"github.com/stackql/stackql/internal/stackql/dto"
 	"github.com/stackql/stackql/internal/stackql/sql_system"
@@ -22,6 +25,9 @@ type TTLDiscoveryStore struct {
 	sqlSystem  sql_system.SQLSystem
 	runtimeCtx dto.RuntimeCtx
 	registry   openapistackql.RegistryAPI
+	// shardMutex serializes the lazy loading of service
+	// shards, which are shared by concurrent server sessions.
+	shardMutex sync.Mutex
 }
 
 type IDiscoveryAdapter interface {
@@ -30,7 +36,7 @@ type IDiscoveryAdapter interface {
 	GetServiceHandlesMap(prov *openapistackql.Provider) (map[string]*openapistackql.ProviderService, error)
 	GetServiceHandle(prov *openapistackql.Provider, serviceKey string) (*openapistackql.ProviderService, error)
 	GetProvider(providerKey string) (*openapistackql.Provider, error)
-	PersistStaticExternalSQLDataSource(prov *openapistackql.Provider) error
+	PersistStaticExternalSQLDataSource(prov *openapistackql.Provider, sqlDataSource sql_datasource.SQLDataSource) error
 	getDicoveryStore() IDiscoveryStore
 }
 
@@ -110,13 +116,23 @@ func (adp *BasicDiscoveryAdapter) GetServiceShard(prov *openapistackql.Provider,
 	return shard, nil
 }
 
-func (adp *BasicDiscoveryAdapter) PersistStaticExternalSQLDataSource(prov *openapistackql.Provider) error {
+// PersistStaticExternalSQLDataSource registers the external tables
+// declared by the provider.  Tables declared without columns have their
+// columns discovered from the data source.
+func (adp *BasicDiscoveryAdapter) PersistStaticExternalSQLDataSource(prov *openapistackql.Provider, sqlDataSource sql_datasource.SQLDataSource) error {
 	if prov.StackQLConfig == nil || len(prov.StackQLConfig.ExternalTables) < 1 {
 		return fmt.Errorf("no external tables supplied")
 	}
 	providerName := prov.Name
 	externalTables := prov.StackQLConfig.ExternalTables
 	for _, tbl := range externalTables {
+		if len(tbl.Columns) == 0 && sqlDataSource != nil {
+			discoveredColumns, err := discoverExternalColumns(sqlDataSource, tbl)
+			if err != nil {
+				return err
+			}
+			tbl.Columns = discoveredColumns
+		}
 		err := adp.sqlSystem.RegisterExternalTable(
 			providerName,
 			tbl,
@@ -128,6 +144,30 @@ func (adp *BasicDiscoveryAdapter) PersistStaticExternalSQLDataSource(prov *opena
 	return nil
 }
 
+func discoverExternalColumns(sqlDataSource sql_datasource.SQLDataSource, tbl openapistackql.SQLExternalTable) ([]openapistackql.SQLExternalColumn, error) {
+	var tableMeta sql_table.SQLTable
+	var err error
+	if tbl.SchemaName == "" {
+		tableMeta, err = sqlDataSource.GetTableMetadata(tbl.Name)
+	} else {
+		tableMeta, err = sqlDataSource.GetTableMetadata(tbl.SchemaName, tbl.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var rv []openapistackql.SQLExternalColumn
+	for _, col := range tableMeta.GetColumns() {
+		colOID, _ := col.GetOID()
+		rv = append(rv, openapistackql.SQLExternalColumn{
+			Name:  col.GetName(),
+			Type:  col.GetType(),
+			Oid:   uint32(colOID),
+			Width: col.GetWidth(),
+		})
+	}
+	return rv, nil
+}
+
 func (adp *BasicDiscoveryAdapter) GetResourcesMap(prov *openapistackql.Provider, serviceKey string) (map[string]*openapistackql.Resource, error) {
 	component, err := adp.GetServiceHandle(prov, serviceKey)
 	if component == nil || err != nil {
@@ -186,6 +226,8 @@ func (store *TTLDiscoveryStore) ProcessProviderDiscoveryDoc(url string, alias st
 }
 
 func (store *TTLDiscoveryStore) PersistServiceShard(pr *openapistackql.Provider, serviceHandle *openapistackql.ProviderService, resourceKey string) (*openapistackql.Service, error) {
+	store.shardMutex.Lock()
+	defer store.shardMutex.Unlock()
 	k := fmt.Sprintf("services.%s.%s", pr.Name, serviceHandle.Name)
 	svc, ok := serviceHandle.PeekServiceFragment(resourceKey)
 	if ok && svc != nil {
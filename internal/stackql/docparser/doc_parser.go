@@ -1,6 +1,7 @@
 package docparser
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/stackql/stackql/internal/stackql/drm"
@@ -16,6 +17,8 @@ import (
 	"strings"
 )
 
+const subsystem logging.Subsystem = "docparser"
+
 const (
 	SchemaDelimiter            string = "."
 	googleServiceKeyDelimiter  string = ":"
@@ -31,6 +34,7 @@ func TranslateServiceKeyIqlToGenericProvider(serviceKey string) string {
 }
 
 func OpenapiStackQLTabulationsPersistor(
+	ctx context.Context,
 	m *openapistackql.OperationStore,
 	tabluationsAnnotated []util.AnnotatedTabulation,
 	dbEngine sqlengine.SQLEngine,
@@ -62,7 +66,7 @@ func OpenapiStackQLTabulationsPersistor(
 		ddl, err := drmCfg.GenerateDDL(tblt, m, discoveryGenerationId, false)
 		if err != nil {
 			displayErr := fmt.Errorf("error generating DDL: %s", err.Error())
-			logging.GetLogger().Infoln(displayErr.Error())
+			subsystem.GetContextLogger(ctx).Infoln(displayErr.Error())
 			txn.Rollback()
 			return discoveryGenerationId, displayErr
 		}
@@ -70,7 +74,7 @@ func OpenapiStackQLTabulationsPersistor(
 			_, err = txn.Exec(q)
 			if err != nil {
 				displayErr := fmt.Errorf("aborting DDL run for query '''%s''' with error: %s", q, err.Error())
-				logging.GetLogger().Infof("aborting DDL run for query '''%s''' with error: %s\n", q, err.Error())
+				subsystem.GetContextLogger(ctx).Infof("aborting DDL run for query '''%s''' with error: %s\n", q, err.Error())
 				txn.Rollback()
 				return discoveryGenerationId, displayErr
 			}
@@ -4,23 +4,31 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 
+	wire "github.com/jeroenrinzema/psql-wire"
 	"github.com/jeroenrinzema/psql-wire/pkg/sqldata"
+	"github.com/stackql/stackql/internal/stackql/dto"
 	"github.com/stackql/stackql/internal/stackql/handler"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
 	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/querysubmit"
+	"github.com/stackql/stackql/internal/stackql/redaction"
 	"github.com/stackql/stackql/internal/stackql/responsehandler"
+	"github.com/stackql/stackql/internal/stackql/sessionctx"
+	"github.com/stackql/stackql/internal/stackql/sqlexport"
 	"github.com/stackql/stackql/internal/stackql/util"
 )
 
+const subsystem logging.Subsystem = "driver"
+
 func ProcessDryRun(handlerCtx handler.HandlerContext) {
 	resultMap := map[string]map[string]interface{}{
 		"1": {
 			"query": handlerCtx.GetRawQuery(),
 		},
 	}
-	logging.GetLogger().Debugln("dryrun query underway...")
+	subsystem.GetContextLogger(handlerCtx.GetContext()).Debugln("dryrun query underway...")
 	response := util.PrepareResultSet(internaldto.NewPrepareResultSetDTO(nil, resultMap, nil, nil, nil, nil))
 	responsehandler.HandleResponse(handlerCtx, response)
 }
@@ -31,25 +39,169 @@ func throwErr(err error, handlerCtx handler.HandlerContext) {
 }
 
 func ProcessQuery(handlerCtx handler.HandlerContext) {
+	exporter, err := getResultExporter(handlerCtx)
+	if err != nil {
+		throwErr(err, handlerCtx)
+		return
+	}
 	responses, ok := processQueryOrQueries(handlerCtx)
 	if ok {
 		for _, r := range responses {
+			if exporter != nil {
+				r = sqlexport.ExportOutput(handlerCtx.GetContext(), exporter, r)
+			}
 			responsehandler.HandleResponse(handlerCtx, r)
 		}
 	}
 }
 
+// getResultExporter returns nil unless results
+// are to be written into an export table.
+func getResultExporter(handlerCtx handler.HandlerContext) (sqlexport.Exporter, error) {
+	runtimeCtx := handlerCtx.GetRuntimeContext()
+	if runtimeCtx.ExportDSN == "" && runtimeCtx.ExportTable == "" {
+		return nil, nil
+	}
+	if runtimeCtx.ExportDSN == "" || runtimeCtx.ExportTable == "" {
+		return nil, fmt.Errorf("export requires both '--%s' and '--%s'", dto.ExportDSNKey, dto.ExportTableKey)
+	}
+	sqlDataSource, err := sqlexport.NewDataSource(runtimeCtx.ExportDSN)
+	if err != nil {
+		return nil, err
+	}
+	return sqlexport.NewExporter(sqlDataSource, runtimeCtx.ExportTable, runtimeCtx.ExportMode, sqlexport.SplitKeys(runtimeCtx.ExportKey))
+}
+
+const (
+	// sessionIDParameter is held amongst the client parameters of
+	// the connection, which last for the life of the connection.
+	sessionIDParameter wire.ParameterStatus = wire.ParameterStatus(sessionctx.SessionIDParameter)
+)
+
 type StackQLBackend struct {
-	handlerCtx handler.HandlerContext
+	handlerCtx    handler.HandlerContext
+	sessionsMutex sync.Mutex
+	sessions      map[string]*serverSession
+}
+
+// serverSession holds the handler context of a client connection, which
+// is cloned from that of the server, so that the context and settings
+// of its queries are not shared with those of other connections.
+// Queries of a connection are run one at a time.
+type serverSession struct {
+	handlerCtx  handler.HandlerContext
+	notifier    sessionctx.Notifier
+	mutex       sync.Mutex
+	cancelQuery context.CancelFunc
+}
+
+func (ss *serverSession) setCancelQuery(cancel context.CancelFunc) {
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+	ss.cancelQuery = cancel
+}
+
+func (ss *serverSession) cancel() {
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+	if ss.cancelQuery != nil {
+		ss.cancelQuery()
+	}
+}
+
+func (ss *serverSession) setNotifier(notifier sessionctx.Notifier) {
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+	ss.notifier = notifier
+}
+
+func (ss *serverSession) getNotifier() sessionctx.Notifier {
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+	return ss.notifier
+}
+
+func (sbs *StackQLBackend) OpenSession(sessionID string, notifier sessionctx.Notifier) {
+	sbs.getSession(sessionID).setNotifier(notifier)
+}
+
+func (sbs *StackQLBackend) CancelSession(sessionID string) {
+	sbs.sessionsMutex.Lock()
+	session, ok := sbs.sessions[sessionID]
+	sbs.sessionsMutex.Unlock()
+	if ok {
+		session.cancel()
+	}
+}
+
+func (sbs *StackQLBackend) CloseSession(sessionID string) {
+	sbs.sessionsMutex.Lock()
+	session, ok := sbs.sessions[sessionID]
+	delete(sbs.sessions, sessionID)
+	sbs.sessionsMutex.Unlock()
+	if ok {
+		session.cancel()
+	}
+}
+
+func (sbs *StackQLBackend) getSession(sessionID string) *serverSession {
+	sbs.sessionsMutex.Lock()
+	defer sbs.sessionsMutex.Unlock()
+	session, ok := sbs.sessions[sessionID]
+	if !ok {
+		session = &serverSession{
+			handlerCtx: sbs.handlerCtx.Clone(),
+		}
+		sbs.sessions[sessionID] = session
+	}
+	return session
 }
 
+// getSessionID identifies the connection by the ID supplied
+// by the server upon connection, else generated upon the first query.
+func getSessionID(ctx context.Context) string {
+	params := wire.ClientParameters(ctx)
+	if params == nil {
+		return ""
+	}
+	sessionID, ok := params[sessionIDParameter]
+	if !ok {
+		var err error
+		sessionID, err = sessionctx.NewSessionID()
+		if err != nil {
+			subsystem.GetContextLogger(ctx).Errorln(fmt.Sprintf("cannot generate session ID: %s", err.Error()))
+			return ""
+		}
+		params[sessionIDParameter] = sessionID
+	}
+	return sessionID
+}
+
+// HandleSimpleQuery runs the query on the handler context of its session,
+// under a context that is cancelled by a cancel request for the session.
 func (sbs *StackQLBackend) HandleSimpleQuery(ctx context.Context, query string) (sqldata.ISQLResultStream, error) {
-	sbs.handlerCtx.SetRawQuery(query)
+	sessionID := getSessionID(ctx)
+	session := sbs.getSession(sessionID)
+	if sessionID != "" {
+		ctx = sessionctx.WithSession(ctx, sessionctx.Session{
+			ID:       sessionID,
+			User:     wire.AuthenticatedUsername(ctx),
+			Notifier: session.getNotifier(),
+		})
+	}
+	queryCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	session.setCancelQuery(cancel)
+	defer session.setCancelQuery(nil)
+	handlerCtx := session.handlerCtx
+	handlerCtx.SetContext(queryCtx)
+	defer handlerCtx.SetContext(context.Background())
+	handlerCtx.SetRawQuery(query)
 	// if strings.Count(query, ";") > 1 {
 	// 	return nil, fmt.Errorf("only support single queries in server mode at this time")
 	// }
-	res, ok := processQueryOrQueries(sbs.handlerCtx)
-	if !ok {
+	res, ok := processQueryOrQueries(handlerCtx)
+	if !ok || len(res) == 0 {
 		return nil, fmt.Errorf("no SQLresults available")
 	}
 	r := res[0]
@@ -82,18 +234,55 @@ func (sb *StackQLBackend) SplitCompoundQuery(s string) ([]string, error) {
 func NewStackQLBackend(handlerCtx handler.HandlerContext) (*StackQLBackend, error) {
 	return &StackQLBackend{
 		handlerCtx: handlerCtx,
+		sessions:   make(map[string]*serverSession),
 	}, nil
 }
 
 func processQueryOrQueries(handlerCtx handler.HandlerContext) ([]internaldto.ExecutorOutput, bool) {
-	var retVal []internaldto.ExecutorOutput
-	cmdString := handlerCtx.GetRawQuery()
+	return processStatements(handlerCtx, splitStatements(handlerCtx.GetRawQuery()), false), true
+}
+
+func splitStatements(cmdString string) []string {
+	var retVal []string
 	for _, s := range strings.Split(cmdString, ";") {
 		if s == "" {
 			continue
 		}
+		retVal = append(retVal, s)
+	}
+	return retVal
+}
+
+// redactExecutorOutput redacts secrets from error text, which
+// may quote request URLs and response bodies, before it is presented.
+func redactExecutorOutput(handlerCtx handler.HandlerContext, r internaldto.ExecutorOutput) internaldto.ExecutorOutput {
+	redactor := handlerCtx.GetRedactor()
+	r.Err = redaction.RedactError(redactor, r.Err)
+	if r.Msg != nil {
+		for i, msg := range r.Msg.WorkingMessages {
+			r.Msg.WorkingMessages[i] = redactor.RedactText(msg)
+		}
+	}
+	return r
+}
+
+func processStatements(handlerCtx handler.HandlerContext, statements []string, isStopOnError bool) []internaldto.ExecutorOutput {
+	var retVal []internaldto.ExecutorOutput
+	recorder := handlerCtx.GetMutationRecorder()
+	for i, s := range statements {
+		if handlerCtx.GetContext().Err() != nil {
+			// remaining statements are not attempted once cancelled
+			break
+		}
+		if recorder != nil {
+			recorder.SetStatement(i, s)
+		}
 		handlerCtx.SetQuery(s)
-		retVal = append(retVal, querysubmit.SubmitQuery(handlerCtx))
+		r := redactExecutorOutput(handlerCtx, querysubmit.SubmitQuery(handlerCtx))
+		retVal = append(retVal, r)
+		if isStopOnError && r.Err != nil {
+			break
+		}
 	}
-	return retVal, true
+	return retVal
 }
@@ -0,0 +1,101 @@
+package driver
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/stackql/stackql/internal/stackql/handler"
+	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
+	"github.com/stackql/stackql/internal/stackql/mutationplan"
+	"github.com/stackql/stackql/internal/stackql/responsehandler"
+	"github.com/stackql/stackql/internal/stackql/sqlexport"
+)
+
+// ProcessPlan runs every statement with mutating calls recorded
+// in place of being sent, and writes the resulting plan as JSON.
+func ProcessPlan(handlerCtx handler.HandlerContext) {
+	subsystem.GetContextLogger(handlerCtx.GetContext()).Debugln("plan underway...")
+	plan, err := buildMutationPlan(handlerCtx)
+	if err != nil {
+		throwErr(err, handlerCtx)
+		return
+	}
+	b, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		throwErr(err, handlerCtx)
+		return
+	}
+	handlerCtx.GetOutfile().Write(append(b, '\n'))
+}
+
+// ProcessApply plans the script of the plan afresh and refuses to proceed
+// unless the outcome is unchanged.  Statements are then executed, sending
+// only the planned calls, until the first error.
+func ProcessApply(handlerCtx handler.HandlerContext, plan *mutationplan.Plan) {
+	subsystem.GetContextLogger(handlerCtx.GetContext()).Debugln("apply underway...")
+	handlerCtx.SetRawQuery(plan.Script)
+	livePlan, err := buildMutationPlan(handlerCtx)
+	if err != nil {
+		throwErr(fmt.Errorf("cannot verify plan: %s", err.Error()), handlerCtx)
+		return
+	}
+	if drift := plan.Drift(livePlan); len(drift) > 0 {
+		throwErr(fmt.Errorf("refusing to apply plan, live state has drifted since planning:\n  %s", strings.Join(drift, "\n  ")), handlerCtx)
+		return
+	}
+	recorder := mutationplan.NewVerifyingRecorder(plan.Calls)
+	handlerCtx.SetMutationRecorder(recorder)
+	defer handlerCtx.SetMutationRecorder(nil)
+	responses := processStatements(handlerCtx, splitStatements(plan.Script), true)
+	isErroneous := false
+	for _, r := range responses {
+		isErroneous = isErroneous || r.Err != nil
+		responsehandler.HandleResponse(handlerCtx, r)
+	}
+	if outstanding := recorder.GetOutstandingCalls(); len(outstanding) > 0 && !isErroneous {
+		throwErr(fmt.Errorf("plan partially applied, %d planned calls were not made, the first being %s", len(outstanding), outstanding[0]), handlerCtx)
+	}
+}
+
+func buildMutationPlan(handlerCtx handler.HandlerContext) (*mutationplan.Plan, error) {
+	recorder := mutationplan.NewCapturingRecorder()
+	handlerCtx.SetMutationRecorder(recorder)
+	defer handlerCtx.SetMutationRecorder(nil)
+	plan := mutationplan.NewPlan(handlerCtx.GetRawQuery())
+	statements := splitStatements(plan.Script)
+	responses := processStatements(handlerCtx, statements, true)
+	if err := handlerCtx.GetContext().Err(); err != nil {
+		return nil, err
+	}
+	for i, r := range responses {
+		if r.Err != nil {
+			return nil, fmt.Errorf("statement %d: %s", i, r.Err.Error())
+		}
+		read, hasResult, err := digestResult(i, statements[i], r)
+		if err != nil {
+			return nil, fmt.Errorf("statement %d: %s", i, err.Error())
+		}
+		if hasResult {
+			plan.Reads = append(plan.Reads, read)
+		}
+	}
+	plan.Calls = append(plan.Calls, recorder.GetCalls()...)
+	return plan, nil
+}
+
+func digestResult(statement int, query string, r internaldto.ExecutorOutput) (mutationplan.Read, bool, error) {
+	if r.GetSQLResult == nil || r.GetSQLResult() == nil {
+		return mutationplan.Read{}, false, nil
+	}
+	columns, rows, err := sqlexport.ReadResult(r.GetSQLResult())
+	if err != nil || len(columns) == 0 {
+		return mutationplan.Read{}, false, err
+	}
+	columnNames := make([]string, len(columns))
+	for i, col := range columns {
+		columnNames[i] = col.GetName()
+	}
+	read, err := mutationplan.NewRead(statement, query, columnNames, rows)
+	return read, err == nil, err
+}
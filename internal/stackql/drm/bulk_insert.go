@@ -0,0 +1,98 @@
+package drm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stackql/stackql/internal/stackql/sqlengine"
+)
+
+var (
+	_ BulkInserter = &standardBulkInserter{}
+)
+
+// BulkInserter buffers rows destined for the table
+// of an insert PreparedStatementCtx and writes them
+// in batches, within a single transaction.
+// Commit() flushes any buffered rows prior to commit.
+type BulkInserter interface {
+	Add(payload map[string]interface{}, requestEncoding string) error
+	Commit() error
+	Rollback() error
+}
+
+type standardBulkInserter struct {
+	drmCfg      *staticDRMConfig
+	insertCtx   PreparedStatementCtx
+	txn         sqlengine.BulkInsertTxn
+	columnCount int
+	batchSize   int
+	rows        [][]interface{}
+}
+
+func (dc *staticDRMConfig) NewBulkInserter(queryCtx context.Context, dbEngine sqlengine.SQLEngine, insertCtx PreparedStatementCtx, batchSize int) (BulkInserter, error) {
+	if insertCtx == nil {
+		return nil, fmt.Errorf("cannot bulk insert on nil PreparedStatementContext")
+	}
+	tableNames := insertCtx.GetTableNames()
+	if len(tableNames) != 1 {
+		return nil, fmt.Errorf("cannot bulk insert into %d tables", len(tableNames))
+	}
+	qualifiedTableName, err := dc.sqlSystem.GetFullyQualifiedTableName(tableNames[0])
+	if err != nil {
+		return nil, err
+	}
+	columnNames := insertCtx.GetInsertColumnNames()
+	txn, err := dbEngine.BeginBulkInsert(queryCtx, qualifiedTableName, columnNames)
+	if err != nil {
+		return nil, err
+	}
+	return &standardBulkInserter{
+		drmCfg:      dc,
+		insertCtx:   insertCtx,
+		txn:         txn,
+		columnCount: len(columnNames),
+		batchSize:   batchSize,
+	}, nil
+}
+
+func (bi *standardBulkInserter) Add(payload map[string]interface{}, requestEncoding string) error {
+	stmtArgs, err := bi.drmCfg.generateVarArgs(NewPreparedStatementParameterized(bi.insertCtx, payload, true).WithRequestEncoding(requestEncoding), true)
+	if err != nil {
+		return err
+	}
+	// a payload lacking all non control columns yields a short row,
+	// which a single row insert would equally reject
+	row := stmtArgs.GetArgs()
+	if len(row) != bi.columnCount {
+		return fmt.Errorf("cannot bulk insert: %d values generated for %d columns", len(row), bi.columnCount)
+	}
+	bi.rows = append(bi.rows, row)
+	if bi.batchSize > 0 && len(bi.rows) >= bi.batchSize {
+		return bi.flush()
+	}
+	return nil
+}
+
+func (bi *standardBulkInserter) flush() error {
+	if len(bi.rows) == 0 {
+		return nil
+	}
+	err := bi.txn.InsertBatch(bi.rows)
+	bi.rows = nil
+	return err
+}
+
+func (bi *standardBulkInserter) Commit() error {
+	err := bi.flush()
+	if err != nil {
+		bi.txn.Rollback()
+		return err
+	}
+	return bi.txn.Commit()
+}
+
+func (bi *standardBulkInserter) Rollback() error {
+	bi.rows = nil
+	return bi.txn.Rollback()
+}
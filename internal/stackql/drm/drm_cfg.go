@@ -1,6 +1,7 @@
 package drm
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
@@ -26,6 +27,8 @@ import (
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 )
 
+const subsystem logging.Subsystem = "drm"
+
 var (
 	_ DRMConfig = &staticDRMConfig{}
 )
@@ -34,8 +37,8 @@ type DRMConfig interface {
 	ColumnsToRelationalColumns(cols []internaldto.ColumnMetadata) []relationaldto.RelationalColumn
 	ColumnToRelationalColumn(cols internaldto.ColumnMetadata) relationaldto.RelationalColumn
 	ExtractFromGolangValue(interface{}) interface{}
-	ExtractObjectFromSQLRows(r *sql.Rows, nonControlColumns []internaldto.ColumnMetadata, stream streaming.MapStream) (map[string]map[string]interface{}, map[int]map[int]interface{})
-	GetCurrentTable(internaldto.HeirarchyIdentifiers) (internaldto.DBTable, error)
+	ExtractObjectFromSQLRows(ctx context.Context, r *sql.Rows, nonControlColumns []internaldto.ColumnMetadata, stream streaming.MapStream) (map[string]map[string]interface{}, map[int]map[int]interface{})
+	GetCurrentTable(context.Context, internaldto.HeirarchyIdentifiers) (internaldto.DBTable, error)
 	GetRelationalType(string) string
 	GenerateDDL(util.AnnotatedTabulation, *openapistackql.OperationStore, int, bool) ([]string, error)
 	GetControlAttributes() sqlcontrol.ControlAttributes
@@ -45,12 +48,14 @@ type DRMConfig interface {
 	GetParserTableName(internaldto.HeirarchyIdentifiers, int) sqlparser.TableName
 	GetSQLSystem() sql_system.SQLSystem
 	GetTable(internaldto.HeirarchyIdentifiers, int) (internaldto.DBTable, error)
-	GenerateInsertDML(util.AnnotatedTabulation, *openapistackql.OperationStore, internaldto.TxnControlCounters) (PreparedStatementCtx, error)
-	GenerateSelectDML(util.AnnotatedTabulation, internaldto.TxnControlCounters, string, string) (PreparedStatementCtx, error)
+	GenerateInsertDML(context.Context, util.AnnotatedTabulation, *openapistackql.OperationStore, internaldto.TxnControlCounters) (PreparedStatementCtx, error)
+	GenerateSelectDML(context.Context, util.AnnotatedTabulation, internaldto.TxnControlCounters, string, string) (PreparedStatementCtx, error)
 	ExecuteInsertDML(sqlengine.SQLEngine, PreparedStatementCtx, map[string]interface{}, string) (sql.Result, error)
+	ExecuteInsertDMLContext(context.Context, sqlengine.SQLEngine, PreparedStatementCtx, map[string]interface{}, string) (sql.Result, error)
+	NewBulkInserter(context.Context, sqlengine.SQLEngine, PreparedStatementCtx, int) (BulkInserter, error)
 	OpenapiColumnsToRelationalColumns(cols []openapistackql.ColumnDescriptor) []relationaldto.RelationalColumn
 	OpenapiColumnsToRelationalColumn(col openapistackql.ColumnDescriptor) relationaldto.RelationalColumn
-	QueryDML(sqlmachinery.Querier, PreparedStatementParameterized) (*sql.Rows, error)
+	QueryDML(context.Context, sqlmachinery.Querier, PreparedStatementParameterized) (*sql.Rows, error)
 }
 
 type staticDRMConfig struct {
@@ -152,11 +157,11 @@ func (dc *staticDRMConfig) GetGolangSlices(nonControlColumns []internaldto.Colum
 	return dc.getGolangSlices(nonControlColumns)
 }
 
-func (dc *staticDRMConfig) ExtractObjectFromSQLRows(r *sql.Rows, nonControlColumns []internaldto.ColumnMetadata, stream streaming.MapStream) (map[string]map[string]interface{}, map[int]map[int]interface{}) {
-	return dc.extractObjectFromSQLRows(r, nonControlColumns, stream)
+func (dc *staticDRMConfig) ExtractObjectFromSQLRows(ctx context.Context, r *sql.Rows, nonControlColumns []internaldto.ColumnMetadata, stream streaming.MapStream) (map[string]map[string]interface{}, map[int]map[int]interface{}) {
+	return dc.extractObjectFromSQLRows(ctx, r, nonControlColumns, stream)
 }
 
-func (dc *staticDRMConfig) extractObjectFromSQLRows(r *sql.Rows, nonControlColumns []internaldto.ColumnMetadata, stream streaming.MapStream) (map[string]map[string]interface{}, map[int]map[int]interface{}) {
+func (dc *staticDRMConfig) extractObjectFromSQLRows(ctx context.Context, r *sql.Rows, nonControlColumns []internaldto.ColumnMetadata, stream streaming.MapStream) (map[string]map[string]interface{}, map[int]map[int]interface{}) {
 	if r != nil {
 		defer r.Close()
 	}
@@ -169,10 +174,10 @@ func (dc *staticDRMConfig) extractObjectFromSQLRows(r *sql.Rows, nonControlColum
 		for r.Next() {
 			errScan := r.Scan(ifArr...)
 			if errScan != nil {
-				logging.GetLogger().Infoln(fmt.Sprintf("%v", errScan))
+				subsystem.GetContextLogger(ctx).Infoln(fmt.Sprintf("%v", errScan))
 			}
 			for ord, val := range ifArr {
-				logging.GetLogger().Infoln(fmt.Sprintf("col #%d '%s':  %v  type: %T", ord, nonControlColumns[ord].GetName(), val, val))
+				subsystem.GetContextLogger(ctx).Infoln(fmt.Sprintf("col #%d '%s':  %v  type: %T", ord, nonControlColumns[ord].GetName(), val, val))
 			}
 			im := make(map[string]interface{})
 			imRaw := make(map[int]interface{})
@@ -191,7 +196,7 @@ func (dc *staticDRMConfig) extractObjectFromSQLRows(r *sql.Rows, nonControlColum
 
 		for ord := range ks {
 			val := altKeys[strconv.Itoa(ord)]
-			logging.GetLogger().Infoln(fmt.Sprintf("row #%d:  %v  type: %T", ord, val, val))
+			subsystem.GetContextLogger(ctx).Infoln(fmt.Sprintf("row #%d:  %v  type: %T", ord, val, val))
 		}
 	}
 	return altKeys, rawRows
@@ -249,7 +254,7 @@ func (dc *staticDRMConfig) GetGolangKind(discoType string) reflect.Kind {
 	return dc.sqlSystem.GetGolangKind(discoType)
 }
 
-func (dc *staticDRMConfig) GetCurrentTable(tableHeirarchyIDs internaldto.HeirarchyIdentifiers) (internaldto.DBTable, error) {
+func (dc *staticDRMConfig) GetCurrentTable(ctx context.Context, tableHeirarchyIDs internaldto.HeirarchyIdentifiers) (internaldto.DBTable, error) {
 	tn := tableHeirarchyIDs.GetTableName()
 	if dc.namespaceCollection.GetAnalyticsCacheTableNamespaceConfigurator().IsAllowed(tn) {
 		templatedName, err := dc.namespaceCollection.GetAnalyticsCacheTableNamespaceConfigurator().RenderTemplate(tn)
@@ -258,7 +263,7 @@ func (dc *staticDRMConfig) GetCurrentTable(tableHeirarchyIDs internaldto.Heirarc
 		}
 		return internaldto.NewDBTableAnalytics(templatedName, -1, tableHeirarchyIDs), nil
 	}
-	return dc.sqlSystem.GetCurrentTable(tableHeirarchyIDs)
+	return dc.sqlSystem.GetCurrentTable(ctx, tableHeirarchyIDs)
 }
 
 func (dc *staticDRMConfig) GetTableName(hIds internaldto.HeirarchyIdentifiers, discoveryGenerationID int) (string, error) {
@@ -373,21 +378,21 @@ func (dc *staticDRMConfig) GenerateDDL(tabAnn util.AnnotatedTabulation, m *opena
 	return dc.sqlSystem.GenerateDDL(relationalTable, dropTable)
 }
 
-func (dc *staticDRMConfig) GenerateInsertDML(tabAnnotated util.AnnotatedTabulation, method *openapistackql.OperationStore, tcc internaldto.TxnControlCounters) (PreparedStatementCtx, error) {
+func (dc *staticDRMConfig) GenerateInsertDML(ctx context.Context, tabAnnotated util.AnnotatedTabulation, method *openapistackql.OperationStore, tcc internaldto.TxnControlCounters) (PreparedStatementCtx, error) {
 	var columns []internaldto.ColumnMetadata
 	_, isSQLDataSource := tabAnnotated.GetSQLDataSource()
 	var tableName string
 	var discoverID int
 	var err error
 	if isSQLDataSource {
-		tableObj, err := dc.GetCurrentTable(tabAnnotated.GetHeirarchyIdentifiers())
+		tableObj, err := dc.GetCurrentTable(ctx, tabAnnotated.GetHeirarchyIdentifiers())
 		tableName = tableObj.GetName()
 		discoverID = tableObj.GetDiscoveryID()
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		tableObj, err := dc.GetCurrentTable(tabAnnotated.GetHeirarchyIdentifiers())
+		tableObj, err := dc.GetCurrentTable(ctx, tabAnnotated.GetHeirarchyIdentifiers())
 		tableName = tableObj.GetName()
 		discoverID = tableObj.GetDiscoveryID()
 		if err != nil {
@@ -450,7 +455,7 @@ func (dc *staticDRMConfig) GenerateInsertDML(tabAnnotated util.AnnotatedTabulati
 		nil
 }
 
-func (dc *staticDRMConfig) GenerateSelectDML(tabAnnotated util.AnnotatedTabulation, txnCtrlCtrs internaldto.TxnControlCounters, selectSuffix, rewrittenWhere string) (PreparedStatementCtx, error) {
+func (dc *staticDRMConfig) GenerateSelectDML(ctx context.Context, tabAnnotated util.AnnotatedTabulation, txnCtrlCtrs internaldto.TxnControlCounters, selectSuffix, rewrittenWhere string) (PreparedStatementCtx, error) {
 	var quotedColNames []string
 	var columns []internaldto.ColumnMetadata
 
@@ -458,7 +463,7 @@ func (dc *staticDRMConfig) GenerateSelectDML(tabAnnotated util.AnnotatedTabulati
 	if tabAnnotated.GetAlias() != "" {
 		aliasStr = fmt.Sprintf(` AS "%s" `, tabAnnotated.GetAlias())
 	}
-	tn, err := dc.GetCurrentTable(tabAnnotated.GetHeirarchyIdentifiers())
+	tn, err := dc.GetCurrentTable(ctx, tabAnnotated.GetHeirarchyIdentifiers())
 	if err != nil {
 		return nil, err
 	}
@@ -535,6 +540,16 @@ func (dc *staticDRMConfig) generateControlVarArgs(cp PreparedStatementParameteri
 	return varArgs, nil
 }
 
+// Strictly typed dialects do not coerce non-string args to text on insert.
+func (dc *staticDRMConfig) isStrictlyTypedDialect() bool {
+	switch strings.ToLower(dc.sqlSystem.GetName()) {
+	case constants.SQLDialectPostgres, constants.SQLDialectDuckDB:
+		return true
+	default:
+		return false
+	}
+}
+
 func (dc *staticDRMConfig) generateVarArgs(cp PreparedStatementParameterized, isInsert bool) (PreparedStatementArgs, error) {
 	retVal := NewPreparedStatementArgs(cp.GetCtx().GetQuery())
 	for i, child := range cp.GetChildren() {
@@ -563,7 +578,7 @@ func (dc *staticDRMConfig) generateVarArgs(cp PreparedStatementParameterized, is
 			case string:
 				varArgs = append(varArgs, va)
 			default:
-				if strings.ToLower(col.GetRelationalType()) == "text" && strings.ToLower(dc.sqlSystem.GetName()) == constants.SQLDialectPostgres {
+				if strings.ToLower(col.GetRelationalType()) == "text" && dc.isStrictlyTypedDialect() {
 					varArgs = append(varArgs, fmt.Sprintf("%v", va))
 					continue
 				}
@@ -576,6 +591,10 @@ func (dc *staticDRMConfig) generateVarArgs(cp PreparedStatementParameterized, is
 }
 
 func (dc *staticDRMConfig) ExecuteInsertDML(dbEngine sqlengine.SQLEngine, ctx PreparedStatementCtx, payload map[string]interface{}, requestEncoding string) (sql.Result, error) {
+	return dc.ExecuteInsertDMLContext(context.Background(), dbEngine, ctx, payload, requestEncoding)
+}
+
+func (dc *staticDRMConfig) ExecuteInsertDMLContext(queryCtx context.Context, dbEngine sqlengine.SQLEngine, ctx PreparedStatementCtx, payload map[string]interface{}, requestEncoding string) (sql.Result, error) {
 	if ctx == nil {
 		return nil, fmt.Errorf("cannot execute on nil PreparedStatementContext")
 	}
@@ -583,10 +602,10 @@ func (dc *staticDRMConfig) ExecuteInsertDML(dbEngine sqlengine.SQLEngine, ctx Pr
 	if err != nil {
 		return nil, err
 	}
-	return dbEngine.Exec(stmtArgs.GetQuery(), stmtArgs.GetArgs()...)
+	return dbEngine.ExecContext(queryCtx, stmtArgs.GetQuery(), stmtArgs.GetArgs()...)
 }
 
-func (dc *staticDRMConfig) QueryDML(querier sqlmachinery.Querier, ctxParameterized PreparedStatementParameterized) (*sql.Rows, error) {
+func (dc *staticDRMConfig) QueryDML(ctx context.Context, querier sqlmachinery.Querier, ctxParameterized PreparedStatementParameterized) (*sql.Rows, error) {
 	if ctxParameterized.GetCtx() == nil {
 		return nil, fmt.Errorf("cannot execute based upon nil PreparedStatementContext")
 	}
@@ -600,7 +619,7 @@ func (dc *staticDRMConfig) QueryDML(querier sqlmachinery.Querier, ctxParameteriz
 	}
 	query := rootArgs.GetExpandedQuery()
 	varArgs := rootArgs.GetExpandedArgs()
-	logging.GetLogger().Infoln(fmt.Sprintf("query = %s", query))
+	subsystem.GetContextLogger(ctx).Infoln(fmt.Sprintf("query = %s", query))
 	return querier.Query(query, varArgs...)
 }
 
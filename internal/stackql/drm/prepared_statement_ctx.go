@@ -12,12 +12,16 @@ type PreparedStatementCtx interface {
 	GetAllCtrlCtrs() []internaldto.TxnControlCounters
 	GetGCCtrlCtrs() internaldto.TxnControlCounters
 	GetIndirectContexts() []PreparedStatementCtx
+	GetInsertColumnNames() []string
 	GetNonControlColumns() []internaldto.ColumnMetadata
 	GetGCHousekeepingQueries() string
 	GetQuery() string
+	GetTableNames() []string
 	SetGCCtrlCtrs(tcc internaldto.TxnControlCounters)
 	SetIndirectContexts(indirectContexts []PreparedStatementCtx)
 	SetKind(kind string)
+	SetNonControlColumns(nonControlColumns []internaldto.ColumnMetadata)
+	SetQuery(query string)
 }
 
 type standardPreparedStatementCtx struct {
@@ -54,6 +58,10 @@ func (ps *standardPreparedStatementCtx) GetQuery() string {
 	return ps.query
 }
 
+func (ps *standardPreparedStatementCtx) SetQuery(query string) {
+	ps.query = query
+}
+
 func (ps *standardPreparedStatementCtx) GetGCCtrlCtrs() internaldto.TxnControlCounters {
 	return ps.txnCtrlCtrs
 }
@@ -66,6 +74,30 @@ func (ps *standardPreparedStatementCtx) GetNonControlColumns() []internaldto.Col
 	return ps.nonControlColumns
 }
 
+func (ps *standardPreparedStatementCtx) SetNonControlColumns(nonControlColumns []internaldto.ColumnMetadata) {
+	ps.nonControlColumns = nonControlColumns
+}
+
+func (ps *standardPreparedStatementCtx) GetTableNames() []string {
+	return ps.TableNames
+}
+
+// GetInsertColumnNames returns column names in the
+// same order as insert args, ie control columns first.
+func (ps *standardPreparedStatementCtx) GetInsertColumnNames() []string {
+	rv := []string{
+		ps.genIdControlColName,
+		ps.sessionIdControlColName,
+		ps.txnIdControlColName,
+		ps.insIdControlColName,
+		ps.insEncodedColName,
+	}
+	for _, col := range ps.nonControlColumns {
+		rv = append(rv, col.GetName())
+	}
+	return rv
+}
+
 func (ps *standardPreparedStatementCtx) GetAllCtrlCtrs() []internaldto.TxnControlCounters {
 	var rv []internaldto.TxnControlCounters
 	rv = append(rv, ps.txnCtrlCtrs)
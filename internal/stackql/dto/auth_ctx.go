@@ -17,7 +17,20 @@ type AuthCtx struct {
 	KeyIDEnvVar string         `json:"keyIDenvvar" yaml:"keyIDenvvar"`
 	KeyFilePath string         `json:"credentialsfilepath" yaml:"credentialsfilepath"`
 	KeyEnvVar   string         `json:"credentialsenvvar" yaml:"credentialsenvvar"`
+	ReadOnly    bool           `json:"readonly" yaml:"readonly"`
 	Active      bool           `json:"-" yaml:"-"`
+	status      *AuthStatus
+}
+
+// GetStatus returns the auth status shared by
+// this context and all of its clones.
+func (ac *AuthCtx) GetStatus() *AuthStatus {
+	authStatusMutex.Lock()
+	defer authStatusMutex.Unlock()
+	if ac.status == nil {
+		ac.status = &AuthStatus{}
+	}
+	return ac.status
 }
 
 func (ac *AuthCtx) GetSQLCfg() (SQLBackendCfg, bool) {
@@ -40,11 +53,22 @@ func (ac *AuthCtx) Clone() *AuthCtx {
 		KeyIDEnvVar: ac.KeyIDEnvVar,
 		KeyFilePath: ac.KeyFilePath,
 		KeyEnvVar:   ac.KeyEnvVar,
+		ReadOnly:    ac.ReadOnly,
 		Active:      ac.Active,
+		status:      ac.GetStatus(),
 	}
 	return rv
 }
 
+// CloneDetached returns a clone having a copy of the auth status,
+// such that attempts to authenticate with candidate credentials
+// do not alter the status of those in use.
+func (ac *AuthCtx) CloneDetached() *AuthCtx {
+	rv := ac.Clone()
+	rv.status = ac.GetStatus().Clone()
+	return rv
+}
+
 func (ac *AuthCtx) HasKey() bool {
 	if ac.KeyFilePath != "" || ac.KeyEnvVar != "" {
 		return true
@@ -0,0 +1,169 @@
+package dto
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	authStatusMutex sync.Mutex
+)
+
+// AuthStatus records the outcome of the most recent
+// authentication attempt for a provider.
+// It is shared between clones of an AuthCtx, so that
+// per-request authentication is reflected in SHOW AUTH.
+type AuthStatus struct {
+	mutex     sync.RWMutex
+	principal string
+	expiry    time.Time
+	validated bool
+	revoked   bool
+	lastErr   error
+}
+
+func (as *AuthStatus) SetValidated(principal string) {
+	as.mutex.Lock()
+	defer as.mutex.Unlock()
+	as.validated = true
+	as.revoked = false
+	as.lastErr = nil
+	if principal != "" {
+		as.principal = principal
+	}
+}
+
+func (as *AuthStatus) SetExpiry(expiry time.Time) {
+	as.mutex.Lock()
+	defer as.mutex.Unlock()
+	as.expiry = expiry
+}
+
+func (as *AuthStatus) SetFailed(err error) {
+	as.mutex.Lock()
+	defer as.mutex.Unlock()
+	as.validated = false
+	as.lastErr = err
+}
+
+func (as *AuthStatus) SetRevoked() {
+	as.mutex.Lock()
+	defer as.mutex.Unlock()
+	as.validated = false
+	as.revoked = true
+	as.principal = ""
+	as.expiry = time.Time{}
+}
+
+// Clone returns a copy of the status that is not shared.
+func (as *AuthStatus) Clone() *AuthStatus {
+	as.mutex.RLock()
+	defer as.mutex.RUnlock()
+	return &AuthStatus{
+		principal: as.principal,
+		expiry:    as.expiry,
+		validated: as.validated,
+		revoked:   as.revoked,
+		lastErr:   as.lastErr,
+	}
+}
+
+// Assign overwrites the status with that of another.
+func (as *AuthStatus) Assign(other *AuthStatus) {
+	rhs := other.Clone()
+	as.mutex.Lock()
+	defer as.mutex.Unlock()
+	as.principal = rhs.principal
+	as.expiry = rhs.expiry
+	as.validated = rhs.validated
+	as.revoked = rhs.revoked
+	as.lastErr = rhs.lastErr
+}
+
+func (as *AuthStatus) GetPrincipal() string {
+	as.mutex.RLock()
+	defer as.mutex.RUnlock()
+	return as.principal
+}
+
+func (as *AuthStatus) GetExpiry() (time.Time, bool) {
+	as.mutex.RLock()
+	defer as.mutex.RUnlock()
+	return as.expiry, !as.expiry.IsZero()
+}
+
+func (as *AuthStatus) IsValidated() bool {
+	as.mutex.RLock()
+	defer as.mutex.RUnlock()
+	return as.validated
+}
+
+func (as *AuthStatus) IsRevoked() bool {
+	as.mutex.RLock()
+	defer as.mutex.RUnlock()
+	return as.revoked
+}
+
+func (as *AuthStatus) GetLastError() error {
+	as.mutex.RLock()
+	defer as.mutex.RUnlock()
+	return as.lastErr
+}
+
+// AuthMetadata is a single row of SHOW AUTH output.
+type AuthMetadata struct {
+	Provider  string
+	Type      string
+	Principal string
+	Source    string
+	Expiry    string
+	Scopes    []string
+	Validated bool
+}
+
+func NewAuthMetadata(providerName string, authType string, principal string, source string, authCtx *AuthCtx) *AuthMetadata {
+	rv := &AuthMetadata{
+		Provider:  providerName,
+		Type:      strings.ToUpper(authType),
+		Principal: principal,
+		Source:    source,
+	}
+	if authCtx == nil {
+		return rv
+	}
+	rv.Scopes = append(rv.Scopes, authCtx.Scopes...)
+	status := authCtx.GetStatus()
+	if rv.Principal == "" {
+		rv.Principal = status.GetPrincipal()
+	}
+	if expiry, ok := status.GetExpiry(); ok {
+		rv.Expiry = expiry.UTC().Format(time.RFC3339)
+	}
+	rv.Validated = status.IsValidated()
+	return rv
+}
+
+func (am *AuthMetadata) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		"provider":  am.Provider,
+		"type":      am.Type,
+		"principal": am.Principal,
+		"source":    am.Source,
+		"expiry":    am.Expiry,
+		"scopes":    strings.Join(am.Scopes, ","),
+		"validated": am.Validated,
+	}
+}
+
+func (am *AuthMetadata) GetHeaders() []string {
+	return []string{
+		"provider",
+		"type",
+		"principal",
+		"source",
+		"expiry",
+		"scopes",
+		"validated",
+	}
+}
@@ -0,0 +1,161 @@
+package dto_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stackql/stackql/internal/stackql/dto"
+)
+
+func TestAuthStatusTransitions(t *testing.T) {
+	expiry := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name          string
+		apply         func(*dto.AuthStatus)
+		wantPrincipal string
+		wantValidated bool
+		wantRevoked   bool
+		wantErr       bool
+		wantExpiry    bool
+	}{
+		{
+			name:  "zero value",
+			apply: func(as *dto.AuthStatus) {},
+		},
+		{
+			name: "validated",
+			apply: func(as *dto.AuthStatus) {
+				as.SetValidated("alice@example.com")
+				as.SetExpiry(expiry)
+			},
+			wantPrincipal: "alice@example.com",
+			wantValidated: true,
+			wantExpiry:    true,
+		},
+		{
+			name: "validated without principal keeps earlier principal",
+			apply: func(as *dto.AuthStatus) {
+				as.SetValidated("alice@example.com")
+				as.SetValidated("")
+			},
+			wantPrincipal: "alice@example.com",
+			wantValidated: true,
+		},
+		{
+			name: "failed after validation",
+			apply: func(as *dto.AuthStatus) {
+				as.SetValidated("alice@example.com")
+				as.SetFailed(fmt.Errorf("token expired"))
+			},
+			wantPrincipal: "alice@example.com",
+			wantErr:       true,
+		},
+		{
+			name: "revoked clears principal and expiry",
+			apply: func(as *dto.AuthStatus) {
+				as.SetValidated("alice@example.com")
+				as.SetExpiry(expiry)
+				as.SetRevoked()
+			},
+			wantRevoked: true,
+		},
+		{
+			name: "validated after revocation",
+			apply: func(as *dto.AuthStatus) {
+				as.SetRevoked()
+				as.SetFailed(fmt.Errorf("no credentials"))
+				as.SetValidated("bob@example.com")
+			},
+			wantPrincipal: "bob@example.com",
+			wantValidated: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			as := &dto.AuthStatus{}
+			tc.apply(as)
+			if got := as.GetPrincipal(); got != tc.wantPrincipal {
+				t.Fatalf("principal = '%s', want '%s'", got, tc.wantPrincipal)
+			}
+			if got := as.IsValidated(); got != tc.wantValidated {
+				t.Fatalf("validated = %t, want %t", got, tc.wantValidated)
+			}
+			if got := as.IsRevoked(); got != tc.wantRevoked {
+				t.Fatalf("revoked = %t, want %t", got, tc.wantRevoked)
+			}
+			if got := as.GetLastError() != nil; got != tc.wantErr {
+				t.Fatalf("has error = %t, want %t", got, tc.wantErr)
+			}
+			if _, got := as.GetExpiry(); got != tc.wantExpiry {
+				t.Fatalf("has expiry = %t, want %t", got, tc.wantExpiry)
+			}
+		})
+	}
+}
+
+func TestNewAuthMetadata(t *testing.T) {
+	validated := &dto.AuthCtx{Scopes: []string{"a", "b"}}
+	validated.GetStatus().SetValidated("sa@example.com")
+	validated.GetStatus().SetExpiry(time.Date(2023, 3, 1, 12, 0, 0, 0, time.FixedZone("AEDT", 11*60*60)))
+	testCases := []struct {
+		name      string
+		principal string
+		authCtx   *dto.AuthCtx
+		want      map[string]interface{}
+	}{
+		{
+			name: "no auth context",
+			want: map[string]interface{}{
+				"provider":  "okta",
+				"type":      "API_KEY",
+				"principal": "",
+				"source":    "env",
+				"expiry":    "",
+				"scopes":    "",
+				"validated": false,
+			},
+		},
+		{
+			name:    "principal and expiry from status",
+			authCtx: validated,
+			want: map[string]interface{}{
+				"provider":  "okta",
+				"type":      "API_KEY",
+				"principal": "sa@example.com",
+				"source":    "env",
+				"expiry":    "2023-03-01T01:00:00Z",
+				"scopes":    "a,b",
+				"validated": true,
+			},
+		},
+		{
+			name:      "explicit principal wins",
+			principal: "explicit",
+			authCtx:   validated,
+			want: map[string]interface{}{
+				"provider":  "okta",
+				"type":      "API_KEY",
+				"principal": "explicit",
+				"source":    "env",
+				"expiry":    "2023-03-01T01:00:00Z",
+				"scopes":    "a,b",
+				"validated": true,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			am := dto.NewAuthMetadata("okta", "api_key", tc.principal, "env", tc.authCtx)
+			got := am.ToMap()
+			if len(got) != len(am.GetHeaders()) {
+				t.Fatalf("map has %d keys, headers %d", len(got), len(am.GetHeaders()))
+			}
+			for _, k := range am.GetHeaders() {
+				if got[k] != tc.want[k] {
+					t.Fatalf("%s = '%v', want '%v'", k, got[k], tc.want[k])
+				}
+			}
+		})
+	}
+}
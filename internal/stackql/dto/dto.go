@@ -16,8 +16,15 @@ const (
 	DefaultWindowsColorScheme       string = NullColorScheme
 	DryRunFlagKey                   string = "dryrun"
 	ExecutionConcurrencyLimitKey    string = "execution.concurrency.limit"
+	ExportDSNKey                    string = "export-dsn"
+	ExportKeyKey                    string = "export-key"
+	ExportModeKey                   string = "export-mode"
+	ExportTableKey                  string = "export-table"
 	AuthCtxKey                      string = "auth"
+	BulkInsertBatchSizeKey          string = "bulkinsert.batchsize"
 	APIRequestTimeoutKey            string = "apirequesttimeout"
+	AuditFileKey                    string = "audit.file"
+	AuditTableKey                   string = "audit.table"
 	CacheKeyCountKey                string = "cachekeycount"
 	CacheTTLKey                     string = "metadatattl"
 	ColorSchemeKey                  string = "colorscheme"
@@ -26,6 +33,7 @@ const (
 	CSVHeadersDisableKey            string = "hideheaders"
 	DelimiterKey                    string = "delimiter"
 	ErrorPresentationKey            string = "errorpresentation"
+	HistoryTablesKey                string = "history.tables"
 	HTTPLogEnabledKey               string = "http.log.enabled"
 	HTTPMaxResultsKey               string = "http.response.maxResults"
 	HTTPPAgeLimitKey                string = "http.response.pageLimit"
@@ -37,7 +45,17 @@ const (
 	CABundleKey                     string = "tls.CABundle"
 	AllowInsecureKey                string = "tls.allowInsecure"
 	InfilePathKey                   string = "infile"
+	JSONFlattenKey                  string = "json.flatten"
+	JSONFlattenArraysKey            string = "json.flattenArrays"
+	JSONFlattenDepthKey             string = "json.flattenDepth"
+	JSONFlattenSeparatorKey         string = "json.flattenSeparator"
+	LogFormatKey                    string = "log.format"
+	LogLevelsKey                    string = "log.levels"
 	LogLevelStrKey                  string = "loglevel"
+	MaxHTTPRequestsPerQueryKey      string = "max_http_requests_per_query"
+	MaxRowsAcquiredKey              string = "max_rows_acquired"
+	MetricsAddressKey               string = "metrics.address"
+	MetricsPortKey                  string = "metrics.port"
 	OutfilePathKey                  string = "outfile"
 	OutputFormatKey                 string = "output"
 	ApplicationFilesRootPathKey     string = "approot"
@@ -48,11 +66,22 @@ const (
 	PgSrvRawTLSCfgKey               string = "pgsrv.tls"
 	ProviderStrKey                  string = "provider"
 	QueryCacheSizeKey               string = "querycachesize"
+	ReadOnlyKey                     string = "readonly"
+	RedactFieldsKey                 string = "redact.fields"
+	RedactHeadersKey                string = "redact.headers"
+	RedactQueryKeysKey              string = "redact.queryKeys"
 	RegistryRawKey                  string = "registry"
+	TracingEndpointKey              string = "tracing.endpoint"
+	TracingExporterKey              string = "tracing.exporter"
+	TracingFileKey                  string = "tracing.file"
+	SafetyConfirmAffectedRowsKey    string = "safety.confirmAffectedRows"
+	SafetyDeleteRequiresKeyKey      string = "safety.deleteRequiresKey"
+	SafetyMaxAffectedRowsKey        string = "safety.maxAffectedRows"
 	GCCfgRawKey                     string = "gc"
 	NamespaceCfgRawKey              string = "namespaces"
 	SQLBackendCfgRawKey             string = "sqlBackend"
 	DBInternalCfgRawKey             string = "dbInternal"
+	StatementTimeoutKey             string = "statement_timeout"
 	StoreTxnCfgRawKey               string = "store.txn"
 	TemplateCtxFilePathKey          string = "iqldata"
 	TestWithoutApiCallsKey          string = "testwithoutapicalls"
@@ -4,7 +4,10 @@ import "strconv"
 
 type RuntimeCtx struct {
 	APIRequestTimeout            int
+	AuditFile                    string
+	AuditTable                   string
 	AuthRaw                      string
+	BulkInsertBatchSize          int
 	CABundle                     string
 	AllowInsecure                bool
 	CacheKeyCount                int
@@ -17,6 +20,11 @@ type RuntimeCtx struct {
 	DryRunFlag                   bool
 	ErrorPresentation            string
 	ExecutionConcurrencyLimit    int
+	ExportDSN                    string
+	ExportKey                    string
+	ExportMode                   string
+	ExportTable                  string
+	HistoryTables                string
 	HTTPLogEnabled               bool
 	HTTPMaxResults               int
 	HTTPPageLimit                int
@@ -26,7 +34,17 @@ type RuntimeCtx struct {
 	HTTPProxyScheme              string
 	HTTPProxyUser                string
 	InfilePath                   string
+	JSONFlatten                  string
+	JSONFlattenArrays            bool
+	JSONFlattenDepth             int
+	JSONFlattenSeparator         string
+	LogFormat                    string
+	LogLevels                    string
 	LogLevelStr                  string
+	MaxHTTPRequestsPerQuery      int
+	MaxRowsAcquired              int
+	MetricsAddress               string
+	MetricsPort                  int
 	OutfilePath                  string
 	OutputFormat                 string
 	ApplicationFilesRootPath     string
@@ -36,8 +54,19 @@ type RuntimeCtx struct {
 	PGSrvPort                    int
 	PGSrvRawTLSCfg               string
 	ProviderStr                  string
+	ReadOnly                     bool
+	RedactFields                 string
+	RedactHeaders                string
+	RedactQueryKeys              string
 	RegistryRaw                  string
+	SafetyConfirmAffectedRows    int
+	SafetyDeleteRequiresKey      bool
+	SafetyMaxAffectedRows        int
 	SQLBackendCfgRaw             string
+	StatementTimeout             int
+	TracingEndpoint              string
+	TracingExporter              string
+	TracingFile                  string
 	DBInternalCfgRaw             string
 	NamespaceCfgRaw              string
 	StoreTxnCfgRaw               string
@@ -80,6 +109,10 @@ func (rc *RuntimeCtx) Set(key string, val string) error {
 	switch key {
 	case APIRequestTimeoutKey:
 		retVal = setInt(&rc.APIRequestTimeout, val)
+	case AuditFileKey:
+		rc.AuditFile = val
+	case AuditTableKey:
+		rc.AuditTable = val
 	case AuthCtxKey:
 		rc.AuthRaw = val
 	case CABundleKey:
@@ -94,6 +127,8 @@ func (rc *RuntimeCtx) Set(key string, val string) error {
 		rc.ConfigFilePath = val
 	case CPUProfileKey:
 		rc.CPUProfile = val
+	case BulkInsertBatchSizeKey:
+		retVal = setInt(&rc.BulkInsertBatchSize, val)
 	case CSVHeadersDisableKey:
 		retVal = setBool(&rc.CSVHeadersDisable, val)
 	case SQLBackendCfgRawKey:
@@ -108,6 +143,16 @@ func (rc *RuntimeCtx) Set(key string, val string) error {
 		rc.ErrorPresentation = val
 	case ExecutionConcurrencyLimitKey:
 		retVal = setInt(&rc.ExecutionConcurrencyLimit, val)
+	case ExportDSNKey:
+		rc.ExportDSN = val
+	case ExportKeyKey:
+		rc.ExportKey = val
+	case ExportModeKey:
+		rc.ExportMode = val
+	case ExportTableKey:
+		rc.ExportTable = val
+	case HistoryTablesKey:
+		rc.HistoryTables = val
 	case HTTPLogEnabledKey:
 		retVal = setBool(&rc.HTTPLogEnabled, val)
 	case HTTPMaxResultsKey:
@@ -126,10 +171,32 @@ func (rc *RuntimeCtx) Set(key string, val string) error {
 		rc.HTTPProxyUser = val
 	case InfilePathKey:
 		rc.InfilePath = val
+	case JSONFlattenKey:
+		rc.JSONFlatten = val
+	case JSONFlattenArraysKey:
+		retVal = setBool(&rc.JSONFlattenArrays, val)
+	case JSONFlattenDepthKey:
+		retVal = setInt(&rc.JSONFlattenDepth, val)
+	case JSONFlattenSeparatorKey:
+		rc.JSONFlattenSeparator = val
+	case LogFormatKey:
+		rc.LogFormat = val
+	case LogLevelsKey:
+		rc.LogLevels = val
 	case LogLevelStrKey:
 		rc.LogLevelStr = val
+	case MaxHTTPRequestsPerQueryKey:
+		retVal = setInt(&rc.MaxHTTPRequestsPerQuery, val)
+	case MaxRowsAcquiredKey:
+		retVal = setInt(&rc.MaxRowsAcquired, val)
+	case MetricsAddressKey:
+		rc.MetricsAddress = val
+	case MetricsPortKey:
+		retVal = setInt(&rc.MetricsPort, val)
 	case NamespaceCfgRawKey:
 		rc.NamespaceCfgRaw = val
+	case StatementTimeoutKey:
+		retVal = setInt(&rc.StatementTimeout, val)
 	case StoreTxnCfgRawKey:
 		rc.StoreTxnCfgRaw = val
 	case GCCfgRawKey:
@@ -152,12 +219,32 @@ func (rc *RuntimeCtx) Set(key string, val string) error {
 		rc.PGSrvRawTLSCfg = val
 	case QueryCacheSizeKey:
 		retVal = setInt(&rc.QueryCacheSize, val)
+	case ReadOnlyKey:
+		retVal = setBool(&rc.ReadOnly, val)
+	case RedactFieldsKey:
+		rc.RedactFields = val
+	case RedactHeadersKey:
+		rc.RedactHeaders = val
+	case RedactQueryKeysKey:
+		rc.RedactQueryKeys = val
 	case RegistryRawKey:
 		rc.RegistryRaw = val
+	case SafetyConfirmAffectedRowsKey:
+		retVal = setInt(&rc.SafetyConfirmAffectedRows, val)
+	case SafetyDeleteRequiresKeyKey:
+		retVal = setBool(&rc.SafetyDeleteRequiresKey, val)
+	case SafetyMaxAffectedRowsKey:
+		retVal = setInt(&rc.SafetyMaxAffectedRows, val)
 	case TemplateCtxFilePathKey:
 		rc.TemplateCtxFilePath = val
 	case TestWithoutApiCallsKey:
 		retVal = setBool(&rc.TestWithoutApiCalls, val)
+	case TracingEndpointKey:
+		rc.TracingEndpoint = val
+	case TracingExporterKey:
+		rc.TracingExporter = val
+	case TracingFileKey:
+		rc.TracingFile = val
 	case UseNonPreferredAPIsKEy:
 		retVal = setBool(&rc.UseNonPreferredAPIs, val)
 	case VerboseFlagKey:
@@ -17,15 +17,16 @@ type SQLBackendSchemata struct {
 }
 
 type SQLBackendCfg struct {
-	DbEngine              string             `json:"dbEngine" yaml:"dbEngine"`
-	DSN                   string             `json:"dsn" yaml:"dsn"`
-	DSNEnvVar             string             `json:"dsnEnvVar" yaml:"dsnEnvVar"`
-	Schemata              SQLBackendSchemata `json:"schemata" yaml:"schemata"`
-	DbInitFilePath        string             `json:"dbInitFilepath" yaml:"dbInitFilepath"`
-	SQLSystem             string             `json:"sqlDialect" yaml:"sqlDialect"`
-	SchemaType            string             `json:"schemaType" yaml:"schemaType"`
-	InitMaxRetries        int                `json:"initMaxRetries" yaml:"initMaxRetries"`
-	InitRetryInitialDelay int                `json:"initRetryInitialDelay" yaml:"initRetryInitialDelay"`
+	DbEngine               string             `json:"dbEngine" yaml:"dbEngine"`
+	DSN                    string             `json:"dsn" yaml:"dsn"`
+	DSNEnvVar              string             `json:"dsnEnvVar" yaml:"dsnEnvVar"`
+	Schemata               SQLBackendSchemata `json:"schemata" yaml:"schemata"`
+	DbInitFilePath         string             `json:"dbInitFilepath" yaml:"dbInitFilepath"`
+	SQLSystem              string             `json:"sqlDialect" yaml:"sqlDialect"`
+	SchemaType             string             `json:"schemaType" yaml:"schemaType"`
+	SchemaOverrideFilePath string             `json:"schemaOverrideFilepath" yaml:"schemaOverrideFilepath"`
+	InitMaxRetries         int                `json:"initMaxRetries" yaml:"initMaxRetries"`
+	InitRetryInitialDelay  int                `json:"initRetryInitialDelay" yaml:"initRetryInitialDelay"`
 }
 
 func (sqlCfg SQLBackendCfg) GetDSN() string {
@@ -40,6 +41,10 @@ func (sqlCfg SQLBackendCfg) GetSchemaType() string {
 	return sqlCfg.SchemaType
 }
 
+func (sqlCfg SQLBackendCfg) GetSchemaOverrideFilePath() string {
+	return sqlCfg.SchemaOverrideFilePath
+}
+
 func (sqlCfg SQLBackendCfg) GetDatabaseName() (string, error) {
 	dsn := sqlCfg.GetDSN()
 	if dsn == "" {
@@ -0,0 +1,247 @@
+package flatten
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/stackql/go-openapistackql/openapistackql"
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/dto"
+	"github.com/stackql/stackql/internal/stackql/parserutil"
+)
+
+const (
+	DirectiveName    string = "FLATTEN"
+	DefaultDepth     int    = 2
+	DefaultSeparator string = "_"
+	// ElementColumnName is the column of a child table
+	// holding each element, from which properties derive.
+	ElementColumnName string = "value"
+)
+
+// Config specifies how nested objects are flattened.
+type Config interface {
+	// GetDepth returns the maximum number of
+	// nested levels expanded into columns.
+	GetDepth() int
+	// GetSeparator returns the string
+	// separating the names of nested properties.
+	GetSeparator() string
+	// IsUnnestArrays reports whether array columns
+	// are presented as child tables.
+	IsUnnestArrays() bool
+}
+
+// Column is a column derived from a nested property.
+type Column interface {
+	// GetName returns the flattened name, eg: "commit_sha".
+	GetName() string
+	// GetParentName returns the name of the top
+	// level column containing the property, eg: "commit".
+	GetParentName() string
+	// GetPath returns the JSON path of the property
+	// within the top level column, eg: "$.sha".
+	GetPath() string
+	// GetType returns the openapi type of the property.
+	GetType() string
+}
+
+// ChildTable is a table of the elements of an array column,
+// named "<resource><separator><column>", eg: "instances_disks".
+type ChildTable interface {
+	// GetName returns the name of the array column.
+	GetName() string
+	// GetColumns returns the flattened properties of
+	// object elements, in terms of the element value.
+	GetColumns() []Column
+}
+
+func NewConfig(depth int, separator string, isUnnestArrays bool) Config {
+	if depth <= 0 {
+		depth = DefaultDepth
+	}
+	if separator == "" {
+		separator = DefaultSeparator
+	}
+	return &standardConfig{
+		depth:          depth,
+		separator:      separator,
+		isUnnestArrays: isUnnestArrays,
+	}
+}
+
+type standardConfig struct {
+	depth          int
+	separator      string
+	isUnnestArrays bool
+}
+
+func (c *standardConfig) GetDepth() int {
+	return c.depth
+}
+
+func (c *standardConfig) GetSeparator() string {
+	return c.separator
+}
+
+func (c *standardConfig) IsUnnestArrays() bool {
+	return c.isUnnestArrays
+}
+
+// GetConfig returns the flattening config for the given table,
+// of the form "<provider>.<service>.<resource>".  The FLATTEN
+// directive enables flattening for all tables in the statement, eg:
+//
+//	/*+ FLATTEN(depth=3, separator=., arrays) */
+//
+// otherwise flattening is enabled for those tables matching
+// the runtime patterns.
+func GetConfig(directives sqlparser.CommentDirectives, runtimeCtx dto.RuntimeCtx, tableName string) (Config, bool) {
+	if directives.IsSet(DirectiveName) {
+		depth, hasDepth := parserutil.GetIntCommentDirective(directives, DirectiveName+".depth")
+		if !hasDepth {
+			depth = runtimeCtx.JSONFlattenDepth
+		}
+		separator, hasSeparator := directives[DirectiveName+".separator"].(string)
+		if !hasSeparator {
+			separator = runtimeCtx.JSONFlattenSeparator
+		}
+		isUnnestArrays, hasArrays := directives[DirectiveName+".arrays"].(bool)
+		if !hasArrays {
+			isUnnestArrays = runtimeCtx.JSONFlattenArrays
+		}
+		return NewConfig(depth, separator, isUnnestArrays), true
+	}
+	for _, p := range strings.Split(runtimeCtx.JSONFlatten, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if isMatch, _ := path.Match(p, tableName); isMatch {
+			return NewConfig(runtimeCtx.JSONFlattenDepth, runtimeCtx.JSONFlattenSeparator, runtimeCtx.JSONFlattenArrays), true
+		}
+	}
+	return nil, false
+}
+
+// GetColumns returns the flattened columns for those
+// columns of the tabulation that are objects with
+// properties, in order of flattened name.
+func GetColumns(tabulation *openapistackql.Tabulation, cfg Config) []Column {
+	var rv []Column
+	for _, col := range tabulation.GetColumns() {
+		rv = append(rv, getPropertyColumns(col.Name, col.Name, "$", col.Schema, cfg, 1)...)
+	}
+	sort.Slice(rv, func(i, j int) bool {
+		return rv[i].GetName() < rv[j].GetName()
+	})
+	return rv
+}
+
+// GetChildTables returns the child tables for those columns
+// of the tabulation that are arrays, if so configured.
+func GetChildTables(tabulation *openapistackql.Tabulation, cfg Config) []ChildTable {
+	if !cfg.IsUnnestArrays() {
+		return nil
+	}
+	var rv []ChildTable
+	for _, col := range tabulation.GetColumns() {
+		if col.Schema == nil || col.Schema.Schema == nil || col.Schema.Type != "array" {
+			continue
+		}
+		var columns []Column
+		if items, err := col.Schema.GetItems(); err == nil {
+			// Element properties are named as though
+			// they were properties of the child table.
+			columns = getPropertyColumns(ElementColumnName, "", "$", items, cfg, 1)
+		}
+		sort.Slice(columns, func(i, j int) bool {
+			return columns[i].GetName() < columns[j].GetName()
+		})
+		rv = append(rv, &standardChildTable{
+			name:    col.Name,
+			columns: columns,
+		})
+	}
+	return rv
+}
+
+func getPropertyColumns(
+	parentName string,
+	name string,
+	jsonPath string,
+	schema *openapistackql.Schema,
+	cfg Config,
+	level int,
+) []Column {
+	if !isFlattenable(schema) {
+		return nil
+	}
+	properties, err := schema.GetProperties()
+	if err != nil {
+		return nil
+	}
+	var rv []Column
+	for k, propertySchema := range properties {
+		propertyName := k
+		if name != "" {
+			propertyName = name + cfg.GetSeparator() + k
+		}
+		propertyPath := jsonPath + "." + k
+		if level < cfg.GetDepth() && isFlattenable(propertySchema) {
+			rv = append(rv, getPropertyColumns(parentName, propertyName, propertyPath, propertySchema, cfg, level+1)...)
+			continue
+		}
+		rv = append(rv, &standardColumn{
+			name:       propertyName,
+			parentName: parentName,
+			path:       propertyPath,
+			schemaType: propertySchema.Type,
+		})
+	}
+	return rv
+}
+
+func isFlattenable(schema *openapistackql.Schema) bool {
+	if schema == nil || schema.Schema == nil {
+		return false
+	}
+	return (schema.Type == "object" || schema.Type == "") && len(schema.Properties) > 0
+}
+
+type standardColumn struct {
+	name       string
+	parentName string
+	path       string
+	schemaType string
+}
+
+func (c *standardColumn) GetName() string {
+	return c.name
+}
+
+func (c *standardColumn) GetParentName() string {
+	return c.parentName
+}
+
+func (c *standardColumn) GetPath() string {
+	return c.path
+}
+
+func (c *standardColumn) GetType() string {
+	return c.schemaType
+}
+
+type standardChildTable struct {
+	name    string
+	columns []Column
+}
+
+func (t *standardChildTable) GetName() string {
+	return t.name
+}
+
+func (t *standardChildTable) GetColumns() []Column {
+	return t.columns
+}
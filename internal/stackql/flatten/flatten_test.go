@@ -0,0 +1,183 @@
+package flatten_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stackql/go-openapistackql/openapistackql"
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/dto"
+	"github.com/stackql/stackql/internal/stackql/flatten"
+	"github.com/stackql/stackql/internal/stackql/parserutil"
+)
+
+func newObjectSchema(properties map[string]*openapi3.Schema) *openapi3.Schema {
+	rv := &openapi3.Schema{Type: "object", Properties: make(openapi3.Schemas)}
+	for k, v := range properties {
+		rv.Properties[k] = &openapi3.SchemaRef{Value: v}
+	}
+	return rv
+}
+
+func newArraySchema(items *openapi3.Schema) *openapi3.Schema {
+	return &openapi3.Schema{Type: "array", Items: &openapi3.SchemaRef{Value: items}}
+}
+
+// getTestTabulation tabulates a resource with nested objects,
+// an array of objects and an array of strings.
+func getTestTabulation() *openapistackql.Tabulation {
+	return openapistackql.NewSchema(
+		newObjectSchema(map[string]*openapi3.Schema{
+			"name": {Type: "string"},
+			"owner": newObjectSchema(map[string]*openapi3.Schema{
+				"login": {Type: "string"},
+				"id":    {Type: "integer"},
+				"address": newObjectSchema(map[string]*openapi3.Schema{
+					"city": {Type: "string"},
+				}),
+			}),
+			"disks": newArraySchema(newObjectSchema(map[string]*openapi3.Schema{
+				"deviceName": {Type: "string"},
+				"boot":       {Type: "boolean"},
+				"initializeParams": newObjectSchema(map[string]*openapi3.Schema{
+					"diskSizeGb": {Type: "integer"},
+				}),
+			})),
+			"tags": newArraySchema(&openapi3.Schema{Type: "string"}),
+		}),
+		nil,
+		"resource",
+		"",
+	).Tabulate(false)
+}
+
+func describeColumns(columns []flatten.Column) string {
+	var rv []string
+	for _, col := range columns {
+		rv = append(rv, fmt.Sprintf("%s:%s:%s:%s", col.GetName(), col.GetParentName(), col.GetPath(), col.GetType()))
+	}
+	return strings.Join(rv, ",")
+}
+
+func TestGetColumns(t *testing.T) {
+	testCases := []struct {
+		name      string
+		depth     int
+		separator string
+		want      string
+	}{
+		{
+			name:  "depth one",
+			depth: 1,
+			want:  "owner_address:owner:$.address:object,owner_id:owner:$.id:integer,owner_login:owner:$.login:string",
+		},
+		{
+			name:      "depth two with separator",
+			depth:     2,
+			separator: ".",
+			want:      "owner.address.city:owner:$.address.city:string,owner.id:owner:$.id:integer,owner.login:owner:$.login:string",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := describeColumns(flatten.GetColumns(getTestTabulation(), flatten.NewConfig(tc.depth, tc.separator, false)))
+			if got != tc.want {
+				t.Fatalf("GetColumns() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGetChildTables(t *testing.T) {
+	if childTables := flatten.GetChildTables(getTestTabulation(), flatten.NewConfig(1, "", false)); len(childTables) != 0 {
+		t.Fatalf("GetChildTables() = %d child tables, want none where arrays are not unnested", len(childTables))
+	}
+	childTables := flatten.GetChildTables(getTestTabulation(), flatten.NewConfig(1, "", true))
+	got := make(map[string]string)
+	for _, childTable := range childTables {
+		got[childTable.GetName()] = describeColumns(childTable.GetColumns())
+	}
+	want := map[string]string{
+		"disks": "boot:value:$.boot:boolean,deviceName:value:$.deviceName:string,initializeParams:value:$.initializeParams:object",
+		"tags":  "",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("GetChildTables() = %v, want %v", got, want)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Fatalf("child table '%s' columns = %s, want %s", k, got[k], v)
+		}
+	}
+}
+
+func TestGetConfig(t *testing.T) {
+	testCases := []struct {
+		name           string
+		directives     string
+		runtimeCtx     dto.RuntimeCtx
+		tableName      string
+		wantEnabled    bool
+		wantDepth      int
+		wantSeparator  string
+		wantUnnestArrs bool
+	}{
+		{
+			name:      "disabled",
+			tableName: "google.compute.instances",
+		},
+		{
+			name:          "enabled by pattern",
+			runtimeCtx:    dto.RuntimeCtx{JSONFlatten: "github.repos.*, google.compute.instances", JSONFlattenDepth: 3},
+			tableName:     "google.compute.instances",
+			wantEnabled:   true,
+			wantDepth:     3,
+			wantSeparator: flatten.DefaultSeparator,
+		},
+		{
+			name:       "unmatched pattern",
+			runtimeCtx: dto.RuntimeCtx{JSONFlatten: "github.repos.*"},
+			tableName:  "google.compute.instances",
+		},
+		{
+			name:           "directive",
+			directives:     "/*+ FLATTEN(depth=1, separator=., arrays) */",
+			runtimeCtx:     dto.RuntimeCtx{JSONFlattenDepth: 3, JSONFlattenSeparator: "_"},
+			tableName:      "google.compute.instances",
+			wantEnabled:    true,
+			wantDepth:      1,
+			wantSeparator:  ".",
+			wantUnnestArrs: true,
+		},
+		{
+			name:           "directive defaults from runtime",
+			directives:     "/*+ FLATTEN */",
+			runtimeCtx:     dto.RuntimeCtx{JSONFlattenDepth: 3, JSONFlattenSeparator: "__", JSONFlattenArrays: true},
+			tableName:      "google.compute.instances",
+			wantEnabled:    true,
+			wantDepth:      3,
+			wantSeparator:  "__",
+			wantUnnestArrs: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var directives sqlparser.CommentDirectives
+			if tc.directives != "" {
+				directives = parserutil.ExtractCommentDirectives(sqlparser.Comments{[]byte(tc.directives)})
+			}
+			cfg, isEnabled := flatten.GetConfig(directives, tc.runtimeCtx, tc.tableName)
+			if isEnabled != tc.wantEnabled {
+				t.Fatalf("GetConfig() enabled = %t, want %t", isEnabled, tc.wantEnabled)
+			}
+			if !isEnabled {
+				return
+			}
+			if cfg.GetDepth() != tc.wantDepth || cfg.GetSeparator() != tc.wantSeparator || cfg.IsUnnestArrays() != tc.wantUnnestArrs {
+				t.Fatalf("GetConfig() = (%d, %s, %t), want (%d, %s, %t)", cfg.GetDepth(), cfg.GetSeparator(), cfg.IsUnnestArrays(), tc.wantDepth, tc.wantSeparator, tc.wantUnnestArrs)
+			}
+		})
+	}
+}
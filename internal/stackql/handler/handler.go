@@ -1,14 +1,17 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"path"
 	"strings"
+	"sync"
 
 	"github.com/stackql/go-openapistackql/openapistackql"
 	"github.com/stackql/go-openapistackql/pkg/nomenclature"
+	"github.com/stackql/stackql/internal/stackql/auditlog"
 	"github.com/stackql/stackql/internal/stackql/bundle"
 	"github.com/stackql/stackql/internal/stackql/datasource/sql_datasource"
 	"github.com/stackql/stackql/internal/stackql/dbmsinternal"
@@ -16,8 +19,12 @@ import (
 	"github.com/stackql/stackql/internal/stackql/dto"
 	"github.com/stackql/stackql/internal/stackql/garbagecollector"
 	"github.com/stackql/stackql/internal/stackql/kstore"
+	"github.com/stackql/stackql/internal/stackql/mutationplan"
 	"github.com/stackql/stackql/internal/stackql/netutils"
 	"github.com/stackql/stackql/internal/stackql/provider"
+	"github.com/stackql/stackql/internal/stackql/querybudget"
+	"github.com/stackql/stackql/internal/stackql/redaction"
+	"github.com/stackql/stackql/internal/stackql/safetypolicy"
 	"github.com/stackql/stackql/internal/stackql/sql_system"
 	"github.com/stackql/stackql/internal/stackql/sqlcontrol"
 	"github.com/stackql/stackql/internal/stackql/sqlengine"
@@ -38,6 +45,7 @@ type HandlerContext interface {
 	//
 	GetASTFormatter() sqlparser.NodeFormatter
 	GetAuthContext(providerName string) (*dto.AuthCtx, error)
+	GetContext() context.Context
 	GetDBMSInternalRouter() dbmsinternal.DBMSInternalRouter
 	GetProvider(providerName string) (provider.IProvider, error)
 	GetSupportedProviders(extended bool) (map[string]map[string]interface{}, error)
@@ -65,19 +73,35 @@ type HandlerContext interface {
 	GetNamespaceCollection() tablenamespace.TableNamespaceCollection
 	GetFormatter() sqlparser.NodeFormatter
 	GetPGInternalRouter() dbmsinternal.DBMSInternalRouter
+	GetQueryBudget() querybudget.QueryBudget
+	GetMutationRecorder() mutationplan.Recorder
+	GetSafetyPolicy() safetypolicy.SafetyPolicy
+	GetAuditLog() auditlog.AuditLog
+	GetRedactor() redaction.Redactor
 	//
+	SetContext(context.Context)
 	SetCurrentProvider(string)
 	SetOutfile(io.Writer)
 	SetOutErrFile(io.Writer)
 	SetQuery(string)
+	SetQueryBudget(querybudget.QueryBudget)
+	SetMutationRecorder(mutationplan.Recorder)
 	SetRawQuery(string)
+	SetRuntimeContext(dto.RuntimeCtx)
 }
 
 type standardHandlerContext struct {
+	ctx                 context.Context
+	queryBudget         querybudget.QueryBudget
+	mutationRecorder    mutationplan.Recorder
+	safetyPolicy        safetypolicy.SafetyPolicy
+	auditLog            auditlog.AuditLog
+	redactor            redaction.Redactor
 	rawQuery            string
 	query               string
 	runtimeContext      dto.RuntimeCtx
 	providers           map[string]provider.IProvider
+	providersMutex      *sync.Mutex
 	controlAttributes   sqlcontrol.ControlAttributes
 	currentProvider     string
 	authContexts        map[string]*dto.AuthCtx
@@ -98,6 +122,58 @@ type standardHandlerContext struct {
 	pgInternalRouter    dbmsinternal.DBMSInternalRouter
 }
 
+// GetContext returns the context governing the current query,
+// which is cancelled when the query is to be abandoned.
+func (hc *standardHandlerContext) GetContext() context.Context {
+	if hc.ctx == nil {
+		return context.Background()
+	}
+	return hc.ctx
+}
+
+func (hc *standardHandlerContext) SetContext(ctx context.Context) {
+	hc.ctx = ctx
+}
+
+func (hc *standardHandlerContext) GetQueryBudget() querybudget.QueryBudget {
+	if hc.queryBudget == nil {
+		hc.queryBudget = querybudget.NewQueryBudget(hc.runtimeContext)
+	}
+	return hc.queryBudget
+}
+
+func (hc *standardHandlerContext) SetQueryBudget(qb querybudget.QueryBudget) {
+	hc.queryBudget = qb
+}
+
+// GetMutationRecorder returns nil unless mutating
+// calls are being planned or applied from a plan.
+func (hc *standardHandlerContext) GetMutationRecorder() mutationplan.Recorder {
+	return hc.mutationRecorder
+}
+
+func (hc *standardHandlerContext) SetMutationRecorder(mr mutationplan.Recorder) {
+	hc.mutationRecorder = mr
+}
+
+// GetSafetyPolicy returns the policy shared by
+// this context and all of its clones.
+func (hc *standardHandlerContext) GetSafetyPolicy() safetypolicy.SafetyPolicy {
+	return hc.safetyPolicy
+}
+
+func (hc *standardHandlerContext) GetAuditLog() auditlog.AuditLog {
+	return hc.auditLog
+}
+
+func (hc *standardHandlerContext) GetRedactor() redaction.Redactor {
+	return hc.redactor
+}
+
+func (hc *standardHandlerContext) SetRuntimeContext(rc dto.RuntimeCtx) {
+	hc.runtimeContext = rc
+}
+
 func (hc *standardHandlerContext) SetCurrentProvider(p string) {
 	hc.currentProvider = p
 }
@@ -209,6 +285,9 @@ func (hc *standardHandlerContext) GetProvider(providerName string) (provider.IPr
 	if err != nil {
 		return nil, err
 	}
+	// providers are shared with clones, which may be in concurrent use
+	hc.providersMutex.Lock()
+	defer hc.providersMutex.Unlock()
 	prov, ok := hc.providers[providerName]
 	if !ok {
 		prov, err = provider.GetProvider(hc.runtimeContext, ds.Name, ds.Tag, hc.registry, hc.sqlSystem)
@@ -229,9 +308,10 @@ func (hc *standardHandlerContext) LogHTTPResponseMap(target interface{}) {
 	if hc.runtimeContext.HTTPLogEnabled {
 		switch target := target.(type) {
 		case map[string]interface{}, []interface{}:
-			b, err := json.MarshalIndent(target, "", "  ")
+			redacted := hc.redactor.RedactValue(target)
+			b, err := json.MarshalIndent(redacted, "", "  ")
 			if err != nil {
-				hc.outErrFile.Write([]byte(fmt.Sprintf("processed http response body map '%v' colud not be marshalled; error: %s\n", target, err.Error())))
+				hc.outErrFile.Write([]byte(fmt.Sprintf("processed http response body map '%v' colud not be marshalled; error: %s\n", redacted, err.Error())))
 				return
 			}
 			if target != nil {
@@ -241,7 +321,7 @@ func (hc *standardHandlerContext) LogHTTPResponseMap(target interface{}) {
 			}
 		default:
 			if target != nil {
-				hc.outErrFile.Write([]byte(fmt.Sprintf("processed http response body object: %v\n", target)))
+				hc.outErrFile.Write([]byte(fmt.Sprintf("processed http response body object: %s\n", hc.redactor.RedactText(fmt.Sprintf("%v", target)))))
 			} else {
 				hc.outErrFile.Write([]byte("processed http response body not present\n"))
 			}
@@ -292,9 +372,17 @@ func getRegistry(runtimeCtx dto.RuntimeCtx) (openapistackql.RegistryAPI, error)
 
 func (hc *standardHandlerContext) Clone() HandlerContext {
 	rv := standardHandlerContext{
+		ctx:                 hc.ctx,
+		queryBudget:         hc.queryBudget,
+		mutationRecorder:    hc.mutationRecorder,
+		safetyPolicy:        hc.safetyPolicy,
+		auditLog:            hc.auditLog,
+		redactor:            hc.redactor,
 		rawQuery:            hc.rawQuery,
+		query:               hc.query,
 		runtimeContext:      hc.runtimeContext,
 		providers:           hc.providers,
+		providersMutex:      hc.providersMutex,
 		authContexts:        hc.authContexts,
 		registry:            hc.registry,
 		controlAttributes:   hc.controlAttributes,
@@ -311,6 +399,7 @@ func (hc *standardHandlerContext) Clone() HandlerContext {
 		namespaceCollection: hc.namespaceCollection,
 		formatter:           hc.formatter,
 		pgInternalRouter:    hc.pgInternalRouter,
+		drmConfig:           hc.drmConfig,
 	}
 	return &rv
 }
@@ -326,11 +415,19 @@ func GetHandlerCtx(cmdString string, runtimeCtx dto.RuntimeCtx, lruCache *lrucac
 	}
 	controlAttributes := inputBundle.GetControlAttributes()
 	sqlEngine := inputBundle.GetSQLEngine()
+	auditLog, err := auditlog.NewAuditLog(runtimeCtx, inputBundle.GetSQLSystem())
+	if err != nil {
+		return nil, err
+	}
 	rv := standardHandlerContext{
 		rawQuery:            cmdString,
 		runtimeContext:      runtimeCtx,
 		providers:           providers,
+		providersMutex:      &sync.Mutex{},
 		authContexts:        inputBundle.GetAuthContexts(),
+		safetyPolicy:        safetypolicy.NewSafetyPolicy(runtimeCtx, inputBundle.GetAuthContexts()),
+		auditLog:            auditLog,
+		redactor:            redaction.NewRedactor(runtimeCtx.RedactFields, runtimeCtx.RedactHeaders, runtimeCtx.RedactQueryKeys),
 		registry:            reg,
 		controlAttributes:   controlAttributes,
 		errorPresentation:   runtimeCtx.ErrorPresentation,
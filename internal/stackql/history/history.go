@@ -0,0 +1,147 @@
+package history
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
+	"github.com/stackql/stackql/internal/stackql/logging"
+	"github.com/stackql/stackql/internal/stackql/sql_system"
+	"github.com/stackql/stackql/internal/stackql/sqlcontrol"
+)
+
+const subsystem logging.Subsystem = "history"
+
+const (
+	SchemaName                string = "stackql_history"
+	AcquiredAtColumnName      string = "stackql_acquired_at"
+	QueryHashColumnName       string = "stackql_query_hash"
+	RequestEncodingColumnName string = "stackql_request_encoding"
+	// TimestampLayout is fixed width, so that
+	// acquisition timestamps sort lexically.
+	TimestampLayout string = "2006-01-02T15:04:05.000000Z"
+)
+
+// Recorder appends acquired rows to history tables.
+type Recorder interface {
+	// IsCaptured reports whether history
+	// is captured for the given table.
+	IsCaptured(tableName string) bool
+	// Record copies those rows of the staging table that were
+	// inserted under the supplied control counters into the
+	// history table for the given table.
+	Record(tableName string, stagingTableName string, columns []internaldto.ColumnMetadata, tcc internaldto.TxnControlCounters) error
+}
+
+// NewRecorder returns a recorder for tables matching any
+// of the comma separated patterns, eg: "google.compute.*".
+// The query, of the context, is the statement for which rows are acquired.
+func NewRecorder(
+	ctx context.Context,
+	patterns string,
+	sqlSystem sql_system.SQLSystem,
+	controlAttributes sqlcontrol.ControlAttributes,
+	query string,
+) Recorder {
+	var tablePatterns []string
+	for _, p := range strings.Split(patterns, ",") {
+		p = strings.TrimSpace(p)
+		if p != "" {
+			tablePatterns = append(tablePatterns, p)
+		}
+	}
+	queryHash := sha256.Sum256([]byte(query))
+	return &standardRecorder{
+		ctx:               ctx,
+		tablePatterns:     tablePatterns,
+		sqlSystem:         sqlSystem,
+		controlAttributes: controlAttributes,
+		queryHash:         hex.EncodeToString(queryHash[:]),
+	}
+}
+
+// GetTableName returns the unqualified backend
+// table name of the history for the given table.
+func GetTableName(tableName string) string {
+	return fmt.Sprintf("%s.%s", SchemaName, tableName)
+}
+
+type standardRecorder struct {
+	ctx               context.Context
+	tablePatterns     []string
+	sqlSystem         sql_system.SQLSystem
+	controlAttributes sqlcontrol.ControlAttributes
+	queryHash         string
+}
+
+func (hr *standardRecorder) IsCaptured(tableName string) bool {
+	for _, p := range hr.tablePatterns {
+		if isMatch, _ := path.Match(p, tableName); isMatch {
+			return true
+		}
+	}
+	return false
+}
+
+func (hr *standardRecorder) Record(
+	tableName string,
+	stagingTableName string,
+	columns []internaldto.ColumnMetadata,
+	tcc internaldto.TxnControlCounters,
+) error {
+	historyTableName, err := hr.sqlSystem.GetFullyQualifiedTableName(GetTableName(tableName))
+	if err != nil {
+		return err
+	}
+	fqStagingTableName, err := hr.sqlSystem.GetFullyQualifiedTableName(stagingTableName)
+	if err != nil {
+		return err
+	}
+	var columnDefinitions, columnNames []string
+	for _, col := range columns {
+		columnDefinitions = append(columnDefinitions, fmt.Sprintf(`"%s" %s`, col.GetName(), col.GetRelationalType()))
+		columnNames = append(columnNames, fmt.Sprintf(`"%s"`, col.GetName()))
+	}
+	for _, colName := range []string{AcquiredAtColumnName, QueryHashColumnName, RequestEncodingColumnName} {
+		columnDefinitions = append(columnDefinitions, fmt.Sprintf(`"%s" text`, colName))
+	}
+	ddl := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s ( %s )`, historyTableName, strings.Join(columnDefinitions, ", "))
+	if _, err := hr.sqlSystem.GetSQLEngine().Exec(ddl); err != nil {
+		return fmt.Errorf("cannot create history table for '%s': %w", tableName, err)
+	}
+	acquiredAt := time.Now().UTC().Format(TimestampLayout)
+	// Control counters are integers and the remaining
+	// literals are generated, so nothing is interpolated
+	// from acquired data.
+	dml := fmt.Sprintf(
+		`INSERT INTO %s ( %s, "%s", "%s", "%s" ) SELECT %s, '%s', '%s', "%s" FROM %s WHERE "%s" = %d AND "%s" = %d AND "%s" = %d AND "%s" = %d`,
+		historyTableName,
+		strings.Join(columnNames, ", "),
+		AcquiredAtColumnName,
+		QueryHashColumnName,
+		RequestEncodingColumnName,
+		strings.Join(columnNames, ", "),
+		acquiredAt,
+		hr.queryHash,
+		hr.controlAttributes.GetControlInsertEncodedIdColumnName(),
+		fqStagingTableName,
+		hr.controlAttributes.GetControlGenIdColumnName(),
+		tcc.GetGenID(),
+		hr.controlAttributes.GetControlSsnIdColumnName(),
+		tcc.GetSessionID(),
+		hr.controlAttributes.GetControlTxnIdColumnName(),
+		tcc.GetTxnID(),
+		hr.controlAttributes.GetControlInsIdColumnName(),
+		tcc.GetInsertID(),
+	)
+	subsystem.GetContextLogger(hr.ctx).Infoln(fmt.Sprintf("recording history: %s", dml))
+	if _, err := hr.sqlSystem.GetSQLEngine().Exec(dml); err != nil {
+		return fmt.Errorf("cannot record history for '%s': %w", tableName, err)
+	}
+	return nil
+}
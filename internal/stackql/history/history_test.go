@@ -0,0 +1,97 @@
+package history_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stackql/stackql/internal/stackql/history"
+)
+
+func TestRewriteSystemTime(t *testing.T) {
+	testCases := []struct {
+		name    string
+		query   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "qualified table",
+			query: "select name from google.compute.instances for system_time as of '2023-03-01 10:00:00' where zone = 'a'",
+			want:  `select name from google.compute."instances@2023-03-01T10:00:00.000000Z" where zone = 'a'`,
+		},
+		{
+			name:  "aliased and upper case",
+			query: "SELECT i.name FROM google.compute.instances FOR SYSTEM_TIME AS OF '2023-03-01T10:00:00+10:00' AS i",
+			want:  `SELECT i.name FROM google.compute."instances@2023-03-01T00:00:00.000000Z" AS i`,
+		},
+		{
+			name:  "delimited table",
+			query: `select * from google.compute."instances" for system_time as of '2023-03-01'`,
+			want:  `select * from google.compute."instances@2023-03-01T00:00:00.000000Z"`,
+		},
+		{
+			name:  "within string literal",
+			query: "select 'x for system_time as of ''2023-03-01''' from t",
+			want:  "select 'x for system_time as of ''2023-03-01''' from t",
+		},
+		{
+			name:  "within comment",
+			query: "select 1 from t /* t for system_time as of '2023-03-01' */",
+			want:  "select 1 from t /* t for system_time as of '2023-03-01' */",
+		},
+		{
+			name:  "within line comment",
+			query: "select 1 from t -- t for system_time as of '2023-03-01'\n",
+			want:  "select 1 from t -- t for system_time as of '2023-03-01'\n",
+		},
+		{
+			name:  "untokenizable unchanged",
+			query: "select 'unterminated",
+			want:  "select 'unterminated",
+		},
+		{
+			name:    "malformed timestamp",
+			query:   "select * from t for system_time as of 'yesterday'",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := history.RewriteSystemTime(tc.query)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("RewriteSystemTime() error = %v, want error %t", err, tc.wantErr)
+			}
+			if !tc.wantErr && got != tc.want {
+				t.Fatalf("RewriteSystemTime() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseResourceIdent(t *testing.T) {
+	resource, asOf, ok := history.ParseResourceIdent("instances@2023-03-01T00:00:00.000000Z")
+	if !ok || resource != "instances" || asOf != "2023-03-01T00:00:00.000000Z" {
+		t.Fatalf("ParseResourceIdent() = (%s, %s, %t)", resource, asOf, ok)
+	}
+	if _, _, ok := history.ParseResourceIdent("instances"); ok {
+		t.Fatalf("ParseResourceIdent() parsed an ident without system time")
+	}
+}
+
+func TestIsCaptured(t *testing.T) {
+	recorder := history.NewRecorder(context.Background(), " google.compute.* , okta.user.users", nil, nil, "select 1")
+	testCases := []struct {
+		tableName string
+		want      bool
+	}{
+		{tableName: "google.compute.instances", want: true},
+		{tableName: "okta.user.users", want: true},
+		{tableName: "google.storage.buckets"},
+		{tableName: "okta.user.groups"},
+	}
+	for _, tc := range testCases {
+		if got := recorder.IsCaptured(tc.tableName); got != tc.want {
+			t.Fatalf("IsCaptured(%s) = %t, want %t", tc.tableName, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package history
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/parserutil"
+)
+
+const (
+	asOfSeparator string = "@"
+)
+
+var (
+	// The parser does not support temporal table
+	// references, so the period is moved into the
+	// preceding identifier ahead of parsing.
+	systemTimeKeywords []string = []string{"for", "system_time", "as", "of"}
+	asOfLayouts        []string = []string{
+		time.RFC3339Nano,
+		"2006-01-02T15:04:05",
+		"2006-01-02 15:04:05Z07:00",
+		"2006-01-02 15:04:05",
+		"2006-01-02 15:04",
+		"2006-01-02",
+	}
+)
+
+// RewriteSystemTime rewrites each "<table> FOR SYSTEM_TIME AS OF '<timestamp>'"
+// into a table reference that parses and that ParseResourceIdent
+// decomposes into the resource and normalised timestamp.
+// The clause is recognised from tokens, and so not within
+// string literals or comments.  Queries that do not tokenize
+// are returned unchanged, for the parser to report.
+func RewriteSystemTime(query string) (string, error) {
+	tokens, err := parserutil.ScanTokens(query)
+	if err != nil {
+		return query, nil
+	}
+	var sb strings.Builder
+	written := 0
+	for i := 0; i+len(systemTimeKeywords)+1 < len(tokens); i++ {
+		if !isSystemTimeClause(query, tokens[i:i+len(systemTimeKeywords)+2]) {
+			continue
+		}
+		resourceToken := tokens[i]
+		timestampToken := tokens[i+len(systemTimeKeywords)+1]
+		asOf, err := normaliseTimestamp(string(timestampToken.Value))
+		if err != nil {
+			return query, err
+		}
+		sb.WriteString(query[written:resourceToken.Start])
+		sb.WriteString(fmt.Sprintf(`"%s%s%s"`, string(resourceToken.Value), asOfSeparator, asOf))
+		written = timestampToken.End
+		i += len(systemTimeKeywords) + 1
+	}
+	sb.WriteString(query[written:])
+	return sb.String(), nil
+}
+
+// isSystemTimeClause reports whether the tokens are an identifier,
+// followed by the system time keywords and a string literal.
+func isSystemTimeClause(query string, tokens []parserutil.Token) bool {
+	if tokens[0].Type != sqlparser.ID || tokens[len(tokens)-1].Type != sqlparser.STRING {
+		return false
+	}
+	for i, keyword := range systemTimeKeywords {
+		if !strings.EqualFold(tokens[i+1].GetRaw(query), keyword) {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseResourceIdent decomposes a resource identifier
+// rewritten by RewriteSystemTime.
+func ParseResourceIdent(ident string) (string, string, bool) {
+	return strings.Cut(ident, asOfSeparator)
+}
+
+// GetSnapshotQuery returns a query for the rows of the given history
+// table as at the supplied timestamp, being the latest acquisition
+// at or before the timestamp for each request encoding.
+func GetSnapshotQuery(fqHistoryTableName string, asOf string) string {
+	return fmt.Sprintf(
+		`SELECT * FROM %s AS "snapshot" WHERE "snapshot"."%s" = ( SELECT max("%s") FROM %s WHERE "%s" = "snapshot"."%s" AND "%s" <= '%s' )`,
+		fqHistoryTableName,
+		AcquiredAtColumnName,
+		AcquiredAtColumnName,
+		fqHistoryTableName,
+		RequestEncodingColumnName,
+		RequestEncodingColumnName,
+		AcquiredAtColumnName,
+		asOf,
+	)
+}
+
+func normaliseTimestamp(s string) (string, error) {
+	for _, layout := range asOfLayouts {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			return t.UTC().Format(TimestampLayout), nil
+		}
+	}
+	return "", fmt.Errorf("cannot parse system time '%s'; expected a timestamp of the form 'YYYY-MM-DD[ HH:MM:SS][Z]'", s)
+}
@@ -18,7 +18,9 @@ import (
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 )
 
-func BuildHTTPRequestCtx(node sqlparser.SQLNode, prov provider.IProvider, m *openapistackql.OperationStore, svc *openapistackql.Service, insertValOnlyRows map[int]map[int]interface{}, execContext ExecContext) (HTTPArmoury, error) {
+const subsystem logging.Subsystem = "httpbuild"
+
+func BuildHTTPRequestCtx(ctx context.Context, node sqlparser.SQLNode, prov provider.IProvider, m *openapistackql.OperationStore, svc *openapistackql.Service, insertValOnlyRows map[int]map[int]interface{}, execContext ExecContext) (HTTPArmoury, error) {
 	var err error
 	httpArmoury := NewHTTPArmoury()
 	var requestSchema, responseSchema *openapistackql.Schema
@@ -30,7 +32,7 @@ func BuildHTTPRequestCtx(node sqlparser.SQLNode, prov provider.IProvider, m *ope
 	}
 	httpArmoury.SetRequestSchema(requestSchema)
 	httpArmoury.SetResponseSchema(responseSchema)
-	paramMap, err := util.ExtractSQLNodeParams(node, insertValOnlyRows)
+	paramMap, err := util.ExtractSQLNodeParams(ctx, node, insertValOnlyRows)
 	if err != nil {
 		return nil, err
 	}
@@ -95,8 +97,8 @@ func BuildHTTPRequestCtx(node sqlparser.SQLNode, prov provider.IProvider, m *ope
 		if err != nil {
 			return nil, err
 		}
-		logging.GetLogger().Infoln(fmt.Sprintf("pre transform: httpArmoury.RequestParams[%d] = %s", i, string(p.GetBodyBytes())))
-		logging.GetLogger().Infoln(fmt.Sprintf("post transform: httpArmoury.RequestParams[%d] = %s", i, string(p.GetBodyBytes())))
+		subsystem.GetContextLogger(ctx).Infoln(fmt.Sprintf("pre transform: httpArmoury.RequestParams[%d] = %s", i, string(p.GetBodyBytes())))
+		subsystem.GetContextLogger(ctx).Infoln(fmt.Sprintf("post transform: httpArmoury.RequestParams[%d] = %s", i, string(p.GetBodyBytes())))
 		secondPassParams[i] = p
 	}
 	httpArmoury.SetRequestParams(secondPassParams)
@@ -131,7 +133,7 @@ func getRequest(prov *openapistackql.Provider, svc *openapistackql.Service, meth
 	return request, nil
 }
 
-func BuildHTTPRequestCtxFromAnnotation(parameters streaming.MapStream, prov provider.IProvider, m *openapistackql.OperationStore, svc *openapistackql.Service, insertValOnlyRows map[int]map[int]interface{}, execContext ExecContext) (HTTPArmoury, error) {
+func BuildHTTPRequestCtxFromAnnotation(ctx context.Context, parameters streaming.MapStream, prov provider.IProvider, m *openapistackql.OperationStore, svc *openapistackql.Service, insertValOnlyRows map[int]map[int]interface{}, execContext ExecContext) (HTTPArmoury, error) {
 	var err error
 	httpArmoury := NewHTTPArmoury()
 	var requestSchema, responseSchema *openapistackql.Schema
@@ -213,8 +215,8 @@ func BuildHTTPRequestCtxFromAnnotation(parameters streaming.MapStream, prov prov
 		}
 
 		p.SetRequest(baseRequestCtx)
-		logging.GetLogger().Infoln(fmt.Sprintf("pre transform: httpArmoury.RequestParams[%d] = %s", i, string(p.GetBodyBytes())))
-		logging.GetLogger().Infoln(fmt.Sprintf("post transform: httpArmoury.RequestParams[%d] = %s", i, string(p.GetBodyBytes())))
+		subsystem.GetContextLogger(ctx).Infoln(fmt.Sprintf("pre transform: httpArmoury.RequestParams[%d] = %s", i, string(p.GetBodyBytes())))
+		subsystem.GetContextLogger(ctx).Infoln(fmt.Sprintf("post transform: httpArmoury.RequestParams[%d] = %s", i, string(p.GetBodyBytes())))
 		secondPassParams[i] = p
 	}
 	httpArmoury.SetRequestParams(secondPassParams)
@@ -2,16 +2,32 @@ package httpmiddleware
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/stackql/go-openapistackql/openapistackql"
 	"github.com/stackql/go-openapistackql/pkg/requesttranslate"
+	"github.com/stackql/stackql/internal/stackql/auditlog"
 	"github.com/stackql/stackql/internal/stackql/handler"
+	"github.com/stackql/stackql/internal/stackql/logging"
+	"github.com/stackql/stackql/internal/stackql/metrics"
+	"github.com/stackql/stackql/internal/stackql/mutationplan"
 	"github.com/stackql/stackql/internal/stackql/provider"
+	"github.com/stackql/stackql/internal/stackql/redaction"
+	"github.com/stackql/stackql/internal/stackql/sessionctx"
+	"github.com/stackql/stackql/internal/stackql/tracing"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
+const subsystem logging.Subsystem = "httpmiddleware"
+
 func GetAuthenticatedClient(handlerCtx handler.HandlerContext, prov provider.IProvider) (*http.Client, error) {
 	return getAuthenticatedClient(handlerCtx, prov)
 }
@@ -28,11 +44,48 @@ func getAuthenticatedClient(handlerCtx handler.HandlerContext, prov provider.IPr
 	return httpClient, nil
 }
 
+// WithParentSpan returns a copy of the handler context, whose HTTP spans
+// are children of the span of ctx, such as that of the executing primitive.
+func WithParentSpan(handlerCtx handler.HandlerContext, ctx context.Context) handler.HandlerContext {
+	rv := handlerCtx.Clone()
+	rv.SetContext(tracing.WithParentSpan(handlerCtx.GetContext(), ctx))
+	return rv
+}
+
+// HttpApiMutationFromRequest is HttpApiCallFromRequest for calls that alter
+// provider state.  Whilst planning, the call is recorded in place of being
+// sent and, there being no response, the response is nil.  Whilst applying
+// a plan, only the planned call is sent.
+func HttpApiMutationFromRequest(handlerCtx handler.HandlerContext, prov provider.IProvider, method *openapistackql.OperationStore, request *http.Request) (*http.Response, error) {
+	recorder := handlerCtx.GetMutationRecorder()
+	if recorder == nil {
+		return HttpApiCallFromRequest(handlerCtx, prov, method, request)
+	}
+	var body []byte
+	if request.Body != nil {
+		b, err := io.ReadAll(request.Body)
+		if err != nil {
+			return nil, err
+		}
+		body = b
+		request.Body = io.NopCloser(bytes.NewBuffer(b))
+	}
+	call := mutationplan.NewCall(handlerCtx.GetRedactor(), prov.GetProviderString(), method.GetName(), request.Method, request.URL, body)
+	if err := recorder.Record(call); err != nil {
+		return nil, err
+	}
+	if recorder.IsCapturing() {
+		return nil, nil
+	}
+	return HttpApiCallFromRequest(handlerCtx, prov, method, request)
+}
+
 func HttpApiCallFromRequest(handlerCtx handler.HandlerContext, prov provider.IProvider, method *openapistackql.OperationStore, request *http.Request) (*http.Response, error) {
 	httpClient, httpClientErr := getAuthenticatedClient(handlerCtx, prov)
 	if httpClientErr != nil {
 		return nil, httpClientErr
 	}
+	request = request.WithContext(handlerCtx.GetContext())
 	request.Header.Del("Authorization")
 	requestTranslator, err := requesttranslate.NewRequestTranslator(method.GetRequestTranslateAlgorithm())
 	if err != nil {
@@ -42,11 +95,13 @@ func HttpApiCallFromRequest(handlerCtx handler.HandlerContext, prov provider.IPr
 	if err != nil {
 		return nil, err
 	}
+	redactor := handlerCtx.GetRedactor()
+	addSensitiveFields(redactor, method)
 	if handlerCtx.GetRuntimeContext().HTTPLogEnabled {
 		urlStr := ""
 		methodStr := ""
 		if translatedRequest != nil && translatedRequest.URL != nil {
-			urlStr = translatedRequest.URL.String()
+			urlStr = redactor.RedactURL(translatedRequest.URL)
 			methodStr = translatedRequest.Method
 		}
 		handlerCtx.GetOutErrFile().Write([]byte(fmt.Sprintf("http request url: '%s', method: '%s'\n", urlStr, methodStr)))
@@ -54,24 +109,42 @@ func HttpApiCallFromRequest(handlerCtx handler.HandlerContext, prov provider.IPr
 		if body != nil {
 			b, err := io.ReadAll(body)
 			if err != nil {
-				handlerCtx.GetOutErrFile().Write([]byte(fmt.Sprintf("error inpecting http request body: %s\n", err.Error())))
+				handlerCtx.GetOutErrFile().Write([]byte(fmt.Sprintf("error inpecting http request body: %s\n", redactor.RedactText(err.Error()))))
 			}
-			bodyStr := string(b)
+			bodyStr := string(redactor.RedactBody(b))
 			translatedRequest.Body = io.NopCloser(bytes.NewBuffer(b))
 			handlerCtx.GetOutErrFile().Write([]byte(fmt.Sprintf("http request body = '%s'\n", bodyStr)))
 		}
 	}
-	r, err := httpClient.Do(translatedRequest)
+	if err := handlerCtx.GetQueryBudget().AcquireHTTPRequest(); err != nil {
+		return nil, err
+	}
+	auditEntry, isAudited := newAuditEntry(handlerCtx, prov, method, translatedRequest)
+	spanCtx, span := startHTTPSpan(handlerCtx, prov, method, translatedRequest)
+	start := time.Now()
+	r, err := httpClient.Do(translatedRequest.WithContext(spanCtx))
+	elapsed := time.Since(start)
+	metrics.ObserveHTTPRequest(prov.GetProviderString(), r, err, elapsed)
+	endHTTPSpan(span, r, err)
+	logHTTPRequest(handlerCtx, prov, method, translatedRequest, r, err, elapsed)
+	if isAudited {
+		writeAuditEntry(handlerCtx, auditEntry, r, err)
+	}
 	if handlerCtx.GetRuntimeContext().HTTPLogEnabled {
 		if r != nil {
 			handlerCtx.GetOutErrFile().Write([]byte(fmt.Sprintf("http response status: %s\n", r.Status)))
+			if handlerCtx.GetRuntimeContext().VerboseFlag && r.Request != nil {
+				// the request as sent, since credentials
+				// are added by the transport
+				handlerCtx.GetOutErrFile().Write([]byte(fmt.Sprintf("http request headers: %v\n", redactor.RedactHeader(r.Request.Header))))
+			}
 			if r.StatusCode >= 300 || handlerCtx.GetRuntimeContext().VerboseFlag {
 				if r.Body != nil {
 					bodyBytes, err := io.ReadAll(r.Body)
 					if err != nil {
 						return nil, err
 					}
-					handlerCtx.GetOutErrFile().Write([]byte(fmt.Sprintf("http error response body: %s\n", string(bodyBytes))))
+					handlerCtx.GetOutErrFile().Write([]byte(fmt.Sprintf("http error response body: %s\n", string(redactor.RedactBody(bodyBytes)))))
 					r.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
 				}
 			}
@@ -81,9 +154,169 @@ func HttpApiCallFromRequest(handlerCtx handler.HandlerContext, prov provider.IPr
 	}
 	if err != nil {
 		if handlerCtx.GetRuntimeContext().HTTPLogEnabled {
-			handlerCtx.GetOutErrFile().Write([]byte(fmt.Sprintln(fmt.Sprintf("http response error: %s", err.Error()))))
+			handlerCtx.GetOutErrFile().Write([]byte(fmt.Sprintln(fmt.Sprintf("http response error: %s", redactor.RedactText(err.Error())))))
 		}
 		return nil, err
 	}
 	return r, err
 }
+
+// logHTTPRequest logs each request at debug level, with
+// provider and resource fields, and failures at info level.
+func logHTTPRequest(
+	handlerCtx handler.HandlerContext,
+	prov provider.IProvider,
+	method *openapistackql.OperationStore,
+	request *http.Request,
+	response *http.Response,
+	err error,
+	elapsed time.Duration,
+) {
+	serviceName, resourceName := getServiceAndResourceNames(method)
+	logger := subsystem.GetResourceLogger(
+		handlerCtx.GetContext(),
+		prov.GetProviderString(),
+		fmt.Sprintf("%s.%s", serviceName, resourceName),
+	)
+	url := handlerCtx.GetRedactor().RedactURL(request.URL)
+	if err != nil {
+		logger.Infof("http request %s %s failed after %s: %s", request.Method, url, elapsed, handlerCtx.GetRedactor().RedactText(err.Error()))
+		return
+	}
+	logger.Debugf("http request %s %s returned %s after %s", request.Method, url, response.Status, elapsed)
+}
+
+// startHTTPSpan starts a client span per request, and
+// so per page where results are paginated.
+func startHTTPSpan(handlerCtx handler.HandlerContext, prov provider.IProvider, method *openapistackql.OperationStore, request *http.Request) (context.Context, trace.Span) {
+	serviceName, resourceName := getServiceAndResourceNames(method)
+	return tracing.StartClient(
+		handlerCtx.GetContext(),
+		fmt.Sprintf("HTTP %s", request.Method),
+		tracing.ProviderKey.String(prov.GetProviderString()),
+		tracing.ServiceKey.String(serviceName),
+		tracing.ResourceKey.String(resourceName),
+		tracing.MethodKey.String(method.GetName()),
+		semconv.HTTPMethodKey.String(request.Method),
+		semconv.HTTPURLKey.String(handlerCtx.GetRedactor().RedactURL(request.URL)),
+	)
+}
+
+func endHTTPSpan(span trace.Span, response *http.Response, err error) {
+	if err == nil && response != nil {
+		span.SetAttributes(semconv.HTTPStatusCodeKey.Int(response.StatusCode))
+		if response.StatusCode >= 400 {
+			span.SetStatus(codes.Error, response.Status)
+		}
+	}
+	tracing.End(span, err)
+}
+
+// addSensitiveFields marks as secret the fields that the request
+// and response schemas of the method designate sensitive.
+func addSensitiveFields(redactor redaction.Redactor, method *openapistackql.OperationStore) {
+	if requestSchema, err := method.GetRequestBodySchema(); err == nil && requestSchema != nil {
+		redactor.AddFields(redaction.GetSensitiveFields(requestSchema.Schema)...)
+	}
+	if responseSchema, _, err := method.GetResponseBodySchemaAndMediaType(); err == nil && responseSchema != nil {
+		redactor.AddFields(redaction.GetSensitiveFields(responseSchema.Schema)...)
+	}
+}
+
+func isAuditedHTTPMethod(httpMethod string) bool {
+	switch httpMethod {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// newAuditEntry records the request prior to sending, as the
+// body is consumed in the sending.  Secret fields are redacted,
+// and literals are omitted from the statement.
+func newAuditEntry(handlerCtx handler.HandlerContext, prov provider.IProvider, method *openapistackql.OperationStore, request *http.Request) (auditlog.Entry, bool) {
+	auditLog := handlerCtx.GetAuditLog()
+	if auditLog == nil || !auditLog.IsEnabled() || request == nil || !isAuditedHTTPMethod(request.Method) {
+		return auditlog.Entry{}, false
+	}
+	redactor := handlerCtx.GetRedactor()
+	entry := auditlog.Entry{
+		Provider:   prov.GetProviderString(),
+		Method:     method.GetName(),
+		Statement:  redaction.OmitLiterals(handlerCtx.GetQuery()),
+		HTTPMethod: request.Method,
+		URL:        redactor.RedactURL(request.URL),
+	}
+	entry.Service, entry.Resource = getServiceAndResourceNames(method)
+	if session, ok := sessionctx.GetSession(handlerCtx.GetContext()); ok {
+		entry.Session = session.ID
+		entry.User = session.User
+	}
+	if request.Body != nil {
+		b, err := io.ReadAll(request.Body)
+		if err == nil {
+			request.Body = io.NopCloser(bytes.NewBuffer(b))
+			entry.RequestBody = auditRequestBody(redactor.RedactBody(b))
+		}
+	}
+	return entry, true
+}
+
+func getServiceAndResourceNames(method *openapistackql.OperationStore) (string, string) {
+	var serviceName, resourceName string
+	switch {
+	case method.ProviderService != nil:
+		serviceName = method.ProviderService.GetName()
+	case method.Resource != nil && method.Resource.ProviderService != nil:
+		serviceName = method.Resource.ProviderService.GetName()
+	case method.Service != nil && method.Service.ProviderService != nil:
+		serviceName = method.Service.ProviderService.GetName()
+	}
+	if method.Resource != nil {
+		resourceName = method.Resource.Name
+		// resource IDs are of the form "<provider>.<service>.<resource>"
+		if idParts := strings.Split(method.Resource.ID, "."); serviceName == "" && len(idParts) == 3 {
+			serviceName = idParts[1]
+		}
+	}
+	return serviceName, resourceName
+}
+
+func auditRequestBody(body []byte) json.RawMessage {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return nil
+	}
+	if json.Valid(body) {
+		return body
+	}
+	b, err := json.Marshal(string(body))
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// writeAuditEntry completes the entry with the outcome of the call.
+// Failure to write is reported but does not fail the call,
+// which has already been made.
+func writeAuditEntry(handlerCtx handler.HandlerContext, entry auditlog.Entry, response *http.Response, callErr error) {
+	entry.Timestamp = time.Now().UTC().Format(auditlog.TimestampLayout)
+	if callErr != nil {
+		entry.Error = redaction.RedactError(handlerCtx.GetRedactor(), callErr).Error()
+	}
+	if response != nil {
+		entry.Status = response.StatusCode
+		if response.Body != nil {
+			b, err := io.ReadAll(response.Body)
+			response.Body = io.NopCloser(bytes.NewBuffer(b))
+			if err == nil {
+				entry.OperationID = auditlog.GetOperationID(b)
+			}
+		}
+	}
+	if err := handlerCtx.GetAuditLog().Write(entry); err != nil {
+		subsystem.GetContextLogger(handlerCtx.GetContext()).Errorln(err.Error())
+		handlerCtx.GetOutErrFile().Write([]byte(fmt.Sprintf("%s\n", err.Error())))
+	}
+}
@@ -1,6 +1,10 @@
 package internaldto
 
 import (
+	"context"
+	"net/http"
+	"net/url"
+
 	"github.com/jeroenrinzema/psql-wire/pkg/sqldata"
 	"github.com/stackql/stackql/internal/stackql/streaming"
 )
@@ -10,12 +14,15 @@ type BackendMessages struct {
 }
 
 type ExecutorOutput struct {
-	GetSQLResult  func() sqldata.ISQLResultStream
-	GetRawResult  func() IRawResultStream
-	GetOutputBody func() map[string]interface{}
-	stream        streaming.MapStream
-	Msg           *BackendMessages
-	Err           error
+	GetSQLResult    func() sqldata.ISQLResultStream
+	GetRawResult    func() IRawResultStream
+	GetOutputBody   func() map[string]interface{}
+	stream          streaming.MapStream
+	responseHeaders http.Header
+	requestURL      *url.URL
+	ctx             context.Context
+	Msg             *BackendMessages
+	Err             error
 }
 
 func (ex ExecutorOutput) ResultToMap() (IRawResultStream, error) {
@@ -30,6 +37,40 @@ func (ex ExecutorOutput) GetStream() streaming.MapStream {
 	return ex.stream
 }
 
+// WithResponse returns a copy of the output that carries the headers
+// of the HTTP response from which it was produced, and the URL of
+// its request, against which relative header values are resolved.
+func (ex ExecutorOutput) WithResponse(response *http.Response) ExecutorOutput {
+	if response == nil {
+		return ex
+	}
+	ex.responseHeaders = response.Header
+	if response.Request != nil {
+		ex.requestURL = response.Request.URL
+	}
+	return ex
+}
+
+func (ex ExecutorOutput) GetResponseHeaders() http.Header {
+	return ex.responseHeaders
+}
+
+func (ex ExecutorOutput) GetRequestURL() (*url.URL, bool) {
+	return ex.requestURL, ex.requestURL != nil
+}
+
+// WithContext returns a copy of the output that carries the
+// context of the query from which it was produced, such that
+// lines logged in presenting the output identify the query.
+func (ex ExecutorOutput) WithContext(ctx context.Context) ExecutorOutput {
+	ex.ctx = ctx
+	return ex
+}
+
+func (ex ExecutorOutput) GetContext() (context.Context, bool) {
+	return ex.ctx, ex.ctx != nil
+}
+
 func NewExecutorOutput(result sqldata.ISQLResultStream, body map[string]interface{}, rawResult map[int]map[int]interface{}, msg *BackendMessages, err error) ExecutorOutput {
 	return newExecutorOutput(result, body, rawResult, msg, err)
 }
@@ -1,11 +1,14 @@
 package internaldto
 
 import (
+	"context"
+
 	"github.com/jeroenrinzema/psql-wire/pkg/sqldata"
 	"github.com/stackql/stackql/internal/stackql/dto"
 )
 
 type OutputContext struct {
+	Context        context.Context
 	RuntimeContext dto.RuntimeCtx
 	Result         sqldata.ISQLResultStream
 }
@@ -1,6 +1,7 @@
 package internaldto
 
 import (
+	"context"
 	"io"
 
 	"github.com/stackql/stackql/internal/stackql/dto"
@@ -12,11 +13,14 @@ var (
 
 type BasicPrimitiveContext interface {
 	GetAuthContext(prov string) (*dto.AuthCtx, error)
+	GetContext() context.Context
 	GetErrWriter() io.Writer
 	GetWriter() io.Writer
+	WithContext(context.Context) BasicPrimitiveContext
 }
 
 type standardBasicPrimitiveContext struct {
+	ctx       context.Context
 	body      map[string]interface{}
 	authCtx   func(string) (*dto.AuthCtx, error)
 	writer    io.Writer
@@ -42,3 +46,15 @@ func (bpp *standardBasicPrimitiveContext) GetWriter() io.Writer {
 func (bpp *standardBasicPrimitiveContext) GetErrWriter() io.Writer {
 	return bpp.errWriter
 }
+
+func (bpp *standardBasicPrimitiveContext) GetContext() context.Context {
+	if bpp.ctx == nil {
+		return context.Background()
+	}
+	return bpp.ctx
+}
+
+func (bpp *standardBasicPrimitiveContext) WithContext(ctx context.Context) BasicPrimitiveContext {
+	bpp.ctx = ctx
+	return bpp
+}
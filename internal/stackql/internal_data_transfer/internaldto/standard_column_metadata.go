@@ -76,3 +76,29 @@ func NewColDescriptor(col openapistackql.ColumnDescriptor, relTypeStr string) Co
 		column:   col,
 	}
 }
+
+type renamedColumnMetadata struct {
+	ColumnMetadata
+	name string
+}
+
+func (cd *renamedColumnMetadata) GetName() string {
+	return cd.name
+}
+
+func (cd *renamedColumnMetadata) GetDecorated() string {
+	return cd.name
+}
+
+func (cd *renamedColumnMetadata) GetIdentifier() string {
+	return cd.name
+}
+
+// NewRenamedColumnMetadata presents an existing column under another name,
+// eg: where a column is projected through an enclosing query.
+func NewRenamedColumnMetadata(col ColumnMetadata, name string) ColumnMetadata {
+	return &renamedColumnMetadata{
+		ColumnMetadata: col,
+		name:           name,
+	}
+}
@@ -10,6 +10,24 @@ func GetStatementNotSupportedError(stmtName string) error {
 	return fmt.Errorf("statement type = '%s' not yet supported", stmtName)
 }
 
+// GetQueryCancelledError wraps the cause of a cancelled query context,
+// eg: context.Canceled or context.DeadlineExceeded.
+func GetQueryCancelledError(cause error) error {
+	return fmt.Errorf("query cancelled: %w", cause)
+}
+
+// GetBudgetExceededError names the query budget that was breached,
+// eg: "max_http_requests_per_query".
+func GetBudgetExceededError(budgetName string, limit int) error {
+	return fmt.Errorf("query budget '%s' exceeded: limit = %d", budgetName, limit)
+}
+
+// GetSafetyPolicyError names the safety policy setting
+// that refused a statement, eg: "readonly".
+func GetSafetyPolicyError(settingName string, detail string) error {
+	return fmt.Errorf("refused by safety policy '%s': %s", settingName, detail)
+}
+
 func PrintErrorAndExitOneIfNil(subject interface{}, msg string) {
 	if subject == nil {
 		fmt.Fprintln(os.Stderr, fmt.Sprintln(msg))
@@ -0,0 +1,328 @@
+package lateral
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/parserutil"
+)
+
+const (
+	// BaseTableAlias is the alias of the inner select,
+	// upon which the expansion is performed.
+	BaseTableAlias string = "stackql_lateral"
+	// KeyColumnName and ValueColumnName are the physical column names
+	// of an expansion, being the array index or object key and the
+	// corresponding element or property value.
+	KeyColumnName   string = "key"
+	ValueColumnName string = "value"
+	// unnestTableName marks the derived table into
+	// which an UNNEST table expression is rewritten.
+	unnestTableName string = "stackql_unnest"
+)
+
+// Unnest describes an UNNEST table expression, ie:
+// "UNNEST(<expr>) [AS] <alias> [([<key>, ]<value>)]".
+type Unnest interface {
+	GetExpr() sqlparser.Expr
+	GetKeyColumnName() string
+	GetValueColumnName() string
+}
+
+type standardUnnest struct {
+	expr            sqlparser.Expr
+	keyColumnName   string
+	valueColumnName string
+}
+
+func (u *standardUnnest) GetExpr() sqlparser.Expr {
+	return u.expr
+}
+
+func (u *standardUnnest) GetKeyColumnName() string {
+	return u.keyColumnName
+}
+
+func (u *standardUnnest) GetValueColumnName() string {
+	return u.valueColumnName
+}
+
+// ParseTableExpr decodes an UNNEST table expression, as rewritten
+// by RewriteUnnest, ie: the derived table
+// "(SELECT <expr>, '<key>', '<value>' FROM stackql_unnest) AS <alias>".
+func ParseTableExpr(tableExpr *sqlparser.AliasedTableExpr) (Unnest, bool) {
+	subquery, isSubquery := tableExpr.Expr.(*sqlparser.Subquery)
+	if !isSubquery {
+		return nil, false
+	}
+	sel, isSelect := subquery.Select.(*sqlparser.Select)
+	if !isSelect || len(sel.From) != 1 || len(sel.SelectExprs) != 3 {
+		return nil, false
+	}
+	fromExpr, isAliased := sel.From[0].(*sqlparser.AliasedTableExpr)
+	if !isAliased {
+		return nil, false
+	}
+	tableName, isTableName := fromExpr.Expr.(sqlparser.TableName)
+	if !isTableName || !tableName.Qualifier.IsEmpty() || tableName.Name.GetRawVal() != unnestTableName {
+		return nil, false
+	}
+	var exprs []sqlparser.Expr
+	for _, selectExpr := range sel.SelectExprs {
+		aliasedExpr, isAliasedExpr := selectExpr.(*sqlparser.AliasedExpr)
+		if !isAliasedExpr {
+			return nil, false
+		}
+		exprs = append(exprs, aliasedExpr.Expr)
+	}
+	keyColumnName, isKeyStr := exprs[1].(*sqlparser.SQLVal)
+	valueColumnName, isValueStr := exprs[2].(*sqlparser.SQLVal)
+	if !isKeyStr || !isValueStr || keyColumnName.Type != sqlparser.StrVal || valueColumnName.Type != sqlparser.StrVal {
+		return nil, false
+	}
+	return &standardUnnest{
+		expr:            exprs[0],
+		keyColumnName:   string(keyColumnName.Val),
+		valueColumnName: string(valueColumnName.Val),
+	}, true
+}
+
+// NewTableExpr returns the table expression of
+// "UNNEST(<expr>) AS <alias>", as would be parsed
+// from the query rewritten by RewriteUnnest.
+func NewTableExpr(expr sqlparser.Expr, alias string) *sqlparser.AliasedTableExpr {
+	return &sqlparser.AliasedTableExpr{
+		Expr: &sqlparser.Subquery{
+			Select: &sqlparser.Select{
+				SelectExprs: sqlparser.SelectExprs{
+					&sqlparser.AliasedExpr{Expr: expr},
+					&sqlparser.AliasedExpr{Expr: sqlparser.NewStrVal([]byte(KeyColumnName))},
+					&sqlparser.AliasedExpr{Expr: sqlparser.NewStrVal([]byte(ValueColumnName))},
+				},
+				From: sqlparser.TableExprs{
+					&sqlparser.AliasedTableExpr{
+						Expr: sqlparser.TableName{Name: sqlparser.NewTableIdent(unnestTableName)},
+					},
+				},
+			},
+		},
+		As: sqlparser.NewTableIdent(alias),
+	}
+}
+
+// RewriteUnnest rewrites each "[LATERAL] UNNEST(<expr>) [AS] <alias> [(<columns>)]"
+// table expression into a derived table that ParseTableExpr decodes, because the
+// parser does not support table valued functions.  The argument is retained
+// verbatim, to be parsed along with the remainder of the query.  Table expressions
+// are recognised from tokens, and so not within string literals or comments.
+// Queries that do not tokenize are returned unchanged, for the parser to report.
+func RewriteUnnest(query string) (string, error) {
+	tokens, err := parserutil.ScanTokens(query)
+	if err != nil {
+		return query, nil
+	}
+	r := &rewriter{
+		query:   query,
+		tokens:  tokens,
+		clauses: []string{""},
+	}
+	return r.rewrite()
+}
+
+type rewriter struct {
+	query  string
+	tokens []parserutil.Token
+	sb     strings.Builder
+	// Offset of the query from which output is pending.
+	written int
+	// Last clause keyword seen at each parenthesis depth.
+	clauses []string
+	// Last significant token, upper cased, and its index.
+	prevWord  string
+	prevIndex int
+}
+
+func (r *rewriter) rewrite() (string, error) {
+	for i := 0; i < len(r.tokens); i++ {
+		tkn := r.tokens[i]
+		if tkn.Type == sqlparser.COMMENT {
+			continue
+		}
+		word := r.getWord(i)
+		switch word {
+		case "(":
+			r.clauses = append(r.clauses, "")
+		case ")":
+			if len(r.clauses) > 1 {
+				r.clauses = r.clauses[:len(r.clauses)-1]
+			}
+		case "SELECT", "FROM", "WHERE", "GROUP", "HAVING", "ORDER", "LIMIT", "ON", "UNION":
+			r.clauses[len(r.clauses)-1] = word
+		case "JOIN":
+			r.clauses[len(r.clauses)-1] = "FROM"
+		case "UNNEST":
+			argsStart, hasArgs := r.next(i)
+			if hasArgs && r.getWord(argsStart) == "(" && r.isTableExprContext() {
+				end, err := r.rewriteUnnest(i, argsStart)
+				if err != nil {
+					return "", err
+				}
+				i = end
+				r.prevWord, r.prevIndex = "", end
+				continue
+			}
+		}
+		r.prevWord, r.prevIndex = word, i
+	}
+	r.sb.WriteString(r.query[r.written:])
+	return r.sb.String(), nil
+}
+
+// getWord returns the upper cased text of a keyword, unquoted
+// identifier or punctuation token and otherwise the empty string.
+func (r *rewriter) getWord(i int) string {
+	tkn := r.tokens[i]
+	raw := tkn.GetRaw(r.query)
+	if tkn.Type == sqlparser.STRING || strings.HasPrefix(raw, `"`) || strings.HasPrefix(raw, "`") {
+		return ""
+	}
+	return strings.ToUpper(raw)
+}
+
+// getColumnName returns the name of an UNNEST column, which,
+// being rendered as a literal, may be a keyword, eg: key.
+func (r *rewriter) getColumnName(i int) (string, bool) {
+	tkn := r.tokens[i]
+	if tkn.Type == sqlparser.ID {
+		return string(tkn.Value), true
+	}
+	raw := tkn.GetRaw(r.query)
+	for j, c := range raw {
+		isLetter := c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+		if !isLetter && (j == 0 || c < '0' || c > '9') {
+			return "", false
+		}
+	}
+	return raw, raw != ""
+}
+
+// next returns the index of the significant token that follows index i.
+func (r *rewriter) next(i int) (int, bool) {
+	for j := i + 1; j < len(r.tokens); j++ {
+		if r.tokens[j].Type != sqlparser.COMMENT {
+			return j, true
+		}
+	}
+	return 0, false
+}
+
+// isTableExprContext determines whether the next token begins a table expression.
+func (r *rewriter) isTableExprContext() bool {
+	switch r.prevWord {
+	case "FROM", "JOIN", "LATERAL":
+		return true
+	case ",":
+		clause := r.clauses[len(r.clauses)-1]
+		return clause == "FROM" || clause == "ON"
+	default:
+		return false
+	}
+}
+
+// closing returns the index of the token that closes
+// the parenthesis opened by the token at index i.
+func (r *rewriter) closing(i int) (int, error) {
+	depth := 0
+	for j := i; j < len(r.tokens); j++ {
+		switch r.getWord(j) {
+		case "(":
+			depth++
+		case ")":
+			depth--
+			if depth == 0 {
+				return j, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("unbalanced parentheses in UNNEST expression")
+}
+
+// rewriteUnnest consumes the UNNEST table expression at index start,
+// whose argument list opens at index argsStart, and returns
+// the index of the last token of the table expression.
+func (r *rewriter) rewriteUnnest(start int, argsStart int) (int, error) {
+	argsEnd, err := r.closing(argsStart)
+	if err != nil {
+		return 0, err
+	}
+	expr := strings.TrimSpace(r.query[r.tokens[argsStart].End:r.tokens[argsEnd].Start])
+	if expr == "" {
+		return 0, fmt.Errorf("UNNEST requires an argument")
+	}
+	aliasIndex, hasAlias := r.next(argsEnd)
+	if hasAlias && r.getWord(aliasIndex) == "AS" {
+		aliasIndex, hasAlias = r.next(aliasIndex)
+	}
+	if !hasAlias || r.tokens[aliasIndex].Type != sqlparser.ID {
+		return 0, fmt.Errorf("UNNEST requires an alias, eg: UNNEST(%s) AS elem", expr)
+	}
+	alias := r.tokens[aliasIndex].GetRaw(r.query)
+	keyColumnName, valueColumnName := KeyColumnName, ValueColumnName
+	end := aliasIndex
+	if columnsStart, hasColumns := r.next(aliasIndex); hasColumns && r.getWord(columnsStart) == "(" {
+		columnsEnd, err := r.closing(columnsStart)
+		if err != nil {
+			return 0, err
+		}
+		var columns []string
+		isNameExpected := true
+		for j := columnsStart + 1; j < columnsEnd; j++ {
+			if r.tokens[j].Type == sqlparser.COMMENT {
+				continue
+			}
+			if isNameExpected {
+				name, isName := r.getColumnName(j)
+				if !isName {
+					return 0, fmt.Errorf("UNNEST alias '%s' must name its columns with identifiers", alias)
+				}
+				columns = append(columns, name)
+			} else if r.getWord(j) != "," {
+				return 0, fmt.Errorf("UNNEST alias '%s' must name its columns with identifiers", alias)
+			}
+			isNameExpected = !isNameExpected
+		}
+		if isNameExpected {
+			return 0, fmt.Errorf("UNNEST alias '%s' must name its columns with identifiers", alias)
+		}
+		switch len(columns) {
+		case 1:
+			valueColumnName = columns[0]
+		case 2:
+			keyColumnName, valueColumnName = columns[0], columns[1]
+		default:
+			return 0, fmt.Errorf("UNNEST alias '%s' may name at most two columns, ie: key and value", alias)
+		}
+		if keyColumnName == "" || valueColumnName == "" {
+			return 0, fmt.Errorf("UNNEST alias '%s' has an empty column name", alias)
+		}
+		end = columnsEnd
+	}
+	// LATERAL is implicit.
+	if r.prevWord == "LATERAL" {
+		start = r.prevIndex
+	}
+	r.sb.WriteString(r.query[r.written:r.tokens[start].Start])
+	r.sb.WriteString(
+		fmt.Sprintf(
+			"(SELECT %s, %s, %s FROM %s) AS %s",
+			expr,
+			sqlparser.String(sqlparser.NewStrVal([]byte(keyColumnName))),
+			sqlparser.String(sqlparser.NewStrVal([]byte(valueColumnName))),
+			unnestTableName,
+			alias,
+		),
+	)
+	r.written = r.tokens[end].End
+	return end, nil
+}
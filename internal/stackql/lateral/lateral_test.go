@@ -0,0 +1,165 @@
+package lateral_test
+
+import (
+	"testing"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/lateral"
+)
+
+// getUnnests returns the UNNEST table expressions of a
+// parsed query, keyed by alias and rendered as
+// "<expr>|<key column>|<value column>".
+func getUnnests(t *testing.T, query string) map[string]string {
+	t.Helper()
+	statement, err := sqlparser.Parse(query)
+	if err != nil {
+		t.Fatalf("cannot parse rewritten query '%s': %v", query, err)
+	}
+	rv := make(map[string]string)
+	sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		tableExpr, isAliased := node.(*sqlparser.AliasedTableExpr)
+		if !isAliased {
+			return true, nil
+		}
+		if unnest, isUnnest := lateral.ParseTableExpr(tableExpr); isUnnest {
+			rv[tableExpr.As.GetRawVal()] = sqlparser.String(unnest.GetExpr()) + "|" + unnest.GetKeyColumnName() + "|" + unnest.GetValueColumnName()
+		}
+		return true, nil
+	}, statement)
+	return rv
+}
+
+func TestRewriteUnnest(t *testing.T) {
+	testCases := []struct {
+		name    string
+		query   string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:  "comma join",
+			query: "select i.name, d.value from google.compute.instances i, unnest(i.disks) as d",
+			want:  map[string]string{"d": `"i".disks|key|value`},
+		},
+		{
+			name:  "chained with function argument and no AS",
+			query: "SELECT 1 FROM t, UNNEST(t.x) d, UNNEST(json_extract(d.value, '$.licenses')) lic",
+			want: map[string]string{
+				"d":   `"t".x|key|value`,
+				"lic": `json_extract("d".value, '$.licenses')|key|value`,
+			},
+		},
+		{
+			name:  "left join with renamed columns",
+			query: "select l.k from t left join unnest(t.labels) as l(k, v) on 1 = 1",
+			want:  map[string]string{"l": `"t".labels|k|v`},
+		},
+		{
+			name:  "keyword column names",
+			query: "select l.key from t left join unnest(t.labels) as l(key, value) on 1 = 1",
+			want:  map[string]string{"l": `"t".labels|key|value`},
+		},
+		{
+			name:  "value column only, quoted",
+			query: `select l.v from t, unnest(t.labels) as l("v")`,
+			want:  map[string]string{"l": `"t".labels|key|v`},
+		},
+		{
+			name:  "lateral and comments",
+			query: "select 1 from t, lateral /* c */ unnest(t.x) /* c */ as u -- c\nwhere t.id = 1",
+			want:  map[string]string{"u": `"t".x|key|value`},
+		},
+		{
+			name:  "literals and comments are not rewritten",
+			query: "select 'from t, unnest(x) as y' from t /* , unnest(x) as y */ where t.a = 'unnest(x)'",
+			want:  map[string]string{},
+		},
+		{
+			name:  "function named unnest in a projection",
+			query: "select a, unnest(t.x) from t",
+			want:  map[string]string{},
+		},
+		{
+			name:  "after a join condition",
+			query: "select 1 from t inner join s on t.id = s.id, unnest(s.x) as u",
+			want:  map[string]string{"u": `"s".x|key|value`},
+		},
+		{
+			name:    "missing alias",
+			query:   "select 1 from t, unnest(t.x) where t.id = 1",
+			wantErr: true,
+		},
+		{
+			name:    "missing argument",
+			query:   "select 1 from t, unnest( ) as u",
+			wantErr: true,
+		},
+		{
+			name:    "unbalanced",
+			query:   "select 1 from t, unnest(t.x as u",
+			wantErr: true,
+		},
+		{
+			name:    "too many columns",
+			query:   "select 1 from t, unnest(t.x) as u(a, b, c)",
+			wantErr: true,
+		},
+		{
+			name:    "malformed columns",
+			query:   "select 1 from t, unnest(t.x) as u(a b)",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rewritten, err := lateral.RewriteUnnest(tc.query)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("RewriteUnnest() error = %v, want error %t", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			got := getUnnests(t, rewritten)
+			if len(got) != len(tc.want) {
+				t.Fatalf("RewriteUnnest() = '%s', with UNNEST %v, want %v", rewritten, got, tc.want)
+			}
+			for alias, want := range tc.want {
+				if got[alias] != want {
+					t.Fatalf("UNNEST alias '%s' = %s, want %s", alias, got[alias], want)
+				}
+			}
+		})
+	}
+}
+
+func TestRewriteUnnestUntokenizable(t *testing.T) {
+	query := "select 'unterminated from t, unnest(t.x) as u"
+	rewritten, err := lateral.RewriteUnnest(query)
+	if err != nil || rewritten != query {
+		t.Fatalf("RewriteUnnest() = (%s, %v), want query unchanged", rewritten, err)
+	}
+}
+
+func TestNewTableExpr(t *testing.T) {
+	expr := &sqlparser.ColName{
+		Name:      sqlparser.NewColIdent("disks"),
+		Qualifier: sqlparser.TableName{Name: sqlparser.NewTableIdent("i")},
+	}
+	tableExpr := lateral.NewTableExpr(expr, "d")
+	unnest, isUnnest := lateral.ParseTableExpr(tableExpr)
+	if !isUnnest {
+		t.Fatalf("ParseTableExpr() did not decode NewTableExpr()")
+	}
+	if unnest.GetExpr() != expr || unnest.GetKeyColumnName() != lateral.KeyColumnName || unnest.GetValueColumnName() != lateral.ValueColumnName {
+		t.Fatalf("ParseTableExpr() = (%s, %s, %s)", sqlparser.String(unnest.GetExpr()), unnest.GetKeyColumnName(), unnest.GetValueColumnName())
+	}
+	// The table expression is that of a parsed, rewritten query.
+	rendered := "select 1 from t as i, " + sqlparser.String(tableExpr)
+	if got := getUnnests(t, rendered); got["d"] != `"i".disks|key|value` {
+		t.Fatalf("rendered NewTableExpr() = '%s', with UNNEST %v", rendered, got)
+	}
+	if _, isUnnest := lateral.ParseTableExpr(&sqlparser.AliasedTableExpr{Expr: sqlparser.TableName{Name: sqlparser.NewTableIdent("stackql_unnest")}}); isUnnest {
+		t.Fatalf("ParseTableExpr() decoded a table name")
+	}
+}
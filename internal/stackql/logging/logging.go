@@ -1,29 +1,167 @@
 package logging
 
 import (
+	"context"
+	"fmt"
 	"io/ioutil"
+	"strings"
+	"sync"
 
 	"github.com/sirupsen/logrus"
+	"github.com/stackql/stackql/internal/stackql/sessionctx"
+)
+
+const (
+	FormatText string = "text"
+	FormatJSON string = "json"
+
+	SubsystemKey string = "subsystem"
+	SessionIDKey string = "session_id"
+	UserKey      string = "user"
+	QueryIDKey   string = "query_id"
+	ProviderKey  string = "provider"
+	ResourceKey  string = "resource"
 )
 
 var (
 	logger *logrus.Logger
+
+	// subsystemLevels is populated from "--log.levels".
+	subsystemLevels    map[string]logrus.Level
+	isSubsystemLogging bool
+	subsystemLoggers   sync.Map
 )
 
-func SetLogger(logLevelStr string) {
+// SetLogger configures the root logger and, where either the json
+// format or subsystem levels are requested, per subsystem loggers.
+// Subsystem levels are comma separated, eg: "httpmiddleware=debug".
+func SetLogger(logLevelStr string, logFormat string, subsystemLevelsStr string) {
 	logger = logrus.StandardLogger()
 	logLevel, err := logrus.ParseLevel(logLevelStr)
 	if err != nil {
 		logger.Fatal(err)
 	}
 	logger.SetLevel(logLevel)
+	switch strings.ToLower(logFormat) {
+	case "", FormatText:
+	case FormatJSON:
+		logger.SetFormatter(&logrus.JSONFormatter{})
+	default:
+		logger.Fatal(fmt.Errorf("unsupported log format '%s', must be (%s | %s)", logFormat, FormatText, FormatJSON))
+	}
+	subsystemLevels, err = parseSubsystemLevels(subsystemLevelsStr)
+	if err != nil {
+		logger.Fatal(err)
+	}
+	isSubsystemLogging = strings.ToLower(logFormat) == FormatJSON || len(subsystemLevels) > 0
+	subsystemLoggers.Range(func(k, _ interface{}) bool {
+		subsystemLoggers.Delete(k)
+		return true
+	})
 }
 
+func parseSubsystemLevels(s string) (map[string]logrus.Level, error) {
+	rv := make(map[string]logrus.Level)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			return nil, fmt.Errorf("subsystem log level '%s' must be of the form '<subsystem>=<level>'", pair)
+		}
+		level, err := logrus.ParseLevel(strings.TrimSpace(kv[1]))
+		if err != nil {
+			return nil, err
+		}
+		rv[strings.ToLower(strings.TrimSpace(kv[0]))] = level
+	}
+	return rv, nil
+}
+
+// GetLogger returns the root logger, which carries neither
+// subsystem nor correlation fields.
 func GetLogger() *logrus.Logger {
-	if logger != nil {
-		return logger
+	if logger == nil {
+		tmpLogger := logrus.New()
+		tmpLogger.SetOutput(ioutil.Discard)
+		return tmpLogger
+	}
+	return logger
+}
+
+// Subsystem names the loggers of a package, eg: "httpmiddleware",
+// such that "--log.levels" may set their level apart.  Each package
+// declares its own, eg:
+//
+//	const subsystem logging.Subsystem = "httpmiddleware"
+type Subsystem string
+
+// GetLogger returns the logger of the subsystem, for lines
+// logged outside of any query, eg: upon startup.
+func (s Subsystem) GetLogger() *logrus.Logger {
+	if logger == nil || !isSubsystemLogging {
+		return GetLogger()
 	}
-	tmpLogger := logrus.New()
-	tmpLogger.SetOutput(ioutil.Discard)
-	return tmpLogger
+	return getSubsystemLogger(string(s))
+}
+
+// GetContextLogger returns the logger of the subsystem with
+// the session and query identified by the context, where known.
+func (s Subsystem) GetContextLogger(ctx context.Context) *logrus.Entry {
+	fields := logrus.Fields{}
+	if ctx != nil {
+		if session, ok := sessionctx.GetSession(ctx); ok {
+			fields[SessionIDKey] = session.ID
+			fields[UserKey] = session.User
+		}
+		if queryID, ok := sessionctx.GetQueryID(ctx); ok {
+			fields[QueryIDKey] = queryID
+		}
+	}
+	return s.GetLogger().WithFields(fields)
+}
+
+// GetResourceLogger adds provider and resource fields
+// to those of GetContextLogger.
+func (s Subsystem) GetResourceLogger(ctx context.Context, provider string, resource string) *logrus.Entry {
+	return s.GetContextLogger(ctx).WithFields(logrus.Fields{
+		ProviderKey: provider,
+		ResourceKey: resource,
+	})
+}
+
+func getSubsystemLogger(subsystem string) *logrus.Logger {
+	if l, ok := subsystemLoggers.Load(subsystem); ok {
+		return l.(*logrus.Logger)
+	}
+	level := logger.GetLevel()
+	if subsystemLevel, ok := subsystemLevels[subsystem]; ok {
+		level = subsystemLevel
+	}
+	l := &logrus.Logger{
+		Out:          logger.Out,
+		Formatter:    logger.Formatter,
+		Hooks:        make(logrus.LevelHooks),
+		Level:        level,
+		ExitFunc:     logger.ExitFunc,
+		ReportCaller: logger.ReportCaller,
+	}
+	l.AddHook(&subsystemHook{subsystem: subsystem})
+	actual, _ := subsystemLoggers.LoadOrStore(subsystem, l)
+	return actual.(*logrus.Logger)
+}
+
+type subsystemHook struct {
+	subsystem string
+}
+
+func (h *subsystemHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *subsystemHook) Fire(entry *logrus.Entry) error {
+	entry.Data[SubsystemKey] = h.subsystem
+	return nil
 }
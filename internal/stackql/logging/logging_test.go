@@ -0,0 +1,148 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stackql/stackql/internal/stackql/sessionctx"
+)
+
+// setTestLogger configures logging as per SetLogger, writing to
+// the returned buffer, and restores prior state upon cleanup.
+func setTestLogger(t *testing.T, logLevelStr string, logFormat string, subsystemLevelsStr string) *bytes.Buffer {
+	t.Helper()
+	std := logrus.StandardLogger()
+	priorLogger, priorOut, priorFormatter, priorLevel := logger, std.Out, std.Formatter, std.GetLevel()
+	priorLevels, priorIsSubsystemLogging := subsystemLevels, isSubsystemLogging
+	t.Cleanup(func() {
+		std.SetOutput(priorOut)
+		std.SetFormatter(priorFormatter)
+		std.SetLevel(priorLevel)
+		logger, subsystemLevels, isSubsystemLogging = priorLogger, priorLevels, priorIsSubsystemLogging
+		subsystemLoggers.Range(func(k, _ interface{}) bool {
+			subsystemLoggers.Delete(k)
+			return true
+		})
+	})
+	buf := &bytes.Buffer{}
+	SetLogger(logLevelStr, logFormat, subsystemLevelsStr)
+	logger.SetOutput(buf)
+	return buf
+}
+
+func readLines(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+	var rv []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		fields := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(line), &fields); err != nil {
+			t.Fatalf("cannot parse log line '%s': %v", line, err)
+		}
+		rv = append(rv, fields)
+	}
+	return rv
+}
+
+func TestParseSubsystemLevels(t *testing.T) {
+	for _, tc := range []struct {
+		input   string
+		want    map[string]logrus.Level
+		wantErr bool
+	}{
+		{input: "", want: map[string]logrus.Level{}},
+		{input: "httpmiddleware=debug", want: map[string]logrus.Level{"httpmiddleware": logrus.DebugLevel}},
+		{
+			input: " httpmiddleware = debug , Driver=warn,",
+			want:  map[string]logrus.Level{"httpmiddleware": logrus.DebugLevel, "driver": logrus.WarnLevel},
+		},
+		{input: "httpmiddleware", wantErr: true},
+		{input: "=debug", wantErr: true},
+		{input: "httpmiddleware=verbose", wantErr: true},
+	} {
+		got, err := parseSubsystemLevels(tc.input)
+		if tc.wantErr {
+			if err == nil {
+				t.Fatalf("parseSubsystemLevels(%q) expected error, got %v", tc.input, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("parseSubsystemLevels(%q) unexpected error: %v", tc.input, err)
+		}
+		if len(got) != len(tc.want) {
+			t.Fatalf("parseSubsystemLevels(%q) = %v, want %v", tc.input, got, tc.want)
+		}
+		for k, v := range tc.want {
+			if got[k] != v {
+				t.Fatalf("parseSubsystemLevels(%q) = %v, want %v", tc.input, got, tc.want)
+			}
+		}
+	}
+}
+
+func TestSubsystemHook(t *testing.T) {
+	hook := &subsystemHook{subsystem: "driver"}
+	if len(hook.Levels()) != len(logrus.AllLevels) {
+		t.Fatalf("subsystem hook fires for %v, want all levels", hook.Levels())
+	}
+	entry := logrus.NewEntry(logrus.New())
+	if err := hook.Fire(entry); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if entry.Data[SubsystemKey] != "driver" {
+		t.Fatalf("%s = %v, want driver", SubsystemKey, entry.Data[SubsystemKey])
+	}
+}
+
+func TestSubsystemLevels(t *testing.T) {
+	buf := setTestLogger(t, "warn", FormatJSON, "httpmiddleware=debug")
+	Subsystem("httpmiddleware").GetLogger().Debugln("shown")
+	Subsystem("driver").GetLogger().Infoln("hidden")
+	Subsystem("driver").GetLogger().Warnln("shown")
+	GetLogger().Infoln("hidden")
+	lines := readLines(t, buf)
+	if len(lines) != 2 {
+		t.Fatalf("logged %d lines, want 2: %s", len(lines), buf.String())
+	}
+	for i, want := range []string{"httpmiddleware", "driver"} {
+		if lines[i][SubsystemKey] != want || lines[i]["msg"] != "shown" {
+			t.Fatalf("line %d = %v, want shown by subsystem %s", i, lines[i], want)
+		}
+	}
+}
+
+func TestGetContextLogger(t *testing.T) {
+	buf := setTestLogger(t, "info", FormatJSON, "")
+	ctx := sessionctx.WithQueryID(
+		sessionctx.WithSession(context.Background(), sessionctx.Session{ID: "s1", User: "alice"}),
+		"q1",
+	)
+	const subsystem Subsystem = "driver"
+	subsystem.GetContextLogger(ctx).Infoln("query")
+	subsystem.GetResourceLogger(ctx, "google", "instances").Infoln("resource")
+	subsystem.GetContextLogger(context.Background()).Infoln("no query")
+	lines := readLines(t, buf)
+	if len(lines) != 3 {
+		t.Fatalf("logged %d lines, want 3: %s", len(lines), buf.String())
+	}
+	for _, line := range lines[:2] {
+		for k, want := range map[string]string{SubsystemKey: "driver", SessionIDKey: "s1", UserKey: "alice", QueryIDKey: "q1"} {
+			if line[k] != want {
+				t.Fatalf("%s = %v, want %s in line %v", k, line[k], want, line)
+			}
+		}
+	}
+	if lines[1][ProviderKey] != "google" || lines[1][ResourceKey] != "instances" {
+		t.Fatalf("resource line = %v, want provider and resource", lines[1])
+	}
+	if _, ok := lines[2][QueryIDKey]; ok || lines[2][SubsystemKey] != "driver" {
+		t.Fatalf("line outside a query = %v, want subsystem only", lines[2])
+	}
+}
@@ -1,6 +1,7 @@
 package metadatavisitors
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/stackql/stackql/internal/stackql/constants"
@@ -16,6 +17,8 @@ import (
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 )
 
+const subsystem logging.Subsystem = "metadatavisitors"
+
 var (
 	_ TemplatedProduct = &standardTemplatedProduct{}
 )
@@ -52,9 +55,10 @@ type SchemaRequestTemplateVisitor struct {
 	PlaceholderPrettyPrinter *prettyprint.PrettyPrinter
 	visitedObjects           map[string]bool
 	requiredOnly             bool
+	ctx                      context.Context
 }
 
-func NewSchemaRequestTemplateVisitor(maxDepth int, strategy string, prettyPrinter, placeHolderPrettyPrinter *prettyprint.PrettyPrinter, requiredOnly bool) *SchemaRequestTemplateVisitor {
+func NewSchemaRequestTemplateVisitor(ctx context.Context, maxDepth int, strategy string, prettyPrinter, placeHolderPrettyPrinter *prettyprint.PrettyPrinter, requiredOnly bool) *SchemaRequestTemplateVisitor {
 	return &SchemaRequestTemplateVisitor{
 		MaxDepth:                 maxDepth,
 		Strategy:                 strategy,
@@ -62,6 +66,7 @@ func NewSchemaRequestTemplateVisitor(maxDepth int, strategy string, prettyPrinte
 		PlaceholderPrettyPrinter: placeHolderPrettyPrinter,
 		visitedObjects:           make(map[string]bool),
 		requiredOnly:             requiredOnly,
+		ctx:                      ctx,
 	}
 }
 
@@ -115,7 +120,7 @@ func isBodyParam(paramName string) bool {
 	return strings.HasPrefix(paramName, constants.RequestBodyBaseKey)
 }
 
-func ToInsertStatement(columns sqlparser.Columns, m *openapistackql.OperationStore, svc *openapistackql.Service, extended bool, prettyPrinter, placeHolderPrettyPrinter *prettyprint.PrettyPrinter, requiredOnly bool) (string, error) {
+func ToInsertStatement(ctx context.Context, columns sqlparser.Columns, m *openapistackql.OperationStore, svc *openapistackql.Service, extended bool, prettyPrinter, placeHolderPrettyPrinter *prettyprint.PrettyPrinter, requiredOnly bool) (string, error) {
 	paramsToInclude := m.GetNonBodyParameters()
 	successfullyIncludedCols := make(map[string]bool)
 	if !extended {
@@ -173,11 +178,11 @@ func ToInsertStatement(columns sqlparser.Columns, m *openapistackql.OperationSto
 			"\n)\n" + "SELECT\n" + strings.Join(exprList, ",\n") + "\n;\n", err
 	}
 
-	schemaVisitor := NewSchemaRequestTemplateVisitor(2, "", prettyPrinter, placeHolderPrettyPrinter, requiredOnly)
+	schemaVisitor := NewSchemaRequestTemplateVisitor(ctx, 2, "", prettyPrinter, placeHolderPrettyPrinter, requiredOnly)
 
 	tVal, _ := schemaVisitor.RetrieveTemplate(sch, m, extended)
 
-	logging.GetLogger().Infoln(fmt.Sprintf("tVal = %v", tVal))
+	subsystem.GetContextLogger(ctx).Infoln(fmt.Sprintf("tVal = %v", tVal))
 
 	colMap := getColsMap(columns)
 
@@ -216,12 +221,12 @@ func ToInsertStatement(columns sqlparser.Columns, m *openapistackql.OperationSto
 func (sv *SchemaRequestTemplateVisitor) processSubSchemasMap(sc *openapistackql.Schema, method *openapistackql.OperationStore, properties map[string]*openapistackql.Schema) (map[string]TemplatedProduct, error) {
 	retVal := make(map[string]TemplatedProduct)
 	for k, ss := range properties {
-		logging.GetLogger().Infoln(fmt.Sprintf("RetrieveTemplate() k = '%s', ss is nil ? '%t'", k, ss == nil))
+		subsystem.GetContextLogger(sv.ctx).Infoln(fmt.Sprintf("RetrieveTemplate() k = '%s', ss is nil ? '%t'", k, ss == nil))
 		if ss != nil && (k == "" || !sv.isVisited(k, nil)) {
 			localSchemaVisitedMap := make(map[string]bool)
 			localSchemaVisitedMap[k] = true
 			if !method.IsRequiredRequestBodyProperty(k) && (ss.ReadOnly || (sv.requiredOnly && !sc.IsRequired(k))) {
-				logging.GetLogger().Infoln(fmt.Sprintf("property = '%s' will be skipped", k))
+				subsystem.GetContextLogger(sv.ctx).Infoln(fmt.Sprintf("property = '%s' will be skipped", k))
 				continue
 			}
 			rv, err := sv.retrieveTemplateVal(ss, method.Service, ".values."+constants.RequestBodyBaseKey+k, localSchemaVisitedMap)
@@ -12,6 +12,14 @@ type IMethodSelector interface {
 	GetMethod(resource *openapistackql.Resource, methodName string) (*openapistackql.OperationStore, error)
 
 	GetMethodForAction(resource *openapistackql.Resource, iqlAction string, parameters parserutil.ColumnKeyedDatastore) (*openapistackql.OperationStore, string, error)
+
+	// GetMostSpecificMethodForAction returns the method, among those
+	// whose required parameters are supplied, that consumes the most
+	// parameters, eg: "get" in preference to "list".
+	GetMostSpecificMethodForAction(resource *openapistackql.Resource, iqlAction string, parameters map[string]interface{}) (*openapistackql.OperationStore, error)
+
+	// GetMethodsForAction returns every method that may implement the action.
+	GetMethodsForAction(resource *openapistackql.Resource, iqlAction string) []*openapistackql.OperationStore
 }
 
 func NewMethodSelector(provider string, version string) (IMethodSelector, error) {
@@ -49,6 +57,51 @@ func (sel *DefaultMethodSelector) GetMethodForAction(resource *openapistackql.Re
 	return m, methodName, err
 }
 
+func (sel *DefaultMethodSelector) GetMostSpecificMethodForAction(resource *openapistackql.Resource, iqlAction string, parameters map[string]interface{}) (*openapistackql.OperationStore, error) {
+	var rv *openapistackql.OperationStore
+	leastRemaining := -1
+	for _, m := range sel.GetMethodsForAction(resource, iqlAction) {
+		remainingParams, ok := m.ParameterMatch(parameters)
+		if !ok {
+			continue
+		}
+		if leastRemaining < 0 || len(remainingParams) < leastRemaining {
+			rv = m
+			leastRemaining = len(remainingParams)
+		}
+	}
+	if rv == nil {
+		return nil, fmt.Errorf("no appropriate method = '%s' for resource = '%s'", iqlAction, resource.Name)
+	}
+	return rv, nil
+}
+
+// GetMethodsForAction returns methods mapped to the action, falling
+// back to methods named for it.  Update falls back to "patch" ahead
+// of "update", so that partial request bodies are accepted.
+func (sel *DefaultMethodSelector) GetMethodsForAction(resource *openapistackql.Resource, iqlAction string) []*openapistackql.OperationStore {
+	var rv []*openapistackql.OperationStore
+	iqlAction = strings.ToLower(iqlAction)
+	for _, ref := range resource.SQLVerbs[iqlAction] {
+		if ref.Value != nil {
+			rv = append(rv, ref.Value)
+		}
+	}
+	if len(rv) > 0 {
+		return rv
+	}
+	methodNames := resource.GetDefaultMethodKeysForSQLVerb(iqlAction)
+	if iqlAction == "update" {
+		methodNames = []string{"patch", "update"}
+	}
+	for _, methodName := range methodNames {
+		if m, err := resource.FindMethod(methodName); err == nil {
+			rv = append(rv, m)
+		}
+	}
+	return rv
+}
+
 func (sel *DefaultMethodSelector) GetMethod(resource *openapistackql.Resource, methodName string) (*openapistackql.OperationStore, error) {
 	return sel.getMethodByName(resource, methodName)
 }
@@ -0,0 +1,180 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+)
+
+const (
+	namespace string = "stackql"
+
+	OutcomeSuccess   string = "success"
+	OutcomeError     string = "error"
+	OutcomeCancelled string = "cancelled"
+
+	CacheHit  string = "hit"
+	CacheMiss string = "miss"
+)
+
+var (
+	// statementTypes bounds the "type" label of query metrics,
+	// other leading keywords being reported as "other".
+	statementTypes map[string]struct{} = map[string]struct{}{
+		"auth":     {},
+		"create":   {},
+		"delete":   {},
+		"describe": {},
+		"drop":     {},
+		"exec":     {},
+		"explain":  {},
+		"insert":   {},
+		"purge":    {},
+		"registry": {},
+		"select":   {},
+		"show":     {},
+		"update":   {},
+		"use":      {},
+	}
+
+	registry *prometheus.Registry = prometheus.NewRegistry()
+
+	activeConnections = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "active_connections",
+		Help:      "Postgres wire protocol client connections currently open.",
+	})
+	queriesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "queries_total",
+		Help:      "Queries executed, by statement type and outcome.",
+	}, []string{"type", "outcome"})
+	queryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "query_duration_seconds",
+		Help:      "Query latency, from planning to completion, by statement type.",
+		Buckets:   prometheus.ExponentialBuckets(0.005, 2.5, 12),
+	}, []string{"type"})
+	httpRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "provider_http_requests_total",
+		Help:      "Provider HTTP requests, by provider and response status code, or 'error' absent a response.",
+	}, []string{"provider", "status"})
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "provider_http_request_duration_seconds",
+		Help:      "Provider HTTP request latency, by provider.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"provider"})
+	pagesFetched = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "pages_fetched_total",
+		Help:      "Pages of provider responses processed, by provider.",
+	}, []string{"provider"})
+	analyticsCacheLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "analytics_cache_lookups_total",
+		Help:      "Analytics cache lookups, by result (hit | miss).",
+	}, []string{"result"})
+	gcRuns = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "gc_runs_total",
+		Help:      "Garbage collection runs.",
+	})
+	gcReclaimedRows = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "gc_reclaimed_rows_total",
+		Help:      "Rows deleted by garbage collection.",
+	})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		activeConnections,
+		queriesTotal,
+		queryDuration,
+		httpRequestsTotal,
+		httpRequestDuration,
+		pagesFetched,
+		analyticsCacheLookups,
+		gcRuns,
+		gcReclaimedRows,
+	)
+}
+
+func ConnectionOpened() {
+	activeConnections.Inc()
+}
+
+func ConnectionClosed() {
+	activeConnections.Dec()
+}
+
+// GetStatementType returns the lowercased leading keyword
+// of the query, after any comments, eg: "select", or "other".
+func GetStatementType(query string) string {
+	fields := strings.Fields(strings.ToLower(sqlparser.StripLeadingComments(query)))
+	if len(fields) == 0 {
+		return "other"
+	}
+	stmtType := strings.TrimRight(fields[0], ";")
+	if _, ok := statementTypes[stmtType]; !ok {
+		return "other"
+	}
+	return stmtType
+}
+
+func getOutcome(err error) string {
+	switch {
+	case err == nil:
+		return OutcomeSuccess
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return OutcomeCancelled
+	default:
+		return OutcomeError
+	}
+}
+
+func ObserveQuery(query string, err error, duration time.Duration) {
+	stmtType := GetStatementType(query)
+	queriesTotal.WithLabelValues(stmtType, getOutcome(err)).Inc()
+	queryDuration.WithLabelValues(stmtType).Observe(duration.Seconds())
+}
+
+func ObserveHTTPRequest(provider string, response *http.Response, err error, duration time.Duration) {
+	status := "error"
+	if err == nil && response != nil {
+		status = strconv.Itoa(response.StatusCode)
+	}
+	httpRequestsTotal.WithLabelValues(provider, status).Inc()
+	httpRequestDuration.WithLabelValues(provider).Observe(duration.Seconds())
+}
+
+func ObservePage(provider string) {
+	pagesFetched.WithLabelValues(provider).Inc()
+}
+
+func ObserveAnalyticsCacheLookup(isHit bool) {
+	if isHit {
+		analyticsCacheLookups.WithLabelValues(CacheHit).Inc()
+		return
+	}
+	analyticsCacheLookups.WithLabelValues(CacheMiss).Inc()
+}
+
+func ObserveGCRun() {
+	gcRuns.Inc()
+}
+
+func AddGCReclaimedRows(n int64) {
+	gcReclaimedRows.Add(float64(n))
+}
@@ -0,0 +1,126 @@
+package metrics_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stackql/stackql/internal/stackql/metrics"
+)
+
+func get(t *testing.T, handler http.Handler, path string) (int, string) {
+	t.Helper()
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+	body, err := io.ReadAll(recorder.Result().Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return recorder.Code, string(body)
+}
+
+// scrape returns the samples of the text exposition, keyed
+// by metric name and labels, eg: `stackql_gc_runs_total`.
+func scrape(t *testing.T) map[string]float64 {
+	t.Helper()
+	code, body := get(t, metrics.NewHandler(nil), metrics.MetricsPath)
+	if code != http.StatusOK {
+		t.Fatalf("GET %s status = %d", metrics.MetricsPath, code)
+	}
+	samples := make(map[string]float64)
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		line := scanner.Text()
+		i := strings.LastIndex(line, " ")
+		if strings.HasPrefix(line, "#") || i < 0 {
+			continue
+		}
+		value, err := strconv.ParseFloat(line[i+1:], 64)
+		if err != nil {
+			t.Fatalf("cannot parse sample '%s': %v", line, err)
+		}
+		samples[line[:i]] = value
+	}
+	return samples
+}
+
+func TestGetStatementType(t *testing.T) {
+	for query, want := range map[string]string{
+		"select * from t":                      "select",
+		"  SELECT 1;":                          "select",
+		"/* comment */ insert into t select 1": "insert",
+		"show;":                                "show",
+		"pragma table_info(t)":                 "other",
+		"":                                     "other",
+		"-- comment\nregistry pull google v1;": "registry",
+	} {
+		if got := metrics.GetStatementType(query); got != want {
+			t.Fatalf("GetStatementType(%q) = %s, want %s", query, got, want)
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	before := scrape(t)
+	metrics.ObserveQuery("select 1", nil, time.Millisecond)
+	metrics.ObserveQuery("delete from t", fmt.Errorf("failed"), time.Millisecond)
+	metrics.ObserveQuery("select 1", fmt.Errorf("timed out: %w", context.DeadlineExceeded), time.Millisecond)
+	metrics.ObserveHTTPRequest("google", &http.Response{StatusCode: 404}, nil, time.Millisecond)
+	metrics.ObserveHTTPRequest("google", nil, fmt.Errorf("connection refused"), time.Millisecond)
+	metrics.ObservePage("google")
+	metrics.ObserveAnalyticsCacheLookup(true)
+	metrics.ObserveAnalyticsCacheLookup(false)
+	metrics.ObserveAnalyticsCacheLookup(false)
+	metrics.ObserveGCRun()
+	metrics.AddGCReclaimedRows(7)
+	metrics.ConnectionOpened()
+	metrics.ConnectionOpened()
+	metrics.ConnectionClosed()
+	after := scrape(t)
+	for sample, want := range map[string]float64{
+		`stackql_queries_total{outcome="success",type="select"}`:                  1,
+		`stackql_queries_total{outcome="error",type="delete"}`:                    1,
+		`stackql_queries_total{outcome="cancelled",type="select"}`:                1,
+		`stackql_query_duration_seconds_count{type="select"}`:                     2,
+		`stackql_provider_http_requests_total{provider="google",status="404"}`:    1,
+		`stackql_provider_http_requests_total{provider="google",status="error"}`:  1,
+		`stackql_provider_http_request_duration_seconds_count{provider="google"}`: 2,
+		`stackql_pages_fetched_total{provider="google"}`:                          1,
+		`stackql_analytics_cache_lookups_total{result="hit"}`:                     1,
+		`stackql_analytics_cache_lookups_total{result="miss"}`:                    2,
+		`stackql_gc_runs_total`:           1,
+		`stackql_gc_reclaimed_rows_total`: 7,
+		`stackql_active_connections`:      1,
+	} {
+		if got := after[sample] - before[sample]; got != want {
+			t.Fatalf("%s increased by %v, want %v", sample, got, want)
+		}
+	}
+	metrics.ConnectionClosed()
+}
+
+func TestHandler(t *testing.T) {
+	var readinessErr error
+	handler := metrics.NewHandler(func() error { return readinessErr })
+	if code, body := get(t, handler, metrics.HealthPath); code != http.StatusOK || body != "ok\n" {
+		t.Fatalf("GET %s = (%d, %s)", metrics.HealthPath, code, body)
+	}
+	if code, body := get(t, handler, metrics.ReadinessPath); code != http.StatusOK || body != "ok\n" {
+		t.Fatalf("GET %s when ready = (%d, %s)", metrics.ReadinessPath, code, body)
+	}
+	readinessErr = fmt.Errorf("postgres wire server is not listening")
+	code, body := get(t, handler, metrics.ReadinessPath)
+	if code != http.StatusServiceUnavailable || body != "not ready: postgres wire server is not listening\n" {
+		t.Fatalf("GET %s when not ready = (%d, %s)", metrics.ReadinessPath, code, body)
+	}
+	if code, _ := get(t, metrics.NewHandler(nil), metrics.ReadinessPath); code != http.StatusOK {
+		t.Fatalf("GET %s absent a readiness check status = %d", metrics.ReadinessPath, code)
+	}
+}
@@ -0,0 +1,98 @@
+package metrics
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/stackql/stackql/internal/stackql/logging"
+)
+
+const subsystem logging.Subsystem = "metrics"
+
+const (
+	MetricsPath   string = "/metrics"
+	HealthPath    string = "/healthz"
+	ReadinessPath string = "/readyz"
+)
+
+// DBSizeFunc reports the size of the backend DB, in bytes.
+type DBSizeFunc func() (int64, error)
+
+// ReadinessFunc reports why the server cannot serve queries,
+// or nil if it can.
+type ReadinessFunc func() error
+
+// Serve listens upon address, returning any error in doing so,
+// and serves metrics, health and readiness in the background.
+func Serve(address string, dbSize DBSizeFunc, readiness ReadinessFunc) error {
+	if dbSize != nil {
+		err := registry.Register(newDBSizeCollector(dbSize))
+		if err != nil {
+			return err
+		}
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+	subsystem.GetLogger().Infof("metrics server is up and running at [%s]", address)
+	go func() {
+		if err := http.Serve(listener, NewHandler(readiness)); err != nil {
+			subsystem.GetLogger().Errorf("metrics server error: %s", err.Error())
+		}
+	}()
+	return nil
+}
+
+// NewHandler serves metrics, health and readiness.
+func NewHandler(readiness ReadinessFunc) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(MetricsPath, promhttp.HandlerFor(registry, promhttp.HandlerOpts{ErrorHandling: promhttp.ContinueOnError}))
+	mux.HandleFunc(HealthPath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "ok")
+	})
+	mux.HandleFunc(ReadinessPath, func(w http.ResponseWriter, r *http.Request) {
+		if readiness != nil {
+			if err := readiness(); err != nil {
+				http.Error(w, fmt.Sprintf("not ready: %s", err.Error()), http.StatusServiceUnavailable)
+				return
+			}
+		}
+		fmt.Fprintln(w, "ok")
+	})
+	return mux
+}
+
+// dbSizeCollector queries the size of the backend DB upon each scrape.
+type dbSizeCollector struct {
+	dbSize DBSizeFunc
+	desc   *prometheus.Desc
+}
+
+func newDBSizeCollector(dbSize DBSizeFunc) prometheus.Collector {
+	return &dbSizeCollector{
+		dbSize: dbSize,
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "backend", "db_size_bytes"),
+			"Size of the backend DB.",
+			nil,
+			nil,
+		),
+	}
+}
+
+func (c *dbSizeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *dbSizeCollector) Collect(ch chan<- prometheus.Metric) {
+	size, err := c.dbSize()
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.desc, err)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(size))
+}
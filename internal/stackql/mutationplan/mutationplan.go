@@ -0,0 +1,331 @@
+package mutationplan
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/stackql/stackql/internal/stackql/redaction"
+)
+
+const (
+	// Version is incremented upon incompatible changes to the plan format.
+	Version int = 1
+)
+
+var (
+	_ Recorder = &capturingRecorder{}
+	_ Recorder = &verifyingRecorder{}
+
+	verbRegexp *regexp.Regexp = regexp.MustCompile(`(?i)^\s*(?:/\*.*?\*/\s*)*([a-z]+)\b`)
+	// keyColumnRegexp matches the names of columns that identify resources.
+	keyColumnRegexp *regexp.Regexp = regexp.MustCompile(`^(?i:id|name|key|uid|arn|selfLink)$|(?:Id|_id|_ID|ID)$`)
+)
+
+// Call is a single mutating provider API call, as rendered at plan time.
+type Call struct {
+	Statement  int             `json:"statement"`
+	Action     string          `json:"action"`
+	Provider   string          `json:"provider"`
+	Method     string          `json:"method"`
+	HTTPMethod string          `json:"httpMethod"`
+	URL        string          `json:"url"`
+	Body       json.RawMessage `json:"body,omitempty"`
+}
+
+// NewCall renders the request body, which must be JSON
+// to be presented as such, and is otherwise presented as text.
+// Secrets are redacted from the URL and body, as plans are
+// written to disk and routinely shared for review.
+func NewCall(rd redaction.Redactor, provider, method, httpMethod string, u *url.URL, body []byte) Call {
+	rv := Call{
+		Provider:   provider,
+		Method:     method,
+		HTTPMethod: httpMethod,
+		URL:        rd.RedactURL(u),
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return rv
+	}
+	body = rd.RedactBody(body)
+	var buf bytes.Buffer
+	if json.Valid(body) && json.Compact(&buf, body) == nil {
+		rv.Body = buf.Bytes()
+		return rv
+	}
+	rv.Body, _ = json.Marshal(rd.RedactText(string(body)))
+	return rv
+}
+
+func (c Call) String() string {
+	return fmt.Sprintf("statement %d: %s %s %s (%s.%s)", c.Statement, c.Action, c.HTTPMethod, c.URL, c.Provider, c.Method)
+}
+
+func (c Call) equals(other Call) bool {
+	return c.Statement == other.Statement &&
+		c.Action == other.Action &&
+		c.Provider == other.Provider &&
+		c.Method == other.Method &&
+		c.HTTPMethod == other.HTTPMethod &&
+		c.URL == other.URL &&
+		bytes.Equal(c.Body, other.Body)
+}
+
+// Read is the digest of the key columns of the result set of a
+// single statement, against which live state is compared at apply time.
+type Read struct {
+	Statement int      `json:"statement"`
+	Query     string   `json:"query"`
+	Keys      []string `json:"keys"`
+	Rows      int      `json:"rows"`
+	Digest    string   `json:"digest"`
+}
+
+// IsKeyColumn is true of columns that identify resources, such as
+// "name", "id" or "instanceId", as opposed to describing them.
+func IsKeyColumn(name string) bool {
+	return keyColumnRegexp.MatchString(name)
+}
+
+// NewRead digests the key columns of the result set, irrespective of
+// row order, such that volatile properties, such as timestamps
+// and statuses, do not register as drift.  A result set
+// without key columns is digested in full.
+func NewRead(statement int, query string, columns []string, rows [][]interface{}) (Read, error) {
+	var keyIndices []int
+	var keys []string
+	for i, col := range columns {
+		if IsKeyColumn(col) {
+			keyIndices = append(keyIndices, i)
+			keys = append(keys, col)
+		}
+	}
+	if len(keyIndices) == 0 {
+		for i := range columns {
+			keyIndices = append(keyIndices, i)
+		}
+		keys = append([]string{}, columns...)
+	}
+	encodedRows := make([]string, len(rows))
+	for i, row := range rows {
+		keyRow := make([]interface{}, len(keyIndices))
+		for j, k := range keyIndices {
+			if k < len(row) {
+				keyRow[j] = row[k]
+			}
+		}
+		b, err := json.Marshal(keyRow)
+		if err != nil {
+			return Read{}, err
+		}
+		encodedRows[i] = string(b)
+	}
+	sort.Strings(encodedRows)
+	h := sha256.New()
+	b, err := json.Marshal(keys)
+	if err != nil {
+		return Read{}, err
+	}
+	h.Write(b)
+	for _, r := range encodedRows {
+		io.WriteString(h, "\n")
+		io.WriteString(h, r)
+	}
+	return Read{
+		Statement: statement,
+		Query:     strings.TrimSpace(query),
+		Keys:      keys,
+		Rows:      len(rows),
+		Digest:    hex.EncodeToString(h.Sum(nil)),
+	}, nil
+}
+
+// Plan is the output of "stackql plan" and the input of "stackql apply".
+type Plan struct {
+	Version int    `json:"version"`
+	Script  string `json:"script"`
+	Reads   []Read `json:"reads"`
+	Calls   []Call `json:"calls"`
+}
+
+func NewPlan(script string) *Plan {
+	return &Plan{
+		Version: Version,
+		Script:  script,
+		Reads:   []Read{},
+		Calls:   []Call{},
+	}
+}
+
+func Unmarshal(b []byte) (*Plan, error) {
+	var rv Plan
+	if err := json.Unmarshal(b, &rv); err != nil {
+		return nil, fmt.Errorf("cannot read plan: %s", err.Error())
+	}
+	if rv.Version != Version {
+		return nil, fmt.Errorf("cannot read plan of version %d, only version %d is supported", rv.Version, Version)
+	}
+	// bodies are presented indented
+	for i, call := range rv.Calls {
+		if len(call.Body) == 0 {
+			continue
+		}
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, call.Body); err != nil {
+			return nil, fmt.Errorf("cannot read plan: %s", err.Error())
+		}
+		rv.Calls[i].Body = buf.Bytes()
+	}
+	return &rv, nil
+}
+
+// Drift describes each difference between the plan and
+// a later plan of the same script; empty means no drift.
+func (p *Plan) Drift(live *Plan) []string {
+	var rv []string
+	for i := 0; i < len(p.Reads) || i < len(live.Reads); i++ {
+		switch {
+		case i >= len(live.Reads):
+			rv = append(rv, fmt.Sprintf("statement %d no longer returns results", p.Reads[i].Statement))
+		case i >= len(p.Reads):
+			rv = append(rv, fmt.Sprintf("statement %d now returns results", live.Reads[i].Statement))
+		case p.Reads[i].Statement != live.Reads[i].Statement || p.Reads[i].Digest != live.Reads[i].Digest:
+			rv = append(rv, fmt.Sprintf("statement %d results have changed: %d rows planned, %d rows now", p.Reads[i].Statement, p.Reads[i].Rows, live.Reads[i].Rows))
+		}
+	}
+	for i := 0; i < len(p.Calls) || i < len(live.Calls); i++ {
+		switch {
+		case i >= len(live.Calls):
+			rv = append(rv, fmt.Sprintf("planned call no longer required: %s", p.Calls[i]))
+		case i >= len(p.Calls):
+			rv = append(rv, fmt.Sprintf("unplanned call now required: %s", live.Calls[i]))
+		case !p.Calls[i].equals(live.Calls[i]):
+			rv = append(rv, fmt.Sprintf("planned call has changed: %s", p.Calls[i]))
+		}
+	}
+	return rv
+}
+
+// Recorder intercepts mutating provider API calls.
+//
+// A capturing recorder records calls in place of sending them.
+// A verifying recorder admits only the calls of a plan, in order.
+type Recorder interface {
+	IsCapturing() bool
+	// Record is called before each mutating call;
+	// an error means the call must not be sent.
+	Record(Call) error
+	// SetStatement denotes the statement presently being executed.
+	SetStatement(int, string)
+	GetCalls() []Call
+	// GetOutstandingCalls returns the planned calls not yet recorded.
+	GetOutstandingCalls() []Call
+}
+
+// GetAction returns the lower cased verb of the statement.
+func GetAction(query string) string {
+	matches := verbRegexp.FindStringSubmatch(query)
+	if matches == nil {
+		return ""
+	}
+	return strings.ToLower(matches[1])
+}
+
+type recorderStatement struct {
+	statement int
+	action    string
+	mutex     sync.Mutex
+}
+
+func (rs *recorderStatement) SetStatement(statement int, query string) {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+	rs.statement = statement
+	rs.action = GetAction(query)
+}
+
+func (rs *recorderStatement) stamp(call Call) Call {
+	call.Statement = rs.statement
+	call.Action = rs.action
+	return call
+}
+
+func NewCapturingRecorder() Recorder {
+	return &capturingRecorder{}
+}
+
+type capturingRecorder struct {
+	recorderStatement
+	calls []Call
+}
+
+func (cr *capturingRecorder) IsCapturing() bool {
+	return true
+}
+
+func (cr *capturingRecorder) Record(call Call) error {
+	cr.mutex.Lock()
+	defer cr.mutex.Unlock()
+	cr.calls = append(cr.calls, cr.stamp(call))
+	return nil
+}
+
+func (cr *capturingRecorder) GetCalls() []Call {
+	cr.mutex.Lock()
+	defer cr.mutex.Unlock()
+	return append([]Call{}, cr.calls...)
+}
+
+func (cr *capturingRecorder) GetOutstandingCalls() []Call {
+	return nil
+}
+
+func NewVerifyingRecorder(planned []Call) Recorder {
+	return &verifyingRecorder{
+		planned: planned,
+	}
+}
+
+type verifyingRecorder struct {
+	recorderStatement
+	planned []Call
+	next    int
+}
+
+func (vr *verifyingRecorder) IsCapturing() bool {
+	return false
+}
+
+func (vr *verifyingRecorder) Record(call Call) error {
+	vr.mutex.Lock()
+	defer vr.mutex.Unlock()
+	call = vr.stamp(call)
+	if vr.next >= len(vr.planned) {
+		return fmt.Errorf("refusing unplanned call: %s", call)
+	}
+	if !vr.planned[vr.next].equals(call) {
+		return fmt.Errorf("refusing call that departs from plan: %s; planned: %s", call, vr.planned[vr.next])
+	}
+	vr.next++
+	return nil
+}
+
+func (vr *verifyingRecorder) GetCalls() []Call {
+	vr.mutex.Lock()
+	defer vr.mutex.Unlock()
+	return append([]Call{}, vr.planned[:vr.next]...)
+}
+
+func (vr *verifyingRecorder) GetOutstandingCalls() []Call {
+	vr.mutex.Lock()
+	defer vr.mutex.Unlock()
+	return append([]Call{}, vr.planned[vr.next:]...)
+}
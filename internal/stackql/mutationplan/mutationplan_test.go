@@ -0,0 +1,225 @@
+package mutationplan_test
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stackql/stackql/internal/stackql/mutationplan"
+	"github.com/stackql/stackql/internal/stackql/redaction"
+)
+
+func newRedactor() redaction.Redactor {
+	return redaction.NewRedactor(redaction.DefaultFields, redaction.DefaultHeaders, redaction.DefaultQueryKeys)
+}
+
+func newCall(t *testing.T, rawURL string, body string) mutationplan.Call {
+	t.Helper()
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return mutationplan.NewCall(newRedactor(), "google", "insert", "POST", u, []byte(body))
+}
+
+func TestNewCall(t *testing.T) {
+	testCases := []struct {
+		name     string
+		url      string
+		body     string
+		wantURL  string
+		wantBody string
+	}{
+		{
+			name:     "json is compacted",
+			url:      "https://example.com/v1/instances",
+			body:     "{\n  \"name\": \"a\"\n}",
+			wantURL:  "https://example.com/v1/instances",
+			wantBody: `{"name":"a"}`,
+		},
+		{
+			name:     "secrets are redacted",
+			url:      "https://example.com/v1/instances?key=abc&view=full",
+			body:     `{"name": "a", "settings": {"password": "hunter2"}}`,
+			wantURL:  "https://example.com/v1/instances?key=%5BREDACTED%5D&view=full",
+			wantBody: `{"name":"a","settings":{"password":"[REDACTED]"}}`,
+		},
+		{
+			name:     "text",
+			url:      "https://example.com/v1/instances",
+			body:     "not json",
+			wantURL:  "https://example.com/v1/instances",
+			wantBody: `"not json"`,
+		},
+		{
+			name:    "empty",
+			url:     "https://example.com/v1/instances",
+			body:    " ",
+			wantURL: "https://example.com/v1/instances",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			call := newCall(t, tc.url, tc.body)
+			if call.URL != tc.wantURL || string(call.Body) != tc.wantBody {
+				t.Fatalf("NewCall() = (%s, %s), want (%s, %s)", call.URL, string(call.Body), tc.wantURL, tc.wantBody)
+			}
+		})
+	}
+}
+
+func TestNewRead(t *testing.T) {
+	columns := []string{"name", "instanceId", "status"}
+	read, err := mutationplan.NewRead(0, " select 1 ", columns, [][]interface{}{{"a", "1", "RUNNING"}, {"b", "2", "RUNNING"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if read.Query != "select 1" || read.Rows != 2 || strings.Join(read.Keys, ",") != "name,instanceId" {
+		t.Fatalf("NewRead() = %+v", read)
+	}
+	testCases := []struct {
+		name      string
+		rows      [][]interface{}
+		wantDrift bool
+	}{
+		{name: "row order", rows: [][]interface{}{{"b", "2", "RUNNING"}, {"a", "1", "RUNNING"}}},
+		{name: "volatile column", rows: [][]interface{}{{"a", "1", "STOPPING"}, {"b", "2", "RUNNING"}}},
+		{name: "key column", rows: [][]interface{}{{"a", "1", "RUNNING"}, {"c", "2", "RUNNING"}}, wantDrift: true},
+		{name: "row count", rows: [][]interface{}{{"a", "1", "RUNNING"}}, wantDrift: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			live, err := mutationplan.NewRead(0, "select 1", columns, tc.rows)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if isDrift := live.Digest != read.Digest; isDrift != tc.wantDrift {
+				t.Fatalf("NewRead() drift = %t, want %t", isDrift, tc.wantDrift)
+			}
+		})
+	}
+}
+
+func TestNewReadWithoutKeyColumns(t *testing.T) {
+	columns := []string{"status"}
+	read, err := mutationplan.NewRead(0, "select 1", columns, [][]interface{}{{"RUNNING"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	live, err := mutationplan.NewRead(0, "select 1", columns, [][]interface{}{{"STOPPING"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(read.Keys, ",") != "status" || read.Digest == live.Digest {
+		t.Fatalf("NewRead() of a result set without key columns = %+v", read)
+	}
+}
+
+func TestIsKeyColumn(t *testing.T) {
+	for name, want := range map[string]bool{
+		"name":       true,
+		"ID":         true,
+		"selfLink":   true,
+		"instanceId": true,
+		"vpc_id":     true,
+		"status":     false,
+		"paid":       false,
+		"hostname":   false,
+	} {
+		if got := mutationplan.IsKeyColumn(name); got != want {
+			t.Fatalf("IsKeyColumn(%s) = %t, want %t", name, got, want)
+		}
+	}
+}
+
+func TestDrift(t *testing.T) {
+	planned := mutationplan.NewPlan("s")
+	planned.Reads = []mutationplan.Read{{Statement: 0, Rows: 1, Digest: "a"}}
+	planned.Calls = []mutationplan.Call{newCall(t, "https://example.com/a", `{"name":"a"}`)}
+	if drift := planned.Drift(planned); len(drift) != 0 {
+		t.Fatalf("Drift() of the same plan = %v", drift)
+	}
+	live := mutationplan.NewPlan("s")
+	live.Reads = []mutationplan.Read{{Statement: 0, Rows: 2, Digest: "b"}}
+	live.Calls = []mutationplan.Call{
+		newCall(t, "https://example.com/a", `{"name":"b"}`),
+		newCall(t, "https://example.com/b", ""),
+	}
+	drift := planned.Drift(live)
+	if len(drift) != 3 ||
+		!strings.Contains(drift[0], "1 rows planned, 2 rows now") ||
+		!strings.HasPrefix(drift[1], "planned call has changed") ||
+		!strings.HasPrefix(drift[2], "unplanned call now required") {
+		t.Fatalf("Drift() = %v", drift)
+	}
+}
+
+func TestCapturingRecorder(t *testing.T) {
+	recorder := mutationplan.NewCapturingRecorder()
+	if !recorder.IsCapturing() {
+		t.Fatalf("IsCapturing() = false")
+	}
+	recorder.SetStatement(2, "/* c */ DELETE FROM t")
+	if err := recorder.Record(newCall(t, "https://example.com/a", "")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	calls := recorder.GetCalls()
+	if len(calls) != 1 || calls[0].Statement != 2 || calls[0].Action != "delete" {
+		t.Fatalf("GetCalls() = %v", calls)
+	}
+}
+
+func TestVerifyingRecorder(t *testing.T) {
+	capturing := mutationplan.NewCapturingRecorder()
+	capturing.SetStatement(0, "insert into t(a) select 'a'")
+	for _, u := range []string{"https://example.com/a", "https://example.com/b"} {
+		if err := capturing.Record(newCall(t, u, "")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	recorder := mutationplan.NewVerifyingRecorder(capturing.GetCalls())
+	if recorder.IsCapturing() {
+		t.Fatalf("IsCapturing() = true")
+	}
+	recorder.SetStatement(0, "insert into t(a) select 'a'")
+	if err := recorder.Record(newCall(t, "https://example.com/b", "")); err == nil {
+		t.Fatalf("Record() admitted a call out of order")
+	}
+	if err := recorder.Record(newCall(t, "https://example.com/a", "")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if outstanding := recorder.GetOutstandingCalls(); len(outstanding) != 1 || outstanding[0].URL != "https://example.com/b" {
+		t.Fatalf("GetOutstandingCalls() = %v", outstanding)
+	}
+	if err := recorder.Record(newCall(t, "https://example.com/b", "")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := recorder.Record(newCall(t, "https://example.com/b", "")); err == nil {
+		t.Fatalf("Record() admitted an unplanned call")
+	}
+	if calls := recorder.GetCalls(); len(calls) != 2 {
+		t.Fatalf("GetCalls() = %v", calls)
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	plan, err := mutationplan.Unmarshal([]byte(`{
+  "version": 1,
+  "script": "s",
+  "calls": [{"statement": 0, "body": {
+    "name": "a"
+  }}]
+}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(plan.Calls[0].Body) != `{"name":"a"}` {
+		t.Fatalf("Unmarshal() body = %s", string(plan.Calls[0].Body))
+	}
+	if _, err := mutationplan.Unmarshal([]byte(`{"version": 2}`)); err == nil {
+		t.Fatalf("Unmarshal() accepted an unsupported version")
+	}
+	if _, err := mutationplan.Unmarshal([]byte(`{`)); err == nil {
+		t.Fatalf("Unmarshal() accepted invalid JSON")
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"github.com/olekukonko/tablewriter"
 )
 
+const subsystem logging.Subsystem = "output"
+
 const (
 	errorKey               string = "error"
 	stderrPressentationStr string = "stderr"
@@ -149,18 +151,15 @@ func resToArr(res sqldata.ISQLResult) []map[string]interface{} {
 	}
 	var retVal []map[string]interface{}
 	for _, r := range res.GetRows() {
-		rowArr := r.GetRowDataNaive()
+		// Native result sets hold scanned sql.Null* values,
+		// which are unwrapped here.
+		rowArr := r.GetRowDataForPgWire()
 		if len(rowArr) == 0 {
 			continue
 		}
 		rm := make(map[string]interface{})
 		for i, c := range keys {
-			switch tp := rowArr[i].(type) {
-			case []byte:
-				rm[c] = string(tp)
-			default:
-				rm[c] = tp
-			}
+			rm[c] = rowArr[i]
 		}
 		retVal = append(retVal, rm)
 	}
@@ -170,7 +169,7 @@ func resToArr(res sqldata.ISQLResult) []map[string]interface{} {
 func (jw *JsonWriter) writeRowsFromResult(res sqldata.ISQLResultStream) error {
 	for {
 		r, err := res.Read()
-		logging.GetLogger().Debugln(fmt.Sprintf("result from stream: %v", r))
+		subsystem.GetContextLogger(jw.outputCtx.Context).Debugln(fmt.Sprintf("result from stream: %v", r))
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				rowsArr := resToArr(r)
@@ -249,7 +248,7 @@ func (tw *TableWriter) Write(res sqldata.ISQLResultStream) error {
 	for {
 		var rowsArr [][]string
 		r, err := res.Read()
-		logging.GetLogger().Debugln(fmt.Sprintf("result from stream: %v", r))
+		subsystem.GetContextLogger(tw.outputCtx.Context).Debugln(fmt.Sprintf("result from stream: %v", r))
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				if !isHeaderRead {
@@ -332,7 +331,7 @@ func (csvw *CSVWriter) Write(res sqldata.ISQLResultStream) error {
 	for {
 		var rowsArr [][]string
 		r, err := res.Read()
-		logging.GetLogger().Debugln(fmt.Sprintf("result from stream: %v", r))
+		subsystem.GetContextLogger(csvw.outputCtx.Context).Debugln(fmt.Sprintf("result from stream: %v", r))
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				if !isHeaderRead {
@@ -382,7 +381,7 @@ func (rw *RawWriter) Write(res sqldata.ISQLResultStream) error {
 	for {
 		var rowsArr [][]string
 		r, err := res.Read()
-		logging.GetLogger().Debugln(fmt.Sprintf("result from stream: %v", r))
+		subsystem.GetContextLogger(rw.outputCtx.Context).Debugln(fmt.Sprintf("result from stream: %v", r))
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				if !isHeaderRead {
@@ -430,7 +429,7 @@ func (rw *PrettyWriter) Write(res sqldata.ISQLResultStream) error {
 	for {
 		var rowsArr [][]string
 		r, err := res.Read()
-		logging.GetLogger().Debugln(fmt.Sprintf("result from stream: %v", r))
+		subsystem.GetContextLogger(rw.outputCtx.Context).Debugln(fmt.Sprintf("result from stream: %v", r))
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				if !isHeaderRead {
@@ -0,0 +1,93 @@
+package parserutil
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+)
+
+var (
+	directiveArgumentsRegex *regexp.Regexp = regexp.MustCompile(`([A-Za-z_][A-Za-z0-9_]*)\(([^)]*)\)`)
+)
+
+// ExtractCommentDirectives extends sqlparser.ExtractCommentDirectives
+// with parameterised directives of the form:
+//
+//	/*+ AWAIT(timeout=600, interval=5) */
+//
+// The directive itself is set, and each argument is keyed
+// as "<DIRECTIVE>.<argument>", eg: "AWAIT.timeout".
+func ExtractCommentDirectives(comments sqlparser.Comments) sqlparser.CommentDirectives {
+	if comments == nil {
+		return nil
+	}
+	var rewritten sqlparser.Comments
+	for _, comment := range comments {
+		rewritten = append(rewritten, []byte(expandDirectiveArguments(string(comment))))
+	}
+	return sqlparser.ExtractCommentDirectives(rewritten)
+}
+
+func expandDirectiveArguments(comment string) string {
+	return directiveArgumentsRegex.ReplaceAllStringFunc(
+		comment,
+		func(s string) string {
+			submatches := directiveArgumentsRegex.FindStringSubmatch(s)
+			directive := submatches[1]
+			expanded := []string{directive}
+			for _, arg := range strings.Split(submatches[2], ",") {
+				arg = strings.TrimSpace(arg)
+				if arg == "" {
+					continue
+				}
+				kv := strings.SplitN(arg, "=", 2)
+				key := directive + "." + strings.ToLower(strings.TrimSpace(kv[0]))
+				if len(kv) == 1 {
+					expanded = append(expanded, key)
+					continue
+				}
+				expanded = append(expanded, key+"="+strings.TrimSpace(kv[1]))
+			}
+			return strings.Join(expanded, " ")
+		},
+	)
+}
+
+// GetIntCommentDirective returns the integer value of a directive
+// or directive argument, eg: GetIntCommentDirective(d, "AWAIT.timeout").
+func GetIntCommentDirective(directives sqlparser.CommentDirectives, key string) (int, bool) {
+	if directives == nil {
+		return 0, false
+	}
+	v, ok := directives[key]
+	if !ok {
+		return 0, false
+	}
+	rv, ok := v.(int)
+	return rv, ok
+}
+
+// GetStatementComments returns the comments of those
+// statement types that support comment directives.
+func GetStatementComments(stmt sqlparser.Statement) sqlparser.Comments {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		return stmt.Comments
+	case *sqlparser.Union:
+		if sel, ok := stmt.FirstStatement.(*sqlparser.Select); ok {
+			return sel.Comments
+		}
+	case *sqlparser.Insert:
+		return stmt.Comments
+	case *sqlparser.Update:
+		return stmt.Comments
+	case *sqlparser.Delete:
+		return stmt.Comments
+	case *sqlparser.Exec:
+		return stmt.Comments
+	case *sqlparser.Show:
+		return stmt.Comments
+	}
+	return nil
+}
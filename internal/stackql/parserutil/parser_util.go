@@ -1,6 +1,7 @@
 package parserutil
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -12,6 +13,8 @@ import (
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 )
 
+const subsystem logging.Subsystem = "parserutil"
+
 const (
 	FloatBitSize int = 64
 )
@@ -151,7 +154,7 @@ func isNullFromClause(from sqlparser.TableExprs) bool {
 	return true
 }
 
-func ExtractSelectValColumns(selStmt *sqlparser.Select) (map[int]map[string]interface{}, int) {
+func ExtractSelectValColumns(ctx context.Context, selStmt *sqlparser.Select) (map[int]map[string]interface{}, int) {
 	cols := make(map[int]map[string]interface{})
 	var nonValCount int
 	fromIsNull := isNullFromClause(selStmt.From)
@@ -183,12 +186,12 @@ func ExtractSelectValColumns(selStmt *sqlparser.Select) (map[int]map[string]inte
 			case sqlparser.BoolVal:
 				cols[idx] = map[string]interface{}{fmt.Sprintf("$$unaliased_col_%d", idx): expr}
 			default:
-				logging.GetLogger().Infoln(fmt.Sprintf("cannot use AliasedExpr of type '%T' as a raw value", expr))
+				subsystem.GetContextLogger(ctx).Infoln(fmt.Sprintf("cannot use AliasedExpr of type '%T' as a raw value", expr))
 				cols[idx] = nil
 				nonValCount++
 			}
 		default:
-			logging.GetLogger().Infoln(fmt.Sprintf("cannot use SelectExpr of type '%T' as a raw value", node))
+			subsystem.GetContextLogger(ctx).Infoln(fmt.Sprintf("cannot use SelectExpr of type '%T' as a raw value", node))
 			cols[idx] = nil
 			nonValCount++
 		}
@@ -196,24 +199,24 @@ func ExtractSelectValColumns(selStmt *sqlparser.Select) (map[int]map[string]inte
 	return cols, nonValCount
 }
 
-func ExtractInsertValColumns(insStmt *sqlparser.Insert) (map[int]map[int]interface{}, int, error) {
-	return extractInsertValColumns(insStmt, false)
+func ExtractInsertValColumns(ctx context.Context, insStmt *sqlparser.Insert) (map[int]map[int]interface{}, int, error) {
+	return extractInsertValColumns(ctx, insStmt, false)
 }
 
-func ExtractUpdateValColumns(upStmt *sqlparser.Update) (map[*sqlparser.ColName]interface{}, []*sqlparser.ColName, error) {
-	return extractUpdateValColumns(upStmt, false)
+func ExtractUpdateValColumns(ctx context.Context, upStmt *sqlparser.Update) (map[*sqlparser.ColName]interface{}, []*sqlparser.ColName, error) {
+	return extractUpdateValColumns(ctx, upStmt, false)
 }
 
-func ExtractInsertValColumnsPlusPlaceHolders(insStmt *sqlparser.Insert) (map[int]map[int]interface{}, int, error) {
-	return extractInsertValColumns(insStmt, false)
+func ExtractInsertValColumnsPlusPlaceHolders(ctx context.Context, insStmt *sqlparser.Insert) (map[int]map[int]interface{}, int, error) {
+	return extractInsertValColumns(ctx, insStmt, false)
 }
 
-func extractInsertValColumns(insStmt *sqlparser.Insert, includePlaceholders bool) (map[int]map[int]interface{}, int, error) {
+func extractInsertValColumns(ctx context.Context, insStmt *sqlparser.Insert, includePlaceholders bool) (map[int]map[int]interface{}, int, error) {
 	var nonValCount int
 	var err error
 	switch node := insStmt.Rows.(type) {
 	case *sqlparser.Select:
-		row, nvc := ExtractSelectValColumns(node)
+		row, nvc := ExtractSelectValColumns(ctx, node)
 		transformedRow := make(map[int]interface{})
 		for k, v := range row {
 			if v != nil {
@@ -239,13 +242,13 @@ func extractInsertValColumns(insStmt *sqlparser.Insert, includePlaceholders bool
 	return nil, nonValCount, err
 }
 
-func extractUpdateValColumns(updateStmt *sqlparser.Update, includePlaceholders bool) (map[*sqlparser.ColName]interface{}, []*sqlparser.ColName, error) {
+func extractUpdateValColumns(ctx context.Context, updateStmt *sqlparser.Update, includePlaceholders bool) (map[*sqlparser.ColName]interface{}, []*sqlparser.ColName, error) {
 	var nonValCols []*sqlparser.ColName
 	retVal := make(map[*sqlparser.ColName]interface{})
 	for _, ex := range updateStmt.Exprs {
 		switch node := ex.Expr.(type) {
 		case *sqlparser.Subquery:
-			logging.GetLogger().Infof("subquery provided for update: '%v'", node)
+			subsystem.GetContextLogger(ctx).Infof("subquery provided for update: '%v'", node)
 			return nil, nil, fmt.Errorf("subquery in update statement not yet supported")
 		case *sqlparser.SQLVal:
 			retVal[ex.Name] = string(node.Val)
@@ -300,6 +303,17 @@ func extractUpdateValColumns(updateStmt *sqlparser.Update, includePlaceholders b
 	return retVal, nonValCols, nil
 }
 
+// SplitConjunction returns the top level conjuncts of expr,
+// such that each must hold for expr to hold.
+func SplitConjunction(expr sqlparser.Expr) []sqlparser.Expr {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return append(SplitConjunction(expr.Left), SplitConjunction(expr.Right)...)
+	default:
+		return []sqlparser.Expr{expr}
+	}
+}
+
 func ExtractWhereColNames(statement *sqlparser.Where) ([]string, error) {
 	var whereNames []string
 	var err error
@@ -350,7 +364,7 @@ func ExtractSleepDuration(statement *sqlparser.Sleep) (int, error) {
 	return retVal, fmt.Errorf("sleep definition inadequate")
 }
 
-func CheckColUsagesAgainstTable(colUsages []ColumnUsageMetadata, table *openapistackql.OperationStore) error {
+func CheckColUsagesAgainstTable(ctx context.Context, colUsages []ColumnUsageMetadata, table *openapistackql.OperationStore) error {
 	for _, colUsage := range colUsages {
 		param, ok := table.GetParameter(colUsage.ColName.Name.GetRawVal())
 		if ok {
@@ -359,7 +373,7 @@ func CheckColUsagesAgainstTable(colUsages []ColumnUsageMetadata, table *openapis
 				return usageErr
 			}
 		}
-		logging.GetLogger().Debugln(fmt.Sprintf("colname = %v", colUsage.ColName))
+		subsystem.GetContextLogger(ctx).Debugln(fmt.Sprintf("colname = %v", colUsage.ColName))
 	}
 	return nil
 }
@@ -514,6 +528,11 @@ func inferColNameFromExpr(node *sqlparser.AliasedExpr, formatter sqlparser.NodeF
 			rv.DecoratedColumn = getDecoratedColRendition(decoratedColumn, alias)
 			rv.Alias = alias
 			return rv, nil
+		case *sqlparser.FuncExpr:
+			decoratedColumn := fmt.Sprintf("CAST(%s AS %s)", astformat.String(ex, formatter), astformat.String(expr.Type, formatter))
+			retVal.Name = astformat.String(expr, formatter)
+			retVal.DecoratedColumn = getDecoratedColRendition(strings.ReplaceAll(decoratedColumn, `\"`, `"`), alias)
+			return retVal, nil
 		}
 	case *sqlparser.SQLVal:
 		// As a shortcut, functions are integral types
@@ -588,9 +607,9 @@ func ExtractTableNameFromTableExpr(tableExpr sqlparser.TableExpr) (*sqlparser.Ta
 	}
 }
 
-func ExtractSingleTableFromTableExprs(tableExprs sqlparser.TableExprs) (*sqlparser.TableName, error) {
+func ExtractSingleTableFromTableExprs(ctx context.Context, tableExprs sqlparser.TableExprs) (*sqlparser.TableName, error) {
 	for _, t := range tableExprs {
-		logging.GetLogger().Infoln(fmt.Sprintf("t = %v", t))
+		subsystem.GetContextLogger(ctx).Infoln(fmt.Sprintf("t = %v", t))
 		return ExtractTableNameFromTableExpr(t)
 	}
 	return nil, fmt.Errorf("could not extract table name from TableExprs")
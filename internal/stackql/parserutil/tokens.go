@@ -0,0 +1,87 @@
+package parserutil
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+)
+
+var (
+	columnNameRegexp *regexp.Regexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*$`)
+)
+
+// Token is a lexical token of a query, with its
+// byte offsets, so that rewrites of extended syntax
+// ahead of parsing need not match inside string
+// literals, delimited identifiers or comments.
+type Token struct {
+	Type int
+	// Value is the unescaped value of
+	// literals and identifiers.
+	Value []byte
+	Start int
+	End   int
+}
+
+// GetRaw returns the query text of the token.
+func (t Token) GetRaw(query string) string {
+	return query[t.Start:t.End]
+}
+
+// GetColumnName returns the column named by the token, which may be
+// a keyword, eg: status, or a delimited identifier, eg: "selfLink".
+// The boolean is false where the token does not name a column.
+func (t Token) GetColumnName(query string) (string, bool) {
+	col := t.GetRaw(query)
+	if t.Type == sqlparser.ID {
+		col = string(t.Value)
+	}
+	if t.Type == sqlparser.STRING || !columnNameRegexp.MatchString(col) {
+		return "", false
+	}
+	return col, true
+}
+
+// NextToken returns the index of the token, other than a
+// comment, that follows index i, or -1 where there is none.
+func NextToken(tokens []Token, i int) int {
+	for j := i + 1; j < len(tokens); j++ {
+		if tokens[j].Type != sqlparser.COMMENT {
+			return j
+		}
+	}
+	return -1
+}
+
+// ScanTokens tokenizes the query with the parser's tokenizer.
+// Comments are returned as tokens of type sqlparser.COMMENT.
+func ScanTokens(query string) ([]Token, error) {
+	tkn := sqlparser.NewStringTokenizer(query)
+	tkn.SkipSpecialComments = true
+	var rv []Token
+	end := 0
+	for {
+		typ, val := tkn.Scan()
+		if typ == 0 {
+			return rv, nil
+		}
+		start := end
+		for start < len(query) && isBlank(query[start]) {
+			start++
+		}
+		// The tokenizer reads one character ahead.
+		end = tkn.Position - 1
+		if end > len(query) {
+			end = len(query)
+		}
+		if typ == sqlparser.LEX_ERROR {
+			return nil, fmt.Errorf("cannot tokenize query near position %d", start)
+		}
+		rv = append(rv, Token{Type: typ, Value: val, Start: start, End: end})
+	}
+}
+
+func isBlank(b byte) bool {
+	return b == ' ' || b == '\n' || b == '\r' || b == '\t'
+}
@@ -0,0 +1,74 @@
+package parserutil_test
+
+import (
+	"testing"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/parserutil"
+)
+
+func TestScanTokens(t *testing.T) {
+	query := "select  \"a\"\"b\", 'it''s' -- note\nfrom\tt"
+	tokens, err := parserutil.ScanTokens(query)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []struct {
+		typ   int
+		raw   string
+		value string
+	}{
+		{typ: sqlparser.SELECT, raw: "select", value: "select"},
+		{typ: sqlparser.ID, raw: `"a""b"`, value: `a"b`},
+		{typ: ',', raw: ","},
+		{typ: sqlparser.STRING, raw: "'it''s'", value: "it's"},
+		{typ: sqlparser.COMMENT, raw: "-- note\n", value: "-- note\n"},
+		{typ: sqlparser.FROM, raw: "from", value: "from"},
+		{typ: sqlparser.ID, raw: "t", value: "t"},
+	}
+	if len(tokens) != len(want) {
+		t.Fatalf("ScanTokens() returned %d tokens, want %d", len(tokens), len(want))
+	}
+	for i, w := range want {
+		if tokens[i].Type != w.typ || tokens[i].GetRaw(query) != w.raw || string(tokens[i].Value) != w.value {
+			t.Fatalf("token %d = (%d, %q, %q), want (%d, %q, %q)", i, tokens[i].Type, tokens[i].GetRaw(query), tokens[i].Value, w.typ, w.raw, w.value)
+		}
+	}
+	if _, err := parserutil.ScanTokens("select 'unterminated"); err == nil {
+		t.Fatalf("ScanTokens() tokenized an unterminated literal")
+	}
+}
+
+func TestNextTokenAndGetColumnName(t *testing.T) {
+	query := "/* c */ status, \"selfLink\", 'name', \"a-b\" -- note\n"
+	tokens, err := parserutil.ScanTokens(query)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []struct {
+		col      string
+		isColumn bool
+	}{
+		{col: "status", isColumn: true},
+		{},
+		{col: "selfLink", isColumn: true},
+		{},
+		{},
+		{},
+		{},
+	}
+	i := parserutil.NextToken(tokens, -1)
+	for _, w := range want {
+		if i < 0 {
+			t.Fatalf("NextToken() ended early, want column %q", w.col)
+		}
+		col, isColumn := tokens[i].GetColumnName(query)
+		if col != w.col || isColumn != w.isColumn {
+			t.Fatalf("GetColumnName(%q) = (%q, %t), want (%q, %t)", tokens[i].GetRaw(query), col, isColumn, w.col, w.isColumn)
+		}
+		i = parserutil.NextToken(tokens, i)
+	}
+	if i >= 0 {
+		t.Fatalf("NextToken() = %d after the last token, want -1", i)
+	}
+}
@@ -22,6 +22,8 @@ type Plan struct {
 	Rows         uint64        // Total number of rows
 	Errors       uint64        // Total number of errors
 	isCacheable  bool
+
+	commentDirectives sqlparser.CommentDirectives
 }
 
 func NewPlan(
@@ -47,3 +49,13 @@ func (p *Plan) IsCacheable() bool {
 func (p *Plan) SetCacheable(isCacheable bool) {
 	p.isCacheable = isCacheable
 }
+
+// Comment directives of the top level statement;
+// retained so that they apply to cached plans.
+func (p *Plan) GetCommentDirectives() sqlparser.CommentDirectives {
+	return p.commentDirectives
+}
+
+func (p *Plan) SetCommentDirectives(commentDirectives sqlparser.CommentDirectives) {
+	p.commentDirectives = commentDirectives
+}
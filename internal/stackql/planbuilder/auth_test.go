@@ -0,0 +1,59 @@
+package planbuilder
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/dto"
+	"github.com/stackql/stackql/internal/stackql/provider"
+)
+
+// authProvider authenticates, as does the generic provider, by
+// recording the outcome upon the status of the supplied context,
+// succeeding only for the accepted key file.
+type authProvider struct {
+	provider.IProvider
+	acceptedKeyFilePath string
+}
+
+func (p *authProvider) InferAuthType(authCtx dto.AuthCtx, authTypeRequested string) string {
+	return dto.AuthServiceAccountStr
+}
+
+func (p *authProvider) Auth(authCtx *dto.AuthCtx, authTypeRequested string, enforceRevokeFirst bool) (*http.Client, error) {
+	status := authCtx.Clone().GetStatus()
+	if authCtx.KeyFilePath != p.acceptedKeyFilePath {
+		err := fmt.Errorf("cannot read key file '%s'", authCtx.KeyFilePath)
+		status.SetFailed(err)
+		return nil, err
+	}
+	status.SetValidated("sa@example.com")
+	return &http.Client{}, nil
+}
+
+func TestAuthLogin(t *testing.T) {
+	prov := &authProvider{acceptedKeyFilePath: "/creds/good.json"}
+	authCtx := &dto.AuthCtx{Type: dto.AuthServiceAccountStr, KeyFilePath: "/creds/current.json"}
+	authCtx.GetStatus().SetValidated("current@example.com")
+
+	err := authLogin(prov, authCtx, &sqlparser.Auth{Provider: "google", Type: "serviceaccount", KeyFilePath: "/creds/bad.json"})
+	if err == nil {
+		t.Fatalf("expected error authenticating with a bad key file")
+	}
+	status := authCtx.GetStatus()
+	if authCtx.KeyFilePath != "/creds/current.json" || !status.IsValidated() || status.GetPrincipal() != "current@example.com" || status.GetLastError() != nil {
+		t.Fatalf("failed login altered the current auth: key file = '%s', validated = %t, principal = '%s', last error = %v",
+			authCtx.KeyFilePath, status.IsValidated(), status.GetPrincipal(), status.GetLastError())
+	}
+
+	err = authLogin(prov, authCtx, &sqlparser.Auth{Provider: "google", Type: "serviceaccount", KeyFilePath: "/creds/good.json"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if authCtx.KeyFilePath != "/creds/good.json" || !authCtx.Active || !status.IsValidated() || status.GetPrincipal() != "sa@example.com" {
+		t.Fatalf("successful login not adopted: key file = '%s', active = %t, validated = %t, principal = '%s'",
+			authCtx.KeyFilePath, authCtx.Active, status.IsValidated(), status.GetPrincipal())
+	}
+}
@@ -0,0 +1,208 @@
+package planbuilder
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/constants"
+	"github.com/stackql/stackql/internal/stackql/handler"
+	"github.com/stackql/stackql/internal/stackql/parserutil"
+	"github.com/stackql/stackql/internal/stackql/plan"
+	"github.com/stackql/stackql/internal/stackql/primitivebuilder"
+	"github.com/stackql/stackql/internal/stackql/returning"
+	"github.com/stackql/stackql/internal/stackql/safetypolicy"
+	"github.com/stackql/stackql/internal/stackql/tablemetadata"
+	"github.com/stackql/stackql/internal/stackql/taxonomy"
+)
+
+// bulkMutation is a DELETE or UPDATE whose WHERE clause filters
+// upon properties of the resource, rather than only supplying
+// method parameters, and which so applies to each matching row.
+type bulkMutation struct {
+	action      string
+	tableExprs  sqlparser.TableExprs
+	where       *sqlparser.Where
+	heirarchy   tablemetadata.HeirarchyObjects
+	parameters  map[string]interface{}
+	requestBody map[string]interface{}
+}
+
+// getBulkMutation identifies bulk mutations.  Statements that are
+// not, or that cannot be analysed as such, are planned as before.
+func getBulkMutation(handlerCtx handler.HandlerContext, statement sqlparser.Statement) (*bulkMutation, bool, error) {
+	var rv bulkMutation
+	var updateExprs sqlparser.UpdateExprs
+	switch node := statement.(type) {
+	case *sqlparser.Delete:
+		rv.action, rv.tableExprs, rv.where = "delete", node.TableExprs, node.Where
+	case *sqlparser.Update:
+		rv.action, rv.tableExprs, rv.where = "update", node.TableExprs, node.Where
+		updateExprs = node.Exprs
+	default:
+		return nil, false, nil
+	}
+	if rv.where == nil || len(rv.tableExprs) != 1 {
+		return nil, false, nil
+	}
+	hIds, err := taxonomy.GetHeirarchyIDsFromParserNode(handlerCtx, statement)
+	if err != nil || hIds.ContainsNativeDBMSTable() {
+		return nil, false, nil
+	}
+	if _, isView := hIds.GetView(); isView {
+		return nil, false, nil
+	}
+	if _, isSQLDataSource := handlerCtx.GetSQLDataSource(hIds.GetProviderStr()); isSQLDataSource {
+		return nil, false, nil
+	}
+	prov, err := handlerCtx.GetProvider(hIds.GetProviderStr())
+	if err != nil {
+		return nil, false, nil
+	}
+	svc, err := prov.GetServiceShard(hIds.GetServiceStr(), hIds.GetResourceStr(), handlerCtx.GetRuntimeContext())
+	if err != nil {
+		return nil, false, nil
+	}
+	rsc, err := prov.GetResource(hIds.GetServiceStr(), hIds.GetResourceStr(), handlerCtx.GetRuntimeContext())
+	if err != nil {
+		return nil, false, nil
+	}
+	methods := prov.GetMethodSelector().GetMethodsForAction(rsc, rv.action)
+	if len(methods) == 0 {
+		return nil, false, nil
+	}
+	isParameter := func(col string) bool {
+		if strings.HasPrefix(col, constants.RequestBodyBaseKey) {
+			return true
+		}
+		for _, srv := range svc.Servers {
+			if _, ok := srv.Variables[col]; ok {
+				return true
+			}
+		}
+		for _, m := range methods {
+			if _, ok := m.GetParameter(col); ok {
+				return true
+			}
+		}
+		return false
+	}
+	isBulk := false
+	err = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, isCol := node.(*sqlparser.ColName); isCol && !isParameter(col.Name.GetRawVal()) {
+			isBulk = true
+		}
+		return !isBulk, nil
+	}, rv.where)
+	if err != nil || !isBulk {
+		return nil, false, err
+	}
+	policy := handlerCtx.GetSafetyPolicy()
+	err = policy.CheckMethod(prov.GetProviderString(), rv.action, methods[0])
+	if err != nil {
+		return nil, false, err
+	}
+	if rv.action == "delete" {
+		keys := make([][]string, len(methods))
+		for i, m := range methods {
+			keys[i] = safetypolicy.GetMethodKey(m)
+		}
+		err = policy.CheckDeleteKey(rv.where, keys...)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	rv.parameters = make(map[string]interface{})
+	for _, expr := range parserutil.SplitConjunction(rv.where.Expr) {
+		comparison, isComparison := expr.(*sqlparser.ComparisonExpr)
+		if !isComparison || comparison.Operator != sqlparser.EqualStr {
+			continue
+		}
+		col, isCol := comparison.Left.(*sqlparser.ColName)
+		val, isVal := comparison.Right.(*sqlparser.SQLVal)
+		if isCol && isVal && isParameter(col.Name.GetRawVal()) {
+			rv.parameters[col.Name.GetRawVal()] = string(val.Val)
+		}
+	}
+	// as for insert columns, only "data__" prefixed
+	// columns make up the request body
+	rv.requestBody = make(map[string]interface{})
+	for _, updateExpr := range updateExprs {
+		colName := updateExpr.Name.Name.GetRawVal()
+		if !strings.HasPrefix(colName, constants.RequestBodyBaseKey) {
+			return nil, false, fmt.Errorf("bulk update sets only request body properties, prefixed '%s', not '%s'", constants.RequestBodyBaseKey, colName)
+		}
+		val, isVal := updateExpr.Expr.(*sqlparser.SQLVal)
+		if !isVal {
+			return nil, false, fmt.Errorf("bulk update supports only literal values, not '%s'", sqlparser.String(updateExpr.Expr))
+		}
+		rv.requestBody[colName] = string(val.Val)
+	}
+	ho := tablemetadata.NewHeirarchyObjects(hIds)
+	ho.SetProvider(prov)
+	ho.SetServiceHdl(svc)
+	ho.SetResource(rsc)
+	rv.heirarchy = ho
+	return &rv, true, nil
+}
+
+// buildBulkMutationPlan plans "SELECT * FROM <table> WHERE <filter>"
+// in its own right and mutates each row of the result.
+func buildBulkMutationPlan(
+	handlerCtx handler.HandlerContext,
+	qPlan *plan.Plan,
+	bm *bulkMutation,
+) (*plan.Plan, error) {
+	commentDirectives := qPlan.GetCommentDirectives()
+	if _, isReturning := returning.GetColumns(commentDirectives); isReturning {
+		return nil, fmt.Errorf("RETURNING is not supported for bulk %s", bm.action)
+	}
+	selectNode := &sqlparser.Select{
+		SelectExprs: sqlparser.SelectExprs{&sqlparser.StarExpr{}},
+		From:        bm.tableExprs,
+		Where:       bm.where,
+	}
+	buf := sqlparser.NewTrackedBuffer(formatBulkMutationSelect)
+	selectNode.Format(buf)
+	selectPlan, err := buildSubSelectPlan(handlerCtx, buf.String())
+	if err != nil {
+		return nil, err
+	}
+	pGBuilder := newPlanGraphBuilder(handlerCtx.GetRuntimeContext().ExecutionConcurrencyLimit)
+	bldr := primitivebuilder.NewBulkMutation(
+		pGBuilder.planGraph,
+		handlerCtx,
+		selectPlan.Instructions,
+		bm.heirarchy,
+		bm.action,
+		bm.parameters,
+		bm.requestBody,
+		commentDirectives,
+		commentDirectives.IsSet("AWAIT"),
+	)
+	err = bldr.Build()
+	if err != nil {
+		return nil, err
+	}
+	switch bm.action {
+	case "delete":
+		qPlan.Type = sqlparser.StmtDelete
+	default:
+		qPlan.Type = sqlparser.StmtUpdate
+	}
+	qPlan.Instructions = pGBuilder.planGraph
+	// rows are selected afresh upon each execution
+	qPlan.SetCacheable(false)
+	return qPlan, qPlan.Instructions.Optimise()
+}
+
+// formatBulkMutationSelect double quotes column names that are
+// keywords, eg: "status", as stackql does not accept backticks.
+func formatBulkMutationSelect(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+	colIdent, isColIdent := node.(sqlparser.ColIdent)
+	if !isColIdent || !strings.HasPrefix(sqlparser.String(colIdent), "`") {
+		formatExportSelect(buf, node)
+		return
+	}
+	buf.WriteString(fmt.Sprintf(`"%s"`, colIdent.String()))
+}
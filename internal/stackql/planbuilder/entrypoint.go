@@ -1,27 +1,54 @@
 package planbuilder
 
 import (
+	"fmt"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 	"github.com/stackql/stackql/internal/stackql/astanalysis/earlyanalysis"
 	"github.com/stackql/stackql/internal/stackql/handler"
+	"github.com/stackql/stackql/internal/stackql/history"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
-	"github.com/stackql/stackql/internal/stackql/logging"
+	"github.com/stackql/stackql/internal/stackql/lateral"
 	"github.com/stackql/stackql/internal/stackql/parse"
+	"github.com/stackql/stackql/internal/stackql/parserutil"
 	"github.com/stackql/stackql/internal/stackql/plan"
 	"github.com/stackql/stackql/internal/stackql/primitivegenerator"
+	"github.com/stackql/stackql/internal/stackql/returning"
+	"github.com/stackql/stackql/internal/stackql/sessionctx"
+	"github.com/stackql/stackql/internal/stackql/tracing"
+	"github.com/stackql/stackql/internal/stackql/upsert"
 )
 
 func BuildPlanFromContext(handlerCtx handler.HandlerContext) (*plan.Plan, error) {
+	return buildPlanFromContext(handlerCtx, true)
+}
+
+// buildSubSelectPlan plans a select in its own right, upon a copy
+// of the context, which is shared across queries of the session.
+// The copy carries the context and budget of the current query,
+// so the plan is neither cached nor taken from the cache.
+func buildSubSelectPlan(handlerCtx handler.HandlerContext, query string) (*plan.Plan, error) {
+	selectCtx := handlerCtx.Clone()
+	selectCtx.SetQuery(query)
+	return buildPlanFromContext(selectCtx, false)
+}
+
+func buildPlanFromContext(handlerCtx handler.HandlerContext, isCacheUsed bool) (rv *plan.Plan, err error) {
 	defer handlerCtx.GetGarbageCollector().Close()
 	tcc, err := internaldto.NewTxnControlCounters(handlerCtx.GetTxnCounterMgr())
 	handlerCtx.GetTxnStore().Put(tcc.GetTxnID())
 	defer handlerCtx.GetTxnStore().Del(tcc.GetTxnID())
-	logging.GetLogger().Debugf("tcc = %v\n", tcc)
+	subsystem.GetContextLogger(handlerCtx.GetContext()).Debugf("tcc = %v\n", tcc)
 	if err != nil {
 		return nil, err
 	}
-	planKey := handlerCtx.GetQuery()
-	if qp, ok := handlerCtx.GetLRUCache().Get(planKey); ok && isPlanCacheEnabled() {
-		logging.GetLogger().Infoln("retrieving query plan from cache")
+	planKey := getPlanKey(handlerCtx)
+	// plans of mutations being planned or applied are neither cached nor
+	// taken from the cache, so as to be built afresh against the recorder
+	isRecording := handlerCtx.GetMutationRecorder() != nil
+	isCacheUsed = isCacheUsed && !isRecording
+	if qp, ok := handlerCtx.GetLRUCache().Get(planKey); ok && isPlanCacheEnabled() && isCacheUsed {
+		subsystem.GetContextLogger(handlerCtx.GetContext()).Infoln("retrieving query plan from cache")
 		pl, ok := qp.(*plan.Plan)
 		if ok {
 			txnId, err := handlerCtx.GetTxnCounterMgr().GetNextTxnId()
@@ -38,10 +65,58 @@ func BuildPlanFromContext(handlerCtx handler.HandlerContext) (*plan.Plan, error)
 	)
 	var rowSort func(map[string]map[string]interface{}) []string
 
-	statement, err := parse.ParseQuery(handlerCtx.GetQuery())
+	_, parseSpan := tracing.Start(handlerCtx.GetContext(), "stackql.parse")
+	statement, err := rewriteAndParse(handlerCtx.GetQuery())
+	tracing.End(parseSpan, err)
+	if err != nil {
+		return createErroneousPlan(handlerCtx, qPlan, rowSort, err)
+	}
+	qPlan.SetCommentDirectives(parserutil.ExtractCommentDirectives(parserutil.GetStatementComments(statement)))
+
+	err = rewriteFlattenedColumns(handlerCtx, statement, qPlan.GetCommentDirectives())
+	if err != nil {
+		return createErroneousPlan(handlerCtx, qPlan, rowSort, err)
+	}
+
+	statement, lateralQuery, err := splitLateral(handlerCtx.GetSQLSystem(), statement)
+	if err != nil {
+		return createErroneousPlan(handlerCtx, qPlan, rowSort, err)
+	}
+
+	if exportNode, sqlDataSource, isExport := getSQLDataSourceExportTarget(handlerCtx, statement); isExport {
+		exportPlan, err := buildSQLDataSourceExportPlan(handlerCtx, qPlan, exportNode, sqlDataSource)
+		if err != nil {
+			return createErroneousPlan(handlerCtx, qPlan, rowSort, err)
+		}
+		return exportPlan, nil
+	}
+
+	bm, isBulkMutation, err := getBulkMutation(handlerCtx, statement)
 	if err != nil {
 		return createErroneousPlan(handlerCtx, qPlan, rowSort, err)
 	}
+	if isBulkMutation {
+		bulkMutationPlan, err := buildBulkMutationPlan(handlerCtx, qPlan, bm)
+		if err != nil {
+			return createErroneousPlan(handlerCtx, qPlan, rowSort, err)
+		}
+		return bulkMutationPlan, nil
+	}
+
+	historyTableExprs, err := getHistoryTableExprs(handlerCtx, statement)
+	if err != nil {
+		return createErroneousPlan(handlerCtx, qPlan, rowSort, err)
+	}
+	if len(historyTableExprs) > 0 {
+		if lateralQuery != nil {
+			return createErroneousPlan(handlerCtx, qPlan, rowSort, fmt.Errorf("UNNEST is not supported for history tables"))
+		}
+		historyPlan, err := buildHistoryPlan(handlerCtx, qPlan, statement, historyTableExprs, tcc)
+		if err != nil {
+			return createErroneousPlan(handlerCtx, qPlan, rowSort, err)
+		}
+		return historyPlan, nil
+	}
 
 	pGBuilder := newPlanGraphBuilder(handlerCtx.GetRuntimeContext().ExecutionConcurrencyLimit)
 
@@ -53,7 +128,9 @@ func BuildPlanFromContext(handlerCtx handler.HandlerContext) (*plan.Plan, error)
 	if err != nil {
 		return createErroneousPlan(handlerCtx, qPlan, rowSort, err)
 	}
+	_, analyzeSpan := tracing.Start(handlerCtx.GetContext(), "stackql.analyze")
 	err = earlyPassScreenerAnalyzer.Analyze(statement, handlerCtx, tcc)
+	tracing.End(analyzeSpan, err)
 	if err != nil {
 		return createErroneousPlan(handlerCtx, qPlan, rowSort, err)
 	}
@@ -61,7 +138,17 @@ func BuildPlanFromContext(handlerCtx handler.HandlerContext) (*plan.Plan, error)
 	statementType := earlyPassScreenerAnalyzer.GetStatementType()
 	qPlan.Type = statementType
 
-	switch earlyPassScreenerAnalyzer.GetInstructionType() {
+	instructionType := earlyPassScreenerAnalyzer.GetInstructionType()
+	if lateralQuery != nil && instructionType != earlyanalysis.StandardInstruction {
+		return createErroneousPlan(handlerCtx, qPlan, rowSort, fmt.Errorf("UNNEST is supported only for provider tables"))
+	}
+
+	_, graphSpan := tracing.Start(handlerCtx.GetContext(), "stackql.graph.build")
+	defer func() {
+		tracing.End(graphSpan, err)
+	}()
+
+	switch instructionType {
 	case earlyanalysis.InternallyRoutableInstruction:
 		createInstructionError := pGBuilder.pgInternal(earlyPassScreenerAnalyzer.GetPlanBuilderInput())
 		if createInstructionError != nil {
@@ -81,6 +168,12 @@ func BuildPlanFromContext(handlerCtx handler.HandlerContext) (*plan.Plan, error)
 		if createInstructionError != nil {
 			return nil, createInstructionError
 		}
+		if lateralQuery != nil {
+			err = lateralQuery.apply(primitiveGenerator.GetPrimitiveComposer().GetSelectPreparedStatementCtx())
+			if err != nil {
+				return createErroneousPlan(handlerCtx, qPlan, rowSort, err)
+			}
+		}
 	case earlyanalysis.NopInstruction:
 		createInstructionError := pGBuilder.nop(earlyPassScreenerAnalyzer.GetPlanBuilderInput())
 		if createInstructionError != nil {
@@ -91,6 +184,12 @@ func BuildPlanFromContext(handlerCtx handler.HandlerContext) (*plan.Plan, error)
 	if pGBuilder.planGraph.ContainsIndirect() {
 		qPlan.SetCacheable(false)
 	}
+	if _, isSet := statement.(*sqlparser.Set); isSet {
+		qPlan.SetCacheable(false)
+	}
+	if !isCacheUsed {
+		qPlan.SetCacheable(false)
+	}
 
 	qPlan.Instructions = pGBuilder.planGraph
 
@@ -106,3 +205,34 @@ func BuildPlanFromContext(handlerCtx handler.HandlerContext) (*plan.Plan, error)
 
 	return qPlan, err
 }
+
+// getPlanKey scopes cached plans to the session, in server mode,
+// since plans hold the handler context of the session that built them.
+func getPlanKey(handlerCtx handler.HandlerContext) string {
+	if session, ok := sessionctx.GetSession(handlerCtx.GetContext()); ok {
+		return fmt.Sprintf("%s:%s", session.ID, handlerCtx.GetQuery())
+	}
+	return handlerCtx.GetQuery()
+}
+
+// rewriteAndParse applies the rewrites of
+// extended syntax ahead of parsing.
+func rewriteAndParse(query string) (sqlparser.Statement, error) {
+	query, err := history.RewriteSystemTime(query)
+	if err != nil {
+		return nil, err
+	}
+	query, err = lateral.RewriteUnnest(query)
+	if err != nil {
+		return nil, err
+	}
+	query, err = returning.RewriteReturning(query)
+	if err != nil {
+		return nil, err
+	}
+	query, err = upsert.RewriteOnConflict(query)
+	if err != nil {
+		return nil, err
+	}
+	return parse.ParseQuery(query)
+}
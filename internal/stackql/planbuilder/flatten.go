@@ -0,0 +1,372 @@
+package planbuilder
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/constants"
+	"github.com/stackql/stackql/internal/stackql/flatten"
+	"github.com/stackql/stackql/internal/stackql/handler"
+	"github.com/stackql/stackql/internal/stackql/lateral"
+	"github.com/stackql/stackql/internal/stackql/taxonomy"
+)
+
+// flattenedTable holds the flattened columns
+// of a table referenced in a select.
+type flattenedTable struct {
+	qualifier        string
+	resourceKey      string
+	cfg              flatten.Config
+	columns          map[string]flatten.Column
+	topLevelColumns  []string
+	flattenedParents map[string][]flatten.Column
+	childTables      map[string]flatten.ChildTable
+}
+
+// rewriteFlattenedColumns rewrites references to flattened columns,
+// in selects against tables for which flattening is enabled, into
+// JSON extraction from the containing top level column.  Star
+// expressions over such tables are expanded to include flattened
+// columns in place of the objects from which they derive.
+// Child tables of array columns are rewritten as UNNEST of
+// the array column of the parent table that they follow.
+func rewriteFlattenedColumns(handlerCtx handler.HandlerContext, statement sqlparser.Statement, directives sqlparser.CommentDirectives) error {
+	if _, isSelect := statement.(sqlparser.SelectStatement); !isSelect {
+		return nil
+	}
+	var selects []*sqlparser.Select
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if sel, isSelect := node.(*sqlparser.Select); isSelect {
+			selects = append(selects, sel)
+		}
+		return true, nil
+	}, statement)
+	if err != nil {
+		return err
+	}
+	for _, sel := range selects {
+		err = rewriteSelectFlattenedColumns(handlerCtx, sel, directives)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func rewriteSelectFlattenedColumns(handlerCtx handler.HandlerContext, sel *sqlparser.Select, directives sqlparser.CommentDirectives) error {
+	var tables []*flattenedTable
+	for _, tableExpr := range getAliasedTableExprs(sel.From) {
+		tbl, isFlattened := getFlattenedTable(handlerCtx, tableExpr, directives)
+		if isFlattened {
+			tables = append(tables, tbl)
+			continue
+		}
+		childTbl, isChild, err := rewriteChildTable(handlerCtx, tableExpr, tables)
+		if err != nil {
+			return err
+		}
+		if isChild {
+			tables = append(tables, childTbl)
+		}
+	}
+	if len(tables) == 0 {
+		return nil
+	}
+	var selectExprs sqlparser.SelectExprs
+	for _, selectExpr := range sel.SelectExprs {
+		switch expr := selectExpr.(type) {
+		case *sqlparser.StarExpr:
+			tbl, isExpandable := getStarExprTable(expr, tables, len(getAliasedTableExprs(sel.From)))
+			if !isExpandable {
+				selectExprs = append(selectExprs, expr)
+				continue
+			}
+			selectExprs = append(selectExprs, expandStarExpr(expr, tbl)...)
+		case *sqlparser.AliasedExpr:
+			colName, isColName := expr.Expr.(*sqlparser.ColName)
+			if isColName && expr.As.IsEmpty() {
+				if col, isFlattenedCol := lookupFlattenedColumn(colName, tables); isFlattenedCol {
+					expr.As = sqlparser.NewColIdent(col.GetName())
+				}
+			}
+			selectExprs = append(selectExprs, expr)
+		default:
+			selectExprs = append(selectExprs, expr)
+		}
+	}
+	sel.SelectExprs = selectExprs
+	var rewriteErr error
+	sqlparser.Rewrite(sel, func(cursor *sqlparser.Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case *sqlparser.Subquery:
+			// Subqueries are rewritten in their own right.
+			return false
+		case *sqlparser.ColName:
+			col, isFlattenedCol := lookupFlattenedColumn(node, tables)
+			if !isFlattenedCol {
+				return true
+			}
+			expr, err := getFlattenedColumnExpr(handlerCtx, node.Qualifier, col)
+			if err != nil {
+				rewriteErr = err
+				return false
+			}
+			cursor.Replace(expr)
+		}
+		return true
+	}, nil)
+	return rewriteErr
+}
+
+func getAliasedTableExprs(tableExprs sqlparser.TableExprs) []*sqlparser.AliasedTableExpr {
+	var rv []*sqlparser.AliasedTableExpr
+	for _, tableExpr := range tableExprs {
+		switch te := tableExpr.(type) {
+		case *sqlparser.AliasedTableExpr:
+			rv = append(rv, te)
+		case *sqlparser.JoinTableExpr:
+			rv = append(rv, getAliasedTableExprs(sqlparser.TableExprs{te.LeftExpr, te.RightExpr})...)
+		case *sqlparser.ParenTableExpr:
+			rv = append(rv, getAliasedTableExprs(te.Exprs)...)
+		}
+	}
+	return rv
+}
+
+// getFlattenedTable resolves the select schema of a provider table
+// for which flattening is enabled.  Tables that do not resolve to
+// provider resources, such as views and subqueries, are not flattened.
+func getFlattenedTable(
+	handlerCtx handler.HandlerContext,
+	tableExpr *sqlparser.AliasedTableExpr,
+	directives sqlparser.CommentDirectives,
+) (*flattenedTable, bool) {
+	tableName, isTableName := tableExpr.Expr.(sqlparser.TableName)
+	if !isTableName {
+		return nil, false
+	}
+	hIDs, err := taxonomy.GetHeirarchyIDsFromParserNode(handlerCtx, tableExpr)
+	if err != nil {
+		return nil, false
+	}
+	if _, isView := hIDs.GetView(); isView || hIDs.ContainsNativeDBMSTable() {
+		return nil, false
+	}
+	if _, isSQLDataSource := handlerCtx.GetSQLDataSource(hIDs.GetProviderStr()); isSQLDataSource {
+		return nil, false
+	}
+	cfg, isEnabled := flatten.GetConfig(
+		directives,
+		handlerCtx.GetRuntimeContext(),
+		fmt.Sprintf("%s.%s.%s", hIDs.GetProviderStr(), hIDs.GetServiceStr(), hIDs.GetResourceStr()),
+	)
+	if !isEnabled {
+		return nil, false
+	}
+	prov, err := handlerCtx.GetProvider(hIDs.GetProviderStr())
+	if err != nil {
+		return nil, false
+	}
+	method, _, err := prov.GetFirstMethodForAction(hIDs.GetServiceStr(), hIDs.GetResourceStr(), "select", handlerCtx.GetRuntimeContext())
+	if err != nil {
+		return nil, false
+	}
+	schema, _, err := method.GetSelectSchemaAndObjectPath()
+	if err != nil || schema == nil {
+		return nil, false
+	}
+	tabulation := schema.Tabulate(false)
+	if tabulation == nil {
+		return nil, false
+	}
+	qualifier := tableExpr.As.GetRawVal()
+	if qualifier == "" {
+		qualifier = tableName.Name.GetRawVal()
+	}
+	rv := &flattenedTable{
+		qualifier:        qualifier,
+		resourceKey:      fmt.Sprintf("%s.%s.%s", hIDs.GetProviderStr(), hIDs.GetServiceStr(), hIDs.GetResourceStr()),
+		cfg:              cfg,
+		columns:          make(map[string]flatten.Column),
+		flattenedParents: make(map[string][]flatten.Column),
+		childTables:      make(map[string]flatten.ChildTable),
+	}
+	existingColumns := make(map[string]struct{})
+	for _, col := range tabulation.GetColumns() {
+		existingColumns[col.Name] = struct{}{}
+		rv.topLevelColumns = append(rv.topLevelColumns, col.Name)
+	}
+	sort.Strings(rv.topLevelColumns)
+	for _, col := range flatten.GetColumns(tabulation, cfg) {
+		if _, isExisting := existingColumns[col.GetName()]; isExisting {
+			subsystem.GetContextLogger(handlerCtx.GetContext()).Infof("flattened column '%s' shadowed by existing column\n", col.GetName())
+			continue
+		}
+		rv.columns[col.GetName()] = col
+		rv.flattenedParents[col.GetParentName()] = append(rv.flattenedParents[col.GetParentName()], col)
+	}
+	for _, childTable := range flatten.GetChildTables(tabulation, cfg) {
+		rv.childTables[childTable.GetName()] = childTable
+	}
+	return rv, len(rv.columns) > 0 || len(rv.childTables) > 0
+}
+
+// rewriteChildTable rewrites a reference to the child table of an array
+// column, of the form "<resource><separator><column>", as UNNEST of the
+// array column of the parent table.  The parent table must precede the
+// child table, in the same FROM clause, exactly once.  Child rows are
+// joined to the parent row from which they derive, which serves
+// as the parent key.
+func rewriteChildTable(
+	handlerCtx handler.HandlerContext,
+	tableExpr *sqlparser.AliasedTableExpr,
+	tables []*flattenedTable,
+) (*flattenedTable, bool, error) {
+	if _, isTableName := tableExpr.Expr.(sqlparser.TableName); !isTableName || len(tables) == 0 {
+		return nil, false, nil
+	}
+	// Tables other than resources, such as UNNEST, are not child tables.
+	hIDs, err := taxonomy.GetHeirarchyIDsFromParserNode(handlerCtx, tableExpr)
+	if err != nil {
+		return nil, false, nil
+	}
+	childKey := fmt.Sprintf("%s.%s.%s", hIDs.GetProviderStr(), hIDs.GetServiceStr(), hIDs.GetResourceStr())
+	var parent *flattenedTable
+	var childTable flatten.ChildTable
+	for _, tbl := range tables {
+		for name, candidate := range tbl.childTables {
+			if tbl.resourceKey+tbl.cfg.GetSeparator()+name != childKey {
+				continue
+			}
+			if parent != nil {
+				return nil, false, fmt.Errorf("child table '%s' is ambiguous; its parent table appears more than once", childKey)
+			}
+			parent, childTable = tbl, candidate
+		}
+	}
+	if parent == nil {
+		return nil, false, nil
+	}
+	alias := tableExpr.As.GetRawVal()
+	if alias == "" {
+		return nil, false, fmt.Errorf("child table '%s' requires an alias", childKey)
+	}
+	unnestExpr := lateral.NewTableExpr(
+		&sqlparser.ColName{
+			Name:      sqlparser.NewColIdent(childTable.GetName()),
+			Qualifier: sqlparser.TableName{Name: sqlparser.NewTableIdent(parent.qualifier)},
+		},
+		alias,
+	)
+	tableExpr.Expr = unnestExpr.Expr
+	rv := &flattenedTable{
+		qualifier:        alias,
+		cfg:              parent.cfg,
+		columns:          make(map[string]flatten.Column),
+		topLevelColumns:  []string{lateral.KeyColumnName, lateral.ValueColumnName},
+		flattenedParents: make(map[string][]flatten.Column),
+	}
+	for _, col := range childTable.GetColumns() {
+		if col.GetName() == lateral.KeyColumnName || col.GetName() == lateral.ValueColumnName {
+			subsystem.GetContextLogger(handlerCtx.GetContext()).Infof("flattened column '%s' shadowed by existing column\n", col.GetName())
+			continue
+		}
+		rv.columns[col.GetName()] = col
+		rv.flattenedParents[col.GetParentName()] = append(rv.flattenedParents[col.GetParentName()], col)
+	}
+	return rv, true, nil
+}
+
+// getStarExprTable returns the flattened table to which a star
+// expression expands.  Unqualified stars are expanded only
+// where the select is against a single table.
+func getStarExprTable(expr *sqlparser.StarExpr, tables []*flattenedTable, tableCount int) (*flattenedTable, bool) {
+	if expr.TableName.IsEmpty() {
+		if tableCount == 1 && len(tables) == 1 {
+			return tables[0], true
+		}
+		return nil, false
+	}
+	for _, tbl := range tables {
+		if tbl.qualifier == expr.TableName.Name.GetRawVal() {
+			return tbl, true
+		}
+	}
+	return nil, false
+}
+
+func expandStarExpr(expr *sqlparser.StarExpr, tbl *flattenedTable) sqlparser.SelectExprs {
+	var qualifier sqlparser.TableName
+	if !expr.TableName.IsEmpty() {
+		qualifier = expr.TableName
+	}
+	var rv sqlparser.SelectExprs
+	for _, colName := range tbl.topLevelColumns {
+		flattenedCols, isFlattened := tbl.flattenedParents[colName]
+		if !isFlattened {
+			rv = append(rv, &sqlparser.AliasedExpr{
+				Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(colName), Qualifier: qualifier},
+			})
+			continue
+		}
+		for _, col := range flattenedCols {
+			rv = append(rv, &sqlparser.AliasedExpr{
+				Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(col.GetName()), Qualifier: qualifier},
+				As:   sqlparser.NewColIdent(col.GetName()),
+			})
+		}
+	}
+	return rv
+}
+
+func lookupFlattenedColumn(colName *sqlparser.ColName, tables []*flattenedTable) (flatten.Column, bool) {
+	if !colName.Qualifier.IsEmpty() {
+		for _, tbl := range tables {
+			if tbl.qualifier == colName.Qualifier.Name.GetRawVal() {
+				col, isFlattened := tbl.columns[colName.Name.GetRawVal()]
+				return col, isFlattened
+			}
+		}
+		return nil, false
+	}
+	var rv flatten.Column
+	for _, tbl := range tables {
+		col, isFlattened := tbl.columns[colName.Name.GetRawVal()]
+		if !isFlattened {
+			continue
+		}
+		if rv != nil {
+			// Ambiguous references are left for later analysis.
+			return nil, false
+		}
+		rv = col
+	}
+	return rv, rv != nil
+}
+
+// getFlattenedColumnExpr returns the extraction of a flattened column.
+// The extraction is rewritten for the backend dialect here, because
+// function rewriting does not descend into conversions.  Conversion
+// is unnecessary for string properties.
+func getFlattenedColumnExpr(handlerCtx handler.HandlerContext, qualifier sqlparser.TableName, col flatten.Column) (sqlparser.Expr, error) {
+	sqlSystem := handlerCtx.GetSQLSystem()
+	funcExpr, err := sqlSystem.GetASTFuncRewriter().RewriteFunc(&sqlparser.FuncExpr{
+		Name: sqlparser.NewColIdent(constants.SQLFuncJSONExtractConformed),
+		Exprs: sqlparser.SelectExprs{
+			&sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(col.GetParentName()), Qualifier: qualifier}},
+			&sqlparser.AliasedExpr{Expr: sqlparser.NewStrVal([]byte(col.GetPath()))},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	relationalType := sqlSystem.GetRelationalType(col.GetType())
+	if relationalType == sqlSystem.GetRelationalType("string") {
+		return funcExpr, nil
+	}
+	return &sqlparser.ConvertExpr{
+		Expr: funcExpr,
+		Type: &sqlparser.ConvertType{Type: relationalType},
+	}, nil
+}
@@ -0,0 +1,111 @@
+package planbuilder
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/handler"
+	"github.com/stackql/stackql/internal/stackql/history"
+	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
+	"github.com/stackql/stackql/internal/stackql/plan"
+	"github.com/stackql/stackql/internal/stackql/primitivebuilder"
+	"github.com/stackql/stackql/internal/stackql/sql_system"
+)
+
+// getHistoryTableExprs identifies selects against tables of the form
+// "stackql_history.<provider>.<service>.<resource>", and renders
+// each such table in terms of the backend history table.
+func getHistoryTableExprs(handlerCtx handler.HandlerContext, statement sqlparser.Statement) (map[*sqlparser.AliasedTableExpr]string, error) {
+	historyTableExprs := make(map[*sqlparser.AliasedTableExpr]string)
+	if _, isSelect := statement.(sqlparser.SelectStatement); !isSelect {
+		return historyTableExprs, nil
+	}
+	var otherTableNames []string
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		tableExpr, isAliasedTableExpr := node.(*sqlparser.AliasedTableExpr)
+		if !isAliasedTableExpr {
+			return true, nil
+		}
+		tableName, isTableName := tableExpr.Expr.(sqlparser.TableName)
+		if !isTableName {
+			return true, nil
+		}
+		if !strings.EqualFold(tableName.QualifierThird.GetRawVal(), history.SchemaName) {
+			otherTableNames = append(otherTableNames, tableName.GetRawVal())
+			return true, nil
+		}
+		rendered, err := renderHistoryTableExpr(handlerCtx.GetSQLSystem(), tableExpr, tableName)
+		if err != nil {
+			return false, err
+		}
+		historyTableExprs[tableExpr] = rendered
+		return true, nil
+	}, statement)
+	if err != nil {
+		return nil, err
+	}
+	if len(historyTableExprs) > 0 && len(otherTableNames) > 0 {
+		return nil, fmt.Errorf("history tables cannot be queried alongside other tables, such as '%s'", otherTableNames[0])
+	}
+	return historyTableExprs, nil
+}
+
+func renderHistoryTableExpr(sqlSystem sql_system.SQLSystem, tableExpr *sqlparser.AliasedTableExpr, tableName sqlparser.TableName) (string, error) {
+	resource, asOf, isAsOf := history.ParseResourceIdent(tableName.Name.GetRawVal())
+	fqTableName, err := sqlSystem.GetFullyQualifiedTableName(
+		history.GetTableName(
+			fmt.Sprintf("%s.%s.%s", tableName.QualifierSecond.GetRawVal(), tableName.Qualifier.GetRawVal(), resource),
+		),
+	)
+	if err != nil {
+		return "", err
+	}
+	alias := tableExpr.As.GetRawVal()
+	if alias == "" {
+		alias = resource
+	}
+	if isAsOf {
+		return fmt.Sprintf(`( %s ) AS "%s"`, history.GetSnapshotQuery(fqTableName, asOf), alias), nil
+	}
+	return fmt.Sprintf(`%s AS "%s"`, fqTableName, alias), nil
+}
+
+// buildHistoryPlan executes the select, with
+// history tables substituted, in the backend.
+func buildHistoryPlan(
+	handlerCtx handler.HandlerContext,
+	qPlan *plan.Plan,
+	statement sqlparser.Statement,
+	historyTableExprs map[*sqlparser.AliasedTableExpr]string,
+	tcc internaldto.TxnControlCounters,
+) (*plan.Plan, error) {
+	formatter := handlerCtx.GetASTFormatter()
+	buf := sqlparser.NewTrackedBuffer(func(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+		if tableExpr, isAliasedTableExpr := node.(*sqlparser.AliasedTableExpr); isAliasedTableExpr {
+			if rendered, isHistory := historyTableExprs[tableExpr]; isHistory {
+				buf.WriteString(rendered)
+				return
+			}
+		}
+		if formatter != nil {
+			formatter(buf, node)
+			return
+		}
+		node.Format(buf)
+	})
+	statement.Format(buf)
+	query, err := handlerCtx.GetSQLSystem().SanitizeQueryString(buf.String())
+	if err != nil {
+		return nil, err
+	}
+	pGBuilder := newPlanGraphBuilder(handlerCtx.GetRuntimeContext().ExecutionConcurrencyLimit)
+	bldr := primitivebuilder.NewRawNativeSelect(pGBuilder.planGraph, handlerCtx, tcc, query)
+	err = bldr.Build()
+	if err != nil {
+		return nil, err
+	}
+	qPlan.Type = sqlparser.StmtSelect
+	qPlan.Instructions = pGBuilder.planGraph
+	return qPlan, qPlan.Instructions.Optimise()
+}
@@ -0,0 +1,486 @@
+package planbuilder
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stackql/go-openapistackql/openapistackql"
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/astformat"
+	"github.com/stackql/stackql/internal/stackql/drm"
+	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
+	"github.com/stackql/stackql/internal/stackql/lateral"
+	"github.com/stackql/stackql/internal/stackql/parserutil"
+	"github.com/stackql/stackql/internal/stackql/sql_system"
+)
+
+// lateralJoin is an UNNEST table expression,
+// joined onto the tables that precede it.
+type lateralJoin struct {
+	unnest      lateral.Unnest
+	alias       string
+	isLeftJoin  bool
+	jsonExpr    sqlparser.Expr
+	onCondition sqlparser.Expr
+}
+
+type lateralColumn struct {
+	name     string
+	baseName string
+}
+
+// lateralQuery expands JSON arrays and objects, via UNNEST,
+// over the result of an inner select that contains only
+// provider tables and is otherwise planned as per usual.
+// The outer query is rendered verbatim around the inner query
+// once the inner query is planned.
+type lateralQuery struct {
+	sqlSystem sql_system.SQLSystem
+	joins     []*lateralJoin
+	// Base columns, keyed by their rendition in the original query.
+	baseColumns    map[string]string
+	outerColumns   []lateralColumn
+	outerPrefix    string
+	outerSuffix    string
+	innerStatement *sqlparser.Select
+}
+
+// splitLateral separates UNNEST table expressions, as rewritten by lateral.RewriteUnnest,
+// from the remainder of the query.  Absent UNNEST, the statement is returned unaltered.
+func splitLateral(sqlSystem sql_system.SQLSystem, statement sqlparser.Statement) (sqlparser.Statement, *lateralQuery, error) {
+	unnestCount := countUnnestTableExprs(statement)
+	if unnestCount == 0 {
+		return statement, nil, nil
+	}
+	sel, isSelect := statement.(*sqlparser.Select)
+	if !isSelect {
+		return nil, nil, fmt.Errorf("UNNEST is supported only in SELECT queries")
+	}
+	innerFrom, joins, err := extractLateralJoins(sel.From)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(joins) != unnestCount {
+		return nil, nil, fmt.Errorf("UNNEST is supported only in the FROM clause of the outermost SELECT")
+	}
+	lq := &lateralQuery{
+		sqlSystem:   sqlSystem,
+		joins:       joins,
+		baseColumns: make(map[string]string),
+	}
+	err = lq.analyze(sel, innerFrom)
+	if err != nil {
+		return nil, nil, err
+	}
+	return lq.innerStatement, lq, nil
+}
+
+func countUnnestTableExprs(statement sqlparser.Statement) int {
+	rv := 0
+	sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if _, _, isUnnest := getUnnestTableExpr(node); isUnnest {
+			rv++
+		}
+		return true, nil
+	}, statement)
+	return rv
+}
+
+func getUnnestTableExpr(node sqlparser.SQLNode) (lateral.Unnest, string, bool) {
+	tableExpr, isAliasedTableExpr := node.(*sqlparser.AliasedTableExpr)
+	if !isAliasedTableExpr {
+		return nil, "", false
+	}
+	unnest, isUnnest := lateral.ParseTableExpr(tableExpr)
+	if !isUnnest {
+		return nil, "", false
+	}
+	return unnest, tableExpr.As.GetRawVal(), true
+}
+
+// extractLateralJoins removes UNNEST table expressions from the FROM clause.
+// A comma separated UNNEST is an inner join.
+func extractLateralJoins(tableExprs sqlparser.TableExprs) (sqlparser.TableExprs, []*lateralJoin, error) {
+	var innerFrom sqlparser.TableExprs
+	var joins []*lateralJoin
+	for i, tableExpr := range tableExprs {
+		if unnest, alias, isUnnest := getUnnestTableExpr(tableExpr); isUnnest {
+			if i == 0 {
+				return nil, nil, fmt.Errorf("UNNEST alias '%s' must follow the table that it expands", alias)
+			}
+			joins = append(joins, &lateralJoin{unnest: unnest, alias: alias})
+			continue
+		}
+		remainder, tableJoins, err := extractJoinedLateralJoins(tableExpr)
+		if err != nil {
+			return nil, nil, err
+		}
+		innerFrom = append(innerFrom, remainder)
+		joins = append(joins, tableJoins...)
+	}
+	return innerFrom, joins, nil
+}
+
+func extractJoinedLateralJoins(tableExpr sqlparser.TableExpr) (sqlparser.TableExpr, []*lateralJoin, error) {
+	joinExpr, isJoin := tableExpr.(*sqlparser.JoinTableExpr)
+	if !isJoin {
+		return tableExpr, nil, nil
+	}
+	unnest, alias, isUnnest := getUnnestTableExpr(joinExpr.RightExpr)
+	if !isUnnest {
+		return tableExpr, nil, nil
+	}
+	if len(joinExpr.Condition.Using) > 0 {
+		return nil, nil, fmt.Errorf("UNNEST alias '%s' cannot be joined with USING", alias)
+	}
+	join := &lateralJoin{
+		unnest:      unnest,
+		alias:       alias,
+		onCondition: joinExpr.Condition.On,
+	}
+	switch joinExpr.Join {
+	case sqlparser.JoinStr:
+	case sqlparser.LeftJoinStr:
+		join.isLeftJoin = true
+	default:
+		return nil, nil, fmt.Errorf("UNNEST alias '%s' cannot be joined with '%s'", alias, joinExpr.Join)
+	}
+	if _, _, isLeftUnnest := getUnnestTableExpr(joinExpr.LeftExpr); isLeftUnnest {
+		return nil, nil, fmt.Errorf("UNNEST alias '%s' must follow the table that it expands", alias)
+	}
+	remainder, joins, err := extractJoinedLateralJoins(joinExpr.LeftExpr)
+	if err != nil {
+		return nil, nil, err
+	}
+	return remainder, append(joins, join), nil
+}
+
+func (lq *lateralQuery) getJoin(alias string) (*lateralJoin, bool) {
+	for _, join := range lq.joins {
+		if join.alias == alias {
+			return join, true
+		}
+	}
+	return nil, false
+}
+
+// resolveUnnestColumn returns the physical rendition of a reference
+// to an UNNEST column, if the column is such a reference.
+func (lq *lateralQuery) resolveUnnestColumn(col *sqlparser.ColName) (*sqlparser.ColName, bool, error) {
+	qualifier := col.Qualifier.Name.GetRawVal()
+	name := col.Name.GetRawVal()
+	var candidates []*lateralJoin
+	if qualifier != "" {
+		if !col.Qualifier.Qualifier.IsEmpty() {
+			return nil, false, nil
+		}
+		join, isUnnest := lq.getJoin(qualifier)
+		if !isUnnest {
+			return nil, false, nil
+		}
+		candidates = append(candidates, join)
+	} else {
+		for _, join := range lq.joins {
+			if name == join.unnest.GetKeyColumnName() || name == join.unnest.GetValueColumnName() {
+				candidates = append(candidates, join)
+			}
+		}
+		if len(candidates) == 0 {
+			return nil, false, nil
+		}
+		if len(candidates) > 1 {
+			return nil, false, fmt.Errorf("column '%s' is ambiguous across UNNEST aliases", name)
+		}
+	}
+	join := candidates[0]
+	var physicalName string
+	switch name {
+	case join.unnest.GetKeyColumnName():
+		physicalName = lateral.KeyColumnName
+	case join.unnest.GetValueColumnName():
+		physicalName = lateral.ValueColumnName
+	default:
+		return nil, false, fmt.Errorf("UNNEST alias '%s' has no column '%s'", join.alias, name)
+	}
+	return &sqlparser.ColName{
+		Name:      sqlparser.NewColIdent(physicalName),
+		Qualifier: sqlparser.TableName{Name: sqlparser.NewTableIdent(join.alias)},
+	}, true, nil
+}
+
+func (lq *lateralQuery) referencesUnnest(expr sqlparser.Expr) (bool, error) {
+	rv := false
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		col, isCol := node.(*sqlparser.ColName)
+		if !isCol {
+			return true, nil
+		}
+		_, isUnnest, err := lq.resolveUnnestColumn(col)
+		if err != nil {
+			return false, err
+		}
+		rv = rv || isUnnest
+		return true, nil
+	}, expr)
+	return rv, err
+}
+
+// rewriteOuterExpr renders references to provider tables in terms of the inner select,
+// references to UNNEST columns in terms of their physical names, and portable functions
+// in the backend dialect.  Unqualified names among selectAliases are left as is.
+func (lq *lateralQuery) rewriteOuterExpr(expr sqlparser.Expr, selectAliases map[string]struct{}) (sqlparser.Expr, error) {
+	var err error
+	rv := sqlparser.Rewrite(expr, func(cursor *sqlparser.Cursor) bool {
+		if err != nil {
+			return false
+		}
+		switch node := cursor.Node().(type) {
+		case *sqlparser.Subquery:
+			err = fmt.Errorf("subqueries are not supported alongside UNNEST")
+			return false
+		case *sqlparser.FuncExpr:
+			var rewritten *sqlparser.FuncExpr
+			rewritten, err = lq.sqlSystem.GetASTFuncRewriter().RewriteFunc(node)
+			if err != nil {
+				return false
+			}
+			cursor.Replace(rewritten)
+		case *sqlparser.ColName:
+			if _, isSelectAlias := selectAliases[node.Name.GetRawVal()]; isSelectAlias && node.Qualifier.IsEmpty() {
+				return false
+			}
+			unnestCol, isUnnest, resolveErr := lq.resolveUnnestColumn(node)
+			if resolveErr != nil {
+				err = resolveErr
+				return false
+			}
+			if isUnnest {
+				cursor.Replace(unnestCol)
+				return false
+			}
+			cursor.Replace(&sqlparser.ColName{
+				Name:      sqlparser.NewColIdent(lq.getBaseColumnName(node)),
+				Qualifier: sqlparser.TableName{Name: sqlparser.NewTableIdent(lateral.BaseTableAlias)},
+			})
+			return false
+		}
+		return true
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	rewritten, isExpr := rv.(sqlparser.Expr)
+	if !isExpr {
+		return nil, fmt.Errorf("cannot rewrite expression of type '%T' alongside UNNEST", rv)
+	}
+	return rewritten, nil
+}
+
+// getBaseColumnName returns the name under which the inner select projects a base column.
+func (lq *lateralQuery) getBaseColumnName(col *sqlparser.ColName) string {
+	key := sqlparser.String(col)
+	if name, ok := lq.baseColumns[key]; ok {
+		return name
+	}
+	name := fmt.Sprintf("lateral_%d", len(lq.baseColumns))
+	lq.baseColumns[key] = name
+	lq.innerStatement.SelectExprs = append(
+		lq.innerStatement.SelectExprs,
+		&sqlparser.AliasedExpr{
+			Expr: &sqlparser.ColName{
+				Name:      col.Name,
+				Qualifier: col.Qualifier,
+			},
+			As: sqlparser.NewColIdent(name),
+		},
+	)
+	return name
+}
+
+func splitAndExpr(expr sqlparser.Expr) []sqlparser.Expr {
+	if andExpr, isAnd := expr.(*sqlparser.AndExpr); isAnd {
+		return append(splitAndExpr(andExpr.Left), splitAndExpr(andExpr.Right)...)
+	}
+	return []sqlparser.Expr{expr}
+}
+
+func joinAndExprs(exprs []sqlparser.Expr) sqlparser.Expr {
+	var rv sqlparser.Expr
+	for _, expr := range exprs {
+		if rv == nil {
+			rv = expr
+			continue
+		}
+		rv = &sqlparser.AndExpr{Left: rv, Right: expr}
+	}
+	return rv
+}
+
+func quoteLateralIdentifier(name string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(name, `"`, `""`))
+}
+
+func (lq *lateralQuery) analyze(sel *sqlparser.Select, innerFrom sqlparser.TableExprs) error {
+	formatter := lq.sqlSystem.GetASTFormatter()
+	lq.innerStatement = &sqlparser.Select{
+		Comments: sel.Comments,
+		From:     innerFrom,
+	}
+
+	// Conditions upon provider tables alone are retained by the inner select,
+	// so that they may parameterise requests.
+	var innerConditions, outerConditions []sqlparser.Expr
+	if sel.Where != nil {
+		for _, condition := range splitAndExpr(sel.Where.Expr) {
+			referencesUnnest, err := lq.referencesUnnest(condition)
+			if err != nil {
+				return err
+			}
+			if referencesUnnest {
+				outerConditions = append(outerConditions, condition)
+				continue
+			}
+			innerConditions = append(innerConditions, condition)
+		}
+	}
+	if len(innerConditions) > 0 {
+		lq.innerStatement.Where = sqlparser.NewWhere(sqlparser.WhereStr, joinAndExprs(innerConditions))
+	}
+
+	selectAliases := make(map[string]struct{})
+	var renderedSelectExprs []string
+	for _, selectExpr := range sel.SelectExprs {
+		aliasedExpr, isAliased := selectExpr.(*sqlparser.AliasedExpr)
+		if !isAliased {
+			return fmt.Errorf("select expression '%s' is not supported alongside UNNEST; name columns explicitly", sqlparser.String(selectExpr))
+		}
+		colHandle, err := parserutil.InferColNameFromExpr(aliasedExpr, formatter)
+		if err != nil {
+			return err
+		}
+		name := colHandle.Alias
+		if name == "" {
+			name = colHandle.Name
+		}
+		rewritten, err := lq.rewriteOuterExpr(aliasedExpr.Expr, nil)
+		if err != nil {
+			return err
+		}
+		col := lateralColumn{name: name}
+		if rewrittenCol, isCol := rewritten.(*sqlparser.ColName); isCol && rewrittenCol.Qualifier.Name.GetRawVal() == lateral.BaseTableAlias {
+			col.baseName = rewrittenCol.Name.GetRawVal()
+		}
+		lq.outerColumns = append(lq.outerColumns, col)
+		selectAliases[name] = struct{}{}
+		renderedSelectExprs = append(
+			renderedSelectExprs,
+			fmt.Sprintf("%s AS %s", astformat.String(rewritten, formatter), quoteLateralIdentifier(name)),
+		)
+	}
+
+	var suffix strings.Builder
+	suffix.WriteString(fmt.Sprintf(" ) AS %s", quoteLateralIdentifier(lateral.BaseTableAlias)))
+	for _, join := range lq.joins {
+		var err error
+		join.jsonExpr, err = lq.rewriteOuterExpr(join.unnest.GetExpr(), nil)
+		if err != nil {
+			return err
+		}
+		var onCondition string
+		if join.onCondition != nil {
+			rewrittenCondition, err := lq.rewriteOuterExpr(join.onCondition, nil)
+			if err != nil {
+				return err
+			}
+			onCondition = astformat.String(rewrittenCondition, formatter)
+		}
+		suffix.WriteString(
+			lq.sqlSystem.ComposeUnnestJoin(
+				join.isLeftJoin,
+				astformat.String(join.jsonExpr, formatter),
+				join.alias,
+				onCondition,
+			),
+		)
+	}
+	if len(outerConditions) > 0 {
+		outerWhere, err := lq.rewriteOuterExpr(joinAndExprs(outerConditions), nil)
+		if err != nil {
+			return err
+		}
+		suffix.WriteString(astformat.String(sqlparser.NewWhere(sqlparser.WhereStr, outerWhere), formatter))
+	}
+	groupBy := make(sqlparser.GroupBy, len(sel.GroupBy))
+	for i, groupExpr := range sel.GroupBy {
+		rewritten, err := lq.rewriteOuterExpr(groupExpr, selectAliases)
+		if err != nil {
+			return err
+		}
+		groupBy[i] = rewritten
+	}
+	suffix.WriteString(astformat.String(groupBy, formatter))
+	if sel.Having != nil {
+		having, err := lq.rewriteOuterExpr(sel.Having.Expr, selectAliases)
+		if err != nil {
+			return err
+		}
+		suffix.WriteString(astformat.String(sqlparser.NewWhere(sqlparser.HavingStr, having), formatter))
+	}
+	orderBy := make(sqlparser.OrderBy, len(sel.OrderBy))
+	for i, order := range sel.OrderBy {
+		rewritten, err := lq.rewriteOuterExpr(order.Expr, selectAliases)
+		if err != nil {
+			return err
+		}
+		orderBy[i] = &sqlparser.Order{Expr: rewritten, Direction: order.Direction}
+	}
+	suffix.WriteString(astformat.String(orderBy, formatter))
+	if sel.Limit != nil {
+		suffix.WriteString(astformat.String(sel.Limit, formatter))
+	}
+
+	if len(lq.innerStatement.SelectExprs) == 0 {
+		return fmt.Errorf("UNNEST requires a reference to a column of the table that it expands")
+	}
+	var distinct string
+	if sel.Distinct {
+		distinct = "DISTINCT "
+	}
+	lq.outerPrefix = fmt.Sprintf("SELECT %s%s FROM ( ", distinct, strings.Join(renderedSelectExprs, ", "))
+	lq.outerSuffix = suffix.String()
+	return nil
+}
+
+// apply renders the outer query around the planned inner select.
+func (lq *lateralQuery) apply(selCtx drm.PreparedStatementCtx) error {
+	if selCtx == nil {
+		return fmt.Errorf("UNNEST is not supported for this query")
+	}
+	prefix, suffix := lq.outerPrefix, lq.outerSuffix
+	if len(selCtx.GetIndirectContexts()) > 0 {
+		// The composed query is a format string.
+		prefix = strings.ReplaceAll(prefix, "%", "%%")
+		suffix = strings.ReplaceAll(suffix, "%", "%%")
+	}
+	innerColumns := make(map[string]internaldto.ColumnMetadata)
+	for _, col := range selCtx.GetNonControlColumns() {
+		innerColumns[col.GetIdentifier()] = col
+	}
+	var outerColumns []internaldto.ColumnMetadata
+	for _, col := range lq.outerColumns {
+		if innerCol, isBase := innerColumns[col.baseName]; isBase && col.baseName != "" {
+			outerColumns = append(outerColumns, internaldto.NewRenamedColumnMetadata(innerCol, col.name))
+			continue
+		}
+		outerColumns = append(
+			outerColumns,
+			internaldto.NewColDescriptor(
+				openapistackql.ColumnDescriptor{Name: col.name},
+				lq.sqlSystem.GetRelationalType("string"),
+			),
+		)
+	}
+	selCtx.SetQuery(prefix + selCtx.GetQuery() + suffix)
+	selCtx.SetNonControlColumns(outerColumns)
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/stackql/go-openapistackql/openapistackql"
 	"github.com/stackql/stackql/internal/stackql/astanalysis/routeanalysis"
+	"github.com/stackql/stackql/internal/stackql/dto"
 	"github.com/stackql/stackql/internal/stackql/handler"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
 	"github.com/stackql/stackql/internal/stackql/iqlerror"
@@ -19,12 +20,17 @@ import (
 	"github.com/stackql/stackql/internal/stackql/primitivebuilder"
 	"github.com/stackql/stackql/internal/stackql/primitivegenerator"
 	"github.com/stackql/stackql/internal/stackql/primitivegraph"
+	"github.com/stackql/stackql/internal/stackql/provider"
+	"github.com/stackql/stackql/internal/stackql/querybudget"
 	"github.com/stackql/stackql/internal/stackql/tablemetadata"
+	"github.com/stackql/stackql/internal/stackql/upsert"
 	"github.com/stackql/stackql/internal/stackql/util"
 
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 )
 
+const subsystem logging.Subsystem = "planbuilder"
+
 var (
 	// only string "false" will disable
 	PlanCacheEnabled string = "true"
@@ -86,7 +92,7 @@ func (pgb *planGraphBuilder) createInstructionFor(pbi planbuilderinput.PlanBuild
 		_, _, err := pgb.handleSelect(pbi)
 		return err
 	case *sqlparser.Set:
-		return pgb.nop(pbi)
+		return pgb.handleSet(pbi)
 	case *sqlparser.SetTransaction:
 		return pgb.nop(pbi)
 	case *sqlparser.Show:
@@ -149,7 +155,7 @@ func (pgb *planGraphBuilder) handleAuth(pbi planbuilderinput.PlanBuilderInput) e
 	}
 	err = primitiveGenerator.AnalyzeStatement(pbi)
 	if err != nil {
-		logging.GetLogger().Debugln(fmt.Sprintf("err = %s", err.Error()))
+		subsystem.GetContextLogger(handlerCtx.GetContext()).Debugln(fmt.Sprintf("err = %s", err.Error()))
 		return err
 	}
 	authCtx, authErr := handlerCtx.GetAuthContext(node.Provider)
@@ -159,20 +165,33 @@ func (pgb *planGraphBuilder) handleAuth(pbi planbuilderinput.PlanBuilderInput) e
 	pr := primitive.NewMetaDataPrimitive(
 		prov,
 		func(pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
-			authType := strings.ToLower(node.Type)
-			if node.KeyFilePath != "" {
-				authCtx.KeyFilePath = node.KeyFilePath
-			}
-			if node.KeyEnvVar != "" {
-				authCtx.KeyEnvVar = node.KeyEnvVar
-			}
-			_, err := prov.Auth(authCtx, authType, true)
-			return internaldto.NewExecutorOutput(nil, nil, nil, nil, err)
+			return internaldto.NewExecutorOutput(nil, nil, nil, nil, authLogin(prov, authCtx, node))
 		})
 	pgb.planGraph.CreatePrimitiveNode(pr)
 	return nil
 }
 
+// authLogin switches the credentials, and status, of the session
+// to those of the AUTH statement only once they authenticate.
+func authLogin(prov provider.IProvider, authCtx *dto.AuthCtx, node *sqlparser.Auth) error {
+	candidate := authCtx.CloneDetached()
+	if node.KeyFilePath != "" || node.KeyEnvVar != "" {
+		candidate.KeyFilePath = node.KeyFilePath
+		candidate.KeyEnvVar = node.KeyEnvVar
+	}
+	authType := prov.InferAuthType(*candidate, strings.ToLower(node.Type))
+	_, err := prov.Auth(candidate, authType, true)
+	if err != nil {
+		return err
+	}
+	authCtx.KeyFilePath = candidate.KeyFilePath
+	authCtx.KeyEnvVar = candidate.KeyEnvVar
+	authCtx.Type = authType
+	authCtx.Active = true
+	authCtx.GetStatus().Assign(candidate.GetStatus())
+	return nil
+}
+
 func (pgb *planGraphBuilder) handleAuthRevoke(pbi planbuilderinput.PlanBuilderInput) error {
 	handlerCtx := pbi.GetHandlerCtx()
 	stmt := pbi.GetStatement()
@@ -278,14 +297,15 @@ func (pgb *planGraphBuilder) handleSelect(pbi planbuilderinput.PlanBuilderInput)
 		primitiveGenerator := pgb.rootPrimitiveGenerator
 		err := primitiveGenerator.AnalyzeStatement(pbi)
 		if err != nil {
-			logging.GetLogger().Infoln(fmt.Sprintf("select statement analysis error = '%s'", err.Error()))
+			subsystem.GetContextLogger(handlerCtx.GetContext()).Infoln(fmt.Sprintf("select statement analysis error = '%s'", err.Error()))
 			return nil, nil, err
 		}
 		builder := primitiveGenerator.GetPrimitiveComposer().GetBuilder()
 		_, isNativeSelect := builder.(*primitivebuilder.NativeSelect)
 		_, isRawNativeSelect := builder.(*primitivebuilder.RawNativeSelect)
 		_, isRawNativeExec := builder.(*primitivebuilder.RawNativeExec)
-		isLocallyExecutable := !isNativeSelect && !isRawNativeSelect && !isRawNativeExec
+		_, isSQLDataSourceSelect := builder.(*primitivebuilder.SQLDataSourceSelect)
+		isLocallyExecutable := !isNativeSelect && !isRawNativeSelect && !isRawNativeExec && !isSQLDataSourceSelect
 		// check tables only if not native
 		if isLocallyExecutable {
 			for _, val := range primitiveGenerator.GetPrimitiveComposer().GetTables() {
@@ -330,7 +350,7 @@ func (pgb *planGraphBuilder) handleUnion(pbi planbuilderinput.PlanBuilderInput)
 	primitiveGenerator := pgb.rootPrimitiveGenerator
 	err := primitiveGenerator.AnalyzeStatement(pbi)
 	if err != nil {
-		logging.GetLogger().Infoln(fmt.Sprintf("select statement analysis error = '%s'", err.Error()))
+		subsystem.GetContextLogger(pbi.GetHandlerCtx().GetContext()).Infoln(fmt.Sprintf("select statement analysis error = '%s'", err.Error()))
 		return nil, nil, err
 	}
 	isLocallyExecutable := true
@@ -371,7 +391,7 @@ func (pgb *planGraphBuilder) handleDelete(pbi planbuilderinput.PlanBuilderInput)
 			handlerCtx,
 			node,
 			tbl,
-			nil,
+			primitiveGenerator.GetPrimitiveComposer().GetCommentDirectives(),
 			primitiveGenerator.GetPrimitiveComposer().IsAwait(),
 		)
 		err = bldr.Build()
@@ -571,7 +591,7 @@ func (pgb *planGraphBuilder) handleInsert(pbi planbuilderinput.PlanBuilderInput)
 		if err != nil {
 			return err
 		}
-		insertValOnlyRows, nonValCols, err := parserutil.ExtractInsertValColumns(node)
+		insertValOnlyRows, nonValCols, err := parserutil.ExtractInsertValColumns(handlerCtx.GetContext(), node)
 		if err != nil {
 			return err
 		}
@@ -612,15 +632,33 @@ func (pgb *planGraphBuilder) handleInsert(pbi planbuilderinput.PlanBuilderInput)
 		if err != nil {
 			return err
 		}
-		bldr := primitivebuilder.NewInsert(
-			pgb.planGraph,
-			handlerCtx,
-			node,
-			tbl,
-			selectPrimitiveNode,
-			primitiveGenerator.GetPrimitiveComposer().GetCommentDirectives(),
-			primitiveGenerator.GetPrimitiveComposer().IsAwait(),
-		)
+		commentDirectives := primitiveGenerator.GetPrimitiveComposer().GetCommentDirectives()
+		var bldr primitivebuilder.Builder
+		_, isUpsert, err := upsert.ParseConflict(node)
+		if err != nil {
+			return err
+		}
+		if isUpsert {
+			bldr = primitivebuilder.NewUpsert(
+				pgb.planGraph,
+				handlerCtx,
+				node,
+				tbl,
+				selectPrimitiveNode,
+				commentDirectives,
+				primitiveGenerator.GetPrimitiveComposer().IsAwait(),
+			)
+		} else {
+			bldr = primitivebuilder.NewInsert(
+				pgb.planGraph,
+				handlerCtx,
+				node,
+				tbl,
+				selectPrimitiveNode,
+				commentDirectives,
+				primitiveGenerator.GetPrimitiveComposer().IsAwait(),
+			)
+		}
 		err = bldr.Build()
 		if err != nil {
 			return err
@@ -646,7 +684,7 @@ func (pgb *planGraphBuilder) handleUpdate(pbi planbuilderinput.PlanBuilderInput)
 		if err != nil {
 			return err
 		}
-		insertValOnlyRows, nonValCols, err := parserutil.ExtractUpdateValColumns(node)
+		insertValOnlyRows, nonValCols, err := parserutil.ExtractUpdateValColumns(handlerCtx.GetContext(), node)
 		if err != nil {
 			return err
 		}
@@ -721,6 +759,7 @@ func (pgb *planGraphBuilder) handleExec(pbi planbuilderinput.PlanBuilderInput) e
 			handlerCtx,
 			node,
 			tbl,
+			primitiveGenerator.GetPrimitiveComposer().GetCommentDirectives(),
 			primitiveGenerator.GetPrimitiveComposer().IsAwait(),
 			primitiveGenerator.IsShowResults(),
 		)
@@ -799,6 +838,73 @@ func (pgb *planGraphBuilder) handleSleep(pbi planbuilderinput.PlanBuilderInput)
 	return nil
 }
 
+// handleSet applies session level query budgets,
+// eg: SET max_http_requests_per_query = 100;
+// other SET statements remain no-ops.  SET plans are not
+// cached, since budgets may only be lowered from those in force.
+func (pgb *planGraphBuilder) handleSet(pbi planbuilderinput.PlanBuilderInput) error {
+	handlerCtx := pbi.GetHandlerCtx()
+	node, ok := pbi.GetStatement().(*sqlparser.Set)
+	if !ok {
+		return fmt.Errorf("could not cast statement of type '%T' to required Set", pbi.GetStatement())
+	}
+	sessionSettings := make(map[string]int)
+	for _, expr := range node.Exprs {
+		key := strings.ToLower(expr.Name.GetRawVal())
+		switch key {
+		case dto.StatementTimeoutKey, dto.MaxHTTPRequestsPerQueryKey, dto.MaxRowsAcquiredKey:
+			val := strings.Trim(sqlparser.String(expr.Expr), `'"`)
+			limit, err := strconv.Atoi(val)
+			if err != nil {
+				return fmt.Errorf("cannot set '%s': integer value required, got '%s'", key, val)
+			}
+			if err := checkBudgetLimit(handlerCtx.GetRuntimeContext(), key, limit); err != nil {
+				return err
+			}
+			sessionSettings[key] = limit
+		}
+	}
+	if len(sessionSettings) == 0 {
+		return pgb.nop(pbi)
+	}
+	pr := primitive.NewLocalPrimitive(
+		func(pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
+			// budgets are checked again against those in force upon execution
+			rc := handlerCtx.GetRuntimeContext()
+			for k, v := range sessionSettings {
+				if err := checkBudgetLimit(rc, k, v); err != nil {
+					return internaldto.NewErroneousExecutorOutput(err)
+				}
+				if err := rc.Set(k, strconv.Itoa(v)); err != nil {
+					return internaldto.NewErroneousExecutorOutput(err)
+				}
+			}
+			handlerCtx.SetRuntimeContext(rc)
+			return internaldto.NewExecutorOutput(nil, nil, nil, nil, nil)
+		})
+	pgb.planGraph.CreatePrimitiveNode(pr)
+	return nil
+}
+
+func checkBudgetLimit(rc dto.RuntimeCtx, key string, limit int) error {
+	if _, ok := querybudget.TightenLimit(getBudgetLimit(rc, key), limit); !ok {
+		return fmt.Errorf("cannot set '%s' = %d: budgets may only be lowered from %d", key, limit, getBudgetLimit(rc, key))
+	}
+	return nil
+}
+
+func getBudgetLimit(rc dto.RuntimeCtx, key string) int {
+	switch key {
+	case dto.StatementTimeoutKey:
+		return rc.StatementTimeout
+	case dto.MaxHTTPRequestsPerQueryKey:
+		return rc.MaxHTTPRequestsPerQuery
+	case dto.MaxRowsAcquiredKey:
+		return rc.MaxRowsAcquired
+	}
+	return 0
+}
+
 func (pgb *planGraphBuilder) handleUse(pbi planbuilderinput.PlanBuilderInput) error {
 	handlerCtx := pbi.GetHandlerCtx()
 	node, ok := pbi.GetUse()
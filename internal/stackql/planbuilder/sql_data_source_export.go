@@ -0,0 +1,95 @@
+package planbuilder
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/datasource/sql_datasource"
+	"github.com/stackql/stackql/internal/stackql/handler"
+	"github.com/stackql/stackql/internal/stackql/plan"
+	"github.com/stackql/stackql/internal/stackql/primitivebuilder"
+	"github.com/stackql/stackql/internal/stackql/sqlexport"
+)
+
+// getSQLDataSourceExportTarget identifies inserts into
+// tables of the form "<sql data source>.<schema>.<table>",
+// which need not exist and so are not analysed as provider tables.
+func getSQLDataSourceExportTarget(handlerCtx handler.HandlerContext, statement sqlparser.Statement) (*sqlparser.Insert, sql_datasource.SQLDataSource, bool) {
+	node, isInsert := statement.(*sqlparser.Insert)
+	if !isInsert || node.Table.QualifierSecond.IsEmpty() {
+		return nil, nil, false
+	}
+	sqlDataSource, isSQLDataSource := handlerCtx.GetSQLDataSource(node.Table.QualifierSecond.GetRawVal())
+	if !isSQLDataSource {
+		return nil, nil, false
+	}
+	return node, sqlDataSource, true
+}
+
+// buildSQLDataSourceExportPlan plans the select in its own right
+// and exports the result, as per the export mode and keys.
+func buildSQLDataSourceExportPlan(
+	handlerCtx handler.HandlerContext,
+	qPlan *plan.Plan,
+	node *sqlparser.Insert,
+	sqlDataSource sql_datasource.SQLDataSource,
+) (*plan.Plan, error) {
+	if len(node.OnDup) > 0 {
+		return nil, fmt.Errorf("ON CONFLICT is not supported for sql data source table '%s', see the export mode", node.Table.GetRawVal())
+	}
+	rowsSelect, isSelect := node.Rows.(sqlparser.SelectStatement)
+	if !isSelect {
+		return nil, fmt.Errorf("insert into sql data source table '%s' requires rows from a select", node.Table.GetRawVal())
+	}
+	tableName := node.Table.Name.GetRawVal()
+	if !node.Table.Qualifier.IsEmpty() {
+		tableName = fmt.Sprintf("%s.%s", node.Table.Qualifier.GetRawVal(), tableName)
+	}
+	runtimeCtx := handlerCtx.GetRuntimeContext()
+	exporter, err := sqlexport.NewExporter(sqlDataSource, tableName, runtimeCtx.ExportMode, sqlexport.SplitKeys(runtimeCtx.ExportKey))
+	if err != nil {
+		return nil, err
+	}
+	if len(node.Columns) > 0 {
+		var columnNames []string
+		for _, col := range node.Columns {
+			columnNames = append(columnNames, col.GetRawVal())
+		}
+		exporter = exporter.WithColumnNames(columnNames)
+	}
+	buf := sqlparser.NewTrackedBuffer(formatExportSelect)
+	rowsSelect.Format(buf)
+	selectPlan, err := buildSubSelectPlan(handlerCtx, buf.String())
+	if err != nil {
+		return nil, err
+	}
+	pGBuilder := newPlanGraphBuilder(runtimeCtx.ExecutionConcurrencyLimit)
+	bldr := primitivebuilder.NewSQLDataSourceExport(pGBuilder.planGraph, selectPlan.Instructions, exporter)
+	err = bldr.Build()
+	if err != nil {
+		return nil, err
+	}
+	qPlan.Type = sqlparser.StmtInsert
+	qPlan.Instructions = pGBuilder.planGraph
+	// The exporter holds replacement state.
+	qPlan.SetCacheable(false)
+	return qPlan, qPlan.Instructions.Optimise()
+}
+
+// The default format of table names, as one delimited
+// identifier, does not parse back to the same table.
+func formatExportSelect(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+	tableName, isTableName := node.(sqlparser.TableName)
+	if !isTableName || tableName.IsEmpty() {
+		node.Format(buf)
+		return
+	}
+	var parts []string
+	for _, ident := range []sqlparser.TableIdent{tableName.QualifierThird, tableName.QualifierSecond, tableName.Qualifier, tableName.Name} {
+		if !ident.IsEmpty() {
+			parts = append(parts, sqlparser.String(ident))
+		}
+	}
+	buf.WriteString(strings.Join(parts, "."))
+}
@@ -0,0 +1,131 @@
+package planbuilder_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	lrucache "github.com/stackql/stackql-parser/go/cache"
+
+	"github.com/stackql/stackql/internal/stackql/dto"
+	"github.com/stackql/stackql/internal/stackql/entryutil"
+	"github.com/stackql/stackql/internal/stackql/handler"
+	"github.com/stackql/stackql/internal/stackql/logging"
+	"github.com/stackql/stackql/internal/stackql/querysubmit"
+	"github.com/stackql/stackql/internal/stackql/util"
+)
+
+// newSQLDataSourceSession returns a session with a file data source,
+// "local", and a sqlite data source, "warehouse", as export target.
+func newSQLDataSourceSession(t *testing.T) (handler.HandlerContext, string) {
+	t.Helper()
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "owners.csv"), []byte("instance_name,owner\ni1,alice\ni2,bob\n"), 0o600)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	warehousePath := filepath.Join(t.TempDir(), "warehouse.db")
+	dbInitFilePath, err := util.GetFilePathFromRepositoryRoot("test/db/sqlite/setup.sql")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	runtimeCtx := dto.RuntimeCtx{
+		Delimiter:                ",",
+		LogLevelStr:              "warn",
+		ApplicationFilesRootPath: t.TempDir(),
+		AuthRaw: fmt.Sprintf(
+			`{ "local": { "type": "sql_data_source::file", "sqlDataSource": { "dsn": "%s" } }, "warehouse": { "type": "sql_data_source::sqlite", "sqlDataSource": { "dsn": "%s" } } }`,
+			filepath.ToSlash(dir),
+			filepath.ToSlash(warehousePath),
+		),
+		RegistryRaw:               fmt.Sprintf(`{ "url": "file://%s", "useEmbedded": false }`, filepath.ToSlash(t.TempDir())),
+		OutputFormat:              "text",
+		SQLBackendCfgRaw:          fmt.Sprintf(`{ "dbInitFilepath": "%s", "dsn": "file:%s?mode=memory&cache=shared" }`, dbInitFilePath, t.Name()),
+		ExecutionConcurrencyLimit: 1,
+		QueryCacheSize:            100,
+	}
+	inputBundle, err := entryutil.BuildInputBundle(runtimeCtx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	handlerCtx, err := entryutil.BuildHandlerContextNoPreProcess(runtimeCtx, lrucache.NewLRUCache(int64(runtimeCtx.QueryCacheSize)), inputBundle)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return handlerCtx, warehousePath
+}
+
+// setJSONLogger logs as json, at info level, into the returned buffer.
+func setJSONLogger(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	std := logrus.StandardLogger()
+	priorOut, priorFormatter, priorLevel := std.Out, std.Formatter, std.GetLevel()
+	t.Cleanup(func() {
+		std.SetOutput(priorOut)
+		logging.SetLogger(priorLevel.String(), logging.FormatText, "")
+		std.SetFormatter(priorFormatter)
+	})
+	buf := &bytes.Buffer{}
+	std.SetOutput(buf)
+	logging.SetLogger("info", logging.FormatJSON, "")
+	return buf
+}
+
+// getLoggedQueryIDs returns the query ID of each line
+// with a message containing the given text.
+func getLoggedQueryIDs(t *testing.T, buf *bytes.Buffer, msg string) []interface{} {
+	t.Helper()
+	var rv []interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		fields := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(line), &fields); err != nil {
+			t.Fatalf("cannot parse log line '%s': %v", line, err)
+		}
+		if fieldMsg, ok := fields["msg"].(string); ok && strings.Contains(fieldMsg, msg) {
+			rv = append(rv, fields[logging.QueryIDKey])
+		}
+	}
+	return rv
+}
+
+// TestSQLDataSourceExportRepeated runs the same export twice in the
+// one session, each query under a context cancelled once the query
+// completes, as per the shell.  The select of each export must run
+// under the context of its own query.
+func TestSQLDataSourceExportRepeated(t *testing.T) {
+	handlerCtx, warehousePath := newSQLDataSourceSession(t)
+	buf := setJSONLogger(t)
+	for i := 1; i <= 2; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		handlerCtx.SetContext(ctx)
+		handlerCtx.SetQuery("INSERT INTO warehouse.main.owners_snapshot SELECT instance_name, owner FROM local.files.owners")
+		output := querysubmit.SubmitQuery(handlerCtx)
+		cancel()
+		if output.Err != nil {
+			t.Fatalf("export %d error: %v", i, output.Err)
+		}
+	}
+	db, err := sql.Open("sqlite3", warehousePath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer db.Close()
+	var count int
+	if err := db.QueryRow(`SELECT count(*) FROM owners_snapshot`).Scan(&count); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count != 4 {
+		t.Fatalf("exported row count = %d, want 4", count)
+	}
+	queryIDs := getLoggedQueryIDs(t, buf, "sql data source query")
+	if len(queryIDs) != 2 || queryIDs[0] == nil || queryIDs[0] == queryIDs[1] {
+		t.Fatalf("query IDs of sql data source selects = %v, want one per export", queryIDs)
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/stackql/stackql/internal/stackql/sqlstream"
 )
 
+const subsystem logging.Subsystem = "planbuilderinput"
+
 var (
 	multipleWhitespaceRegexp     *regexp.Regexp = regexp.MustCompile(`\s+`)
 	getOidsRegexp                *regexp.Regexp = regexp.MustCompile(`(?i)select\s+t\.oid,\s+(?:NULL|typarray)\s+from.*pg_type`)
@@ -20,8 +22,8 @@ var (
 // TODO: Get rid ASAP
 func IsPGSetupQuery(pbi PlanBuilderInput) (nativedb.Select, bool) {
 	handlerCtx := pbi.GetHandlerCtx()
-	routeType, canRoute := handlerCtx.GetDBMSInternalRouter().CanRoute(pbi.GetStatement())
-	logging.GetLogger().Debugf("canRoute = %t, routeType = %v\n", canRoute, routeType)
+	routeType, canRoute := handlerCtx.GetDBMSInternalRouter().CanRoute(handlerCtx.GetContext(), pbi.GetStatement())
+	subsystem.GetContextLogger(handlerCtx.GetContext()).Debugf("canRoute = %t, routeType = %v\n", canRoute, routeType)
 	qStripped := multipleWhitespaceRegexp.ReplaceAllString(pbi.GetRawQuery(), " ")
 	if qStripped == "select relname, nspname, relkind from pg_catalog.pg_class c, pg_catalog.pg_namespace n where relkind in ('r', 'v', 'm', 'f') and nspname not in ('pg_catalog', 'information_schema', 'pg_toast', 'pg_temp_1') and n.oid = relnamespace order by nspname, relname" {
 		return nil, true
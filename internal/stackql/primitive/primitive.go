@@ -1,6 +1,7 @@
 package primitive
 
 import (
+	"context"
 	"io"
 
 	"github.com/stackql/stackql/internal/stackql/dto"
@@ -9,6 +10,7 @@ import (
 
 type IPrimitiveCtx interface {
 	GetAuthContext(string) (*dto.AuthCtx, error)
+	GetContext() context.Context
 	GetWriter() io.Writer
 	GetErrWriter() io.Writer
 }
@@ -0,0 +1,34 @@
+package primitivebuilder
+
+import (
+	"fmt"
+
+	"github.com/stackql/stackql/internal/stackql/drm"
+	"github.com/stackql/stackql/internal/stackql/handler"
+	"github.com/stackql/stackql/internal/stackql/history"
+	"github.com/stackql/stackql/internal/stackql/tablemetadata"
+)
+
+// recordAcquisitionHistory appends the rows acquired under the
+// insert context to the history table, if history is captured
+// for the resource.  History tables are named for the resource,
+// irrespective of the response schema.  History is incidental
+// to the query, so failure to record it is logged, not returned.
+func recordAcquisitionHistory(handlerCtx handler.HandlerContext, insertCtx drm.PreparedStatementCtx, tableMeta tablemetadata.ExtendedTableMetadata) {
+	hIDs := tableMeta.GetHeirarchyObjects().GetHeirarchyIds()
+	tableName := fmt.Sprintf("%s.%s.%s", hIDs.GetProviderStr(), hIDs.GetServiceStr(), hIDs.GetResourceStr())
+	recorder := history.NewRecorder(
+		handlerCtx.GetContext(),
+		handlerCtx.GetRuntimeContext().HistoryTables,
+		handlerCtx.GetSQLSystem(),
+		handlerCtx.GetDrmConfig().GetControlAttributes(),
+		handlerCtx.GetQuery(),
+	)
+	if insertCtx == nil || len(insertCtx.GetTableNames()) == 0 || !recorder.IsCaptured(tableName) {
+		return
+	}
+	err := recorder.Record(tableName, insertCtx.GetTableNames()[0], insertCtx.GetNonControlColumns(), insertCtx.GetGCCtrlCtrs())
+	if err != nil {
+		subsystem.GetContextLogger(handlerCtx.GetContext()).Warnf("acquisition history not recorded: %s", err.Error())
+	}
+}
@@ -6,10 +6,40 @@ import (
 	"github.com/stackql/stackql/internal/stackql/handler"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
 	"github.com/stackql/stackql/internal/stackql/primitive"
+	"github.com/stackql/stackql/internal/stackql/provider"
 	"github.com/stackql/stackql/internal/stackql/tablemetadata"
 )
 
+// newAwaitedPrimitive composes the monitor of ex upon execution.
+func newAwaitedPrimitive(
+	handlerCtx handler.HandlerContext,
+	prov provider.IProvider,
+	ex func(pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput,
+	meta tablemetadata.ExtendedTableMetadata,
+	commentDirectives sqlparser.CommentDirectives,
+) primitive.IPrimitive {
+	return primitive.NewHTTPRestPrimitive(
+		prov,
+		func(pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
+			execPrim, err := composeAsyncMonitor(handlerCtx, primitive.NewHTTPRestPrimitive(prov, ex, nil, nil), meta, commentDirectives)
+			if err != nil {
+				return internaldto.NewErroneousExecutorOutput(err)
+			}
+			return execPrim.Execute(pc)
+		},
+		nil,
+		nil,
+	)
+}
+
+// composeAsyncMonitor is called upon execution, rather than
+// when building, as the plan may be cached and executed
+// irrespective of whether mutations are being planned.
 func composeAsyncMonitor(handlerCtx handler.HandlerContext, precursor primitive.IPrimitive, meta tablemetadata.ExtendedTableMetadata, commentDirectives sqlparser.CommentDirectives) (primitive.IPrimitive, error) {
+	if recorder := handlerCtx.GetMutationRecorder(); recorder != nil && recorder.IsCapturing() {
+		// whilst planning there is no operation to await
+		return precursor, nil
+	}
 	prov, err := meta.GetProvider()
 	if err != nil {
 		return nil, err
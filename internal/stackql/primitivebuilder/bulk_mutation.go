@@ -0,0 +1,371 @@
+package primitivebuilder
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/stackql/go-openapistackql/openapistackql"
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/constants"
+	"github.com/stackql/stackql/internal/stackql/handler"
+	"github.com/stackql/stackql/internal/stackql/httpmiddleware"
+	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
+	"github.com/stackql/stackql/internal/stackql/primitive"
+	"github.com/stackql/stackql/internal/stackql/primitivegraph"
+	"github.com/stackql/stackql/internal/stackql/provider"
+	"github.com/stackql/stackql/internal/stackql/sqlexport"
+	"github.com/stackql/stackql/internal/stackql/tablemetadata"
+	"github.com/stackql/stackql/internal/stackql/upsert"
+	"github.com/stackql/stackql/internal/stackql/util"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	bulkMutationStatusSuccess string = "success"
+	bulkMutationStatusFailure string = "failure"
+	bulkMutationStatusPlanned string = "planned"
+	// bulkMutationNameColumn conventionally identifies a resource,
+	// and so may supply the one method parameter that no column does.
+	bulkMutationNameColumn string = "name"
+)
+
+var (
+	bulkMutationColumns []string = []string{"row", "method", "parameters", "status", "error"}
+)
+
+// BulkMutation implements the Builder interface and represents a
+// DELETE or UPDATE of each row of a separately planned select, by way
+// of one call per row.  Failed rows are reported alongside the rest,
+// rather than ending the statement.
+type BulkMutation struct {
+	graph              primitivegraph.PrimitiveGraph
+	handlerCtx         handler.HandlerContext
+	root               primitivegraph.PrimitiveNode
+	selectInstructions primitive.IPrimitive
+	heirarchy          tablemetadata.HeirarchyObjects
+	action             string
+	parameters         map[string]interface{}
+	requestBody        map[string]interface{}
+	commentDirectives  sqlparser.CommentDirectives
+	isAwait            bool
+}
+
+// NewBulkMutation accepts the parameters that the WHERE clause fixes
+// for every row and, for updates, the request body keyed as per
+// insert columns, ie: "data__<property>".
+func NewBulkMutation(
+	graph primitivegraph.PrimitiveGraph,
+	handlerCtx handler.HandlerContext,
+	selectInstructions primitive.IPrimitive,
+	heirarchy tablemetadata.HeirarchyObjects,
+	action string,
+	parameters map[string]interface{},
+	requestBody map[string]interface{},
+	commentDirectives sqlparser.CommentDirectives,
+	isAwait bool,
+) Builder {
+	return &BulkMutation{
+		graph:              graph,
+		handlerCtx:         handlerCtx,
+		selectInstructions: selectInstructions,
+		heirarchy:          heirarchy,
+		action:             action,
+		parameters:         parameters,
+		requestBody:        requestBody,
+		commentDirectives:  commentDirectives,
+		isAwait:            isAwait,
+	}
+}
+
+func (ss *BulkMutation) GetRoot() primitivegraph.PrimitiveNode {
+	return ss.root
+}
+
+func (ss *BulkMutation) GetTail() primitivegraph.PrimitiveNode {
+	return ss.root
+}
+
+type bulkMutationResult struct {
+	method     string
+	parameters map[string]interface{}
+	status     string
+	err        error
+}
+
+func (ss *BulkMutation) Build() error {
+	prov := ss.heirarchy.GetProvider()
+	svc := ss.heirarchy.GetServiceHdl()
+	rsc := ss.heirarchy.GetResource()
+	if prov == nil || svc == nil || rsc == nil {
+		return fmt.Errorf("bulk %s requires a provider resource", ss.action)
+	}
+	methods := prov.GetMethodSelector().GetMethodsForAction(rsc, ss.action)
+	if len(methods) == 0 {
+		return fmt.Errorf("no appropriate method = '%s' for resource = '%s'", ss.action, rsc.Name)
+	}
+	// awaiting requires table metadata per method
+	tableMetas := make(map[string]tablemetadata.ExtendedTableMetadata, len(methods))
+	for _, m := range methods {
+		ho := tablemetadata.NewHeirarchyObjects(ss.heirarchy.GetHeirarchyIds())
+		ho.SetProvider(prov)
+		ho.SetServiceHdl(svc)
+		ho.SetResource(rsc)
+		ho.SetMethod(m)
+		tableMetas[m.GetName()] = tablemetadata.NewExtendedTableMetadata(ho, ss.heirarchy.GetTableName(), "")
+	}
+	isDryRun := upsert.IsDryRun(ss.commentDirectives)
+	ex := func(pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
+		selection := ss.selectInstructions.Execute(pc)
+		if selection.Err != nil {
+			return selection
+		}
+		columns, rows, err := sqlexport.ReadResult(selection.GetSQLResult())
+		if err != nil {
+			return internaldto.NewErroneousExecutorOutput(err)
+		}
+		if !isDryRun {
+			err = ss.handlerCtx.GetSafetyPolicy().CheckAffectedRows(ss.action, len(rows))
+			if err != nil {
+				return internaldto.NewErroneousExecutorOutput(err)
+			}
+		}
+		concurrencyLimit := ss.handlerCtx.GetRuntimeContext().ExecutionConcurrencyLimit
+		// planned calls are recorded in row order
+		if ss.handlerCtx.GetMutationRecorder() != nil {
+			concurrencyLimit = 1
+		}
+		results, failureCount := mutateRows(len(rows), concurrencyLimit, func(i int) bulkMutationResult {
+			row := make(map[string]interface{}, len(columns))
+			for j, col := range columns {
+				if rows[i][j] != nil {
+					row[col.GetName()] = fmt.Sprintf("%v", rows[i][j])
+				}
+			}
+			return ss.mutateRow(pc, prov, svc, methods, tableMetas, row, isDryRun)
+		})
+		msgs := internaldto.BackendMessages{
+			WorkingMessages: []string{getBulkMutationSummary(ss.action, isDryRun, len(rows), failureCount)},
+		}
+		return prepareBulkMutationResultSet(results, &msgs)
+	}
+	ss.root = ss.graph.CreatePrimitiveNode(primitive.NewLocalPrimitive(ex))
+	return nil
+}
+
+// mutateRows mutates each of rowCount rows, no more than concurrencyLimit
+// at a time, and returns the results in row order alongside the number
+// of failures.  A failed row does not prevent the others from running.
+func mutateRows(rowCount int, concurrencyLimit int, mutate func(int) bulkMutationResult) ([]bulkMutationResult, int) {
+	if concurrencyLimit < 1 {
+		concurrencyLimit = 1
+	}
+	results := make([]bulkMutationResult, rowCount)
+	var eg errgroup.Group
+	eg.SetLimit(concurrencyLimit)
+	var failureCount int64
+	for i := 0; i < rowCount; i++ {
+		i := i
+		eg.Go(func() error {
+			results[i] = mutate(i)
+			if results[i].err != nil {
+				atomic.AddInt64(&failureCount, 1)
+			}
+			return nil
+		})
+	}
+	eg.Wait()
+	return results, int(failureCount)
+}
+
+func getBulkMutationSummary(action string, isDryRun bool, rowCount int, failureCount int) string {
+	switch {
+	case isDryRun:
+		return fmt.Sprintf("%d rows planned for %s", rowCount, action)
+	case failureCount > 0:
+		return fmt.Sprintf("%s failed for %d of %d rows", action, failureCount, rowCount)
+	default:
+		return fmt.Sprintf("%s succeeded for %d rows", action, rowCount)
+	}
+}
+
+func (ss *BulkMutation) mutateRow(
+	pc primitive.IPrimitiveCtx,
+	prov provider.IProvider,
+	svc *openapistackql.Service,
+	methods []*openapistackql.OperationStore,
+	tableMetas map[string]tablemetadata.ExtendedTableMetadata,
+	row map[string]interface{},
+	isDryRun bool,
+) bulkMutationResult {
+	rv := bulkMutationResult{status: bulkMutationStatusFailure}
+	m, params, err := resolveBulkMutationMethod(methods, ss.parameters, ss.requestBody, row)
+	if err != nil {
+		rv.err = err
+		return rv
+	}
+	rv.method = m.GetName()
+	rv.parameters = params
+	if isDryRun {
+		rv.status = bulkMutationStatusPlanned
+		return rv
+	}
+	if err := ss.handlerCtx.GetContext().Err(); err != nil {
+		rv.err = err
+		return rv
+	}
+	callParams := make(map[string]interface{}, len(params)+len(ss.requestBody))
+	for k, v := range params {
+		callParams[k] = v
+	}
+	for k, v := range ss.requestBody {
+		callParams[k] = v
+	}
+	execInstance := func() internaldto.ExecutorOutput {
+		response, err := callMutationMethod(httpmiddleware.WithParentSpan(ss.handlerCtx, pc.GetContext()), prov, svc, m, callParams)
+		if err != nil {
+			return internaldto.NewErroneousExecutorOutput(err)
+		}
+		if response == nil {
+			return newPlannedCallOutput()
+		}
+		target, err := m.DeprecatedProcessResponse(response)
+		ss.handlerCtx.LogHTTPResponseMap(target)
+		if err == nil && response.StatusCode >= 300 {
+			err = fmt.Errorf("%s via method '%s' failed with status %s", ss.action, m.GetName(), response.Status)
+		}
+		return internaldto.NewExecutorOutput(nil, target, nil, nil, err)
+	}
+	var output internaldto.ExecutorOutput
+	if ss.isAwait {
+		dependentPrimitive := primitive.NewHTTPRestPrimitive(prov, nil, nil, nil)
+		err = dependentPrimitive.SetExecutor(func(pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
+			return execInstance()
+		})
+		if err != nil {
+			rv.err = err
+			return rv
+		}
+		execPrim, err := composeAsyncMonitor(ss.handlerCtx, dependentPrimitive, tableMetas[m.GetName()], ss.commentDirectives)
+		if err != nil {
+			rv.err = err
+			return rv
+		}
+		output = execPrim.Execute(pc)
+	} else {
+		output = execInstance()
+	}
+	if output.Err != nil {
+		rv.err = output.Err
+		return rv
+	}
+	rv.status = bulkMutationStatusSuccess
+	return rv
+}
+
+// resolveBulkMutationMethod returns the method, among those whose required
+// parameters the row supplies, that consumes the most parameters.  The
+// WHERE clause takes precedence over the row's columns, and the name
+// column may stand for the sole parameter otherwise absent.
+func resolveBulkMutationMethod(
+	methods []*openapistackql.OperationStore,
+	fixedParams map[string]interface{},
+	requestBody map[string]interface{},
+	row map[string]interface{},
+) (*openapistackql.OperationStore, map[string]interface{}, error) {
+	var rv *openapistackql.OperationStore
+	var rvParams map[string]interface{}
+	leastRemaining := -1
+	var missing []string
+	for _, m := range methods {
+		params := make(map[string]interface{})
+		for k, v := range fixedParams {
+			params[k] = v
+		}
+		for k := range m.GetNonBodyParameters() {
+			if _, ok := params[k]; ok {
+				continue
+			}
+			if v, ok := row[k]; ok {
+				params[k] = v
+			}
+		}
+		var absent []string
+		for k := range m.GetRequiredParameters() {
+			if _, ok := params[k]; ok || strings.HasPrefix(k, constants.RequestBodyBaseKey) {
+				continue
+			}
+			absent = append(absent, k)
+		}
+		if v, ok := row[bulkMutationNameColumn]; ok && len(absent) == 1 {
+			params[absent[0]] = v
+			absent = nil
+		}
+		if len(absent) > 0 {
+			sort.Strings(absent)
+			missing = append(missing, fmt.Sprintf("'%s' requires %s", m.GetName(), strings.Join(absent, ", ")))
+			continue
+		}
+		matchParams := make(map[string]interface{}, len(params)+len(requestBody))
+		for k, v := range params {
+			matchParams[k] = v
+		}
+		for k, v := range requestBody {
+			matchParams[k] = v
+		}
+		remainingParams, ok := m.ParameterMatch(matchParams)
+		if !ok {
+			continue
+		}
+		if leastRemaining < 0 || len(remainingParams) < leastRemaining {
+			rv = m
+			rvParams = params
+			leastRemaining = len(remainingParams)
+		}
+	}
+	if rv == nil {
+		if len(missing) > 0 {
+			return nil, nil, fmt.Errorf("cannot resolve method parameters from row: %s", strings.Join(missing, "; "))
+		}
+		return nil, nil, fmt.Errorf("cannot resolve method parameters from row")
+	}
+	return rv, rvParams, nil
+}
+
+func prepareBulkMutationResultSet(results []bulkMutationResult, msgs *internaldto.BackendMessages) internaldto.ExecutorOutput {
+	rowMap := make(map[string]map[string]interface{})
+	for i, r := range results {
+		var parameters string
+		if r.parameters != nil {
+			b, err := json.Marshal(r.parameters)
+			if err != nil {
+				return internaldto.NewErroneousExecutorOutput(err)
+			}
+			parameters = string(b)
+		}
+		var errStr string
+		if r.err != nil {
+			errStr = r.err.Error()
+		}
+		rowMap[strconv.Itoa(i)] = map[string]interface{}{
+			"row":        i + 1,
+			"method":     r.method,
+			"parameters": parameters,
+			"status":     r.status,
+			"error":      errStr,
+		}
+	}
+	return util.PrepareResultSet(
+		internaldto.NewPrepareResultSetDTO(
+			nil,
+			rowMap,
+			bulkMutationColumns,
+			returningRowSort,
+			nil,
+			msgs,
+		),
+	)
+}
@@ -0,0 +1,98 @@
+package primitivebuilder
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stackql/stackql/internal/stackql/sqlexport"
+)
+
+func TestMutateRowsConcurrencyLimit(t *testing.T) {
+	for _, limit := range []int{0, 1, 3} {
+		t.Run(fmt.Sprintf("limit %d", limit), func(t *testing.T) {
+			var inFlight, maxInFlight int64
+			results, failureCount := mutateRows(12, limit, func(i int) bulkMutationResult {
+				n := atomic.AddInt64(&inFlight, 1)
+				defer atomic.AddInt64(&inFlight, -1)
+				for {
+					m := atomic.LoadInt64(&maxInFlight)
+					if n <= m || atomic.CompareAndSwapInt64(&maxInFlight, m, n) {
+						break
+					}
+				}
+				time.Sleep(5 * time.Millisecond)
+				return bulkMutationResult{method: fmt.Sprintf("m%d", i), status: bulkMutationStatusSuccess}
+			})
+			wantLimit := int64(limit)
+			if wantLimit < 1 {
+				wantLimit = 1
+			}
+			if maxInFlight > wantLimit {
+				t.Fatalf("mutateRows() ran %d rows at once, want at most %d", maxInFlight, wantLimit)
+			}
+			if failureCount != 0 || len(results) != 12 {
+				t.Fatalf("mutateRows() = (%d results, %d failures)", len(results), failureCount)
+			}
+			for i, r := range results {
+				if r.method != fmt.Sprintf("m%d", i) {
+					t.Fatalf("mutateRows() result %d is of method %s, want row order", i, r.method)
+				}
+			}
+		})
+	}
+}
+
+func TestMutateRowsReportsFailures(t *testing.T) {
+	results, failureCount := mutateRows(4, 2, func(i int) bulkMutationResult {
+		if i%2 == 1 {
+			return bulkMutationResult{
+				method: "delete",
+				status: bulkMutationStatusFailure,
+				err:    fmt.Errorf("row %d failed", i),
+			}
+		}
+		return bulkMutationResult{method: "delete", status: bulkMutationStatusSuccess}
+	})
+	if failureCount != 2 {
+		t.Fatalf("mutateRows() failure count = %d, want 2", failureCount)
+	}
+	if got := getBulkMutationSummary("delete", false, len(results), failureCount); got != "delete failed for 2 of 4 rows" {
+		t.Fatalf("getBulkMutationSummary() = %s", got)
+	}
+	output := prepareBulkMutationResultSet(results, nil)
+	if output.Err != nil {
+		t.Fatalf("unexpected error: %v", output.Err)
+	}
+	columns, rows, err := sqlexport.ReadResult(output.GetSQLResult())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(columns) != len(bulkMutationColumns) {
+		t.Fatalf("prepareBulkMutationResultSet() has %d columns", len(columns))
+	}
+	want := []string{
+		"[1 delete  success ]",
+		"[2 delete  failure row 1 failed]",
+		"[3 delete  success ]",
+		"[4 delete  failure row 3 failed]",
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("prepareBulkMutationResultSet() has %d rows, want %d", len(rows), len(want))
+	}
+	for i, row := range rows {
+		if got := fmt.Sprintf("%s", row); got != want[i] {
+			t.Fatalf("prepareBulkMutationResultSet() row %d = %s, want %s", i, got, want[i])
+		}
+	}
+}
+
+func TestGetBulkMutationSummary(t *testing.T) {
+	if got := getBulkMutationSummary("update", true, 3, 0); got != "3 rows planned for update" {
+		t.Fatalf("getBulkMutationSummary() = %s", got)
+	}
+	if got := getBulkMutationSummary("update", false, 3, 0); got != "update succeeded for 3 rows" {
+		t.Fatalf("getBulkMutationSummary() = %s", got)
+	}
+}
@@ -2,6 +2,7 @@ package primitivebuilder
 
 import (
 	"fmt"
+	"net/http"
 	"strconv"
 
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
@@ -9,7 +10,6 @@ import (
 	"github.com/stackql/stackql/internal/stackql/handler"
 	"github.com/stackql/stackql/internal/stackql/httpmiddleware"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
-	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/primitive"
 	"github.com/stackql/stackql/internal/stackql/primitivegraph"
 	"github.com/stackql/stackql/internal/stackql/tablemetadata"
@@ -66,19 +66,35 @@ func (ss *Delete) Build() error {
 	if err != nil {
 		return err
 	}
+	returningProjection, isReturning, err := newReturningProjection(tbl, ss.commentDirectives, ss.isAwait)
+	if err != nil {
+		return err
+	}
 	ex := func(pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
 		var target map[string]interface{}
+		var lastResponse *http.Response
 		keys := make(map[string]map[string]interface{})
+		var responseBodies []map[string]interface{}
 		httpArmoury, err := tbl.GetHttpArmoury()
 		if err != nil {
 			return util.PrepareResultSet(internaldto.NewPrepareResultSetDTO(nil, nil, nil, nil, err, nil))
 		}
+		err = handlerCtx.GetSafetyPolicy().CheckAffectedRows("delete", len(httpArmoury.GetRequestParams()))
+		if err != nil {
+			return util.PrepareResultSet(internaldto.NewPrepareResultSetDTO(nil, nil, nil, nil, err, nil))
+		}
 		for _, req := range httpArmoury.GetRequestParams() {
-			response, apiErr := httpmiddleware.HttpApiCallFromRequest(handlerCtx.Clone(), prov, m, req.GetRequest())
+			response, apiErr := httpmiddleware.HttpApiMutationFromRequest(httpmiddleware.WithParentSpan(handlerCtx, pc.GetContext()), prov, m, req.GetRequest())
 			if apiErr != nil {
 				return util.PrepareResultSet(internaldto.NewPrepareResultSetDTO(nil, nil, nil, nil, apiErr, nil))
 			}
+			if response == nil {
+				// recorded in the plan, not sent
+				continue
+			}
 			target, err = m.DeprecatedProcessResponse(response)
+			lastResponse = response
+			responseBodies = append(responseBodies, target)
 			if response.StatusCode < 300 && len(target) < 1 {
 				msgs := internaldto.BackendMessages{}
 				msgs.WorkingMessages = generateSuccessMessagesFromHeirarchy(tbl, ss.isAwait)
@@ -89,11 +105,11 @@ func (ss *Delete) Build() error {
 					nil,
 					nil,
 					&msgs,
-				))
+				)).WithResponse(lastResponse)
 			}
 			handlerCtx.LogHTTPResponseMap(target)
 
-			logging.GetLogger().Infoln(fmt.Sprintf("DeleteExecutor() target = %v", target))
+			getResourceLogger(pc, tbl).Infoln(fmt.Sprintf("DeleteExecutor() target = %v", target))
 			if err != nil {
 				return util.PrepareResultSet(internaldto.NewPrepareResultSetDTO(
 					nil,
@@ -104,7 +120,7 @@ func (ss *Delete) Build() error {
 					nil,
 				))
 			}
-			logging.GetLogger().Infoln(fmt.Sprintf("target = %v", target))
+			getResourceLogger(pc, tbl).Infoln(fmt.Sprintf("target = %v", target))
 			items, ok := target[prov.GetDefaultKeyForDeleteItems()]
 			if ok {
 				iArr, ok := items.([]interface{})
@@ -122,7 +138,10 @@ func (ss *Delete) Build() error {
 		if err == nil {
 			msgs.WorkingMessages = generateSuccessMessagesFromHeirarchy(tbl, ss.isAwait)
 		}
-		return generateResultIfNeededfunc(keys, target, &msgs, err, false)
+		if isReturning {
+			return returningProjection.prepareResultSet(responseBodies, &msgs).WithResponse(lastResponse)
+		}
+		return generateResultIfNeededfunc(keys, target, &msgs, err, false).WithResponse(lastResponse)
 	}
 	deletePrimitive := primitive.NewHTTPRestPrimitive(
 		prov,
@@ -131,10 +150,7 @@ func (ss *Delete) Build() error {
 		nil,
 	)
 	if ss.isAwait {
-		deletePrimitive, err = composeAsyncMonitor(handlerCtx, deletePrimitive, tbl, nil)
-	}
-	if err != nil {
-		return err
+		deletePrimitive = newAwaitedPrimitive(handlerCtx, prov, ex, tbl, ss.commentDirectives)
 	}
 
 	graph := ss.graph
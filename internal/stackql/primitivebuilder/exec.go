@@ -2,6 +2,7 @@ package primitivebuilder
 
 import (
 	"fmt"
+	"net/http"
 	"strconv"
 
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
@@ -9,21 +10,22 @@ import (
 	"github.com/stackql/stackql/internal/stackql/handler"
 	"github.com/stackql/stackql/internal/stackql/httpmiddleware"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
-	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/primitive"
 	"github.com/stackql/stackql/internal/stackql/primitivegraph"
+	"github.com/stackql/stackql/internal/stackql/safetypolicy"
 	"github.com/stackql/stackql/internal/stackql/tablemetadata"
 	"github.com/stackql/stackql/internal/stackql/util"
 )
 
 type Exec struct {
-	graph         primitivegraph.PrimitiveGraph
-	handlerCtx    handler.HandlerContext
-	drmCfg        drm.DRMConfig
-	root          primitivegraph.PrimitiveNode
-	tbl           tablemetadata.ExtendedTableMetadata
-	isAwait       bool
-	isShowResults bool
+	graph             primitivegraph.PrimitiveGraph
+	handlerCtx        handler.HandlerContext
+	drmCfg            drm.DRMConfig
+	root              primitivegraph.PrimitiveNode
+	tbl               tablemetadata.ExtendedTableMetadata
+	commentDirectives sqlparser.CommentDirectives
+	isAwait           bool
+	isShowResults     bool
 }
 
 func NewExec(
@@ -31,16 +33,18 @@ func NewExec(
 	handlerCtx handler.HandlerContext,
 	node sqlparser.SQLNode,
 	tbl tablemetadata.ExtendedTableMetadata,
+	commentDirectives sqlparser.CommentDirectives,
 	isAwait bool,
 	isShowResults bool,
 ) Builder {
 	return &Exec{
-		graph:         graph,
-		handlerCtx:    handlerCtx,
-		drmCfg:        handlerCtx.GetDrmConfig(),
-		tbl:           tbl,
-		isAwait:       isAwait,
-		isShowResults: isShowResults,
+		graph:             graph,
+		handlerCtx:        handlerCtx,
+		drmCfg:            handlerCtx.GetDrmConfig(),
+		tbl:               tbl,
+		commentDirectives: commentDirectives,
+		isAwait:           isAwait,
+		isShowResults:     isShowResults,
 	}
 }
 
@@ -68,16 +72,28 @@ func (ss *Exec) Build() error {
 	ex := func(pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
 		var err error
 		var columnOrder []string
+		var lastResponse *http.Response
 		keys := make(map[string]map[string]interface{})
 		httpArmoury, err := tbl.GetHttpArmoury()
 		if err != nil {
 			return internaldto.NewErroneousExecutorOutput(err)
 		}
+		if safetypolicy.IsMutatingMethod(m) {
+			err = handlerCtx.GetSafetyPolicy().CheckAffectedRows("exec", len(httpArmoury.GetRequestParams()))
+			if err != nil {
+				return internaldto.NewErroneousExecutorOutput(err)
+			}
+		}
 		for i, req := range httpArmoury.GetRequestParams() {
-			response, apiErr := httpmiddleware.HttpApiCallFromRequest(handlerCtx.Clone(), prov, m, req.GetRequest())
+			response, apiErr := httpmiddleware.HttpApiMutationFromRequest(httpmiddleware.WithParentSpan(handlerCtx, pc.GetContext()), prov, m, req.GetRequest())
 			if apiErr != nil {
 				return util.PrepareResultSet(internaldto.NewPrepareResultSetDTO(nil, nil, nil, nil, apiErr, nil))
 			}
+			if response == nil {
+				// recorded in the plan, not sent
+				continue
+			}
+			lastResponse = response
 			target, err = m.DeprecatedProcessResponse(response)
 			handlerCtx.LogHTTPResponseMap(target)
 			if err != nil {
@@ -90,7 +106,7 @@ func (ss *Exec) Build() error {
 					nil,
 				))
 			}
-			logging.GetLogger().Infoln(fmt.Sprintf("target = %v", target))
+			getResourceLogger(pc, tbl).Infoln(fmt.Sprintf("target = %v", target))
 			items, ok := target[tbl.LookupSelectItemsKey()]
 			if ok {
 				iArr, ok := items.([]interface{})
@@ -107,14 +123,14 @@ func (ss *Exec) Build() error {
 			}
 			// optional data return pattern to be included in grammar subsequently
 			// return util.PrepareResultSet(internaldto.NewPrepareResultSetDTO(nil, keys, columnOrder, nil, err, nil))
-			logging.GetLogger().Debugln(fmt.Sprintf("keys = %v", keys))
-			logging.GetLogger().Debugln(fmt.Sprintf("columnOrder = %v", columnOrder))
+			getResourceLogger(pc, tbl).Debugln(fmt.Sprintf("keys = %v", keys))
+			getResourceLogger(pc, tbl).Debugln(fmt.Sprintf("columnOrder = %v", columnOrder))
 		}
 		msgs := internaldto.BackendMessages{}
 		if err == nil {
 			msgs.WorkingMessages = generateSuccessMessagesFromHeirarchy(tbl, ss.isAwait)
 		}
-		return generateResultIfNeededfunc(keys, target, &msgs, err, ss.isShowResults)
+		return generateResultIfNeededfunc(keys, target, &msgs, err, ss.isShowResults).WithResponse(lastResponse)
 	}
 	execPrimitive := primitive.NewHTTPRestPrimitive(
 		prov,
@@ -126,10 +142,6 @@ func (ss *Exec) Build() error {
 		ss.graph.CreatePrimitiveNode(execPrimitive)
 		return nil
 	}
-	pr, err := composeAsyncMonitor(handlerCtx, execPrimitive, tbl, nil)
-	if err != nil {
-		return err
-	}
-	ss.graph.CreatePrimitiveNode(pr)
+	ss.graph.CreatePrimitiveNode(newAwaitedPrimitive(handlerCtx, prov, ex, tbl, ss.commentDirectives))
 	return nil
 }
@@ -9,7 +9,7 @@ import (
 	"github.com/stackql/stackql/internal/stackql/handler"
 	"github.com/stackql/stackql/internal/stackql/httpmiddleware"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
-	"github.com/stackql/stackql/internal/stackql/logging"
+	"github.com/stackql/stackql/internal/stackql/metrics"
 	"github.com/stackql/stackql/internal/stackql/primitive"
 	"github.com/stackql/stackql/internal/stackql/primitivegraph"
 	"github.com/stackql/stackql/internal/stackql/streaming"
@@ -87,7 +87,7 @@ func (ss *GraphQLSingleSelectAcquire) Build() error {
 	ex := func(pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
 		currentTcc := ss.insertPreparedStatementCtx.GetGCCtrlCtrs().Clone()
 		ss.graph.AddTxnControlCounters(currentTcc)
-
+		isAcquired := false
 		for _, reqCtx := range httpArmoury.GetRequestParams() {
 			req := reqCtx.GetRequest()
 			housekeepingDone := false
@@ -126,7 +126,7 @@ func (ss *GraphQLSingleSelectAcquire) Build() error {
 				if sqlErr != nil {
 					internaldto.NewErroneousExecutorOutput(sqlErr)
 				}
-				ss.drmCfg.ExtractObjectFromSQLRows(r, nonControlColumns, ss.stream)
+				ss.drmCfg.ExtractObjectFromSQLRows(pc.GetContext(), r, nonControlColumns, ss.stream)
 				return internaldto.ExecutorOutput{}
 			}
 			graphQLReader, err := graphql.NewStandardGQLReader(
@@ -145,6 +145,7 @@ func (ss *GraphQLSingleSelectAcquire) Build() error {
 			for {
 				response, err := graphQLReader.Read()
 				if len(response) > 0 {
+					metrics.ObservePage(prov.GetProviderString())
 					if !housekeepingDone && ss.insertPreparedStatementCtx != nil {
 						_, err = ss.handlerCtx.GetSQLEngine().Exec(ss.insertPreparedStatementCtx.GetGCHousekeepingQueries())
 						ss.insertionContainer.SetTableTxnCounters(tableName, ss.insertPreparedStatementCtx.GetGCCtrlCtrs())
@@ -157,14 +158,24 @@ func (ss *GraphQLSingleSelectAcquire) Build() error {
 					if err != nil {
 						return internaldto.NewErroneousExecutorOutput(err)
 					}
+					bulkInserter, insertErr := ss.drmCfg.NewBulkInserter(ss.handlerCtx.GetContext(), ss.handlerCtx.GetSQLEngine(), ss.insertPreparedStatementCtx, ss.handlerCtx.GetRuntimeContext().BulkInsertBatchSize)
+					if insertErr != nil {
+						return internaldto.NewErroneousExecutorOutput(insertErr)
+					}
 					for _, item := range response {
 						// TODO: handle request encoding
-						r, err := ss.drmCfg.ExecuteInsertDML(ss.handlerCtx.GetSQLEngine(), ss.insertPreparedStatementCtx, item, "")
-						logging.GetLogger().Infoln(fmt.Sprintf("insert result = %v, error = %v", r, err))
-						if err != nil {
-							return internaldto.NewErroneousExecutorOutput(err)
+						insertErr = bulkInserter.Add(item, "")
+						if insertErr != nil {
+							bulkInserter.Rollback()
+							return internaldto.NewErroneousExecutorOutput(insertErr)
 						}
 					}
+					insertErr = bulkInserter.Commit()
+					getResourceLogger(pc, ss.tableMeta).Infoln(fmt.Sprintf("bulk insert of %d items, error = %v", len(response), insertErr))
+					if insertErr != nil {
+						return internaldto.NewErroneousExecutorOutput(insertErr)
+					}
+					isAcquired = true
 				}
 				if err == io.EOF {
 					break
@@ -174,6 +185,9 @@ func (ss *GraphQLSingleSelectAcquire) Build() error {
 				}
 			}
 		}
+		if isAcquired {
+			recordAcquisitionHistory(ss.handlerCtx, ss.insertPreparedStatementCtx, ss.tableMeta)
+		}
 		return internaldto.ExecutorOutput{}
 	}
 
@@ -10,7 +10,6 @@ import (
 	"github.com/stackql/stackql/internal/stackql/httpbuild"
 	"github.com/stackql/stackql/internal/stackql/httpmiddleware"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
-	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/primitive"
 	"github.com/stackql/stackql/internal/stackql/primitivegraph"
 	"github.com/stackql/stackql/internal/stackql/tablemetadata"
@@ -69,6 +68,10 @@ func (ss *Insert) Build() error {
 	default:
 		return fmt.Errorf("mutation executor: cannnot accomodate node of type '%T'", node)
 	}
+	action := "insert"
+	if _, isUpdate := node.(*sqlparser.Update); isUpdate {
+		action = "update"
+	}
 	prov, err := tbl.GetProvider()
 	if err != nil {
 		return err
@@ -85,6 +88,10 @@ func (ss *Insert) Build() error {
 	if err != nil {
 		return err
 	}
+	returningProjection, isReturning, err := newReturningProjection(tbl, commentDirectives, isAwait)
+	if err != nil {
+		return err
+	}
 	insertPrimitive := primitive.NewHTTPRestPrimitive(
 		prov,
 		nil,
@@ -109,7 +116,11 @@ func (ss *Insert) Build() error {
 		if err != nil {
 			return internaldto.NewErroneousExecutorOutput(err)
 		}
-		httpArmoury, err := httpbuild.BuildHTTPRequestCtx(node, prov, m, svc, inputMap, nil)
+		httpArmoury, err := httpbuild.BuildHTTPRequestCtx(pc.GetContext(), node, prov, m, svc, inputMap, nil)
+		if err != nil {
+			return internaldto.NewErroneousExecutorOutput(err)
+		}
+		err = handlerCtx.GetSafetyPolicy().CheckAffectedRows(action, len(httpArmoury.GetRequestParams()))
 		if err != nil {
 			return internaldto.NewErroneousExecutorOutput(err)
 		}
@@ -121,10 +132,13 @@ func (ss *Insert) Build() error {
 				// logging.GetLogger().Infoln(fmt.Sprintf("req.BodyBytes = %s", string(req.BodyBytes)))
 				// req.Context.SetBody(bytes.NewReader(req.BodyBytes))
 				// logging.GetLogger().Infoln(fmt.Sprintf("req.Context = %v", req.Context))
-				response, apiErr := httpmiddleware.HttpApiCallFromRequest(handlerCtx.Clone(), prov, m, req.GetRequest())
+				response, apiErr := httpmiddleware.HttpApiMutationFromRequest(httpmiddleware.WithParentSpan(handlerCtx, pc.GetContext()), prov, m, req.GetRequest())
 				if apiErr != nil {
 					return internaldto.NewErroneousExecutorOutput(apiErr)
 				}
+				if response == nil {
+					return newPlannedCallOutput()
+				}
 
 				target, err = m.DeprecatedProcessResponse(response)
 				handlerCtx.LogHTTPResponseMap(target)
@@ -135,7 +149,7 @@ func (ss *Insert) Build() error {
 				if err != nil {
 					return internaldto.NewErroneousExecutorOutput(err)
 				}
-				logging.GetLogger().Infoln(fmt.Sprintf("target = %v", target))
+				getResourceLogger(pc, tbl).Infoln(fmt.Sprintf("target = %v", target))
 				items, ok := target[tbl.LookupSelectItemsKey()]
 				keys := make(map[string]map[string]interface{})
 				if ok {
@@ -155,16 +169,18 @@ func (ss *Insert) Build() error {
 				} else {
 					msgs.WorkingMessages = []string{err.Error()}
 				}
-				return internaldto.NewExecutorOutput(nil, target, nil, &msgs, err)
+				return internaldto.NewExecutorOutput(nil, target, nil, &msgs, err).WithResponse(response)
 			}
 			zeroArityExecutors = append(zeroArityExecutors, zeroArityEx)
 		}
 		resultSet := internaldto.NewErroneousExecutorOutput(fmt.Errorf("no executions detected"))
 		msgs := internaldto.BackendMessages{}
 		if !isAwait {
+			var responseBodies []map[string]interface{}
 			for _, ei := range zeroArityExecutors {
 				execInstance := ei
 				resultSet = execInstance()
+				responseBodies = append(responseBodies, resultSet.GetOutputBody())
 				if resultSet.Msg != nil && resultSet.Msg.WorkingMessages != nil && len(resultSet.Msg.WorkingMessages) > 0 {
 					for _, m := range resultSet.Msg.WorkingMessages {
 						msgs.WorkingMessages = append(msgs.WorkingMessages, m)
@@ -175,6 +191,9 @@ func (ss *Insert) Build() error {
 					return resultSet
 				}
 			}
+			if isReturning {
+				return returningProjection.prepareResultSet(responseBodies, &msgs)
+			}
 			resultSet.Msg = &msgs
 			return resultSet
 		}
@@ -8,7 +8,6 @@ import (
 	"github.com/stackql/stackql/internal/stackql/drm"
 	"github.com/stackql/stackql/internal/stackql/handler"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
-	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/nativedb"
 	"github.com/stackql/stackql/internal/stackql/primitive"
 	"github.com/stackql/stackql/internal/stackql/primitivegraph"
@@ -45,7 +44,7 @@ func (ss *NativeSelect) Build() error {
 	selectEx := func(pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
 
 		// select phase
-		logging.GetLogger().Infoln(fmt.Sprintf("running empty select with columns: %v", ss.selectQuery))
+		subsystem.GetContextLogger(pc.GetContext()).Infoln(fmt.Sprintf("running empty select with columns: %v", ss.selectQuery))
 
 		var colz []string
 		for _, col := range ss.selectQuery.GetColumns() {
@@ -5,7 +5,6 @@ import (
 
 	"github.com/stackql/stackql/internal/stackql/handler"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
-	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/primitive"
 	"github.com/stackql/stackql/internal/stackql/primitivegraph"
 	"github.com/stackql/stackql/internal/stackql/util"
@@ -46,16 +45,16 @@ func (ss *RawNativeExec) Build() error {
 	selectEx := func(pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
 
 		// select phase
-		logging.GetLogger().Infoln(fmt.Sprintf("running native query: '''%s''' ", ss.nativeQuery))
+		subsystem.GetContextLogger(pc.GetContext()).Infoln(fmt.Sprintf("running native query: '''%s''' ", ss.nativeQuery))
 
 		row, err := ss.handlerCtx.GetSQLEngine().Exec(ss.nativeQuery)
 
 		if row != nil {
 			rowsAffected, countErr := row.RowsAffected()
 			if countErr == nil {
-				logging.GetLogger().Debugf("native exec rows affected = %d\n", rowsAffected)
+				subsystem.GetContextLogger(pc.GetContext()).Debugf("native exec rows affected = %d\n", rowsAffected)
 			} else {
-				logging.GetLogger().Infof("native exec affected count error = '%s'\n", countErr.Error())
+				subsystem.GetContextLogger(pc.GetContext()).Infof("native exec affected count error = '%s'\n", countErr.Error())
 			}
 		}
 
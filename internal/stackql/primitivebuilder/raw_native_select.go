@@ -6,7 +6,6 @@ import (
 	"github.com/stackql/stackql/internal/stackql/data_staging/input_data_staging"
 	"github.com/stackql/stackql/internal/stackql/handler"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
-	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/primitive"
 	"github.com/stackql/stackql/internal/stackql/primitivegraph"
 )
@@ -46,7 +45,7 @@ func (ss *RawNativeSelect) Build() error {
 	selectEx := func(pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
 
 		// select phase
-		logging.GetLogger().Infoln(fmt.Sprintf("running native query: '''%s''' ", ss.nativeQuery))
+		subsystem.GetContextLogger(pc.GetContext()).Infoln(fmt.Sprintf("running native query: '''%s''' ", ss.nativeQuery))
 
 		rows, err := ss.handlerCtx.GetSQLEngine().Query(ss.nativeQuery)
 
@@ -55,7 +54,7 @@ func (ss *RawNativeSelect) Build() error {
 		}
 		defer rows.Close()
 
-		preparator := input_data_staging.NewNaiveNativeResultSetPreparator(rows, ss.handlerCtx.GetDrmConfig(), nil)
+		preparator := input_data_staging.NewNaiveNativeResultSetPreparator(ss.handlerCtx.GetContext(), rows, ss.handlerCtx.GetDrmConfig(), nil, 0)
 
 		rv := preparator.PrepareNativeResultSet()
 		return rv
@@ -0,0 +1,137 @@
+package primitivebuilder
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
+	"github.com/stackql/stackql/internal/stackql/returning"
+	"github.com/stackql/stackql/internal/stackql/tablemetadata"
+	"github.com/stackql/stackql/internal/stackql/util"
+)
+
+// returningProjection renders provider response bodies of a mutation,
+// per RETURNING, as a result set.  Columns are top level properties
+// of the method's response schema.
+type returningProjection struct {
+	columns  []string
+	itemsKey string
+}
+
+// newReturningProjection returns the projection of the RETURNING clause, if any.
+// Awaited mutations are rejected, because the final response of a long
+// running operation describes the operation, rather than the resource.
+func newReturningProjection(tbl tablemetadata.ExtendedTableMetadata, commentDirectives sqlparser.CommentDirectives, isAwait bool) (*returningProjection, bool, error) {
+	columns, isReturning := returning.GetColumns(commentDirectives)
+	if !isReturning {
+		return nil, false, nil
+	}
+	if isAwait {
+		return nil, false, fmt.Errorf("RETURNING is not supported for awaited mutations; query the resource once the mutation is complete")
+	}
+	rv := &returningProjection{
+		itemsKey: tbl.LookupSelectItemsKey(),
+	}
+	schema, _, err := tbl.GetResponseSchemaAndMediaType()
+	if err != nil || schema == nil {
+		// Absent a schema, columns are inferred from the response.
+		if !returning.IsAllColumns(columns) {
+			rv.columns = columns
+		}
+		return rv, true, nil
+	}
+	if itemsSchema, err := schema.GetProperty(rv.itemsKey); err == nil && itemsSchema.Type == "array" {
+		if items, err := itemsSchema.GetItems(); err == nil && items != nil {
+			schema = items
+		}
+	}
+	if returning.IsAllColumns(columns) {
+		for _, col := range schema.Tabulate(false).GetColumns() {
+			rv.columns = append(rv.columns, col.Name)
+		}
+		return rv, true, nil
+	}
+	m, err := tbl.GetMethod()
+	if err != nil {
+		return nil, false, err
+	}
+	for _, col := range columns {
+		if _, err := schema.GetProperty(col); err != nil {
+			return nil, false, fmt.Errorf("RETURNING column '%s' is not present in the response of method '%s'", col, m.GetName())
+		}
+	}
+	rv.columns = columns
+	return rv, true, nil
+}
+
+// getRows returns a row per item of a list response, otherwise the response itself.
+func (rp *returningProjection) getRows(body map[string]interface{}) []map[string]interface{} {
+	if body == nil {
+		return nil
+	}
+	if items, ok := body[rp.itemsKey].([]interface{}); ok {
+		var rv []map[string]interface{}
+		for _, item := range items {
+			if row, ok := item.(map[string]interface{}); ok {
+				rv = append(rv, row)
+			}
+		}
+		return rv
+	}
+	return []map[string]interface{}{body}
+}
+
+func (rp *returningProjection) prepareResultSet(bodies []map[string]interface{}, msgs *internaldto.BackendMessages) internaldto.ExecutorOutput {
+	rowMap := make(map[string]map[string]interface{})
+	columns := rp.columns
+	inferColumns := columns == nil
+	inferredColumns := make(map[string]struct{})
+	for _, body := range bodies {
+		for _, row := range rp.getRows(body) {
+			projected := make(map[string]interface{})
+			if inferColumns {
+				for k, v := range row {
+					projected[k] = v
+					inferredColumns[k] = struct{}{}
+				}
+			} else {
+				for _, col := range columns {
+					projected[col] = row[col]
+				}
+			}
+			rowMap[strconv.Itoa(len(rowMap))] = projected
+		}
+	}
+	if inferColumns {
+		for k := range inferredColumns {
+			columns = append(columns, k)
+		}
+		sort.Strings(columns)
+	}
+	return util.PrepareResultSet(
+		internaldto.NewPrepareResultSetDTO(
+			nil,
+			rowMap,
+			columns,
+			returningRowSort,
+			nil,
+			msgs,
+		),
+	)
+}
+
+// returningRowSort retains response order.
+func returningRowSort(rowMap map[string]map[string]interface{}) []string {
+	var keys []string
+	for k := range rowMap {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		iVal, _ := strconv.Atoi(keys[i])
+		jVal, _ := strconv.Atoi(keys[j])
+		return iVal < jVal
+	})
+	return keys
+}
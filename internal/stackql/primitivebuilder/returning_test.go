@@ -0,0 +1,50 @@
+package primitivebuilder
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestReturningProjectionGetRows(t *testing.T) {
+	rp := &returningProjection{itemsKey: "items"}
+	listBody := map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"name": "a"},
+			"not an object",
+			map[string]interface{}{"name": "b"},
+		},
+	}
+	if got := rp.getRows(listBody); len(got) != 2 || got[0]["name"] != "a" || got[1]["name"] != "b" {
+		t.Fatalf("getRows() of a list response = %v", got)
+	}
+	body := map[string]interface{}{"name": "operation-1"}
+	if got := rp.getRows(body); len(got) != 1 || !reflect.DeepEqual(got[0], body) {
+		t.Fatalf("getRows() of a response = %v", got)
+	}
+	if got := rp.getRows(nil); got != nil {
+		t.Fatalf("getRows() of no response = %v", got)
+	}
+}
+
+func TestReturningRowSort(t *testing.T) {
+	rowMap := make(map[string]map[string]interface{})
+	var want []string
+	for i := 0; i < 12; i++ {
+		rowMap[strconv.Itoa(i)] = nil
+		want = append(want, strconv.Itoa(i))
+	}
+	if got := returningRowSort(rowMap); !reflect.DeepEqual(got, want) {
+		t.Fatalf("returningRowSort() = %v, want response order %v", got, want)
+	}
+}
+
+func TestNewReturningProjectionRejectsAwait(t *testing.T) {
+	directives := map[string]interface{}{"RETURNING": "(name)"}
+	if _, _, err := newReturningProjection(nil, directives, true); err == nil {
+		t.Fatalf("newReturningProjection() of an awaited mutation error = nil")
+	}
+	if rp, isReturning, err := newReturningProjection(nil, nil, true); rp != nil || isReturning || err != nil {
+		t.Fatalf("newReturningProjection() without RETURNING = (%v, %t, %v)", rp, isReturning, err)
+	}
+}
@@ -9,9 +9,9 @@ import (
 	"github.com/stackql/go-openapistackql/openapistackql"
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 	"github.com/stackql/stackql/internal/stackql/constants"
+	"github.com/stackql/stackql/internal/stackql/dto"
 	"github.com/stackql/stackql/internal/stackql/handler"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
-	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/metadatavisitors"
 	"github.com/stackql/stackql/internal/stackql/primitive"
 	"github.com/stackql/stackql/internal/stackql/provider"
@@ -88,30 +88,33 @@ func NewShowInstructionExecutor(node *sqlparser.Show, prov provider.IProvider, t
 	var columnOrder []string
 	var err error
 	var filter func(interface{}) (openapistackql.ITable, error)
-	logging.GetLogger().Infoln(fmt.Sprintf("filter type = %T", filter))
+	subsystem.GetContextLogger(handlerCtx.GetContext()).Infoln(fmt.Sprintf("filter type = %T", filter))
 	switch nodeTypeUpperCase {
 	case "AUTH":
-		logging.GetLogger().Infoln(fmt.Sprintf("Show For node.Type = '%s'", node.Type))
-		if err == nil {
-			authCtx, err := handlerCtx.GetAuthContext(prov.GetProviderString())
-			if err == nil {
-				var authMeta *openapistackql.AuthMetadata
-				authMeta, err = prov.ShowAuth(authCtx)
-				if err == nil {
-					keys = map[string]map[string]interface{}{
-						"1": authMeta.ToMap(),
-					}
-					columnOrder = authMeta.GetHeaders()
-				}
-			}
+		subsystem.GetContextLogger(handlerCtx.GetContext()).Infoln(fmt.Sprintf("Show For node.Type = '%s'", node.Type))
+		if prov == nil {
+			keys, columnOrder = showAuthForAllProviders(handlerCtx)
+			break
+		}
+		authCtx, authErr := handlerCtx.GetAuthContext(prov.GetProviderString())
+		if authErr != nil {
+			return prepareErroneousResultSet(keys, columnOrder, authErr)
+		}
+		authMeta, showErr := prov.ShowAuth(handlerCtx.GetContext(), authCtx)
+		if authMeta == nil {
+			return prepareErroneousResultSet(keys, columnOrder, showErr)
+		}
+		keys = map[string]map[string]interface{}{
+			"1": authMeta.ToMap(),
 		}
+		columnOrder = authMeta.GetHeaders()
 	case "INSERT":
 		ppCtx := prettyprint.NewPrettyPrintContext(
 			handlerCtx.GetRuntimeContext().OutputFormat == constants.PrettyTextStr,
 			constants.DefaultPrettyPrintIndent,
 			constants.DefaultPrettyPrintBaseIndent,
 			"'",
-			logging.GetLogger(),
+			subsystem.GetContextLogger(handlerCtx.GetContext()),
 		)
 		if err != nil {
 			return util.GenerateSimpleErroneousOutput(err)
@@ -131,7 +134,7 @@ func NewShowInstructionExecutor(node *sqlparser.Show, prov provider.IProvider, t
 		pp := prettyprint.NewPrettyPrinter(ppCtx)
 		ppPlaceholder := prettyprint.NewPrettyPrinter(ppCtx)
 		requiredOnly := commentDirectives != nil && commentDirectives.IsSet("REQUIRED")
-		insertStmt, err := metadatavisitors.ToInsertStatement(node.Columns, meth, svc, extended, pp, ppPlaceholder, requiredOnly)
+		insertStmt, err := metadatavisitors.ToInsertStatement(handlerCtx.GetContext(), node.Columns, meth, svc, extended, pp, ppPlaceholder, requiredOnly)
 		tableName, _ := tbl.GetTableName()
 		if err != nil {
 			return util.GenerateSimpleErroneousOutput(fmt.Errorf("error creating insert statement for %s: %s", tableName, err.Error()))
@@ -148,7 +151,7 @@ func NewShowInstructionExecutor(node *sqlparser.Show, prov provider.IProvider, t
 		methods := rsc.GetMethodsMatched()
 		var filter func(openapistackql.ITable) (openapistackql.ITable, error)
 		if tbl == nil {
-			logging.GetLogger().Infoln(fmt.Sprintf("table and therefore filter not found for AST, shall procede nil filter"))
+			subsystem.GetContextLogger(handlerCtx.GetContext()).Infoln(fmt.Sprintf("table and therefore filter not found for AST, shall procede nil filter"))
 		} else {
 			filter = tbl.GetTableFilter()
 		}
@@ -194,7 +197,7 @@ func NewShowInstructionExecutor(node *sqlparser.Show, prov provider.IProvider, t
 		columnOrder = openapistackql.GetResourcesHeader(extended)
 		var filter func(openapistackql.ITable) (openapistackql.ITable, error)
 		if err != nil {
-			logging.GetLogger().Infoln(fmt.Sprintf("table and therefore filter not found for AST, shall procede nil filter"))
+			subsystem.GetContextLogger(handlerCtx.GetContext()).Infoln(fmt.Sprintf("table and therefore filter not found for AST, shall procede nil filter"))
 		} else {
 			filter = tableFilter
 		}
@@ -207,7 +210,7 @@ func NewShowInstructionExecutor(node *sqlparser.Show, prov provider.IProvider, t
 			keys[k] = v.ToMap(extended)
 		}
 	case "SERVICES":
-		logging.GetLogger().Infoln(fmt.Sprintf("Show For node.Type = '%s': Displaying services for provider = '%s'", node.Type, prov.GetProviderString()))
+		subsystem.GetContextLogger(handlerCtx.GetContext()).Infoln(fmt.Sprintf("Show For node.Type = '%s': Displaying services for provider = '%s'", node.Type, prov.GetProviderString()))
 		var services map[string]*openapistackql.ProviderService
 		services, err = prov.GetProviderServicesRedacted(handlerCtx.GetRuntimeContext(), extended)
 		if err != nil {
@@ -223,6 +226,35 @@ func NewShowInstructionExecutor(node *sqlparser.Show, prov provider.IProvider, t
 	return util.PrepareResultSet(internaldto.NewPrepareResultSetDTO(nil, keys, columnOrder, nil, err, nil))
 }
 
+// showAuthForAllProviders reports auth status for every
+// provider with a configured auth context, in name order.
+// Providers that cannot be loaded are reported from
+// their auth context alone.
+func showAuthForAllProviders(handlerCtx handler.HandlerContext) (map[string]map[string]interface{}, []string) {
+	authContexts := handlerCtx.GetAuthContexts()
+	var providerNames []string
+	for k := range authContexts {
+		providerNames = append(providerNames, k)
+	}
+	sort.Strings(providerNames)
+	keys := make(map[string]map[string]interface{})
+	columnOrder := (&dto.AuthMetadata{}).GetHeaders()
+	for i, providerName := range providerNames {
+		authCtx := authContexts[providerName]
+		var authMeta *dto.AuthMetadata
+		prov, err := handlerCtx.GetProvider(providerName)
+		if err == nil {
+			authMeta, err = prov.ShowAuth(handlerCtx.GetContext(), authCtx)
+		}
+		if authMeta == nil {
+			subsystem.GetContextLogger(handlerCtx.GetContext()).Infoln(fmt.Sprintf("show auth for provider = '%s': %v", providerName, err))
+			authMeta = dto.NewAuthMetadata(providerName, authCtx.Type, "", "", authCtx)
+		}
+		keys[fmt.Sprintf("%06d", i)] = authMeta.ToMap()
+	}
+	return keys, columnOrder
+}
+
 func filterResources(resources map[string]*openapistackql.Resource, tableFilter func(openapistackql.ITable) (openapistackql.ITable, error)) (map[string]*openapistackql.Resource, error) {
 	var err error
 	if tableFilter != nil {
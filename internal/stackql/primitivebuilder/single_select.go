@@ -7,9 +7,9 @@ import (
 	"github.com/stackql/stackql/internal/stackql/drm"
 	"github.com/stackql/stackql/internal/stackql/handler"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
-	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/primitive"
 	"github.com/stackql/stackql/internal/stackql/primitivegraph"
+	"github.com/stackql/stackql/internal/stackql/sqlmachinery"
 	"github.com/stackql/stackql/internal/stackql/streaming"
 	"github.com/stackql/stackql/internal/stackql/tableinsertioncontainer"
 )
@@ -60,12 +60,14 @@ func (ss *SingleSelect) Build() error {
 	selectEx := func(pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
 
 		// select phase
-		logging.GetLogger().Infoln(fmt.Sprintf("running select with control parameters: %v", ss.selectPreparedStatementCtx.GetGCCtrlCtrs()))
+		subsystem.GetContextLogger(pc.GetContext()).Infoln(fmt.Sprintf("running select with control parameters: %v", ss.selectPreparedStatementCtx.GetGCCtrlCtrs()))
 
 		outputter := output_data_staging.NewNaiveOutputter(
 			output_data_staging.NewNaivePacketPreparator(
+				pc.GetContext(),
 				output_data_staging.NewNaiveSource(
-					ss.handlerCtx.GetSQLEngine(),
+					pc.GetContext(),
+					sqlmachinery.NewContextQuerier(ss.handlerCtx.GetContext(), ss.handlerCtx.GetSQLEngine()),
 					drm.NewPreparedStatementParameterized(ss.selectPreparedStatementCtx, nil, true),
 					ss.drmCfg,
 				),
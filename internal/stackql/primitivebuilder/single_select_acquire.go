@@ -1,6 +1,7 @@
 package primitivebuilder
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -10,7 +11,8 @@ import (
 	"github.com/stackql/stackql/internal/stackql/handler"
 	"github.com/stackql/stackql/internal/stackql/httpmiddleware"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
-	"github.com/stackql/stackql/internal/stackql/logging"
+	"github.com/stackql/stackql/internal/stackql/iqlerror"
+	"github.com/stackql/stackql/internal/stackql/metrics"
 	"github.com/stackql/stackql/internal/stackql/primitive"
 	"github.com/stackql/stackql/internal/stackql/primitivegraph"
 	"github.com/stackql/stackql/internal/stackql/streaming"
@@ -142,7 +144,11 @@ func (ss *SingleSelectAcquire) Build() error {
 				httpArmoury.SetRequestParams(passOverParams)
 			}
 		}
+		isAcquired := false
 		for _, reqCtx := range httpArmoury.GetRequestParams() {
+			if ctxErr := ss.handlerCtx.GetContext().Err(); ctxErr != nil {
+				return internaldto.NewErroneousExecutorOutput(iqlerror.GetQueryCancelledError(ctxErr))
+			}
 			paramsUsed, err := reqCtx.ToFlatMap()
 			if err != nil {
 				return internaldto.NewErroneousExecutorOutput(err)
@@ -162,11 +168,11 @@ func (ss *SingleSelectAcquire) Build() error {
 				if sqlErr != nil {
 					internaldto.NewErroneousExecutorOutput(sqlErr)
 				}
-				ss.drmCfg.ExtractObjectFromSQLRows(r, nonControlColumns, ss.stream)
+				ss.drmCfg.ExtractObjectFromSQLRows(pc.GetContext(), r, nonControlColumns, ss.stream)
 				return internaldto.ExecutorOutput{}
 			}
 			// TODO: fix cloning ops
-			response, apiErr := httpmiddleware.HttpApiCallFromRequest(ss.handlerCtx.Clone(), prov, m, reqCtx.GetRequest().Clone(reqCtx.GetRequest().Context()))
+			response, apiErr := httpmiddleware.HttpApiCallFromRequest(httpmiddleware.WithParentSpan(ss.handlerCtx, pc.GetContext()), prov, m, reqCtx.GetRequest().Clone(reqCtx.GetRequest().Context()))
 			housekeepingDone := false
 			npt := prov.InferNextPageResponseElement(ss.tableMeta.GetHeirarchyObjects())
 			nptRequest := prov.InferNextPageRequestElement(ss.tableMeta.GetHeirarchyObjects())
@@ -179,11 +185,12 @@ func (ss *SingleSelectAcquire) Build() error {
 				if err != nil {
 					return internaldto.NewErroneousExecutorOutput(err)
 				}
+				metrics.ObservePage(prov.GetProviderString())
 				ss.handlerCtx.LogHTTPResponseMap(res.GetProcessedBody())
 				if err != nil {
 					return internaldto.NewErroneousExecutorOutput(err)
 				}
-				logging.GetLogger().Infoln(fmt.Sprintf("target = %v", res))
+				getResourceLogger(pc, ss.tableMeta).Infoln(fmt.Sprintf("target = %v", res))
 				var items interface{}
 				var ok bool
 				target := res.GetProcessedBody()
@@ -224,6 +231,10 @@ func (ss *SingleSelectAcquire) Build() error {
 					if err != nil {
 						return internaldto.NewErroneousExecutorOutput(err)
 					}
+					err = ss.handlerCtx.GetQueryBudget().AcquireRows(len(iArr))
+					if err != nil {
+						return internaldto.NewErroneousExecutorOutput(err)
+					}
 					if ok && len(iArr) > 0 {
 						if !housekeepingDone && ss.insertPreparedStatementCtx != nil {
 							_, err = ss.handlerCtx.GetSQLEngine().Exec(ss.insertPreparedStatementCtx.GetGCHousekeepingQueries())
@@ -236,41 +247,52 @@ func (ss *SingleSelectAcquire) Build() error {
 							return internaldto.NewErroneousExecutorOutput(err)
 						}
 
+						getResourceLogger(pc, ss.tableMeta).Infoln(fmt.Sprintf("running bulk insert of %d items with control parameters: %v", len(iArr), ss.insertPreparedStatementCtx.GetGCCtrlCtrs()))
+						bulkInserter, err := ss.drmCfg.NewBulkInserter(ss.handlerCtx.GetContext(), ss.handlerCtx.GetSQLEngine(), ss.insertPreparedStatementCtx, ss.handlerCtx.GetRuntimeContext().BulkInsertBatchSize)
+						if err != nil {
+							return internaldto.NewErroneousExecutorOutput(fmt.Errorf("sql insert error: '%s' from query: %s", err.Error(), ss.insertPreparedStatementCtx.GetQuery()))
+						}
 						for i, item := range iArr {
 							if item != nil {
 
-								if err == nil {
-									for k, v := range paramsUsed {
-										if _, ok := item[k]; !ok {
-											item[k] = v
-										}
+								for k, v := range paramsUsed {
+									if _, ok := item[k]; !ok {
+										item[k] = v
 									}
 								}
 
-								logging.GetLogger().Infoln(fmt.Sprintf("running insert with control parameters: %v", ss.insertPreparedStatementCtx.GetGCCtrlCtrs()))
-								r, err := ss.drmCfg.ExecuteInsertDML(ss.handlerCtx.GetSQLEngine(), ss.insertPreparedStatementCtx, item, reqEncoding)
-								logging.GetLogger().Infoln(fmt.Sprintf("insert result = %v, error = %v", r, err))
+								err = bulkInserter.Add(item, reqEncoding)
 								if err != nil {
+									bulkInserter.Rollback()
 									return internaldto.NewErroneousExecutorOutput(fmt.Errorf("sql insert error: '%s' from query: %s", err.Error(), ss.insertPreparedStatementCtx.GetQuery()))
 								}
 								keys[strconv.Itoa(i)] = item
 							}
 						}
+						err = bulkInserter.Commit()
+						getResourceLogger(pc, ss.tableMeta).Infoln(fmt.Sprintf("bulk insert error = %v", err))
+						if err != nil {
+							return internaldto.NewErroneousExecutorOutput(fmt.Errorf("sql insert error: '%s' from query: %s", err.Error(), ss.insertPreparedStatementCtx.GetQuery()))
+						}
+						isAcquired = true
 					}
 				}
 				if npt == nil || nptRequest == nil {
 					break
 				}
-				tk := extractNextPageToken(res, npt)
+				tk := extractNextPageToken(pc.GetContext(), res, npt)
 				if tk == "" || tk == "<nil>" || tk == "[]" || (ss.handlerCtx.GetRuntimeContext().HTTPPageLimit > 0 && pageCount >= ss.handlerCtx.GetRuntimeContext().HTTPPageLimit) {
 					break
 				}
+				if ctxErr := ss.handlerCtx.GetContext().Err(); ctxErr != nil {
+					return internaldto.NewErroneousExecutorOutput(iqlerror.GetQueryCancelledError(ctxErr))
+				}
 				pageCount++
 				req, err := reqCtx.SetNextPage(m, tk, nptRequest)
 				if err != nil {
 					return internaldto.NewErroneousExecutorOutput(err)
 				}
-				response, apiErr = httpmiddleware.HttpApiCallFromRequest(ss.handlerCtx.Clone(), prov, m, req)
+				response, apiErr = httpmiddleware.HttpApiCallFromRequest(httpmiddleware.WithParentSpan(ss.handlerCtx, pc.GetContext()), prov, m, req)
 			}
 			if reqCtx.GetRequest() != nil {
 				q := reqCtx.GetRequest().URL.Query()
@@ -278,6 +300,9 @@ func (ss *SingleSelectAcquire) Build() error {
 				reqCtx.SetRawQuery(q.Encode())
 			}
 		}
+		if isAcquired {
+			recordAcquisitionHistory(ss.handlerCtx, ss.insertPreparedStatementCtx, ss.tableMeta)
+		}
 		return internaldto.ExecutorOutput{}
 	}
 
@@ -297,10 +322,10 @@ func (ss *SingleSelectAcquire) Build() error {
 	return nil
 }
 
-func extractNextPageToken(res *response.Response, tokenKey internaldto.HTTPElement) string {
+func extractNextPageToken(ctx context.Context, res *response.Response, tokenKey internaldto.HTTPElement) string {
 	switch tokenKey.GetType() {
 	case internaldto.BodyAttribute:
-		return extractNextPageTokenFromBody(res, tokenKey)
+		return extractNextPageTokenFromBody(ctx, res, tokenKey)
 	case internaldto.Header:
 		return extractNextPageTokenFromHeader(res, tokenKey)
 	}
@@ -331,7 +356,7 @@ func extractNextPageTokenFromHeader(res *response.Response, tokenKey internaldto
 	return ""
 }
 
-func extractNextPageTokenFromBody(res *response.Response, tokenKey internaldto.HTTPElement) string {
+func extractNextPageTokenFromBody(ctx context.Context, res *response.Response, tokenKey internaldto.HTTPElement) string {
 	elem, err := httpelement.NewHTTPElement(tokenKey.GetName(), "body")
 	if err == nil {
 		rawVal, err := res.ExtractElement(elem)
@@ -352,12 +377,12 @@ func extractNextPageTokenFromBody(res *response.Response, tokenKey internaldto.H
 		tokenName := tokenKey.GetName()
 		nextPageToken, ok := target[tokenName]
 		if !ok || nextPageToken == "" {
-			logging.GetLogger().Infoln("breaking out")
+			subsystem.GetContextLogger(ctx).Infoln("breaking out")
 			return ""
 		}
 		tk, ok := nextPageToken.(string)
 		if !ok {
-			logging.GetLogger().Infoln("breaking out")
+			subsystem.GetContextLogger(ctx).Infoln("breaking out")
 			return ""
 		}
 		return tk
@@ -0,0 +1,47 @@
+package primitivebuilder
+
+import (
+	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
+	"github.com/stackql/stackql/internal/stackql/primitive"
+	"github.com/stackql/stackql/internal/stackql/primitivegraph"
+	"github.com/stackql/stackql/internal/stackql/sqlexport"
+)
+
+// SQLDataSourceExport implements the Builder interface
+// and represents an insert into an external sql data source
+// table, the rows of which are the result of a separately
+// planned select.
+type SQLDataSourceExport struct {
+	graph              primitivegraph.PrimitiveGraph
+	root               primitivegraph.PrimitiveNode
+	selectInstructions primitive.IPrimitive
+	exporter           sqlexport.Exporter
+}
+
+func NewSQLDataSourceExport(
+	graph primitivegraph.PrimitiveGraph,
+	selectInstructions primitive.IPrimitive,
+	exporter sqlexport.Exporter,
+) Builder {
+	return &SQLDataSourceExport{
+		graph:              graph,
+		selectInstructions: selectInstructions,
+		exporter:           exporter,
+	}
+}
+
+func (ex *SQLDataSourceExport) GetRoot() primitivegraph.PrimitiveNode {
+	return ex.root
+}
+
+func (ex *SQLDataSourceExport) GetTail() primitivegraph.PrimitiveNode {
+	return ex.root
+}
+
+func (ex *SQLDataSourceExport) Build() error {
+	exportEx := func(pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
+		return sqlexport.ExportOutput(pc.GetContext(), ex.exporter, ex.selectInstructions.Execute(pc))
+	}
+	ex.root = ex.graph.CreatePrimitiveNode(primitive.NewLocalPrimitive(exportEx))
+	return nil
+}
@@ -0,0 +1,70 @@
+package primitivebuilder
+
+import (
+	"fmt"
+
+	"github.com/stackql/stackql/internal/stackql/data_staging/input_data_staging"
+	"github.com/stackql/stackql/internal/stackql/datasource/sql_datasource"
+	"github.com/stackql/stackql/internal/stackql/handler"
+	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
+	"github.com/stackql/stackql/internal/stackql/primitive"
+	"github.com/stackql/stackql/internal/stackql/primitivegraph"
+)
+
+// SQLDataSourceSelect implements the Builder interface
+// and represents a select statement that is executed
+// in its entirety by an external sql data source.
+type SQLDataSourceSelect struct {
+	graph         primitivegraph.PrimitiveGraph
+	handlerCtx    handler.HandlerContext
+	root          primitivegraph.PrimitiveNode
+	sqlDataSource sql_datasource.SQLDataSource
+	query         string
+}
+
+func NewSQLDataSourceSelect(
+	graph primitivegraph.PrimitiveGraph,
+	handlerCtx handler.HandlerContext,
+	sqlDataSource sql_datasource.SQLDataSource,
+	query string,
+) Builder {
+	return &SQLDataSourceSelect{
+		graph:         graph,
+		handlerCtx:    handlerCtx,
+		sqlDataSource: sqlDataSource,
+		query:         query,
+	}
+}
+
+func (ss *SQLDataSourceSelect) GetRoot() primitivegraph.PrimitiveNode {
+	return ss.root
+}
+
+func (ss *SQLDataSourceSelect) GetTail() primitivegraph.PrimitiveNode {
+	return ss.root
+}
+
+func (ss *SQLDataSourceSelect) Build() error {
+	selectEx := func(pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
+		logSQLDataSourceQuery(ss.handlerCtx, ss.sqlDataSource, ss.query)
+		rows, err := ss.sqlDataSource.Query(ss.query)
+		if err != nil {
+			return internaldto.NewErroneousExecutorOutput(err)
+		}
+		defer rows.Close()
+		preparator := input_data_staging.NewTextNativeResultSetPreparator(ss.handlerCtx.GetContext(), rows, ss.handlerCtx.GetDrmConfig())
+		return preparator.PrepareNativeResultSet()
+	}
+	selectNode := ss.graph.CreatePrimitiveNode(primitive.NewLocalPrimitive(selectEx))
+	ss.root = selectNode
+	return nil
+}
+
+// logSQLDataSourceQuery records the query sent to an external
+// sql data source, so that pushdown can be inspected.
+func logSQLDataSourceQuery(handlerCtx handler.HandlerContext, sqlDataSource sql_datasource.SQLDataSource, query string) {
+	subsystem.GetContextLogger(handlerCtx.GetContext()).Infoln(fmt.Sprintf("sql data source query: dialect = '%s', query = '''%s'''", sqlDataSource.GetDBName(), query))
+	if handlerCtx.GetRuntimeContext().VerboseFlag {
+		handlerCtx.GetOutErrFile().Write([]byte(fmt.Sprintf("sql data source query: dialect = '%s', query = '%s'\n", sqlDataSource.GetDBName(), query)))
+	}
+}
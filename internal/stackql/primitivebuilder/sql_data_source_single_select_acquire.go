@@ -112,7 +112,7 @@ func (ss *sqlDataSourceSingleSelectAcquire) Build() error {
 	// targetTableName := annotationCtx.GetHIDs().GetStackQLTableName()
 	// inputQuery := fmt.Sprintf(`INSERT INTO %s ( %s ) VALUES ( ?,  )`, targetTableName, projectionStr, tableName)
 	ex := func(pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
-		// ss.tableMeta.GetP
+		logSQLDataSourceQuery(ss.handlerCtx, sqlDB, ss.query)
 		rows, err := sqlDB.Query(ss.query, ss.queryArgs...)
 		if err != nil {
 			return internaldto.NewErroneousExecutorOutput(err)
@@ -121,7 +121,7 @@ func (ss *sqlDataSourceSingleSelectAcquire) Build() error {
 		ss.graph.AddTxnControlCounters(currentTcc)
 		currentTcc.SetTableName(tableName)
 		ss.insertionContainer.SetTableTxnCounters(tableName, currentTcc)
-		preparator := input_data_staging.NewNaiveNativeResultSetPreparator(rows, ss.handlerCtx.GetDrmConfig(), ss.insertPreparedStatementCtx)
+		preparator := input_data_staging.NewNaiveNativeResultSetPreparator(ss.handlerCtx.GetContext(), rows, ss.handlerCtx.GetDrmConfig(), ss.insertPreparedStatementCtx, ss.handlerCtx.GetRuntimeContext().BulkInsertBatchSize)
 		return preparator.PrepareNativeResultSet()
 	}
 
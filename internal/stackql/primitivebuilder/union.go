@@ -7,6 +7,7 @@ import (
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
 	"github.com/stackql/stackql/internal/stackql/primitive"
 	"github.com/stackql/stackql/internal/stackql/primitivegraph"
+	"github.com/stackql/stackql/internal/stackql/sqlmachinery"
 	"github.com/stackql/stackql/internal/stackql/streaming"
 )
 
@@ -25,8 +26,10 @@ func (un *Union) Build() error {
 		us := drm.NewPreparedStatementParameterized(un.unionCtx, nil, false)
 		outputter := output_data_staging.NewNaiveOutputter(
 			output_data_staging.NewNaivePacketPreparator(
+				pc.GetContext(),
 				output_data_staging.NewNaiveSource(
-					un.handlerCtx.GetSQLEngine(),
+					pc.GetContext(),
+					sqlmachinery.NewContextQuerier(un.handlerCtx.GetContext(), un.handlerCtx.GetSQLEngine()),
 					us,
 					un.drmCfg,
 				),
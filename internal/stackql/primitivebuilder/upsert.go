@@ -0,0 +1,562 @@
+package primitivebuilder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/stackql/go-openapistackql/openapistackql"
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/constants"
+	"github.com/stackql/stackql/internal/stackql/handler"
+	"github.com/stackql/stackql/internal/stackql/httpbuild"
+	"github.com/stackql/stackql/internal/stackql/httpmiddleware"
+	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
+	"github.com/stackql/stackql/internal/stackql/primitive"
+	"github.com/stackql/stackql/internal/stackql/primitivegraph"
+	"github.com/stackql/stackql/internal/stackql/provider"
+	"github.com/stackql/stackql/internal/stackql/requests"
+	"github.com/stackql/stackql/internal/stackql/streaming"
+	"github.com/stackql/stackql/internal/stackql/tablemetadata"
+	"github.com/stackql/stackql/internal/stackql/upsert"
+	"github.com/stackql/stackql/internal/stackql/util"
+)
+
+const (
+	upsertActionInsert string = "insert"
+	upsertActionUpdate string = "update"
+	upsertActionNone   string = "none"
+	// upsertDefaultItemsKey is the list
+	// property absent any configuration.
+	upsertDefaultItemsKey string = "items"
+)
+
+var (
+	upsertDryRunColumns []string = []string{"action", "method", "key", "changes"}
+)
+
+// Upsert reconciles each inserted row against the existing resource,
+// per "INSERT ... ON CONFLICT", by inserting, updating or doing nothing.
+type Upsert struct {
+	graph               primitivegraph.PrimitiveGraph
+	handlerCtx          handler.HandlerContext
+	root                primitivegraph.PrimitiveNode
+	tbl                 tablemetadata.ExtendedTableMetadata
+	node                *sqlparser.Insert
+	commentDirectives   sqlparser.CommentDirectives
+	selectPrimitiveNode primitivegraph.PrimitiveNode
+	isAwait             bool
+}
+
+func NewUpsert(
+	graph primitivegraph.PrimitiveGraph,
+	handlerCtx handler.HandlerContext,
+	node *sqlparser.Insert,
+	tbl tablemetadata.ExtendedTableMetadata,
+	selectPrimitiveNode primitivegraph.PrimitiveNode,
+	commentDirectives sqlparser.CommentDirectives,
+	isAwait bool,
+) Builder {
+	return &Upsert{
+		graph:               graph,
+		handlerCtx:          handlerCtx,
+		tbl:                 tbl,
+		node:                node,
+		commentDirectives:   commentDirectives,
+		selectPrimitiveNode: selectPrimitiveNode,
+		isAwait:             isAwait,
+	}
+}
+
+func (ss *Upsert) GetRoot() primitivegraph.PrimitiveNode {
+	return ss.root
+}
+
+func (ss *Upsert) GetTail() primitivegraph.PrimitiveNode {
+	return ss.root
+}
+
+// upsertChange is the current and desired value of a request body property.
+type upsertChange struct {
+	Current interface{} `json:"current"`
+	Desired interface{} `json:"desired"`
+}
+
+// upsertPlan is the action planned for a single inserted row.
+type upsertPlan struct {
+	action  string
+	method  *openapistackql.OperationStore
+	key     map[string]interface{}
+	changes map[string]upsertChange
+	params  map[string]interface{}
+}
+
+func (ss *Upsert) Build() error {
+	tbl := ss.tbl
+	conflict, ok, err := upsert.ParseConflict(ss.node)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("upsert requires an ON CONFLICT clause")
+	}
+	insertColumns := make(map[string]struct{})
+	for _, col := range ss.node.Columns {
+		insertColumns[col.GetRawVal()] = struct{}{}
+	}
+	for _, col := range append(conflict.GetKeyColumns(), conflict.GetUpdateColumns()...) {
+		if _, ok := insertColumns[col]; !ok {
+			return fmt.Errorf("ON CONFLICT column '%s' is not an inserted column", col)
+		}
+	}
+	prov, err := tbl.GetProvider()
+	if err != nil {
+		return err
+	}
+	svc, err := tbl.GetService()
+	if err != nil {
+		return err
+	}
+	rsc, err := tbl.GetResource()
+	if err != nil {
+		return err
+	}
+	insertMethod, err := tbl.GetMethod()
+	if err != nil {
+		return err
+	}
+	returningProjection, isReturning, err := newReturningProjection(tbl, ss.commentDirectives, ss.isAwait)
+	if err != nil {
+		return err
+	}
+	isDryRun := upsert.IsDryRun(ss.commentDirectives)
+	upsertPrimitive := primitive.NewHTTPRestPrimitive(
+		prov,
+		nil,
+		nil,
+		nil,
+	)
+	ex := func(pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
+		input, inputExists := upsertPrimitive.GetInputFromAlias("")
+		if !inputExists {
+			return internaldto.NewErroneousExecutorOutput(fmt.Errorf("input does not exist"))
+		}
+		inputStream, err := input.ResultToMap()
+		if err != nil {
+			return internaldto.NewErroneousExecutorOutput(err)
+		}
+		rr, err := inputStream.Read()
+		if err != nil {
+			return internaldto.NewErroneousExecutorOutput(err)
+		}
+		inputMap, err := rr.GetMap()
+		if err != nil {
+			return internaldto.NewErroneousExecutorOutput(err)
+		}
+		rows, err := util.ExtractSQLNodeParams(pc.GetContext(), ss.node, inputMap)
+		if err != nil {
+			return internaldto.NewErroneousExecutorOutput(err)
+		}
+		var rowKeys []int
+		for k := range rows {
+			rowKeys = append(rowKeys, k)
+		}
+		sort.Ints(rowKeys)
+		var plans []*upsertPlan
+		for _, k := range rowKeys {
+			p, err := ss.planRow(prov, svc, rsc, insertMethod, conflict, rows[k], pc)
+			if err != nil {
+				return internaldto.NewErroneousExecutorOutput(err)
+			}
+			plans = append(plans, p)
+		}
+		if isDryRun {
+			return prepareUpsertDryRunResultSet(plans)
+		}
+		affectedRows := 0
+		for _, p := range plans {
+			if p.action != upsertActionNone {
+				affectedRows++
+			}
+		}
+		err = ss.handlerCtx.GetSafetyPolicy().CheckAffectedRows("upsert", affectedRows)
+		if err != nil {
+			return internaldto.NewErroneousExecutorOutput(err)
+		}
+		msgs := internaldto.BackendMessages{}
+		var responseBodies []map[string]interface{}
+		for _, p := range plans {
+			if p.action == upsertActionNone {
+				continue
+			}
+			rs := ss.execute(prov, svc, p, pc)
+			if rs.Msg != nil {
+				msgs.WorkingMessages = append(msgs.WorkingMessages, rs.Msg.WorkingMessages...)
+			}
+			if rs.Err != nil {
+				rs.Msg = &msgs
+				return rs
+			}
+			responseBodies = append(responseBodies, rs.GetOutputBody())
+		}
+		if len(responseBodies) == 0 {
+			msgs.WorkingMessages = append(msgs.WorkingMessages, "No changes required")
+		}
+		if isReturning {
+			return returningProjection.prepareResultSet(responseBodies, &msgs)
+		}
+		return internaldto.NewExecutorOutput(nil, nil, nil, &msgs, nil)
+	}
+	err = upsertPrimitive.SetExecutor(ex)
+	if err != nil {
+		return err
+	}
+	upsertPrimitive.SetInputAlias("", ss.selectPrimitiveNode.ID())
+	upsertNode := ss.graph.CreatePrimitiveNode(upsertPrimitive)
+	ss.graph.NewDependency(ss.selectPrimitiveNode, upsertNode, 1.0)
+	ss.root = ss.selectPrimitiveNode
+	return nil
+}
+
+// planRow looks up the resource identified by the row's key columns
+// and plans an insert where there is none, otherwise an update of
+// only those properties that differ, or nothing.
+func (ss *Upsert) planRow(
+	prov provider.IProvider,
+	svc *openapistackql.Service,
+	rsc *openapistackql.Resource,
+	insertMethod *openapistackql.OperationStore,
+	conflict upsert.Conflict,
+	row map[string]interface{},
+	pc primitive.IPrimitiveCtx,
+) (*upsertPlan, error) {
+	row = normaliseUpsertRow(row)
+	desired, err := getUpsertRequestBody(prov, insertMethod, row)
+	if err != nil {
+		return nil, err
+	}
+	rv := &upsertPlan{
+		key: make(map[string]interface{}),
+	}
+	lookupParams := make(map[string]interface{})
+	keyProperties := make(map[string]interface{})
+	for _, col := range conflict.GetKeyColumns() {
+		rv.key[col] = row[col]
+		if property := strings.TrimPrefix(col, constants.RequestBodyBaseKey); property != col {
+			keyProperties[property] = desired[property]
+			continue
+		}
+		lookupParams[col] = row[col]
+	}
+	existing, err := ss.lookup(prov, svc, rsc, lookupParams, keyProperties, pc)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		rv.action = upsertActionInsert
+		rv.method = insertMethod
+		rv.params = row
+		rv.changes = make(map[string]upsertChange)
+		for k, v := range desired {
+			rv.changes[k] = upsertChange{Desired: v}
+		}
+		return rv, nil
+	}
+	rv.action = upsertActionNone
+	if conflict.GetAction() == upsert.ActionNothing {
+		return rv, nil
+	}
+	reconciled := desired
+	if updateColumns := conflict.GetUpdateColumns(); updateColumns != nil {
+		reconciled = make(map[string]interface{})
+		for _, col := range updateColumns {
+			if property := strings.TrimPrefix(col, constants.RequestBodyBaseKey); property != col {
+				reconciled[property] = desired[property]
+			}
+		}
+	}
+	rv.changes = make(map[string]upsertChange)
+	for k, v := range reconciled {
+		current := normaliseJSONValue(existing[k])
+		if !reflect.DeepEqual(current, normaliseJSONValue(v)) {
+			rv.changes[k] = upsertChange{Current: current, Desired: v}
+		}
+	}
+	if len(rv.changes) == 0 {
+		return rv, nil
+	}
+	updateParams := make(map[string]interface{})
+	for k, v := range row {
+		if !strings.HasPrefix(k, constants.RequestBodyBaseKey) {
+			updateParams[k] = v
+		}
+	}
+	updateMethod, err := prov.GetMethodSelector().GetMostSpecificMethodForAction(rsc, "update", updateParams)
+	if err != nil {
+		return nil, fmt.Errorf("cannot update existing resource, the parameters of its update method must be inserted columns: %s", err.Error())
+	}
+	for k := range rv.changes {
+		updateParams[constants.RequestBodyBaseKey+k] = row[constants.RequestBodyBaseKey+k]
+	}
+	rv.action = upsertActionUpdate
+	rv.method = updateMethod
+	rv.params = updateParams
+	return rv, nil
+}
+
+// lookup returns the resource that matches the key columns,
+// or nil where there is none.  Every page of a list response is
+// inspected, subject to the page limit, as per SELECT.
+func (ss *Upsert) lookup(
+	prov provider.IProvider,
+	svc *openapistackql.Service,
+	rsc *openapistackql.Resource,
+	lookupParams map[string]interface{},
+	keyProperties map[string]interface{},
+	pc primitive.IPrimitiveCtx,
+) (map[string]interface{}, error) {
+	selectMethod, err := prov.GetMethodSelector().GetMostSpecificMethodForAction(rsc, "select", lookupParams)
+	if err != nil {
+		return nil, err
+	}
+	remainingParams, _ := selectMethod.ParameterMatch(lookupParams)
+	for k, v := range remainingParams {
+		keyProperties[k] = v
+	}
+	itemsKey := selectMethod.GetSelectItemsKey()
+	if itemsKey == "" {
+		itemsKey = upsertDefaultItemsKey
+	}
+	heirarchy := internaldto.NewHeirarchy(nil)
+	heirarchy.SetServiceHdl(svc)
+	heirarchy.SetResource(rsc)
+	heirarchy.SetMethod(selectMethod)
+	npt := prov.InferNextPageResponseElement(heirarchy)
+	nptRequest := prov.InferNextPageRequestElement(heirarchy)
+	reqCtx, err := buildMethodRequest(pc.GetContext(), prov, svc, selectMethod, lookupParams)
+	if err != nil {
+		return nil, err
+	}
+	response, apiErr := httpmiddleware.HttpApiCallFromRequest(httpmiddleware.WithParentSpan(ss.handlerCtx, pc.GetContext()), prov, selectMethod, reqCtx.GetRequest())
+	var matches []map[string]interface{}
+	pageCount := 1
+	for {
+		if apiErr != nil {
+			return nil, apiErr
+		}
+		if response.StatusCode == http.StatusNotFound {
+			break
+		}
+		if response.StatusCode >= 300 {
+			return nil, fmt.Errorf("existence check via method '%s' failed with status %s", selectMethod.GetName(), response.Status)
+		}
+		res, err := selectMethod.ProcessResponse(response)
+		if err != nil {
+			return nil, err
+		}
+		target, _ := res.GetProcessedBody().(map[string]interface{})
+		candidates := []map[string]interface{}{target}
+		if items, ok := target[itemsKey].([]interface{}); ok {
+			candidates = nil
+			for _, item := range items {
+				if candidate, ok := item.(map[string]interface{}); ok {
+					candidates = append(candidates, candidate)
+				}
+			}
+		}
+		for _, candidate := range candidates {
+			if isUpsertKeyMatch(candidate, keyProperties) {
+				matches = append(matches, candidate)
+			}
+		}
+		if npt == nil || nptRequest == nil {
+			break
+		}
+		tk := extractNextPageToken(pc.GetContext(), res, npt)
+		if tk == "" || tk == "<nil>" || tk == "[]" || (ss.handlerCtx.GetRuntimeContext().HTTPPageLimit > 0 && pageCount >= ss.handlerCtx.GetRuntimeContext().HTTPPageLimit) {
+			break
+		}
+		pageCount++
+		req, err := reqCtx.SetNextPage(selectMethod, tk, nptRequest)
+		if err != nil {
+			return nil, err
+		}
+		response, apiErr = httpmiddleware.HttpApiCallFromRequest(httpmiddleware.WithParentSpan(ss.handlerCtx, pc.GetContext()), prov, selectMethod, req)
+	}
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("ON CONFLICT key columns match %d resources via method '%s', key columns must identify a single resource", len(matches), selectMethod.GetName())
+	}
+}
+
+// buildMethodRequest builds a single request, as per the name keyed parameters.
+func buildMethodRequest(ctx context.Context, prov provider.IProvider, svc *openapistackql.Service, m *openapistackql.OperationStore, params map[string]interface{}) (httpbuild.HTTPArmouryParameters, error) {
+	paramStream := streaming.NewStandardMapStream()
+	err := paramStream.Write([]map[string]interface{}{params})
+	if err != nil {
+		return nil, err
+	}
+	httpArmoury, err := httpbuild.BuildHTTPRequestCtxFromAnnotation(ctx, paramStream, prov, m, svc, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	requestParams := httpArmoury.GetRequestParams()
+	if len(requestParams) != 1 {
+		return nil, fmt.Errorf("expected a single request for method '%s', got %d", m.GetName(), len(requestParams))
+	}
+	return requestParams[0], nil
+}
+
+// callMutationMethod sends a single mutation request, subject
+// to any plan in progress, as per the name keyed parameters.
+// The response is nil where the call is recorded in a plan.
+// The handler context is that of the call, and so is not copied.
+func callMutationMethod(handlerCtx handler.HandlerContext, prov provider.IProvider, svc *openapistackql.Service, m *openapistackql.OperationStore, params map[string]interface{}) (*http.Response, error) {
+	reqCtx, err := buildMethodRequest(handlerCtx.GetContext(), prov, svc, m, params)
+	if err != nil {
+		return nil, err
+	}
+	return httpmiddleware.HttpApiMutationFromRequest(handlerCtx, prov, m, reqCtx.GetRequest())
+}
+
+func (ss *Upsert) execute(prov provider.IProvider, svc *openapistackql.Service, p *upsertPlan, pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
+	execInstance := func() internaldto.ExecutorOutput {
+		response, err := callMutationMethod(httpmiddleware.WithParentSpan(ss.handlerCtx, pc.GetContext()), prov, svc, p.method, p.params)
+		if err != nil {
+			return internaldto.NewErroneousExecutorOutput(err)
+		}
+		if response == nil {
+			return newPlannedCallOutput()
+		}
+		if response.StatusCode >= 300 {
+			return internaldto.NewErroneousExecutorOutput(fmt.Errorf("%s via method '%s' failed with status %s", p.action, p.method.GetName(), response.Status))
+		}
+		target, err := p.method.DeprecatedProcessResponse(response)
+		ss.handlerCtx.LogHTTPResponseMap(target)
+		if err != nil {
+			return internaldto.NewErroneousExecutorOutput(err)
+		}
+		msgs := internaldto.BackendMessages{
+			WorkingMessages: generateSuccessMessagesFromHeirarchy(ss.tbl, ss.isAwait),
+		}
+		return internaldto.NewExecutorOutput(nil, target, nil, &msgs, nil).WithResponse(response)
+	}
+	if !ss.isAwait {
+		return execInstance()
+	}
+	dependentPrimitive := primitive.NewHTTPRestPrimitive(
+		prov,
+		nil,
+		nil,
+		nil,
+	)
+	err := dependentPrimitive.SetExecutor(func(pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
+		return execInstance()
+	})
+	if err != nil {
+		return internaldto.NewErroneousExecutorOutput(err)
+	}
+	execPrim, err := composeAsyncMonitor(ss.handlerCtx, dependentPrimitive, ss.tbl, ss.commentDirectives)
+	if err != nil {
+		return internaldto.NewErroneousExecutorOutput(err)
+	}
+	return execPrim.Execute(pc)
+}
+
+func prepareUpsertDryRunResultSet(plans []*upsertPlan) internaldto.ExecutorOutput {
+	rowMap := make(map[string]map[string]interface{})
+	for i, p := range plans {
+		var methodName string
+		if p.method != nil {
+			methodName = p.method.GetName()
+		}
+		key, err := json.Marshal(p.key)
+		if err != nil {
+			return internaldto.NewErroneousExecutorOutput(err)
+		}
+		changes, err := json.Marshal(p.changes)
+		if err != nil {
+			return internaldto.NewErroneousExecutorOutput(err)
+		}
+		rowMap[strconv.Itoa(i)] = map[string]interface{}{
+			"action":  p.action,
+			"method":  methodName,
+			"key":     string(key),
+			"changes": string(changes),
+		}
+	}
+	return util.PrepareResultSet(
+		internaldto.NewPrepareResultSetDTO(
+			nil,
+			rowMap,
+			upsertDryRunColumns,
+			returningRowSort,
+			nil,
+			nil,
+		),
+	)
+}
+
+// getUpsertRequestBody returns the request body
+// that the row supplies, as per an insert.
+func getUpsertRequestBody(prov provider.IProvider, m *openapistackql.OperationStore, row map[string]interface{}) (map[string]interface{}, error) {
+	paramList, err := requests.SplitHttpParameters(prov, map[int]map[string]interface{}{0: row}, m)
+	if err != nil {
+		return nil, err
+	}
+	rv := make(map[string]interface{})
+	for _, params := range paramList {
+		for k, v := range params.RequestBody {
+			rv[k] = v
+		}
+	}
+	return rv, nil
+}
+
+func isUpsertKeyMatch(candidate map[string]interface{}, keyProperties map[string]interface{}) bool {
+	for k, v := range keyProperties {
+		current, ok := candidate[k]
+		if !ok || fmt.Sprintf("%v", normaliseJSONValue(current)) != fmt.Sprintf("%v", normaliseJSONValue(v)) {
+			return false
+		}
+	}
+	return true
+}
+
+func normaliseUpsertRow(row map[string]interface{}) map[string]interface{} {
+	rv := make(map[string]interface{}, len(row))
+	for k, v := range row {
+		switch v := v.(type) {
+		case []byte:
+			rv[k] = string(v)
+		case *sqlparser.SQLVal:
+			rv[k] = string(v.Val)
+		default:
+			rv[k] = v
+		}
+	}
+	return rv
+}
+
+// normaliseJSONValue renders a value as it would be decoded
+// from JSON, so that desired and current values compare.
+func normaliseJSONValue(v interface{}) interface{} {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var rv interface{}
+	if json.Unmarshal(b, &rv) != nil {
+		return v
+	}
+	return rv
+}
@@ -0,0 +1,45 @@
+package primitivebuilder
+
+import (
+	"testing"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+)
+
+func TestIsUpsertKeyMatch(t *testing.T) {
+	candidate := map[string]interface{}{
+		"name":   "instance-1",
+		"id":     float64(123),
+		"labels": map[string]interface{}{"env": "dev"},
+	}
+	testCases := []struct {
+		name          string
+		keyProperties map[string]interface{}
+		want          bool
+	}{
+		{name: "no key properties", keyProperties: map[string]interface{}{}, want: true},
+		{name: "string", keyProperties: map[string]interface{}{"name": "instance-1"}, want: true},
+		{name: "number as decoded", keyProperties: map[string]interface{}{"id": 123}, want: true},
+		{name: "object", keyProperties: map[string]interface{}{"labels": map[string]string{"env": "dev"}}, want: true},
+		{name: "differs", keyProperties: map[string]interface{}{"name": "instance-2"}, want: false},
+		{name: "absent", keyProperties: map[string]interface{}{"zone": "z"}, want: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isUpsertKeyMatch(candidate, tc.keyProperties); got != tc.want {
+				t.Fatalf("isUpsertKeyMatch() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNormaliseUpsertRow(t *testing.T) {
+	row := normaliseUpsertRow(map[string]interface{}{
+		"a": []byte("x"),
+		"b": sqlparser.NewStrVal([]byte("y")),
+		"c": 1,
+	})
+	if row["a"] != "x" || row["b"] != "y" || row["c"] != 1 {
+		t.Fatalf("normaliseUpsertRow() = %v", row)
+	}
+}
@@ -3,11 +3,27 @@ package primitivebuilder
 import (
 	"fmt"
 
+	"github.com/sirupsen/logrus"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
+	"github.com/stackql/stackql/internal/stackql/logging"
+	"github.com/stackql/stackql/internal/stackql/primitive"
 	"github.com/stackql/stackql/internal/stackql/tablemetadata"
 	"github.com/stackql/stackql/internal/stackql/util"
 )
 
+const subsystem logging.Subsystem = "primitivebuilder"
+
+// getResourceLogger correlates log lines with the query,
+// and with the provider and resource acted upon.
+func getResourceLogger(pc primitive.IPrimitiveCtx, meta tablemetadata.ExtendedTableMetadata) *logrus.Entry {
+	hIds := meta.GetHeirarchyObjects().GetHeirarchyIds()
+	return subsystem.GetResourceLogger(
+		pc.GetContext(),
+		hIds.GetProviderStr(),
+		fmt.Sprintf("%s.%s", hIds.GetServiceStr(), hIds.GetResourceStr()),
+	)
+}
+
 func generateSuccessMessagesFromHeirarchy(meta tablemetadata.ExtendedTableMetadata, isAwait bool) []string {
 	baseSuccessString := "The operation completed successfully"
 	if !isAwait {
@@ -28,6 +44,14 @@ func generateSuccessMessagesFromHeirarchy(meta tablemetadata.ExtendedTableMetada
 	return successMsgs
 }
 
+// newPlannedCallOutput is the output of a
+// call recorded in a plan in place of being sent.
+func newPlannedCallOutput() internaldto.ExecutorOutput {
+	return internaldto.NewExecutorOutput(nil, nil, nil, &internaldto.BackendMessages{
+		WorkingMessages: []string{"The operation was planned and not sent"},
+	}, nil)
+}
+
 func generateResultIfNeededfunc(resultMap map[string]map[string]interface{}, body map[string]interface{}, msg *internaldto.BackendMessages, err error, isShowResults bool) internaldto.ExecutorOutput {
 	if isShowResults {
 		return util.PrepareResultSet(internaldto.NewPrepareResultSetDTO(nil, resultMap, nil, nil, nil, nil))
@@ -1,6 +1,7 @@
 package primitivegenerator
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -25,6 +26,8 @@ import (
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 )
 
+const subsystem logging.Subsystem = "primitivegenerator"
+
 var (
 	_ PrimitiveGenerator = &standardPrimitiveGenerator{}
 )
@@ -47,6 +50,7 @@ type PrimitiveGenerator interface {
 }
 
 type standardPrimitiveGenerator struct {
+	ctx               context.Context
 	Parent            PrimitiveGenerator
 	dataflowDependent PrimitiveGenerator
 	Children          []PrimitiveGenerator
@@ -58,6 +62,7 @@ func NewRootPrimitiveGenerator(ast sqlparser.SQLNode, handlerCtx handler.Handler
 	tblMap := make(taxonomy.TblMap)
 	symTab := symtab.NewHashMapTreeSymTab()
 	return &standardPrimitiveGenerator{
+		ctx:               handlerCtx.GetContext(),
 		PrimitiveComposer: primitivecomposer.NewPrimitiveComposer(nil, ast, handlerCtx.GetDrmConfig(), handlerCtx.GetTxnCounterMgr(), graph, tblMap, symTab, handlerCtx.GetSQLEngine(), handlerCtx.GetSQLSystem(), handlerCtx.GetASTFormatter()),
 	}
 }
@@ -88,10 +93,11 @@ func (pb *standardPrimitiveGenerator) AddChildPrimitiveGenerator(ast sqlparser.S
 	tables := pb.PrimitiveComposer.GetTables()
 	switch node := ast.(type) {
 	case sqlparser.Statement:
-		logging.GetLogger().Infoln(fmt.Sprintf("creating new table map for node = %v", node))
+		subsystem.GetContextLogger(pb.ctx).Infoln(fmt.Sprintf("creating new table map for node = %v", node))
 		tables = make(taxonomy.TblMap)
 	}
 	retVal := &standardPrimitiveGenerator{
+		ctx:    pb.ctx,
 		Parent: pb,
 		PrimitiveComposer: primitivecomposer.NewPrimitiveComposer(
 			pb.PrimitiveComposer,
@@ -177,6 +183,10 @@ func (pb *standardPrimitiveGenerator) inferProviderForShow(node *sqlparser.Show,
 	nodeTypeUpperCase := strings.ToUpper(node.Type)
 	switch nodeTypeUpperCase {
 	case "AUTH":
+		if node.OnTable.Name.GetRawVal() == "" {
+			// no provider named; auth status is shown for all configured providers
+			return nil
+		}
 		prov, err := handlerCtx.GetProvider(node.OnTable.Name.GetRawVal())
 		if err != nil {
 			return err
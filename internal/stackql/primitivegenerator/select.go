@@ -5,7 +5,6 @@ import (
 
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 	"github.com/stackql/stackql/internal/stackql/dependencyplanner"
-	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/parserutil"
 	"github.com/stackql/stackql/internal/stackql/planbuilderinput"
 	"github.com/stackql/stackql/internal/stackql/primitivebuilder"
@@ -49,6 +48,9 @@ func (p *standardPrimitiveGenerator) analyzeSelect(pbi planbuilderinput.PlanBuil
 	if !hasTblz {
 		return fmt.Errorf("select analysis: no table map present")
 	}
+	if p.analyzeSQLDataSourceSelect(handlerCtx, node, tblz) {
+		return nil
+	}
 	annotations, hasAnnotations := selectMetadata.GetAnnotations()
 	if !hasAnnotations {
 		return fmt.Errorf("select analysis not viable: no annotations present")
@@ -63,7 +65,7 @@ func (p *standardPrimitiveGenerator) analyzeSelect(pbi planbuilderinput.PlanBuil
 	if !ok {
 		return fmt.Errorf("could not obtain ON condition data flows for select AST node")
 	}
-	logging.GetLogger().Debugf("%v\n", dataFlows)
+	subsystem.GetContextLogger(p.ctx).Debugf("%v\n", dataFlows)
 	// END_BLOCK  SequencingAccrual
 
 	onConditionsToRewrite := selectMetadata.GetOnConditionsToRewrite()
@@ -110,7 +112,7 @@ func (p *standardPrimitiveGenerator) analyzeSelect(pbi planbuilderinput.PlanBuil
 	if len(node.From) == 1 {
 		switch ft := node.From[0].(type) {
 		case *sqlparser.ExecSubquery:
-			logging.GetLogger().Infoln(fmt.Sprintf("%v", ft))
+			subsystem.GetContextLogger(p.ctx).Infoln(fmt.Sprintf("%v", ft))
 		default:
 			rewrittenWhere, paramsPresent, err = p.analyzeWhere(node.Where, existingParams)
 			if err != nil {
@@ -119,7 +121,7 @@ func (p *standardPrimitiveGenerator) analyzeSelect(pbi planbuilderinput.PlanBuil
 			p.PrimitiveComposer.SetWhere(rewrittenWhere)
 		}
 	}
-	logging.GetLogger().Debugf("len(paramsPresent) = %d\n", len(paramsPresent))
+	subsystem.GetContextLogger(p.ctx).Debugf("len(paramsPresent) = %d\n", len(paramsPresent))
 	// END_BLOCK REWRITE_WHERE
 
 	if len(node.From) == 1 {
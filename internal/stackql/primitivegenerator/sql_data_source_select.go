@@ -0,0 +1,100 @@
+package primitivegenerator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/datasource/sql_datasource"
+	"github.com/stackql/stackql/internal/stackql/handler"
+	"github.com/stackql/stackql/internal/stackql/primitivebuilder"
+	"github.com/stackql/stackql/internal/stackql/sqlpushdown"
+	"github.com/stackql/stackql/internal/stackql/taxonomy"
+)
+
+// analyzeSQLDataSourceSelect pushes an entire top level select
+// down to the external sql data source, where that one data source
+// hosts every table in the statement and every expression is portable.
+// Otherwise, false is returned and the statement is planned as usual.
+func (p *standardPrimitiveGenerator) analyzeSQLDataSourceSelect(
+	handlerCtx handler.HandlerContext,
+	node *sqlparser.Select,
+	tblz taxonomy.TblMap,
+) bool {
+	if p.Parent != nil || p.PrimitiveComposer.IsIndirect() || p.PrimitiveComposer.GetGraph().ContainsIndirect() {
+		return false
+	}
+	if len(tblz) == 0 || countAliasedTableExprs(node.From) != len(tblz) {
+		return false
+	}
+	var sqlDataSource sql_datasource.SQLDataSource
+	var tables []sqlpushdown.Table
+	aliases := make(map[string]struct{})
+	for k, v := range tblz {
+		aliased, isAliased := k.(*sqlparser.AliasedTableExpr)
+		if !isAliased {
+			return false
+		}
+		if _, isIndirect := v.GetIndirect(); isIndirect {
+			return false
+		}
+		ds, isSQLDataSource := v.GetSQLDataSource()
+		if !isSQLDataSource || (sqlDataSource != nil && ds != sqlDataSource) {
+			return false
+		}
+		sqlDataSource = ds
+		alias := v.GetAlias()
+		if alias == "" {
+			tableName, ok := aliased.Expr.(sqlparser.TableName)
+			if !ok {
+				return false
+			}
+			alias = tableName.Name.GetRawVal()
+		}
+		if _, isDuplicate := aliases[strings.ToLower(alias)]; isDuplicate {
+			return false
+		}
+		aliases[strings.ToLower(alias)] = struct{}{}
+		tableName := v.GetHeirarchyObjects().GetHeirarchyIds().GetSQLDataSourceTableName()
+		tableMeta, err := sqlDataSource.GetTableMetadata(strings.Split(tableName, ".")...)
+		if err != nil {
+			subsystem.GetContextLogger(p.ctx).Infoln(fmt.Sprintf("sql data source pushdown not viable for table '%s': %s", tableName, err.Error()))
+			return false
+		}
+		columns := make(map[string]string)
+		for _, col := range tableMeta.GetColumns() {
+			columns[strings.ToLower(col.GetName())] = col.GetType()
+		}
+		tables = append(tables, sqlpushdown.Table{
+			Node:    aliased,
+			Name:    tableName,
+			Alias:   alias,
+			Columns: columns,
+		})
+	}
+	if !sqlpushdown.IsSupportedDialect(sqlDataSource.GetDBName()) {
+		return false
+	}
+	query, ok := sqlpushdown.RenderSelect(sqlDataSource.GetDBName(), node, tables)
+	if !ok {
+		return false
+	}
+	bldr := primitivebuilder.NewSQLDataSourceSelect(p.PrimitiveComposer.GetGraph(), handlerCtx, sqlDataSource, query)
+	p.PrimitiveComposer.SetBuilder(bldr)
+	return true
+}
+
+func countAliasedTableExprs(tableExprs sqlparser.TableExprs) int {
+	rv := 0
+	for _, tableExpr := range tableExprs {
+		switch tableExpr := tableExpr.(type) {
+		case *sqlparser.AliasedTableExpr:
+			rv++
+		case *sqlparser.ParenTableExpr:
+			rv += countAliasedTableExprs(tableExpr.Exprs)
+		case *sqlparser.JoinTableExpr:
+			rv += countAliasedTableExprs(sqlparser.TableExprs{tableExpr.LeftExpr, tableExpr.RightExpr})
+		}
+	}
+	return rv
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/stackql/stackql/internal/stackql/astvisit"
+	"github.com/stackql/stackql/internal/stackql/asyncmonitor"
 	"github.com/stackql/stackql/internal/stackql/constants"
 	"github.com/stackql/stackql/internal/stackql/drm"
 	"github.com/stackql/stackql/internal/stackql/dto"
@@ -16,13 +17,13 @@ import (
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
 	"github.com/stackql/stackql/internal/stackql/iqlerror"
 	"github.com/stackql/stackql/internal/stackql/iqlutil"
-	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/parserutil"
 	"github.com/stackql/stackql/internal/stackql/planbuilderinput"
 	"github.com/stackql/stackql/internal/stackql/primitive"
 	"github.com/stackql/stackql/internal/stackql/primitivebuilder"
 	"github.com/stackql/stackql/internal/stackql/provider"
 	"github.com/stackql/stackql/internal/stackql/relational"
+	"github.com/stackql/stackql/internal/stackql/safetypolicy"
 	"github.com/stackql/stackql/internal/stackql/suffix"
 	"github.com/stackql/stackql/internal/stackql/symtab"
 	"github.com/stackql/stackql/internal/stackql/tableinsertioncontainer"
@@ -214,11 +215,16 @@ func (p *standardPrimitiveGenerator) analyzeAuthRevoke(pbi planbuilderinput.Plan
 	if authErr != nil {
 		return authErr
 	}
-	switch strings.ToLower(authCtx.Type) {
-	case dto.AuthServiceAccountStr, dto.AuthInteractiveStr:
+	prov, pErr := handlerCtx.GetProvider(authNode.Provider)
+	if pErr != nil {
+		return pErr
+	}
+	switch prov.InferAuthType(*authCtx, authCtx.Type) {
+	case dto.AuthServiceAccountStr, dto.AuthInteractiveStr, dto.AuthApiKeyStr, dto.AuthBearerStr, dto.AuthBasicStr, dto.AuthAWSSigningv4Str, dto.AuthAzureDefaultStr, dto.AuthNullStr:
+		p.PrimitiveComposer.SetProvider(prov)
 		return nil
 	}
-	return fmt.Errorf(`Auth revoke for Google Failed; improper auth method: "%s" specified`, authCtx.Type)
+	return fmt.Errorf(`auth revoke for provider '%s' failed; improper auth method: "%s" specified`, prov.GetProviderString(), authCtx.Type)
 }
 
 func checkResource(handlerCtx handler.HandlerContext, prov provider.IProvider, service string, resource string) (*openapistackql.Resource, error) {
@@ -264,7 +270,7 @@ func (pb *standardPrimitiveGenerator) traverseShowFilter(table openapistackql.IT
 	case *sqlparser.ComparisonExpr:
 		return pb.comparisonExprToFilterFunc(table, node, filter)
 	case *sqlparser.AndExpr:
-		logging.GetLogger().Infoln("complex AND expr detected")
+		subsystem.GetContextLogger(pb.ctx).Infoln("complex AND expr detected")
 		lhs, lhErr := pb.traverseShowFilter(table, node, filter.Left)
 		rhs, rhErr := pb.traverseShowFilter(table, node, filter.Right)
 		if lhErr != nil {
@@ -275,7 +281,7 @@ func (pb *standardPrimitiveGenerator) traverseShowFilter(table openapistackql.IT
 		}
 		return relational.AndTableFilters(lhs, rhs), nil
 	case *sqlparser.OrExpr:
-		logging.GetLogger().Infoln("complex OR expr detected")
+		subsystem.GetContextLogger(pb.ctx).Infoln("complex OR expr detected")
 		lhs, lhErr := pb.traverseShowFilter(table, node, filter.Left)
 		rhs, rhErr := pb.traverseShowFilter(table, node, filter.Right)
 		if lhErr != nil {
@@ -293,13 +299,34 @@ func (pb *standardPrimitiveGenerator) traverseShowFilter(table openapistackql.IT
 	return retVal, nil
 }
 
+// isComputedOperand reports whether a comparison operand
+// is an expression over columns, rather than a column or literal.
+func isComputedOperand(expr sqlparser.Expr) bool {
+	switch expr.(type) {
+	case *sqlparser.FuncExpr, *sqlparser.ConvertExpr:
+		return true
+	default:
+		return false
+	}
+}
+
 func (pb *standardPrimitiveGenerator) traverseWhereFilter(node sqlparser.SQLNode, requiredParameters, optionalParameters suffix.ParameterSuffixMap) (sqlparser.Expr, []string, error) {
 	switch node := node.(type) {
 	case *sqlparser.ComparisonExpr:
+		if isComputedOperand(node.Left) || isComputedOperand(node.Right) {
+			// Expressions over columns, such as JSON extraction,
+			// cannot be parameters and so are filtered upon in the backend.
+			return &sqlparser.ComparisonExpr{
+				Left:     node.Left,
+				Right:    node.Right,
+				Operator: node.Operator,
+				Escape:   node.Escape,
+			}, nil, nil
+		}
 		exp, cn, err := pb.whereComparisonExprCopyAndReWrite(node, requiredParameters, optionalParameters)
 		return exp, []string{cn}, err
 	case *sqlparser.AndExpr:
-		logging.GetLogger().Infoln("complex AND expr detected")
+		subsystem.GetContextLogger(pb.ctx).Infoln("complex AND expr detected")
 		lhs, lParams, lhErr := pb.traverseWhereFilter(node.Left, requiredParameters, optionalParameters)
 		rhs, rParams, rhErr := pb.traverseWhereFilter(node.Right, requiredParameters, optionalParameters)
 		if lhErr != nil {
@@ -311,7 +338,7 @@ func (pb *standardPrimitiveGenerator) traverseWhereFilter(node sqlparser.SQLNode
 		lParams = append(lParams, rParams...)
 		return &sqlparser.AndExpr{Left: lhs, Right: rhs}, lParams, nil
 	case *sqlparser.OrExpr:
-		logging.GetLogger().Infoln("complex OR expr detected")
+		subsystem.GetContextLogger(pb.ctx).Infoln("complex OR expr detected")
 		lhs, lParams, lhErr := pb.traverseWhereFilter(node.Left, requiredParameters, optionalParameters)
 		rhs, rParams, rhErr := pb.traverseWhereFilter(node.Right, requiredParameters, optionalParameters)
 		if lhErr != nil {
@@ -323,6 +350,13 @@ func (pb *standardPrimitiveGenerator) traverseWhereFilter(node sqlparser.SQLNode
 		lParams = append(lParams, rParams...)
 		return &sqlparser.OrExpr{Left: lhs, Right: rhs}, lParams, nil
 	case *sqlparser.FuncExpr:
+		// Predicate functions, such as regexp_like(),
+		// are filtered upon in the backend.
+		return node, nil, nil
+	case *sqlparser.NotExpr:
+		if _, isFuncExpr := node.Expr.(*sqlparser.FuncExpr); isFuncExpr {
+			return node, nil, nil
+		}
 		return nil, nil, fmt.Errorf("unsupported constraint in openapistackql filter: %v", sqlparser.String(node))
 	case *sqlparser.IsExpr:
 		return &sqlparser.IsExpr{
@@ -343,7 +377,7 @@ func (pb *standardPrimitiveGenerator) whereComparisonExprCopyAndReWrite(expr *sq
 	symTabEntry, symTabErr := pb.PrimitiveComposer.GetSymbol(colName)
 	_, requiredParamPresent := requiredParameters.Get(colName)
 	_, optionalParamPresent := optionalParameters.Get(colName)
-	logging.GetLogger().Infoln(fmt.Sprintf("symTabEntry = %v", symTabEntry))
+	subsystem.GetContextLogger(pb.ctx).Infoln(fmt.Sprintf("symTabEntry = %v", symTabEntry))
 	containsSQLDataSource := pb.GetPrimitiveComposer().ContainsSQLDataSource()
 	if !containsSQLDataSource && symTabErr != nil && !(requiredParamPresent || optionalParamPresent) {
 		return nil, colName, symTabErr
@@ -458,9 +492,32 @@ func (pb *standardPrimitiveGenerator) analyzeWhere(where *sqlparser.Where, exist
 	if where == nil {
 		return nil, paramsSupplied, nil
 	}
+	err = pb.rewriteWhereFuncs(retVal)
+	if err != nil {
+		return nil, paramsSupplied, err
+	}
 	return &sqlparser.Where{Type: where.Type, Expr: retVal}, paramsSupplied, nil
 }
 
+// rewriteWhereFuncs rewrites functions that remain in the
+// filter, such as JSON extraction, for the backend dialect.
+func (pb *standardPrimitiveGenerator) rewriteWhereFuncs(expr sqlparser.Expr) error {
+	funcRewriter := pb.PrimitiveComposer.GetSQLSystem().GetASTFuncRewriter()
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		funcExpr, isFuncExpr := node.(*sqlparser.FuncExpr)
+		if !isFuncExpr {
+			return true, nil
+		}
+		newNode, err := funcRewriter.RewriteFunc(funcExpr)
+		if err != nil {
+			return false, err
+		}
+		funcExpr.Name = newNode.Name
+		funcExpr.Exprs = newNode.Exprs
+		return true, nil
+	}, expr)
+}
+
 func extractVarDefFromExec(node *sqlparser.Exec, argName string) (*sqlparser.ExecVarDef, error) {
 	for _, varDef := range node.ExecVarDefs {
 		if varDef.ColIdent.GetRawVal() == argName {
@@ -472,7 +529,7 @@ func extractVarDefFromExec(node *sqlparser.Exec, argName string) (*sqlparser.Exe
 
 func (p *standardPrimitiveGenerator) parseComments(comments sqlparser.Comments) {
 	if comments != nil {
-		p.PrimitiveComposer.SetCommentDirectives(sqlparser.ExtractCommentDirectives(comments))
+		p.PrimitiveComposer.SetCommentDirectives(parserutil.ExtractCommentDirectives(comments))
 		p.PrimitiveComposer.SetAwait(p.PrimitiveComposer.GetCommentDirectives().IsSet("AWAIT"))
 	}
 }
@@ -498,8 +555,19 @@ func (p *standardPrimitiveGenerator) AnalyzeUnaryExec(pbi planbuilderinput.PlanB
 		return nil, err
 	}
 
-	if p.PrimitiveComposer.IsAwait() && !method.IsAwaitable() {
-		return nil, fmt.Errorf("method %s is not awaitable", method.GetName())
+	if p.PrimitiveComposer.IsAwait() {
+		if err := checkAwaitable(method); err != nil {
+			return nil, err
+		}
+	}
+
+	prov, err := meta.GetProvider()
+	if err != nil {
+		return nil, err
+	}
+	err = handlerCtx.GetSafetyPolicy().CheckMethod(prov.GetProviderString(), "exec", method)
+	if err != nil {
+		return nil, err
 	}
 
 	requiredParams := method.GetRequiredParameters()
@@ -508,21 +576,17 @@ func (p *standardPrimitiveGenerator) AnalyzeUnaryExec(pbi planbuilderinput.PlanB
 	if err != nil {
 		return nil, err
 	}
-	usageErr := parserutil.CheckColUsagesAgainstTable(colz, method)
+	usageErr := parserutil.CheckColUsagesAgainstTable(p.ctx, colz, method)
 	if usageErr != nil {
 		return nil, usageErr
 	}
 	for k, param := range requiredParams {
-		logging.GetLogger().Debugln(fmt.Sprintf("param = %v", param))
+		subsystem.GetContextLogger(p.ctx).Debugln(fmt.Sprintf("param = %v", param))
 		_, err := extractVarDefFromExec(node, k)
 		if err != nil {
 			return nil, fmt.Errorf("required param not supplied for exec: %s", err.Error())
 		}
 	}
-	prov, err := meta.GetProvider()
-	if err != nil {
-		return nil, err
-	}
 	svcStr, err := meta.GetServiceStr()
 	if err != nil {
 		return nil, err
@@ -531,7 +595,7 @@ func (p *standardPrimitiveGenerator) AnalyzeUnaryExec(pbi planbuilderinput.PlanB
 	if err != nil {
 		return nil, err
 	}
-	logging.GetLogger().Infoln(fmt.Sprintf("provider = '%s', service = '%s', resource = '%s'", prov.GetProviderString(), svcStr, rStr))
+	subsystem.GetContextLogger(p.ctx).Infoln(fmt.Sprintf("provider = '%s', service = '%s', resource = '%s'", prov.GetProviderString(), svcStr, rStr))
 	requestSchema, err := method.GetRequestBodySchema()
 	// requestSchema, err := prov.GetObjectSchema(svcStr, rStr, method.Request.BodyMediaType)
 	if err != nil && method.Request != nil {
@@ -597,7 +661,7 @@ func (p *standardPrimitiveGenerator) analyzeExec(pbi planbuilderinput.PlanBuilde
 		return err
 	}
 	if err != nil {
-		logging.GetLogger().Infoln(fmt.Sprintf("error analyzing EXEC as selection: '%s'", err.Error()))
+		subsystem.GetContextLogger(p.ctx).Infoln(fmt.Sprintf("error analyzing EXEC as selection: '%s'", err.Error()))
 		return err
 	} else {
 		m, err := tbl.GetMethod()
@@ -728,7 +792,7 @@ func (p *standardPrimitiveGenerator) analyzeSchemaVsMap(handlerCtx handler.Handl
 
 func (p *standardPrimitiveGenerator) AnalyzePGInternal(pbi planbuilderinput.PlanBuilderInput) error {
 	handlerCtx := pbi.GetHandlerCtx()
-	if backendQueryType, ok := handlerCtx.GetDBMSInternalRouter().CanRoute(pbi.GetStatement()); ok {
+	if backendQueryType, ok := handlerCtx.GetDBMSInternalRouter().CanRoute(handlerCtx.GetContext(), pbi.GetStatement()); ok {
 		if backendQueryType == constants.BackendQuery {
 			bldr := primitivebuilder.NewRawNativeSelect(p.PrimitiveComposer.GetGraph(), handlerCtx, pbi.GetTxnCtrlCtrs(), pbi.GetRawQuery())
 			p.PrimitiveComposer.SetBuilder(bldr)
@@ -753,11 +817,11 @@ func (p *standardPrimitiveGenerator) expandTable(tbl tablemetadata.ExtendedTable
 
 		p.PrimitiveComposer.SetSymTab(viewIndirect.GetUnderlyingSymTab())
 
-		logging.GetLogger().Debugf("viewAST = %v\n", viewAST)
+		subsystem.GetContextLogger(p.ctx).Debugf("viewAST = %v\n", viewAST)
 		return nil
 	}
 	if sqlDataSource, isSQLDataSource := tbl.GetSQLDataSource(); isSQLDataSource {
-		logging.GetLogger().Debugf("sqlDataSource = %v\n", sqlDataSource)
+		subsystem.GetContextLogger(p.ctx).Debugf("sqlDataSource = %v\n", sqlDataSource)
 		return nil
 	}
 	// TODO: encapsulate the mapping of openapi schemas to symbol table entries.
@@ -822,7 +886,7 @@ func (p *standardPrimitiveGenerator) buildRequestContext(handlerCtx handler.Hand
 	if err != nil {
 		return nil, err
 	}
-	httpArmoury, err := httpbuild.BuildHTTPRequestCtx(node, prov, m, svc, rowsToInsert, execContext)
+	httpArmoury, err := httpbuild.BuildHTTPRequestCtx(handlerCtx.GetContext(), node, prov, m, svc, rowsToInsert, execContext)
 	if err != nil {
 		return nil, err
 	}
@@ -856,7 +920,7 @@ func (p *standardPrimitiveGenerator) AnalyzeInsert(pbi planbuilderinput.PlanBuil
 	if err != nil {
 		return err
 	}
-	insertValOnlyRows, nonValCols, err := parserutil.ExtractInsertValColumnsPlusPlaceHolders(node)
+	insertValOnlyRows, nonValCols, err := parserutil.ExtractInsertValColumnsPlusPlaceHolders(p.ctx, node)
 	if err != nil {
 		return err
 	}
@@ -884,8 +948,15 @@ func (p *standardPrimitiveGenerator) AnalyzeInsert(pbi planbuilderinput.PlanBuil
 		return err
 	}
 
-	if p.PrimitiveComposer.IsAwait() && !method.IsAwaitable() {
-		return fmt.Errorf("method %s is not awaitable", method.GetName())
+	if p.PrimitiveComposer.IsAwait() {
+		if err := checkAwaitable(method); err != nil {
+			return err
+		}
+	}
+
+	err = handlerCtx.GetSafetyPolicy().CheckMethod(prov.GetProviderString(), "insert", method)
+	if err != nil {
+		return err
 	}
 
 	_, err = checkResource(handlerCtx, prov, currentService, currentResource)
@@ -935,8 +1006,15 @@ func (p *standardPrimitiveGenerator) AnalyzeUpdate(pbi planbuilderinput.PlanBuil
 		return err
 	}
 
-	if p.PrimitiveComposer.IsAwait() && !method.IsAwaitable() {
-		return fmt.Errorf("method %s is not awaitable", method.GetName())
+	if p.PrimitiveComposer.IsAwait() {
+		if err := checkAwaitable(method); err != nil {
+			return err
+		}
+	}
+
+	err = handlerCtx.GetSafetyPolicy().CheckMethod(prov.GetProviderString(), "update", method)
+	if err != nil {
+		return err
 	}
 
 	_, err = checkResource(handlerCtx, prov, currentService, currentResource)
@@ -964,6 +1042,12 @@ func (p *standardPrimitiveGenerator) analyzeDelete(pbi planbuilderinput.PlanBuil
 		return fmt.Errorf("could not cast node of type '%T' to required Delete", pbi.GetStatement())
 	}
 	p.parseComments(node.Comments)
+	if node.Where == nil {
+		err := handlerCtx.GetSafetyPolicy().CheckDeleteKey(node.Where)
+		if err != nil {
+			return err
+		}
+	}
 	paramMap, ok := pbi.GetAnnotatedAST().GetWhereParamMapsEntry(node.Where)
 	if !ok {
 		return fmt.Errorf("where parameters not found; should be anlaysed a priori")
@@ -986,11 +1070,18 @@ func (p *standardPrimitiveGenerator) analyzeDelete(pbi planbuilderinput.PlanBuil
 		return err
 	}
 
-	if p.PrimitiveComposer.IsAwait() && !method.IsAwaitable() {
-		return fmt.Errorf("method %s is not awaitable", method.GetName())
+	if p.PrimitiveComposer.IsAwait() {
+		if err := checkAwaitable(method); err != nil {
+			return err
+		}
 	}
-	if p.PrimitiveComposer.IsAwait() && !method.IsAwaitable() {
-		return fmt.Errorf("method %s is not awaitable", method.GetName())
+	err = handlerCtx.GetSafetyPolicy().CheckMethod(prov.GetProviderString(), "delete", method)
+	if err != nil {
+		return err
+	}
+	err = handlerCtx.GetSafetyPolicy().CheckDeleteKey(node.Where, safetypolicy.GetMethodKey(method))
+	if err != nil {
+		return err
 	}
 	currentService, err := tbl.GetServiceStr()
 	if err != nil {
@@ -1006,11 +1097,11 @@ func (p *standardPrimitiveGenerator) analyzeDelete(pbi planbuilderinput.PlanBuil
 	}
 	requestSchema, err := method.GetRequestBodySchema()
 	if err != nil {
-		logging.GetLogger().Infof("no request schema for delete: %s \n", err.Error())
+		subsystem.GetContextLogger(p.ctx).Infof("no request schema for delete: %s \n", err.Error())
 	}
 	responseSchema, _, err := method.GetResponseBodySchemaAndMediaType()
 	if err != nil {
-		logging.GetLogger().Infof("no response schema for delete: %s \n", err.Error())
+		subsystem.GetContextLogger(p.ctx).Infof("no response schema for delete: %s \n", err.Error())
 	}
 	svc, err := tbl.GetService()
 	if err != nil {
@@ -1047,7 +1138,7 @@ func (p *standardPrimitiveGenerator) analyzeDelete(pbi planbuilderinput.PlanBuil
 		if responseSchema == nil {
 			return fmt.Errorf("cannot locate parameter '%s'", w)
 		}
-		logging.GetLogger().Infoln(fmt.Sprintf("w = '%s'", w))
+		subsystem.GetContextLogger(p.ctx).Infoln(fmt.Sprintf("w = '%s'", w))
 		foundSchemaPrefixed := responseSchema.FindByPath(colPrefix+w, nil)
 		foundSchema := responseSchema.FindByPath(w, nil)
 		foundRequestSchema := requestSchema.FindByPath(strings.TrimPrefix(w, openapistackql.RequestBodyBaseKey), nil)
@@ -1278,3 +1369,14 @@ func (p *standardPrimitiveGenerator) analyzeShow(pbi planbuilderinput.PlanBuilde
 	}
 	return err
 }
+
+func checkAwaitable(method *openapistackql.OperationStore) error {
+	isAwaitable, err := asyncmonitor.IsAwaitable(method)
+	if err != nil {
+		return err
+	}
+	if !isAwaitable {
+		return fmt.Errorf("method %s is not awaitable", method.GetName())
+	}
+	return nil
+}
@@ -45,12 +45,12 @@ func (p *standardPrimitiveGenerator) assembleUnarySelectionBuilder(
 		return err
 	}
 
-	_, err = docparser.OpenapiStackQLTabulationsPersistor(method, []util.AnnotatedTabulation{annotatedInsertTabulation}, p.PrimitiveComposer.GetSQLEngine(), prov.Name, handlerCtx.GetNamespaceCollection(), handlerCtx.GetControlAttributes(), handlerCtx.GetSQLSystem())
+	_, err = docparser.OpenapiStackQLTabulationsPersistor(p.ctx, method, []util.AnnotatedTabulation{annotatedInsertTabulation}, p.PrimitiveComposer.GetSQLEngine(), prov.Name, handlerCtx.GetNamespaceCollection(), handlerCtx.GetControlAttributes(), handlerCtx.GetSQLSystem())
 	if err != nil {
 		return err
 	}
 	ctrs := pbi.GetTxnCtrlCtrs()
-	insPsc, err := p.PrimitiveComposer.GetDRMConfig().GenerateInsertDML(annotatedInsertTabulation, method, ctrs)
+	insPsc, err := p.PrimitiveComposer.GetDRMConfig().GenerateInsertDML(p.ctx, annotatedInsertTabulation, method, ctrs)
 	if err != nil {
 		return err
 	}
@@ -67,6 +67,7 @@ func (p *standardPrimitiveGenerator) assembleUnarySelectionBuilder(
 	}
 	selectSuffix := astvisit.GenerateModifiedSelectSuffix(pbi.GetAnnotatedAST(), node, handlerCtx.GetSQLSystem(), handlerCtx.GetASTFormatter(), handlerCtx.GetNamespaceCollection())
 	selPsc, err := p.PrimitiveComposer.GetDRMConfig().GenerateSelectDML(
+		p.ctx,
 		util.NewAnnotatedTabulation(selectTabulation, hIds, inputTableName, tbl.GetAlias()),
 		insPsc.GetGCCtrlCtrs(),
 		selectSuffix,
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
 	"github.com/stackql/stackql/internal/stackql/primitive"
+	"github.com/stackql/stackql/internal/stackql/tracing"
 
 	"gonum.org/v1/gonum/graph"
 
@@ -66,15 +67,47 @@ func (pr *standardPrimitiveGraph) GetInputFromAlias(string) (internaldto.Executo
 	return rv, false
 }
 
+// spanPrimitiveCtx is the context of a single primitive, whose
+// context carries the span of the primitive, so that the spans
+// of HTTP calls and nested primitives are its children.
+type spanPrimitiveCtx struct {
+	primitive.IPrimitiveCtx
+	ctx context.Context
+}
+
+func newSpanPrimitiveCtx(pc primitive.IPrimitiveCtx, ctx context.Context) primitive.IPrimitiveCtx {
+	return &spanPrimitiveCtx{
+		IPrimitiveCtx: pc,
+		ctx:           ctx,
+	}
+}
+
+func (pc *spanPrimitiveCtx) GetContext() context.Context {
+	return pc.ctx
+}
+
 func (pg *standardPrimitiveGraph) Execute(ctx primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
 	var output internaldto.ExecutorOutput = internaldto.NewExecutorOutput(nil, nil, nil, nil, fmt.Errorf("empty execution graph"))
 	for _, node := range pg.sorted {
+		// no further primitives are scheduled once the
+		// query is cancelled or exceeds its budget
+		if err := ctx.GetContext().Err(); err != nil {
+			pg.errGroup.Wait()
+			return internaldto.NewErroneousExecutorOutput(err)
+		}
 		outChan := make(chan internaldto.ExecutorOutput, 1)
 		switch node := node.(type) {
 		case standardPrimitiveNode:
 			pg.errGroup.Go(
 				func() error {
-					output := node.GetPrimitive().Execute(ctx)
+					spanCtx, span := tracing.Start(
+						ctx.GetContext(),
+						"stackql.primitive",
+						tracing.PrimitiveKey.String(fmt.Sprintf("%T", node.GetPrimitive())),
+						tracing.PrimitiveIDKey.Int64(node.ID()),
+					)
+					output := node.GetPrimitive().Execute(newSpanPrimitiveCtx(ctx, spanCtx))
+					tracing.End(span, output.Err)
 					outChan <- output
 					close(outChan)
 					return output.Err
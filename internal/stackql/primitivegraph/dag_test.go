@@ -0,0 +1,62 @@
+package primitivegraph_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
+	"github.com/stackql/stackql/internal/stackql/primitive"
+	"github.com/stackql/stackql/internal/stackql/primitivegraph"
+	"github.com/stackql/stackql/internal/stackql/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestExecuteSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(previous)
+
+	type key struct{}
+	queryCtx, querySpan := tracing.Start(context.WithValue(context.Background(), key{}, "v"), "stackql.query")
+	graph := primitivegraph.NewPrimitiveGraph(1)
+	graph.CreatePrimitiveNode(primitive.NewLocalPrimitive(func(pc primitive.IPrimitiveCtx) internaldto.ExecutorOutput {
+		if pc.GetContext().Value(key{}) != "v" {
+			return internaldto.NewErroneousExecutorOutput(fmt.Errorf("primitive context does not derive from the query context"))
+		}
+		// as for HTTP calls, made upon the handler context
+		_, httpSpan := tracing.StartClient(tracing.WithParentSpan(context.Background(), pc.GetContext()), "HTTP GET")
+		tracing.End(httpSpan, nil)
+		return internaldto.NewErroneousExecutorOutput(fmt.Errorf("primitive failed"))
+	}))
+	if err := graph.Optimise(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pc := internaldto.NewBasicPrimitiveContext(nil, nil, nil).WithContext(queryCtx)
+	output := graph.Execute(pc)
+	tracing.End(querySpan, nil)
+	if output.Err == nil || output.Err.Error() != "primitive failed" {
+		t.Fatalf("Execute() error = %v", output.Err)
+	}
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, s := range recorder.Ended() {
+		spans[s.Name()] = s
+	}
+	query, prim, http := spans["stackql.query"], spans["stackql.primitive"], spans["HTTP GET"]
+	if query == nil || prim == nil || http == nil {
+		t.Fatalf("spans ended = %v", spans)
+	}
+	if prim.Parent().SpanID() != query.SpanContext().SpanID() {
+		t.Fatalf("primitive span is not a child of the query span")
+	}
+	if http.Parent().SpanID() != prim.SpanContext().SpanID() {
+		t.Fatalf("HTTP span is not a child of the primitive span")
+	}
+	if prim.Status().Code != codes.Error {
+		t.Fatalf("primitive span status = %v, want error", prim.Status())
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/stackql/stackql/internal/stackql/constants"
 	"github.com/stackql/stackql/internal/stackql/dto"
 	"github.com/stackql/stackql/internal/stackql/netutils"
+	"github.com/stackql/stackql/internal/stackql/redaction"
 	"github.com/stackql/stackql/pkg/awssign"
 	"github.com/stackql/stackql/pkg/azureauth"
 
@@ -49,10 +50,14 @@ func newTransport(token []byte, authType, authValuePrefix, tokenLocation, key st
 	default:
 		switch tokenLocation {
 		case locationHeader:
+			if key != "" {
+				redaction.RegisterHeader(key)
+			}
 		case locationQuery:
 			if key == "" {
 				return nil, fmt.Errorf("key required for query param based auth")
 			}
+			redaction.RegisterQueryKey(key)
 		default:
 			return nil, fmt.Errorf("token location not supported: '%s'", tokenLocation)
 		}
@@ -114,6 +119,24 @@ func activateAuth(authCtx *dto.AuthCtx, principal string, authType string) {
 
 func deactivateAuth(authCtx *dto.AuthCtx) {
 	authCtx.Active = false
+	authCtx.GetStatus().SetRevoked()
+}
+
+// statusRecordingTokenSource notes the expiry of each
+// token issued, so that it can be reported by SHOW AUTH.
+type statusRecordingTokenSource struct {
+	tokenSource oauth2.TokenSource
+	status      *dto.AuthStatus
+}
+
+func (ts *statusRecordingTokenSource) Token() (*oauth2.Token, error) {
+	tok, err := ts.tokenSource.Token()
+	if err != nil {
+		ts.status.SetFailed(err)
+		return nil, err
+	}
+	ts.status.SetExpiry(tok.Expiry)
+	return tok, nil
 }
 
 func parseServiceAccountFile(ac *dto.AuthCtx) (serviceAccount, error) {
@@ -143,12 +166,22 @@ func oauthServiceAccount(provider string, authCtx *dto.AuthCtx, scopes []string,
 	if errToken != nil {
 		return nil, errToken
 	}
-	activateAuth(authCtx, "", dto.AuthServiceAccountStr)
+	activateAuth(authCtx, config.Email, dto.AuthServiceAccountStr)
 	httpClient := netutils.GetHttpClient(runtimeCtx, http.DefaultClient)
 	if DummyAuth {
 		// return httpClient, nil
 	}
-	return config.Client(context.WithValue(oauth2.NoContext, oauth2.HTTPClient, httpClient)), nil
+	ctx := context.WithValue(oauth2.NoContext, oauth2.HTTPClient, httpClient)
+	rv := oauth2.NewClient(
+		ctx,
+		&statusRecordingTokenSource{
+			tokenSource: config.TokenSource(ctx),
+			status:      authCtx.GetStatus(),
+		},
+	)
+	// oauth2 clients do not inherit the base client timeout
+	rv.Timeout = httpClient.Timeout
+	return rv, nil
 }
 
 func apiTokenAuth(authCtx *dto.AuthCtx, runtimeCtx dto.RuntimeCtx, enforceBearer bool) (*http.Client, error) {
@@ -216,6 +249,7 @@ func azureDefaultAuth(authCtx *dto.AuthCtx, runtimeCtx dto.RuntimeCtx) (*http.Cl
 	}
 	tokenString := token.Token
 	activateAuth(authCtx, "", "azure_default")
+	authCtx.GetStatus().SetExpiry(token.ExpiresOn)
 	httpClient := netutils.GetHttpClient(runtimeCtx, http.DefaultClient)
 	tr, err := newTransport([]byte(tokenString), authTypeBearer, "Bearer ", locationHeader, "", httpClient.Transport)
 	if err != nil {
@@ -1,10 +1,12 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/stackql/stackql/internal/stackql/constants"
+	"github.com/stackql/stackql/internal/stackql/datasource/sql_datasource"
 	"github.com/stackql/stackql/internal/stackql/discovery"
 	"github.com/stackql/stackql/internal/stackql/docparser"
 	"github.com/stackql/stackql/internal/stackql/dto"
@@ -19,6 +21,7 @@ import (
 	"github.com/stackql/stackql/pkg/sqltypeutil"
 
 	"github.com/stackql/go-openapistackql/openapistackql"
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 
 	"net/http"
 	"net/url"
@@ -26,6 +29,8 @@ import (
 	"strings"
 )
 
+const subsystem logging.Subsystem = "provider"
+
 var (
 	gitHubLinksNextRegex *regexp.Regexp = regexp.MustCompile(`.*<(?P<nextURL>[^>]*)>;\ rel="next".*`)
 )
@@ -58,11 +63,11 @@ func (gp *GenericProvider) GetServiceShard(serviceKey string, resourceKey string
 	return gp.discoveryAdapter.GetServiceShard(gp.provider, serviceKey, resourceKey)
 }
 
-func (gp *GenericProvider) PersistStaticExternalSQLDataSource(runtimeCtx dto.RuntimeCtx) error {
-	return gp.discoveryAdapter.PersistStaticExternalSQLDataSource(gp.provider)
+func (gp *GenericProvider) PersistStaticExternalSQLDataSource(runtimeCtx dto.RuntimeCtx, sqlDataSource sql_datasource.SQLDataSource) error {
+	return gp.discoveryAdapter.PersistStaticExternalSQLDataSource(gp.provider, sqlDataSource)
 }
 
-func (gp *GenericProvider) inferAuthType(authCtx dto.AuthCtx, authTypeRequested string) string {
+func (gp *GenericProvider) InferAuthType(authCtx dto.AuthCtx, authTypeRequested string) string {
 	ft := strings.ToLower(authTypeRequested)
 	switch ft {
 	case dto.AuthAzureDefaultStr:
@@ -81,6 +86,14 @@ func (gp *GenericProvider) inferAuthType(authCtx dto.AuthCtx, authTypeRequested
 		return dto.AuthNullStr
 	case dto.AuthAWSSigningv4Str:
 		return dto.AuthAWSSigningv4Str
+	case sqlparser.ServiceAccountStr:
+		// The AUTH LOGIN grammar only admits INTERACTIVE and SERVICEACCOUNT,
+		// so the latter stands in for any configured credentials file based type.
+		switch configuredType := strings.ToLower(authCtx.Type); configuredType {
+		case dto.AuthApiKeyStr, dto.AuthBasicStr, dto.AuthBearerStr, dto.AuthAWSSigningv4Str:
+			return configuredType
+		}
+		return dto.AuthServiceAccountStr
 	}
 	if authCtx.KeyFilePath != "" || authCtx.KeyEnvVar != "" {
 		return dto.AuthServiceAccountStr
@@ -90,8 +103,21 @@ func (gp *GenericProvider) inferAuthType(authCtx dto.AuthCtx, authTypeRequested
 
 func (gp *GenericProvider) Auth(authCtx *dto.AuthCtx, authTypeRequested string, enforceRevokeFirst bool) (*http.Client, error) {
 	authCtx = authCtx.Clone()
-	at := gp.inferAuthType(*authCtx, authTypeRequested)
-	switch at {
+	status := authCtx.GetStatus()
+	if !enforceRevokeFirst && status.IsRevoked() {
+		return nil, fmt.Errorf(constants.RevokedAuthErrStr)
+	}
+	client, err := gp.authByType(authCtx, gp.InferAuthType(*authCtx, authTypeRequested), enforceRevokeFirst)
+	if err != nil {
+		status.SetFailed(err)
+		return nil, err
+	}
+	status.SetValidated(authCtx.ID)
+	return client, nil
+}
+
+func (gp *GenericProvider) authByType(authCtx *dto.AuthCtx, authType string, enforceRevokeFirst bool) (*http.Client, error) {
+	switch authType {
 	case dto.AuthApiKeyStr:
 		return gp.apiTokenFileAuth(authCtx, false)
 	case dto.AuthBearerStr:
@@ -113,17 +139,22 @@ func (gp *GenericProvider) Auth(authCtx *dto.AuthCtx, authTypeRequested string,
 }
 
 func (gp *GenericProvider) AuthRevoke(authCtx *dto.AuthCtx) error {
-	switch strings.ToLower(authCtx.Type) {
-	case dto.AuthServiceAccountStr:
-		return errors.New(constants.ServiceAccountRevokeErrStr)
+	switch gp.InferAuthType(*authCtx, authCtx.Type) {
 	case dto.AuthInteractiveStr:
 		err := google_sdk.RevokeGoogleAuth()
 		if err == nil {
 			deactivateAuth(authCtx)
 		}
 		return err
+	case dto.AuthServiceAccountStr, dto.AuthApiKeyStr, dto.AuthBearerStr, dto.AuthBasicStr, dto.AuthAWSSigningv4Str, dto.AuthAzureDefaultStr:
+		// Credentials owned by files or the environment cannot be revoked upstream,
+		// so they are instead withdrawn from the session until the next AUTH.
+		deactivateAuth(authCtx)
+		return nil
+	case dto.AuthNullStr:
+		return nil
 	}
-	return fmt.Errorf(`Auth revoke for Google Failed; improper auth method: "%s" specified`, authCtx.Type)
+	return fmt.Errorf(`auth revoke for provider '%s' failed; improper auth method: "%s" specified`, gp.GetProviderString(), authCtx.Type)
 }
 
 func (gp *GenericProvider) GetMethodForAction(serviceName string, resourceName string, iqlAction string, parameters parserutil.ColumnKeyedDatastore, runtimeCtx dto.RuntimeCtx) (*openapistackql.OperationStore, string, error) {
@@ -165,49 +196,64 @@ func (gp *GenericProvider) GetObjectSchema(serviceName string, resourceName stri
 	return svc.GetSchema(schemaName)
 }
 
-func (gp *GenericProvider) ShowAuth(authCtx *dto.AuthCtx) (*openapistackql.AuthMetadata, error) {
-	var err error
-	var retVal *openapistackql.AuthMetadata
-	var authObj openapistackql.AuthMetadata
+func (gp *GenericProvider) ShowAuth(ctx context.Context, authCtx *dto.AuthCtx) (*dto.AuthMetadata, error) {
 	if authCtx == nil {
 		return nil, errors.New(constants.NotAuthenticatedShowStr)
 	}
-	switch gp.inferAuthType(*authCtx, authCtx.Type) {
+	providerName := gp.GetProviderString()
+	authType := gp.InferAuthType(*authCtx, authCtx.Type)
+	if authCtx.GetStatus().IsRevoked() {
+		return dto.NewAuthMetadata(providerName, authType, "", "", authCtx), errors.New(constants.NotAuthenticatedShowStr)
+	}
+	if strings.HasPrefix(authCtx.Type, constants.AuthTypeSQLDataSourcePrefix) {
+		return dto.NewAuthMetadata(providerName, authCtx.Type, "", "sqlDataSource", authCtx), nil
+	}
+	switch authType {
 	case dto.AuthServiceAccountStr:
-		var sa serviceAccount
-		sa, err = parseServiceAccountFile(authCtx)
-		if err == nil {
-			authObj = openapistackql.AuthMetadata{
-				Principal: sa.Email,
-				Type:      strings.ToUpper(dto.AuthServiceAccountStr),
-				Source:    authCtx.GetCredentialsSourceDescriptorString(),
-			}
-			retVal = &authObj
-			activateAuth(authCtx, sa.Email, dto.AuthServiceAccountStr)
+		sa, err := parseServiceAccountFile(authCtx)
+		if err != nil {
+			return dto.NewAuthMetadata(providerName, authType, "", authCtx.GetCredentialsSourceDescriptorString(), authCtx), errors.New(constants.NotAuthenticatedShowStr)
 		}
+		activateAuth(authCtx, sa.Email, dto.AuthServiceAccountStr)
+		return dto.NewAuthMetadata(providerName, authType, sa.Email, authCtx.GetCredentialsSourceDescriptorString(), authCtx), nil
 	case dto.AuthInteractiveStr:
 		principal, sdkErr := google_sdk.GetCurrentAuthUser()
-		if sdkErr == nil {
-			principalStr := string(principal)
-			if principalStr != "" {
-				authObj = openapistackql.AuthMetadata{
-					Principal: principalStr,
-					Type:      strings.ToUpper(dto.AuthInteractiveStr),
-					Source:    "OAuth",
-				}
-				retVal = &authObj
-				activateAuth(authCtx, principalStr, dto.AuthInteractiveStr)
-			} else {
-				err = errors.New(constants.NotAuthenticatedShowStr)
-			}
-		} else {
-			logging.GetLogger().Infoln(sdkErr)
-			err = errors.New(constants.NotAuthenticatedShowStr)
+		if sdkErr != nil {
+			subsystem.GetContextLogger(ctx).Infoln(sdkErr)
+			return dto.NewAuthMetadata(providerName, authType, "", "OAuth", authCtx), errors.New(constants.NotAuthenticatedShowStr)
 		}
-	default:
-		err = errors.New(constants.NotAuthenticatedShowStr)
+		principalStr := strings.TrimSpace(string(principal))
+		if principalStr == "" {
+			return dto.NewAuthMetadata(providerName, authType, "", "OAuth", authCtx), errors.New(constants.NotAuthenticatedShowStr)
+		}
+		activateAuth(authCtx, principalStr, dto.AuthInteractiveStr)
+		return dto.NewAuthMetadata(providerName, authType, principalStr, "OAuth", authCtx), nil
+	case dto.AuthApiKeyStr, dto.AuthBearerStr, dto.AuthBasicStr:
+		source := authCtx.GetCredentialsSourceDescriptorString()
+		if _, err := authCtx.GetCredentialsBytes(); err != nil {
+			return dto.NewAuthMetadata(providerName, authType, "", source, authCtx), errors.New(constants.NotAuthenticatedShowStr)
+		}
+		activateAuth(authCtx, "", authType)
+		return dto.NewAuthMetadata(providerName, authType, "", source, authCtx), nil
+	case dto.AuthAWSSigningv4Str:
+		source := authCtx.GetCredentialsSourceDescriptorString()
+		keyID, err := authCtx.GetKeyIDString()
+		if err != nil || keyID == "" {
+			return dto.NewAuthMetadata(providerName, authType, "", source, authCtx), errors.New(constants.NotAuthenticatedShowStr)
+		}
+		if _, err := authCtx.GetCredentialsBytes(); err != nil {
+			return dto.NewAuthMetadata(providerName, authType, keyID, source, authCtx), errors.New(constants.NotAuthenticatedShowStr)
+		}
+		activateAuth(authCtx, keyID, authType)
+		return dto.NewAuthMetadata(providerName, authType, keyID, source, authCtx), nil
+	case dto.AuthAzureDefaultStr:
+		return dto.NewAuthMetadata(providerName, authType, "", "DefaultAzureCredential", authCtx), nil
+	case dto.AuthNullStr:
+		rv := dto.NewAuthMetadata(providerName, authType, "", "", authCtx)
+		rv.Validated = true
+		return rv, nil
 	}
-	return retVal, err
+	return nil, errors.New(constants.NotAuthenticatedShowStr)
 }
 
 func (gp *GenericProvider) oAuth(authCtx *dto.AuthCtx, enforceRevokeFirst bool) (*http.Client, error) {
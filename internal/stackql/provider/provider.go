@@ -1,9 +1,11 @@
 package provider
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/stackql/stackql/internal/stackql/constants"
+	"github.com/stackql/stackql/internal/stackql/datasource/sql_datasource"
 	"github.com/stackql/stackql/internal/stackql/discovery"
 	"github.com/stackql/stackql/internal/stackql/docparser"
 	"github.com/stackql/stackql/internal/stackql/dto"
@@ -67,6 +69,8 @@ type IProvider interface {
 
 	GetVersion() string
 
+	InferAuthType(authCtx dto.AuthCtx, authTypeRequested string) string
+
 	InferDescribeMethod(*openapistackql.Resource) (*openapistackql.OperationStore, string, error)
 
 	InferMaxResultsElement(*openapistackql.OperationStore) internaldto.HTTPElement
@@ -75,11 +79,11 @@ type IProvider interface {
 
 	InferNextPageResponseElement(internaldto.Heirarchy) internaldto.HTTPElement
 
-	PersistStaticExternalSQLDataSource(dto.RuntimeCtx) error
+	PersistStaticExternalSQLDataSource(dto.RuntimeCtx, sql_datasource.SQLDataSource) error
 
 	SetCurrentService(serviceKey string)
 
-	ShowAuth(authCtx *dto.AuthCtx) (*openapistackql.AuthMetadata, error)
+	ShowAuth(ctx context.Context, authCtx *dto.AuthCtx) (*dto.AuthMetadata, error)
 }
 
 func GetProvider(runtimeCtx dto.RuntimeCtx, providerStr, providerVersion string, reg openapistackql.RegistryAPI, sqlSystem sql_system.SQLSystem) (IProvider, error) {
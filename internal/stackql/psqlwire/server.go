@@ -7,6 +7,8 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net"
+	"sync/atomic"
 
 	"github.com/sirupsen/logrus"
 	"github.com/stackql/stackql/internal/stackql/dto"
@@ -17,26 +19,34 @@ import (
 	wire "github.com/jeroenrinzema/psql-wire"
 )
 
+const subsystem logging.Subsystem = "psqlwire"
+
 type IWireServer interface {
+	// IsListening reports whether connections are accepted.
+	IsListening() bool
 	Serve() error
 }
 
 type SimpleWireServer struct {
-	logger *logrus.Logger
-	server *wire.Server
-	rtCtx  dto.RuntimeCtx
-	tlsCfg dto.PgTLSCfg
+	logger         *logrus.Logger
+	server         *wire.Server
+	rtCtx          dto.RuntimeCtx
+	tlsCfg         dto.PgTLSCfg
+	tlsConfig      *tls.Config
+	sessionHandler SessionHandler
+	isListening    int32
 }
 
+// MakeWireServer serves the backend, whose sessions are tracked
+// where it implements SessionHandler.
 func MakeWireServer(sbe sqlbackend.ISQLBackend, cfg dto.RuntimeCtx) (IWireServer, error) {
-	logger := logging.GetLogger()
+	logger := subsystem.GetLogger()
 
 	var tlsCfg dto.PgTLSCfg
-	var server *wire.Server
+	var tlsConfig *tls.Config
 
-	var err error
 	if cfg.PGSrvRawTLSCfg != "" {
-		err = json.Unmarshal([]byte(cfg.PGSrvRawTLSCfg), &tlsCfg)
+		err := json.Unmarshal([]byte(cfg.PGSrvRawTLSCfg), &tlsCfg)
 		if err != nil {
 			return nil, err
 		}
@@ -44,15 +54,12 @@ func MakeWireServer(sbe sqlbackend.ISQLBackend, cfg dto.RuntimeCtx) (IWireServer
 		if err != nil {
 			return nil, err
 		}
-		certs := []tls.Certificate{cert}
-		server, err = wire.NewServer(
-			wire.SQLBackend(sbe),
-			wire.Certificates(certs),
-			wire.Logger(logging.GetLogger()),
-		)
-		var cp *x509.CertPool
+		// TLS is negotiated by the session listener, ahead of the wire server
+		tlsConfig = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+		}
 		if len(tlsCfg.ClientCAs) > 0 {
-			cp = x509.NewCertPool()
+			cp := x509.NewCertPool()
 			for _, pemStr := range tlsCfg.ClientCAs {
 				b, err := base64.RawStdEncoding.DecodeString(pemStr)
 				if err != nil {
@@ -63,29 +70,41 @@ func MakeWireServer(sbe sqlbackend.ISQLBackend, cfg dto.RuntimeCtx) (IWireServer
 					logger.Error("failed loading Client CA")
 				}
 			}
-			server.ClientCAs = cp
-			server.ClientAuth = tls.RequireAndVerifyClientCert
-		}
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		server, err = wire.NewServer(wire.SQLBackend(sbe))
-		if err != nil {
-			return nil, err
+			tlsConfig.ClientCAs = cp
+			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
 		}
 	}
+	server, err := wire.NewServer(
+		wire.SQLBackend(sbe),
+		wire.Logger(logger),
+	)
+	if err != nil {
+		return nil, err
+	}
+	sessionHandler, _ := sbe.(SessionHandler)
 	return &SimpleWireServer{
-		logger: logger,
-		rtCtx:  cfg,
-		server: server,
-		tlsCfg: tlsCfg,
+		logger:         logger,
+		rtCtx:          cfg,
+		server:         server,
+		tlsCfg:         tlsCfg,
+		tlsConfig:      tlsConfig,
+		sessionHandler: sessionHandler,
 	}, nil
 }
 
+func (sws *SimpleWireServer) IsListening() bool {
+	return atomic.LoadInt32(&sws.isListening) == 1
+}
+
 func (sws *SimpleWireServer) Serve() error {
+	listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", sws.rtCtx.PGSrvAddress, sws.rtCtx.PGSrvPort))
+	if err != nil {
+		return err
+	}
 	sws.logger.Info(fmt.Sprintf("PostgreSQL server is up and running at [%s:%d]", sws.rtCtx.PGSrvAddress, sws.rtCtx.PGSrvPort))
-	return sws.server.ListenAndServe(fmt.Sprintf("%s:%d", sws.rtCtx.PGSrvAddress, sws.rtCtx.PGSrvPort))
+	atomic.StoreInt32(&sws.isListening, 1)
+	defer atomic.StoreInt32(&sws.isListening, 0)
+	return sws.server.Serve(newSessionListener(listener, sws.tlsConfig, sws.sessionHandler))
 }
 
 func handle(ctx context.Context, query string, writer wire.DataWriter) error {
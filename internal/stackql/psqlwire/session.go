@@ -0,0 +1,310 @@
+package psqlwire
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/stackql/stackql/internal/stackql/metrics"
+	"github.com/stackql/stackql/internal/stackql/sessionctx"
+)
+
+const (
+	cancelRequestCode uint32 = 80877102
+	sslRequestCode    uint32 = 80877103
+	gssEncRequestCode uint32 = 80877104
+
+	// maxStartupMessageSize is as per the postgres server.
+	maxStartupMessageSize int = 10000
+
+	backendKeyDataMessage byte = 'K'
+	noticeResponseMessage byte = 'N'
+	readyForQueryMessage  byte = 'Z'
+)
+
+// SessionHandler is notified of the life cycle of client sessions.
+// Sessions are identified to queries by the client parameter
+// sessionctx.SessionIDParameter, which the server supplies.
+type SessionHandler interface {
+	// OpenSession supplies the notifier by which
+	// notices are sent to the client of the session.
+	OpenSession(sessionID string, notifier sessionctx.Notifier)
+	// CancelSession cancels the query in progress, if any.
+	CancelSession(sessionID string)
+	CloseSession(sessionID string)
+}
+
+type backendKey struct {
+	processID uint32
+	secretKey uint32
+}
+
+// sessionListener negotiates the start of each connection ahead of the
+// wire server, which does not support cancel requests: it upgrades to TLS,
+// answers cancel requests and identifies the session, which it supplies
+// to the client as backend key data for subsequent cancel requests.
+type sessionListener struct {
+	net.Listener
+	tlsConfig      *tls.Config
+	sessionHandler SessionHandler
+	mutex          sync.Mutex
+	lastProcessID  uint32
+	sessions       map[backendKey]string
+}
+
+func newSessionListener(listener net.Listener, tlsConfig *tls.Config, sessionHandler SessionHandler) *sessionListener {
+	return &sessionListener{
+		Listener:       listener,
+		tlsConfig:      tlsConfig,
+		sessionHandler: sessionHandler,
+		sessions:       make(map[backendKey]string),
+	}
+}
+
+func (l *sessionListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	metrics.ConnectionOpened()
+	return &sessionConn{Conn: conn, listener: l}, nil
+}
+
+func (l *sessionListener) openSession(notifier sessionctx.Notifier) (string, backendKey, error) {
+	sessionID, err := sessionctx.NewSessionID()
+	if err != nil {
+		return "", backendKey{}, err
+	}
+	var b [4]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", backendKey{}, err
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.lastProcessID++
+	key := backendKey{processID: l.lastProcessID, secretKey: binary.BigEndian.Uint32(b[:])}
+	l.sessions[key] = sessionID
+	if l.sessionHandler != nil {
+		l.sessionHandler.OpenSession(sessionID, notifier)
+	}
+	return sessionID, key, nil
+}
+
+func (l *sessionListener) closeSession(sessionID string, key backendKey) {
+	l.mutex.Lock()
+	delete(l.sessions, key)
+	l.mutex.Unlock()
+	if l.sessionHandler != nil {
+		l.sessionHandler.CloseSession(sessionID)
+	}
+}
+
+// cancelSession ignores unknown keys, as does the postgres server.
+func (l *sessionListener) cancelSession(key backendKey) {
+	l.mutex.Lock()
+	sessionID, ok := l.sessions[key]
+	l.mutex.Unlock()
+	if ok && l.sessionHandler != nil {
+		l.sessionHandler.CancelSession(sessionID)
+	}
+}
+
+// sessionConn negotiates upon the first read, then replays
+// the startup message, amended with the session ID,
+// or the cancel request, to the wire server.
+type sessionConn struct {
+	net.Conn
+	listener      *sessionListener
+	negotiateOnce sync.Once
+	negotiateErr  error
+	pending       []byte
+	writeMutex    sync.Mutex
+	isKeySent     bool
+	sessionID     string
+	key           backendKey
+	closeOnce     sync.Once
+}
+
+func (c *sessionConn) Read(p []byte) (int, error) {
+	c.negotiateOnce.Do(func() {
+		c.negotiateErr = c.negotiate()
+	})
+	if c.negotiateErr != nil {
+		return 0, c.negotiateErr
+	}
+	if len(c.pending) > 0 {
+		n := copy(p, c.pending)
+		c.pending = c.pending[n:]
+		return n, nil
+	}
+	return c.Conn.Read(p)
+}
+
+// Write sends backend key data ahead of the first
+// ready for query message, which ends the startup.
+func (c *sessionConn) Write(p []byte) (int, error) {
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+	if !c.isKeySent && c.sessionID != "" && len(p) > 0 && p[0] == readyForQueryMessage {
+		c.isKeySent = true
+		msg := make([]byte, 13)
+		msg[0] = backendKeyDataMessage
+		binary.BigEndian.PutUint32(msg[1:5], 12)
+		binary.BigEndian.PutUint32(msg[5:9], c.key.processID)
+		binary.BigEndian.PutUint32(msg[9:13], c.key.secretKey)
+		if _, err := c.Conn.Write(msg); err != nil {
+			return 0, err
+		}
+	}
+	return c.Conn.Write(p)
+}
+
+// Notify sends a notice response, which clients accept at any time
+// once the startup is complete, and which is written whole
+// so as not to interleave with the messages of the wire server.
+func (c *sessionConn) Notify(message string) error {
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+	if !c.isKeySent {
+		return fmt.Errorf("cannot send notice ahead of session startup")
+	}
+	_, err := c.Conn.Write(noticeResponse(message))
+	return err
+}
+
+// noticeResponse comprises the fields severity, severity
+// (non localized), code "successful completion" and message.
+func noticeResponse(message string) []byte {
+	var fields bytes.Buffer
+	for _, f := range []struct {
+		code  byte
+		value string
+	}{
+		{code: 'S', value: "NOTICE"},
+		{code: 'V', value: "NOTICE"},
+		{code: 'C', value: "00000"},
+		{code: 'M', value: strings.ReplaceAll(message, "\x00", "")},
+	} {
+		fields.WriteByte(f.code)
+		fields.WriteString(f.value)
+		fields.WriteByte(0)
+	}
+	fields.WriteByte(0)
+	msg := make([]byte, 5, 5+fields.Len())
+	msg[0] = noticeResponseMessage
+	binary.BigEndian.PutUint32(msg[1:5], uint32(4+fields.Len()))
+	return append(msg, fields.Bytes()...)
+}
+
+// Close may be called more than once, eg: for
+// TLS connections and for cancel requests.
+func (c *sessionConn) Close() error {
+	c.closeOnce.Do(func() {
+		metrics.ConnectionClosed()
+		if c.sessionID != "" {
+			c.listener.closeSession(c.sessionID, c.key)
+		}
+	})
+	return c.Conn.Close()
+}
+
+func (c *sessionConn) negotiate() error {
+	for {
+		msg, err := readStartupMessage(c.Conn)
+		if err != nil {
+			return err
+		}
+		switch binary.BigEndian.Uint32(msg[4:8]) {
+		case sslRequestCode:
+			if _, isTLS := c.Conn.(*tls.Conn); c.listener.tlsConfig == nil || isTLS {
+				if _, err := c.Conn.Write([]byte{'N'}); err != nil {
+					return err
+				}
+				continue
+			}
+			if _, err := c.Conn.Write([]byte{'S'}); err != nil {
+				return err
+			}
+			c.Conn = tls.Server(c.Conn, c.listener.tlsConfig)
+		case gssEncRequestCode:
+			if _, err := c.Conn.Write([]byte{'N'}); err != nil {
+				return err
+			}
+		case cancelRequestCode:
+			if len(msg) != 16 {
+				return fmt.Errorf("malformed cancel request")
+			}
+			c.listener.cancelSession(backendKey{
+				processID: binary.BigEndian.Uint32(msg[8:12]),
+				secretKey: binary.BigEndian.Uint32(msg[12:16]),
+			})
+			c.pending = msg
+			return nil
+		default:
+			sessionID, key, err := c.listener.openSession(c)
+			if err != nil {
+				return err
+			}
+			c.sessionID = sessionID
+			c.key = key
+			c.pending, err = withStartupParameter(msg, sessionctx.SessionIDParameter, sessionID)
+			return err
+		}
+	}
+}
+
+func readStartupMessage(r io.Reader) ([]byte, error) {
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	size := int(binary.BigEndian.Uint32(header))
+	if size < 8 || size > maxStartupMessageSize {
+		return nil, fmt.Errorf("invalid startup message length %d", size)
+	}
+	msg := make([]byte, size)
+	copy(msg, header)
+	if _, err := io.ReadFull(r, msg[4:]); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// withStartupParameter sets the parameter in the startup message,
+// overwriting any value supplied by the client.
+func withStartupParameter(msg []byte, key string, value string) ([]byte, error) {
+	var params bytes.Buffer
+	fields := bytes.Split(msg[8:], []byte{0})
+	// the parameter list is terminated by an empty name
+	for i := 0; i+1 < len(fields); i += 2 {
+		if len(fields[i]) == 0 {
+			break
+		}
+		if string(fields[i]) == key {
+			continue
+		}
+		params.Write(fields[i])
+		params.WriteByte(0)
+		params.Write(fields[i+1])
+		params.WriteByte(0)
+	}
+	params.WriteString(key)
+	params.WriteByte(0)
+	params.WriteString(value)
+	params.WriteByte(0)
+	params.WriteByte(0)
+	rv := make([]byte, 8, 8+params.Len())
+	copy(rv[4:8], msg[4:8])
+	rv = append(rv, params.Bytes()...)
+	if len(rv) > maxStartupMessageSize {
+		return nil, fmt.Errorf("startup message too long")
+	}
+	binary.BigEndian.PutUint32(rv[0:4], uint32(len(rv)))
+	return rv, nil
+}
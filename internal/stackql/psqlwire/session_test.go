@@ -0,0 +1,446 @@
+package psqlwire
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stackql/stackql/internal/stackql/metrics"
+	"github.com/stackql/stackql/internal/stackql/sessionctx"
+)
+
+type recordingSessionHandler struct {
+	mutex     sync.Mutex
+	opened    []string
+	notifiers []sessionctx.Notifier
+	cancelled []string
+	closed    []string
+}
+
+func (h *recordingSessionHandler) OpenSession(sessionID string, notifier sessionctx.Notifier) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.opened = append(h.opened, sessionID)
+	h.notifiers = append(h.notifiers, notifier)
+}
+
+func (h *recordingSessionHandler) CancelSession(sessionID string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.cancelled = append(h.cancelled, sessionID)
+}
+
+func (h *recordingSessionHandler) CloseSession(sessionID string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.closed = append(h.closed, sessionID)
+}
+
+func startupMessage(params ...string) []byte {
+	var body bytes.Buffer
+	for _, p := range params {
+		body.WriteString(p)
+		body.WriteByte(0)
+	}
+	body.WriteByte(0)
+	msg := make([]byte, 8, 8+body.Len())
+	binary.BigEndian.PutUint32(msg[4:8], 196608)
+	msg = append(msg, body.Bytes()...)
+	binary.BigEndian.PutUint32(msg[0:4], uint32(len(msg)))
+	return msg
+}
+
+func requestMessage(code uint32, args ...uint32) []byte {
+	msg := make([]byte, 8+4*len(args))
+	binary.BigEndian.PutUint32(msg[0:4], uint32(len(msg)))
+	binary.BigEndian.PutUint32(msg[4:8], code)
+	for i, a := range args {
+		binary.BigEndian.PutUint32(msg[8+4*i:], a)
+	}
+	return msg
+}
+
+func parseStartupParameters(t *testing.T, msg []byte) map[string]string {
+	if int(binary.BigEndian.Uint32(msg[0:4])) != len(msg) {
+		t.Fatalf("startup message length %d, want %d", binary.BigEndian.Uint32(msg[0:4]), len(msg))
+	}
+	rv := make(map[string]string)
+	fields := bytes.Split(msg[8:], []byte{0})
+	for i := 0; i+1 < len(fields) && len(fields[i]) > 0; i += 2 {
+		rv[string(fields[i])] = string(fields[i+1])
+	}
+	return rv
+}
+
+func TestWithStartupParameter(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params []string
+		want   map[string]string
+	}{
+		{
+			name: "no parameters",
+			want: map[string]string{sessionctx.SessionIDParameter: "abc"},
+		},
+		{
+			name:   "client parameters retained",
+			params: []string{"user", "alice", "database", "stackql"},
+			want:   map[string]string{"user": "alice", "database": "stackql", sessionctx.SessionIDParameter: "abc"},
+		},
+		{
+			name:   "client supplied session overwritten",
+			params: []string{sessionctx.SessionIDParameter, "spoofed", "user", "alice"},
+			want:   map[string]string{"user": "alice", sessionctx.SessionIDParameter: "abc"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := withStartupParameter(startupMessage(tc.params...), sessionctx.SessionIDParameter, "abc")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if binary.BigEndian.Uint32(msg[4:8]) != 196608 {
+				t.Fatalf("protocol version not retained")
+			}
+			got := parseStartupParameters(t, msg)
+			if len(got) != len(tc.want) {
+				t.Fatalf("parameters = %v, want %v", got, tc.want)
+			}
+			for k, v := range tc.want {
+				if got[k] != v {
+					t.Fatalf("parameter '%s' = '%s', want '%s'", k, got[k], v)
+				}
+			}
+		})
+	}
+}
+
+// negotiatePipe negotiates the server side of a pipe and returns the
+// bytes replayed to the wire server; the client writes
+// the requests then reads responses into its buffer.
+func negotiatePipe(t *testing.T, listener *sessionListener, requests ...[]byte) (*sessionConn, []byte, []byte) {
+	client, server := net.Pipe()
+	conn := &sessionConn{Conn: server, listener: listener}
+	var responses bytes.Buffer
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for _, r := range requests {
+			if _, err := client.Write(r); err != nil {
+				return
+			}
+			if code := binary.BigEndian.Uint32(r[4:8]); code == sslRequestCode || code == gssEncRequestCode {
+				b := make([]byte, 1)
+				if _, err := io.ReadFull(client, b); err != nil {
+					return
+				}
+				responses.Write(b)
+			}
+		}
+	}()
+	replayed, err := readStartupMessage(conn)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	<-done
+	t.Cleanup(func() { client.Close() })
+	return conn, replayed, responses.Bytes()
+}
+
+func TestSessionConnNegotiation(t *testing.T) {
+	testCases := []struct {
+		name          string
+		requests      [][]byte
+		wantResponses string
+	}{
+		{
+			name:     "plain startup",
+			requests: [][]byte{startupMessage("user", "alice")},
+		},
+		{
+			name:          "ssl request refused without TLS",
+			requests:      [][]byte{requestMessage(sslRequestCode), startupMessage("user", "alice")},
+			wantResponses: "N",
+		},
+		{
+			name:          "gss encryption refused",
+			requests:      [][]byte{requestMessage(gssEncRequestCode), startupMessage("user", "alice")},
+			wantResponses: "N",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := &recordingSessionHandler{}
+			listener := newSessionListener(nil, nil, handler)
+			conn, replayed, responses := negotiatePipe(t, listener, tc.requests...)
+			if string(responses) != tc.wantResponses {
+				t.Fatalf("responses = '%s', want '%s'", string(responses), tc.wantResponses)
+			}
+			params := parseStartupParameters(t, replayed)
+			if params["user"] != "alice" {
+				t.Fatalf("user = '%s', want 'alice'", params["user"])
+			}
+			if len(handler.opened) != 1 || params[sessionctx.SessionIDParameter] != handler.opened[0] {
+				t.Fatalf("session parameter '%s', opened sessions %v", params[sessionctx.SessionIDParameter], handler.opened)
+			}
+			if conn.key.processID == 0 {
+				t.Fatalf("no backend key assigned")
+			}
+		})
+	}
+}
+
+func TestSessionConnBackendKeyData(t *testing.T) {
+	handler := &recordingSessionHandler{}
+	listener := newSessionListener(nil, nil, handler)
+	conn, _, _ := negotiatePipe(t, listener, startupMessage("user", "alice"))
+	peer, server := net.Pipe()
+	defer peer.Close()
+	conn.Conn = server
+	authOK := []byte{'R', 0, 0, 0, 8, 0, 0, 0, 0}
+	readyForQuery := []byte{'Z', 0, 0, 0, 5, 'I'}
+	keyData := make([]byte, 13)
+	keyData[0] = 'K'
+	binary.BigEndian.PutUint32(keyData[1:5], 12)
+	binary.BigEndian.PutUint32(keyData[5:9], conn.key.processID)
+	binary.BigEndian.PutUint32(keyData[9:13], conn.key.secretKey)
+	var want []byte
+	for _, msg := range [][]byte{authOK, keyData, readyForQuery, readyForQuery} {
+		want = append(want, msg...)
+	}
+	received := make(chan []byte)
+	go func() {
+		b := make([]byte, len(want))
+		io.ReadFull(peer, b) //nolint:errcheck // checked by content
+		received <- b
+	}()
+	for _, msg := range [][]byte{authOK, readyForQuery, readyForQuery} {
+		if _, err := conn.Write(msg); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if got := <-received; !bytes.Equal(got, want) {
+		t.Fatalf("written = %v, want %v", got, want)
+	}
+}
+
+func TestSessionConnCancelRequest(t *testing.T) {
+	handler := &recordingSessionHandler{}
+	listener := newSessionListener(nil, nil, handler)
+	conn, _, _ := negotiatePipe(t, listener, startupMessage("user", "alice"))
+	testCases := []struct {
+		name          string
+		key           backendKey
+		wantCancelled int
+	}{
+		{
+			name:          "unknown process",
+			key:           backendKey{processID: conn.key.processID + 1, secretKey: conn.key.secretKey},
+			wantCancelled: 0,
+		},
+		{
+			name:          "wrong secret",
+			key:           backendKey{processID: conn.key.processID, secretKey: conn.key.secretKey + 1},
+			wantCancelled: 0,
+		},
+		{
+			name:          "matching key",
+			key:           conn.key,
+			wantCancelled: 1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler.cancelled = nil
+			request := requestMessage(cancelRequestCode, tc.key.processID, tc.key.secretKey)
+			cancelConn, replayed, _ := negotiatePipe(t, listener, request)
+			if !bytes.Equal(replayed, request) {
+				t.Fatalf("cancel request not replayed to the wire server")
+			}
+			if cancelConn.sessionID != "" {
+				t.Fatalf("cancel request opened a session")
+			}
+			if len(handler.cancelled) != tc.wantCancelled {
+				t.Fatalf("cancelled sessions = %v, want %d", handler.cancelled, tc.wantCancelled)
+			}
+			if tc.wantCancelled > 0 && handler.cancelled[0] != conn.sessionID {
+				t.Fatalf("cancelled session '%s', want '%s'", handler.cancelled[0], conn.sessionID)
+			}
+		})
+	}
+	conn.Close()
+	conn.Close()
+	if len(handler.closed) != 1 || handler.closed[0] != conn.sessionID {
+		t.Fatalf("closed sessions = %v, want exactly '%s'", handler.closed, conn.sessionID)
+	}
+	handler.cancelled = nil
+	negotiatePipe(t, listener, requestMessage(cancelRequestCode, conn.key.processID, conn.key.secretKey))
+	if len(handler.cancelled) != 0 {
+		t.Fatalf("closed session cancelled")
+	}
+}
+
+func TestReadStartupMessageBounds(t *testing.T) {
+	testCases := []struct {
+		name    string
+		size    uint32
+		wantErr bool
+	}{
+		{name: "too short", size: 4, wantErr: true},
+		{name: "too long", size: uint32(maxStartupMessageSize + 1), wantErr: true},
+		{name: "minimal", size: 8},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := make([]byte, 8)
+			binary.BigEndian.PutUint32(msg[0:4], tc.size)
+			_, err := readStartupMessage(bytes.NewReader(msg))
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("err = %v, want error %t", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestNoticeResponse(t *testing.T) {
+	testCases := []struct {
+		name        string
+		message     string
+		wantMessage string
+	}{
+		{name: "plain", message: "create operation in progress", wantMessage: "create operation in progress"},
+		{name: "nul stripped", message: "a\x00b", wantMessage: "ab"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := noticeResponse(tc.message)
+			if msg[0] != noticeResponseMessage {
+				t.Fatalf("message type = '%c', want 'N'", msg[0])
+			}
+			if int(binary.BigEndian.Uint32(msg[1:5])) != len(msg)-1 {
+				t.Fatalf("message length %d, want %d", binary.BigEndian.Uint32(msg[1:5]), len(msg)-1)
+			}
+			if msg[len(msg)-1] != 0 {
+				t.Fatalf("fields not terminated")
+			}
+			fields := make(map[byte]string)
+			for _, f := range bytes.Split(msg[5:len(msg)-2], []byte{0}) {
+				fields[f[0]] = string(f[1:])
+			}
+			want := map[byte]string{'S': "NOTICE", 'V': "NOTICE", 'C': "00000", 'M': tc.wantMessage}
+			if len(fields) != len(want) {
+				t.Fatalf("fields = %v, want %v", fields, want)
+			}
+			for k, v := range want {
+				if fields[k] != v {
+					t.Fatalf("field '%c' = '%s', want '%s'", k, fields[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestSessionConnNotify(t *testing.T) {
+	handler := &recordingSessionHandler{}
+	listener := newSessionListener(nil, nil, handler)
+	conn, _, _ := negotiatePipe(t, listener, startupMessage("user", "alice"))
+	if handler.notifiers[0] != conn {
+		t.Fatalf("session not opened with the connection as notifier")
+	}
+	peer, server := net.Pipe()
+	defer peer.Close()
+	conn.Conn = server
+	if err := conn.Notify("too early"); err == nil {
+		t.Fatalf("expected error for notice ahead of startup")
+	}
+	readyForQuery := []byte{'Z', 0, 0, 0, 5, 'I'}
+	notice := noticeResponse("in progress")
+	received := make(chan []byte)
+	go func() {
+		b := make([]byte, 13+len(readyForQuery)+len(notice))
+		io.ReadFull(peer, b) //nolint:errcheck // checked by content
+		received <- b
+	}()
+	if _, err := conn.Write(readyForQuery); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := conn.Notify("in progress"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := <-received; !bytes.Equal(got[13+len(readyForQuery):], notice) {
+		t.Fatalf("notice written = %v, want %v", got[13+len(readyForQuery):], notice)
+	}
+}
+
+// pipeListener accepts the server ends of pipes.
+type pipeListener struct {
+	net.Listener
+	conns chan net.Conn
+}
+
+func (l *pipeListener) Accept() (net.Conn, error) {
+	conn, ok := <-l.conns
+	if !ok {
+		return nil, net.ErrClosed
+	}
+	return conn, nil
+}
+
+func getActiveConnections(t *testing.T) float64 {
+	t.Helper()
+	recorder := httptest.NewRecorder()
+	metrics.NewHandler(nil).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, metrics.MetricsPath, nil))
+	scanner := bufio.NewScanner(recorder.Body)
+	for scanner.Scan() {
+		if value := strings.TrimPrefix(scanner.Text(), "stackql_active_connections "); value != scanner.Text() {
+			rv, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			return rv
+		}
+	}
+	t.Fatalf("stackql_active_connections not exported")
+	return 0
+}
+
+func TestSessionListenerCountsConnections(t *testing.T) {
+	conns := make(chan net.Conn, 2)
+	listener := newSessionListener(&pipeListener{conns: conns}, nil, nil)
+	baseline := getActiveConnections(t)
+	var accepted []net.Conn
+	for i := 0; i < 2; i++ {
+		client, server := net.Pipe()
+		t.Cleanup(func() { client.Close() })
+		conns <- server
+		conn, err := listener.Accept()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		accepted = append(accepted, conn)
+	}
+	close(conns)
+	if _, err := listener.Accept(); err == nil {
+		t.Fatalf("Accept() of a closed listener error = nil")
+	}
+	if got := getActiveConnections(t) - baseline; got != 2 {
+		t.Fatalf("active connections after Accept() = %v, want 2", got)
+	}
+	accepted[0].Close()
+	accepted[0].Close()
+	if got := getActiveConnections(t) - baseline; got != 1 {
+		t.Fatalf("active connections after repeated Close() = %v, want 1", got)
+	}
+	accepted[1].Close()
+	if got := getActiveConnections(t) - baseline; got != 0 {
+		t.Fatalf("active connections after Close() = %v, want 0", got)
+	}
+}
@@ -0,0 +1,147 @@
+package querybudget
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/dto"
+	"github.com/stackql/stackql/internal/stackql/iqlerror"
+	"github.com/stackql/stackql/internal/stackql/parserutil"
+)
+
+const (
+	budgetDirective string = "BUDGET"
+)
+
+var (
+	_ QueryBudget = &standardQueryBudget{}
+)
+
+// QueryBudget bounds the resources consumed by a single query.
+// Limits <= 0 are not enforced.
+//
+// Limits are sourced from runtime config and may be lowered,
+// but never raised, per session by SET and per query by the
+// comment directive:
+//
+//	/*+ BUDGET(statement_timeout=30, max_http_requests_per_query=100, max_rows_acquired=10000) */
+//
+// A breach cancels the context returned from Start().
+type QueryBudget interface {
+	AcquireHTTPRequest() error
+	AcquireRows(int) error
+	ApplyCommentDirectives(sqlparser.CommentDirectives)
+	Err() error
+	GetHTTPRequests() int
+	GetRowsAcquired() int
+	Start(context.Context) (context.Context, context.CancelFunc)
+}
+
+func NewQueryBudget(runtimeCtx dto.RuntimeCtx) QueryBudget {
+	return &standardQueryBudget{
+		statementTimeout: runtimeCtx.StatementTimeout,
+		maxHTTPRequests:  runtimeCtx.MaxHTTPRequestsPerQuery,
+		maxRowsAcquired:  runtimeCtx.MaxRowsAcquired,
+	}
+}
+
+type standardQueryBudget struct {
+	statementTimeout int
+	maxHTTPRequests  int
+	maxRowsAcquired  int
+	httpRequests     int64
+	rowsAcquired     int64
+	mutex            sync.Mutex
+	ctx              context.Context
+	cancel           context.CancelFunc
+	breach           error
+}
+
+// TightenLimit returns the effective limit when a new limit is requested
+// against an existing one, and whether the request was honoured.
+// Limits <= 0 are unbounded.
+func TightenLimit(existing int, requested int) (int, bool) {
+	if existing <= 0 {
+		return requested, true
+	}
+	if requested <= 0 || requested > existing {
+		return existing, false
+	}
+	return requested, true
+}
+
+func (qb *standardQueryBudget) ApplyCommentDirectives(directives sqlparser.CommentDirectives) {
+	if v, ok := parserutil.GetIntCommentDirective(directives, budgetDirective+"."+dto.StatementTimeoutKey); ok {
+		qb.statementTimeout, _ = TightenLimit(qb.statementTimeout, v)
+	}
+	if v, ok := parserutil.GetIntCommentDirective(directives, budgetDirective+"."+dto.MaxHTTPRequestsPerQueryKey); ok {
+		qb.maxHTTPRequests, _ = TightenLimit(qb.maxHTTPRequests, v)
+	}
+	if v, ok := parserutil.GetIntCommentDirective(directives, budgetDirective+"."+dto.MaxRowsAcquiredKey); ok {
+		qb.maxRowsAcquired, _ = TightenLimit(qb.maxRowsAcquired, v)
+	}
+}
+
+func (qb *standardQueryBudget) Start(parent context.Context) (context.Context, context.CancelFunc) {
+	qb.mutex.Lock()
+	defer qb.mutex.Unlock()
+	if qb.statementTimeout > 0 {
+		qb.ctx, qb.cancel = context.WithTimeout(parent, time.Duration(qb.statementTimeout)*time.Second)
+	} else {
+		qb.ctx, qb.cancel = context.WithCancel(parent)
+	}
+	return qb.ctx, qb.cancel
+}
+
+func (qb *standardQueryBudget) AcquireHTTPRequest() error {
+	count := atomic.AddInt64(&qb.httpRequests, 1)
+	if qb.maxHTTPRequests > 0 && count > int64(qb.maxHTTPRequests) {
+		return qb.setBreach(iqlerror.GetBudgetExceededError(dto.MaxHTTPRequestsPerQueryKey, qb.maxHTTPRequests))
+	}
+	return nil
+}
+
+func (qb *standardQueryBudget) AcquireRows(n int) error {
+	count := atomic.AddInt64(&qb.rowsAcquired, int64(n))
+	if qb.maxRowsAcquired > 0 && count > int64(qb.maxRowsAcquired) {
+		return qb.setBreach(iqlerror.GetBudgetExceededError(dto.MaxRowsAcquiredKey, qb.maxRowsAcquired))
+	}
+	return nil
+}
+
+func (qb *standardQueryBudget) GetHTTPRequests() int {
+	return int(atomic.LoadInt64(&qb.httpRequests))
+}
+
+func (qb *standardQueryBudget) GetRowsAcquired() int {
+	return int(atomic.LoadInt64(&qb.rowsAcquired))
+}
+
+// Err returns the first budget breach, including statement timeout.
+func (qb *standardQueryBudget) Err() error {
+	qb.mutex.Lock()
+	defer qb.mutex.Unlock()
+	if qb.breach != nil {
+		return qb.breach
+	}
+	if qb.ctx != nil && qb.statementTimeout > 0 && errors.Is(qb.ctx.Err(), context.DeadlineExceeded) {
+		return iqlerror.GetBudgetExceededError(dto.StatementTimeoutKey, qb.statementTimeout)
+	}
+	return nil
+}
+
+func (qb *standardQueryBudget) setBreach(err error) error {
+	qb.mutex.Lock()
+	defer qb.mutex.Unlock()
+	if qb.breach == nil {
+		qb.breach = err
+	}
+	if qb.cancel != nil {
+		qb.cancel()
+	}
+	return qb.breach
+}
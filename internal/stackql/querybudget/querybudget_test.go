@@ -0,0 +1,85 @@
+package querybudget_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/dto"
+	"github.com/stackql/stackql/internal/stackql/parserutil"
+	"github.com/stackql/stackql/internal/stackql/querybudget"
+)
+
+func TestTightenLimit(t *testing.T) {
+	testCases := []struct {
+		name       string
+		existing   int
+		requested  int
+		wantLimit  int
+		wantHonour bool
+	}{
+		{name: "unbounded accepts any", existing: 0, requested: 50, wantLimit: 50, wantHonour: true},
+		{name: "lowered", existing: 50, requested: 10, wantLimit: 10, wantHonour: true},
+		{name: "unchanged", existing: 50, requested: 50, wantLimit: 50, wantHonour: true},
+		{name: "raise refused", existing: 10, requested: 50, wantLimit: 10},
+		{name: "unbounding refused", existing: 10, requested: 0, wantLimit: 10},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			limit, ok := querybudget.TightenLimit(tc.existing, tc.requested)
+			if limit != tc.wantLimit || ok != tc.wantHonour {
+				t.Fatalf("TightenLimit(%d, %d) = (%d, %t), want (%d, %t)", tc.existing, tc.requested, limit, ok, tc.wantLimit, tc.wantHonour)
+			}
+		})
+	}
+}
+
+func TestQueryBudgetBreach(t *testing.T) {
+	testCases := []struct {
+		name       string
+		runtimeCtx dto.RuntimeCtx
+		directives string
+		requests   int
+		wantBreach bool
+	}{
+		{name: "unbounded", requests: 5},
+		{name: "within limit", runtimeCtx: dto.RuntimeCtx{MaxHTTPRequestsPerQuery: 5}, requests: 5},
+		{name: "beyond limit", runtimeCtx: dto.RuntimeCtx{MaxHTTPRequestsPerQuery: 5}, requests: 6, wantBreach: true},
+		{
+			name:       "lowered by directive",
+			runtimeCtx: dto.RuntimeCtx{MaxHTTPRequestsPerQuery: 5},
+			directives: "/*+ BUDGET(max_http_requests_per_query=2) */",
+			requests:   3,
+			wantBreach: true,
+		},
+		{
+			name:       "not raised by directive",
+			runtimeCtx: dto.RuntimeCtx{MaxHTTPRequestsPerQuery: 2},
+			directives: "/*+ BUDGET(max_http_requests_per_query=5) */",
+			requests:   3,
+			wantBreach: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			qb := querybudget.NewQueryBudget(tc.runtimeCtx)
+			if tc.directives != "" {
+				qb.ApplyCommentDirectives(parserutil.ExtractCommentDirectives(sqlparser.Comments{[]byte(tc.directives)}))
+			}
+			ctx, cancel := qb.Start(context.Background())
+			defer cancel()
+			for i := 0; i < tc.requests; i++ {
+				qb.AcquireHTTPRequest() //nolint:errcheck // checked by Err()
+			}
+			if (qb.Err() != nil) != tc.wantBreach {
+				t.Fatalf("Err() = %v, want breach %t", qb.Err(), tc.wantBreach)
+			}
+			if (ctx.Err() != nil) != tc.wantBreach {
+				t.Fatalf("context error = %v, want cancelled %t", ctx.Err(), tc.wantBreach)
+			}
+			if qb.GetHTTPRequests() != tc.requests {
+				t.Fatalf("GetHTTPRequests() = %d, want %d", qb.GetHTTPRequests(), tc.requests)
+			}
+		})
+	}
+}
@@ -1,22 +1,97 @@
 package querysubmit
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"github.com/stackql/stackql/internal/stackql/handler"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
+	"github.com/stackql/stackql/internal/stackql/iqlerror"
 	"github.com/stackql/stackql/internal/stackql/logging"
+	"github.com/stackql/stackql/internal/stackql/metrics"
 	"github.com/stackql/stackql/internal/stackql/planbuilder"
+	"github.com/stackql/stackql/internal/stackql/querybudget"
+	"github.com/stackql/stackql/internal/stackql/sessionctx"
+	"github.com/stackql/stackql/internal/stackql/tracing"
 )
 
+const subsystem logging.Subsystem = "querysubmit"
+
+// SubmitQuery identifies the query, for correlation of logs, traces
+// it, with child spans for planning and for the execution of each
+// primitive, and records metrics.
 func SubmitQuery(handlerCtx handler.HandlerContext) internaldto.ExecutorOutput {
-	logging.GetLogger().Debugln("SubmitQuery() invoked...")
+	budget := querybudget.NewQueryBudget(handlerCtx.GetRuntimeContext())
+	handlerCtx.SetQueryBudget(budget)
+	parentCtx := handlerCtx.GetContext()
+	statement := handlerCtx.GetRedactor().RedactText(handlerCtx.GetQuery())
+	queryCtx, querySpan := tracing.Start(withQueryID(parentCtx), "stackql.query", tracing.StatementKey.String(statement))
+	handlerCtx.SetContext(queryCtx)
+	defer handlerCtx.SetContext(parentCtx)
+	logger := subsystem.GetContextLogger(queryCtx)
+	logger.Debugf("query submitted: %s", statement)
+	start := time.Now()
+	rv := submitQuery(handlerCtx, budget, queryCtx)
+	elapsed := time.Since(start)
+	metrics.ObserveQuery(handlerCtx.GetQuery(), rv.Err, elapsed)
+	if rv.Err != nil {
+		logger.Debugf("query failed after %s: %s", elapsed, handlerCtx.GetRedactor().RedactText(rv.Err.Error()))
+	} else {
+		logger.Debugf("query completed after %s", elapsed)
+	}
+	querySpan.SetAttributes(
+		tracing.HTTPRequestsKey.Int(budget.GetHTTPRequests()),
+		tracing.RowsKey.Int(budget.GetRowsAcquired()),
+	)
+	tracing.End(querySpan, rv.Err)
+	return rv.WithContext(queryCtx)
+}
+
+func submitQuery(handlerCtx handler.HandlerContext, budget querybudget.QueryBudget, queryCtx context.Context) internaldto.ExecutorOutput {
+	planCtx, planSpan := tracing.Start(queryCtx, "stackql.plan")
+	handlerCtx.SetContext(planCtx)
 	plan, err := planbuilder.BuildPlanFromContext(handlerCtx)
+	handlerCtx.SetContext(queryCtx)
+	tracing.End(planSpan, err)
 	if err != nil {
 		return internaldto.NewExecutorOutput(nil, nil, nil, nil, err)
 	}
+	budget.ApplyCommentDirectives(plan.GetCommentDirectives())
+	ctx, cancel := budget.Start(queryCtx)
+	defer cancel()
+	handlerCtx.SetContext(ctx)
+	defer handlerCtx.SetContext(queryCtx)
 	pl := internaldto.NewBasicPrimitiveContext(
 		nil,
 		handlerCtx.GetOutfile(),
 		handlerCtx.GetOutErrFile(),
-	)
-	return plan.Instructions.Execute(pl)
+	).WithContext(ctx)
+	rv := plan.Instructions.Execute(pl)
+	if budgetErr := budget.Err(); budgetErr != nil {
+		return cleanupCancelledQuery(handlerCtx, budgetErr)
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return cleanupCancelledQuery(handlerCtx, iqlerror.GetQueryCancelledError(ctxErr))
+	}
+	return rv
+}
+
+func withQueryID(ctx context.Context) context.Context {
+	queryID, err := sessionctx.NewQueryID()
+	if err != nil {
+		subsystem.GetContextLogger(ctx).Errorln(fmt.Sprintf("cannot generate query ID: %s", err.Error()))
+		return ctx
+	}
+	return sessionctx.WithQueryID(ctx, queryID)
+}
+
+// cleanupCancelledQuery discards any partial result
+// and collects data staged by the cancelled query.
+func cleanupCancelledQuery(handlerCtx handler.HandlerContext, cause error) internaldto.ExecutorOutput {
+	err := handlerCtx.GetGarbageCollector().Collect()
+	if err != nil {
+		subsystem.GetContextLogger(handlerCtx.GetContext()).Infoln(fmt.Sprintf("garbage collection after cancelled query failed: %s", err.Error()))
+	}
+	return internaldto.NewErroneousExecutorOutput(cause)
 }
@@ -0,0 +1,303 @@
+package redaction
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/parserutil"
+)
+
+const (
+	RedactedValue string = "[REDACTED]"
+	// DefaultFields is the default value of "--redact.fields".
+	DefaultFields string = "password,secret,token,apiKey,api_key,clientSecret,client_secret,privateKey,private_key,accessToken,access_token,refreshToken,refresh_token"
+	// DefaultHeaders is the default value of "--redact.headers".
+	DefaultHeaders string = "Authorization,Proxy-Authorization,Cookie,Set-Cookie,X-Api-Key,X-Auth-Token,X-Amz-Security-Token"
+	// DefaultQueryKeys is the default value of "--redact.queryKeys".
+	DefaultQueryKeys string = "key,apikey,sig,signature,code,X-Amz-Credential,X-Amz-Security-Token,X-Amz-Signature"
+)
+
+var (
+	_ Redactor = &standardRedactor{}
+
+	// jsonPairRegexp matches "name": "value" pairs in text.
+	jsonPairRegexp *regexp.Regexp = regexp.MustCompile(`"([^"\\]{1,128})"(\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	// queryPairRegexp matches name=value pairs in text, such as URLs.
+	queryPairRegexp *regexp.Regexp = regexp.MustCompile(`([A-Za-z0-9_\-\.]{1,128})=([^&\s"',;]+)`)
+	// credentialsRegexp matches authorization header values in text.
+	credentialsRegexp *regexp.Regexp = regexp.MustCompile(`(?i)\b(Bearer|Basic)\s+[A-Za-z0-9\-\._~\+/]+=*`)
+
+	registeredMutex     sync.RWMutex
+	registeredHeaders   map[string]struct{} = make(map[string]struct{})
+	registeredQueryKeys map[string]struct{} = make(map[string]struct{})
+)
+
+// RegisterHeader marks a header as secret for all redactors,
+// for use where credentials are placed in custom headers.
+func RegisterHeader(name string) {
+	registeredMutex.Lock()
+	defer registeredMutex.Unlock()
+	registeredHeaders[strings.ToLower(name)] = struct{}{}
+}
+
+// RegisterQueryKey marks a query parameter as secret for all
+// redactors, for use where credentials are placed in the query.
+func RegisterQueryKey(name string) {
+	registeredMutex.Lock()
+	defer registeredMutex.Unlock()
+	registeredQueryKeys[strings.ToLower(name)] = struct{}{}
+}
+
+func isRegistered(registered map[string]struct{}, name string) bool {
+	registeredMutex.RLock()
+	defer registeredMutex.RUnlock()
+	_, ok := registered[strings.ToLower(name)]
+	return ok
+}
+
+// Redactor replaces the values of secret fields, headers and
+// query parameters, matched by name irrespective of case,
+// with RedactedValue.  Query parameters are also matched
+// against fields.
+type Redactor interface {
+	// AddFields marks further fields as secret, such
+	// as those designated sensitive by provider schemas.
+	AddFields(names ...string)
+	IsSecret(name string) bool
+	IsSecretHeader(name string) bool
+	IsSecretQueryKey(name string) bool
+	// RedactBody redacts JSON and form encoded bodies,
+	// at any depth, and returns other bodies unaltered.
+	RedactBody(body []byte) []byte
+	RedactHeader(header http.Header) http.Header
+	// RedactText redacts JSON pairs, query pairs and
+	// credentials embedded in free text, such as errors.
+	RedactText(text string) string
+	RedactURL(u *url.URL) string
+	// RedactValue redacts a copy of decoded JSON.
+	RedactValue(val interface{}) interface{}
+}
+
+// NewRedactor accepts comma separated names.
+func NewRedactor(fields string, headers string, queryKeys string) Redactor {
+	return &standardRedactor{
+		fields:    splitNames(fields),
+		headers:   splitNames(headers),
+		queryKeys: splitNames(queryKeys),
+	}
+}
+
+func splitNames(names string) map[string]struct{} {
+	rv := make(map[string]struct{})
+	for _, n := range strings.Split(names, ",") {
+		n = strings.ToLower(strings.TrimSpace(n))
+		if n != "" {
+			rv[n] = struct{}{}
+		}
+	}
+	return rv
+}
+
+type standardRedactor struct {
+	fields    map[string]struct{}
+	headers   map[string]struct{}
+	queryKeys map[string]struct{}
+	mutex     sync.RWMutex
+}
+
+func (rd *standardRedactor) AddFields(names ...string) {
+	rd.mutex.Lock()
+	defer rd.mutex.Unlock()
+	for _, n := range names {
+		n = strings.ToLower(strings.TrimSpace(n))
+		if n != "" {
+			rd.fields[n] = struct{}{}
+		}
+	}
+}
+
+func (rd *standardRedactor) IsSecret(name string) bool {
+	rd.mutex.RLock()
+	defer rd.mutex.RUnlock()
+	_, ok := rd.fields[strings.ToLower(name)]
+	return ok
+}
+
+func (rd *standardRedactor) IsSecretHeader(name string) bool {
+	if _, ok := rd.headers[strings.ToLower(name)]; ok {
+		return true
+	}
+	return isRegistered(registeredHeaders, name)
+}
+
+func (rd *standardRedactor) IsSecretQueryKey(name string) bool {
+	if _, ok := rd.queryKeys[strings.ToLower(name)]; ok {
+		return true
+	}
+	return rd.IsSecret(name) || isRegistered(registeredQueryKeys, name)
+}
+
+func (rd *standardRedactor) RedactBody(body []byte) []byte {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 {
+		return body
+	}
+	if json.Valid(trimmed) {
+		var val interface{}
+		decoder := json.NewDecoder(bytes.NewReader(trimmed))
+		decoder.UseNumber()
+		if err := decoder.Decode(&val); err != nil {
+			return body
+		}
+		rv, err := json.Marshal(rd.RedactValue(val))
+		if err != nil {
+			return body
+		}
+		return rv
+	}
+	form, err := url.ParseQuery(string(trimmed))
+	if err != nil || len(form) == 0 || bytes.ContainsAny(trimmed, " \n{") {
+		return body
+	}
+	for k := range form {
+		if rd.IsSecretQueryKey(k) {
+			form[k] = []string{RedactedValue}
+		}
+	}
+	return []byte(form.Encode())
+}
+
+func (rd *standardRedactor) RedactValue(val interface{}) interface{} {
+	switch val := val.(type) {
+	case map[string]interface{}:
+		rv := make(map[string]interface{}, len(val))
+		for k, v := range val {
+			if rd.IsSecret(k) {
+				rv[k] = RedactedValue
+				continue
+			}
+			rv[k] = rd.RedactValue(v)
+		}
+		return rv
+	case []interface{}:
+		rv := make([]interface{}, len(val))
+		for i, v := range val {
+			rv[i] = rd.RedactValue(v)
+		}
+		return rv
+	default:
+		return val
+	}
+}
+
+func (rd *standardRedactor) RedactHeader(header http.Header) http.Header {
+	rv := header.Clone()
+	for k := range rv {
+		if rd.IsSecretHeader(k) {
+			rv[k] = []string{RedactedValue}
+		}
+	}
+	return rv
+}
+
+func (rd *standardRedactor) RedactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	rv := *u
+	if rv.User != nil {
+		if _, hasPassword := rv.User.Password(); hasPassword {
+			rv.User = url.UserPassword(rv.User.Username(), RedactedValue)
+		}
+	}
+	query := rv.Query()
+	isRedacted := false
+	for k := range query {
+		if rd.IsSecretQueryKey(k) {
+			query[k] = []string{RedactedValue}
+			isRedacted = true
+		}
+	}
+	// the query is re-encoded only where
+	// necessary, as encoding reorders it
+	if isRedacted {
+		rv.RawQuery = query.Encode()
+	}
+	return rv.String()
+}
+
+func (rd *standardRedactor) RedactText(text string) string {
+	text = credentialsRegexp.ReplaceAllString(text, "$1 "+RedactedValue)
+	text = jsonPairRegexp.ReplaceAllStringFunc(text, func(pair string) string {
+		submatches := jsonPairRegexp.FindStringSubmatch(pair)
+		if !rd.IsSecret(submatches[1]) {
+			return pair
+		}
+		return `"` + submatches[1] + `"` + submatches[2] + `"` + RedactedValue + `"`
+	})
+	return queryPairRegexp.ReplaceAllStringFunc(text, func(pair string) string {
+		submatches := queryPairRegexp.FindStringSubmatch(pair)
+		if !rd.IsSecretQueryKey(submatches[1]) {
+			return pair
+		}
+		return submatches[1] + "=" + RedactedValue
+	})
+}
+
+// OmitLiterals replaces each literal of the statement with "?", as
+// literals that are secrets cannot be recognised by name, eg: the
+// values of an INSERT.  Comments are retained.  A statement that
+// cannot be tokenized is omitted altogether.
+func OmitLiterals(statement string) string {
+	tokens, err := parserutil.ScanTokens(statement)
+	if err != nil {
+		return RedactedValue
+	}
+	var sb strings.Builder
+	end := 0
+	for _, tkn := range tokens {
+		switch tkn.Type {
+		case sqlparser.STRING, sqlparser.INTEGRAL, sqlparser.FLOAT, sqlparser.HEX, sqlparser.HEXNUM, sqlparser.BIT_LITERAL:
+			sb.WriteString(statement[end:tkn.Start])
+			sb.WriteString("?")
+			end = tkn.End
+		}
+	}
+	sb.WriteString(statement[end:])
+	return sb.String()
+}
+
+type redactedError struct {
+	text string
+	err  error
+}
+
+func (e *redactedError) Error() string {
+	return e.text
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
+
+// RedactError returns the error unaltered unless its text
+// requires redaction, in which case the original is wrapped.
+func RedactError(rd Redactor, err error) error {
+	if err == nil {
+		return nil
+	}
+	text := rd.RedactText(err.Error())
+	if text == err.Error() {
+		return err
+	}
+	return &redactedError{
+		text: text,
+		err:  err,
+	}
+}
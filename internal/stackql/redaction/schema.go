@@ -0,0 +1,89 @@
+package redaction
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+const (
+	SensitiveExtensionKey string = "x-stackQL-sensitive"
+	passwordFormat        string = "password"
+)
+
+var (
+	// sensitiveFieldsCache is keyed by schema, since
+	// schemas are shared across calls and are immutable.
+	sensitiveFieldsCache sync.Map
+)
+
+// GetSensitiveFields returns the names of properties, at any depth, that
+// are of format "password" or are marked with "x-stackQL-sensitive: true".
+func GetSensitiveFields(schema *openapi3.Schema) []string {
+	if schema == nil {
+		return nil
+	}
+	if cached, ok := sensitiveFieldsCache.Load(schema); ok {
+		return cached.([]string)
+	}
+	var rv []string
+	collectSensitiveFields(schema, make(map[*openapi3.Schema]struct{}), &rv)
+	sensitiveFieldsCache.Store(schema, rv)
+	return rv
+}
+
+func collectSensitiveFields(schema *openapi3.Schema, visited map[*openapi3.Schema]struct{}, acc *[]string) {
+	if schema == nil {
+		return
+	}
+	if _, ok := visited[schema]; ok {
+		return
+	}
+	visited[schema] = struct{}{}
+	for k, v := range schema.Properties {
+		if v == nil || v.Value == nil {
+			continue
+		}
+		if isSensitive(v.Value) {
+			*acc = append(*acc, k)
+		}
+		collectSensitiveFields(v.Value, visited, acc)
+	}
+	if schema.Items != nil {
+		collectSensitiveFields(schema.Items.Value, visited, acc)
+	}
+	if schema.AdditionalProperties != nil {
+		collectSensitiveFields(schema.AdditionalProperties.Value, visited, acc)
+	}
+	for _, refs := range []openapi3.SchemaRefs{schema.AllOf, schema.AnyOf, schema.OneOf} {
+		for _, ref := range refs {
+			if ref != nil {
+				collectSensitiveFields(ref.Value, visited, acc)
+			}
+		}
+	}
+}
+
+func isSensitive(schema *openapi3.Schema) bool {
+	if strings.EqualFold(schema.Format, passwordFormat) {
+		return true
+	}
+	ext, ok := schema.Extensions[SensitiveExtensionKey]
+	if !ok {
+		return false
+	}
+	switch ext := ext.(type) {
+	case bool:
+		return ext
+	case json.RawMessage:
+		var b bool
+		return json.Unmarshal(ext, &b) == nil && b
+	case []byte:
+		var b bool
+		return json.Unmarshal(ext, &b) == nil && b
+	default:
+		return false
+	}
+}
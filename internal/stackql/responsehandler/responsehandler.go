@@ -10,6 +10,8 @@ import (
 	"github.com/stackql/stackql/internal/stackql/output"
 )
 
+const subsystem logging.Subsystem = "responsehandler"
+
 func handleEmptyWriter(outputWriter output.IOutputWriter, err error) {
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err.Error())
@@ -24,7 +26,11 @@ func handleEmptyWriter(outputWriter output.IOutputWriter, err error) {
 func HandleResponse(handlerCtx handler.HandlerContext, response internaldto.ExecutorOutput) error {
 	var outputWriter output.IOutputWriter
 	var err error
-	logging.GetLogger().Debugln(fmt.Sprintf("response from query = '%v'", response.GetSQLResult()))
+	ctx, ok := response.GetContext()
+	if !ok {
+		ctx = handlerCtx.GetContext()
+	}
+	subsystem.GetContextLogger(ctx).Debugln(fmt.Sprintf("response from query = '%v'", response.GetSQLResult()))
 	if response.Msg != nil {
 		for _, msg := range response.Msg.WorkingMessages {
 			handlerCtx.GetOutfile().Write([]byte(msg + fmt.Sprintln("")))
@@ -35,6 +41,7 @@ func HandleResponse(handlerCtx handler.HandlerContext, response internaldto.Exec
 			handlerCtx.GetOutfile(),
 			handlerCtx.GetOutErrFile(),
 			internaldto.OutputContext{
+				Context:        ctx,
 				RuntimeContext: handlerCtx.GetRuntimeContext(),
 				Result:         response.GetSQLResult(),
 			},
@@ -49,6 +56,7 @@ func HandleResponse(handlerCtx handler.HandlerContext, response internaldto.Exec
 			handlerCtx.GetOutfile(),
 			handlerCtx.GetOutErrFile(),
 			internaldto.OutputContext{
+				Context:        ctx,
 				RuntimeContext: handlerCtx.GetRuntimeContext(),
 				Result:         response.GetSQLResult(),
 			},
@@ -0,0 +1,136 @@
+package returning
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/parserutil"
+)
+
+const (
+	// DirectiveName is the comment directive into which
+	// RewriteReturning moves the RETURNING clause, ie:
+	// "/*+ RETURNING=(<column>,...) */".
+	DirectiveName string = "RETURNING"
+	// AllColumns denotes every property of the response.
+	AllColumns string = "*"
+)
+
+// RewriteReturning moves a trailing "RETURNING <columns>" clause of an
+// INSERT, UPDATE or DELETE into a comment directive upon the statement,
+// because the parser does not support RETURNING.  The clause is recognised
+// from tokens, and so not within string literals, comments or parentheses.
+// Other queries, and queries that do not tokenize, are returned unaltered.
+func RewriteReturning(query string) (string, error) {
+	tokens, err := parserutil.ScanTokens(query)
+	if err != nil {
+		return query, nil
+	}
+	verbIndex := parserutil.NextToken(tokens, -1)
+	if verbIndex < 0 {
+		return query, nil
+	}
+	switch tokens[verbIndex].Type {
+	case sqlparser.INSERT, sqlparser.UPDATE, sqlparser.DELETE:
+	default:
+		return query, nil
+	}
+	clauseIndex := findReturningClause(query, tokens)
+	if clauseIndex < 0 {
+		return query, nil
+	}
+	columns, err := parseColumns(query, tokens, clauseIndex)
+	if err != nil {
+		return "", err
+	}
+	verbEnd := tokens[verbIndex].End
+	return fmt.Sprintf(
+		"%s /*+ %s=(%s) */%s",
+		query[:verbEnd],
+		DirectiveName,
+		strings.Join(columns, ","),
+		strings.TrimRight(query[verbEnd:tokens[clauseIndex].Start], " \t\r\n"),
+	), nil
+}
+
+// GetColumns returns the columns named by RETURNING, if any.
+// A sole AllColumns entry denotes every property of the response.
+func GetColumns(directives sqlparser.CommentDirectives) ([]string, bool) {
+	if directives == nil {
+		return nil, false
+	}
+	encoded, ok := directives[DirectiveName].(string)
+	if !ok || !strings.HasPrefix(encoded, "(") || !strings.HasSuffix(encoded, ")") {
+		return nil, false
+	}
+	encoded = strings.TrimSuffix(strings.TrimPrefix(encoded, "("), ")")
+	if encoded == "" {
+		return nil, false
+	}
+	return strings.Split(encoded, ","), true
+}
+
+// IsAllColumns determines whether RETURNING columns denote every property of the response.
+func IsAllColumns(columns []string) bool {
+	return len(columns) == 1 && columns[0] == AllColumns
+}
+
+// findReturningClause returns the index of the last RETURNING
+// keyword outside of parentheses, or -1 where there is none.
+func findReturningClause(query string, tokens []parserutil.Token) int {
+	depth := 0
+	rv := -1
+	for i, tkn := range tokens {
+		switch tkn.Type {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case sqlparser.ID:
+			if depth == 0 && strings.EqualFold(tkn.GetRaw(query), "RETURNING") {
+				rv = i
+			}
+		}
+	}
+	return rv
+}
+
+// parseColumns returns the columns of the RETURNING
+// clause whose keyword is at index clauseIndex.
+func parseColumns(query string, tokens []parserutil.Token, clauseIndex int) ([]string, error) {
+	var rv []string
+	i := parserutil.NextToken(tokens, clauseIndex)
+	for {
+		if i < 0 || tokens[i].Type == ';' {
+			return nil, fmt.Errorf("RETURNING requires column names or '*'")
+		}
+		col, isColumn := tokens[i].GetColumnName(query)
+		if tokens[i].GetRaw(query) == AllColumns {
+			col, isColumn = AllColumns, true
+		}
+		if !isColumn {
+			return nil, fmt.Errorf("RETURNING supports only column names or '*', not '%s'", tokens[i].GetRaw(query))
+		}
+		rv = append(rv, col)
+		i = parserutil.NextToken(tokens, i)
+		if i < 0 || tokens[i].Type != ',' {
+			break
+		}
+		i = parserutil.NextToken(tokens, i)
+	}
+	if i >= 0 && tokens[i].Type == ';' {
+		i = parserutil.NextToken(tokens, i)
+	}
+	if i >= 0 {
+		return nil, fmt.Errorf("RETURNING supports only column names or '*', not '%s'", query[tokens[i].Start:])
+	}
+	if len(rv) > 1 {
+		for _, col := range rv {
+			if col == AllColumns {
+				return nil, fmt.Errorf("RETURNING '*' cannot be combined with other columns")
+			}
+		}
+	}
+	return rv, nil
+}
@@ -0,0 +1,139 @@
+package returning_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/returning"
+)
+
+// getDirectives returns the comment directives of a parsed mutation.
+func getDirectives(t *testing.T, query string) sqlparser.CommentDirectives {
+	t.Helper()
+	statement, err := sqlparser.Parse(query)
+	if err != nil {
+		t.Fatalf("cannot parse rewritten query '%s': %v", query, err)
+	}
+	switch node := statement.(type) {
+	case *sqlparser.Insert:
+		return sqlparser.ExtractCommentDirectives(node.Comments)
+	case *sqlparser.Update:
+		return sqlparser.ExtractCommentDirectives(node.Comments)
+	case *sqlparser.Delete:
+		return sqlparser.ExtractCommentDirectives(node.Comments)
+	default:
+		return nil
+	}
+}
+
+func TestRewriteReturning(t *testing.T) {
+	testCases := []struct {
+		name    string
+		query   string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "insert select",
+			query: "insert into google.compute.instances(project, zone, data__name) select 'p', 'z', 'n' returning name, status, targetId;",
+			want:  []string{"name", "status", "targetId"},
+		},
+		{
+			name:  "all columns with await",
+			query: "INSERT /*+ AWAIT */ INTO t(a) SELECT 'x' RETURNING *",
+			want:  []string{"*"},
+		},
+		{
+			name:  "update with quoted and keyword columns",
+			query: `update t set data__description = 'returning x' where a = 'b' returning "selfLink", key`,
+			want:  []string{"selfLink", "key"},
+		},
+		{
+			name:  "delete with comments",
+			query: "/* c */ delete from t where a = 'returning' -- returning b\nreturning /* c */ id",
+			want:  []string{"id"},
+		},
+		{
+			name:  "last clause outside parentheses",
+			query: "insert into t(a) select returning from (select 'x' as returning) s returning id",
+			want:  []string{"id"},
+		},
+		{
+			name:  "select is not rewritten",
+			query: "select returning from t",
+		},
+		{
+			name:  "no clause",
+			query: "insert into t(a) select 'returning'",
+		},
+		{
+			name:    "expression",
+			query:   "insert into t(a) select 'x' returning a + 1",
+			wantErr: true,
+		},
+		{
+			name:    "string literal",
+			query:   "delete from t where a = 'b' returning 'a'",
+			wantErr: true,
+		},
+		{
+			name:    "star among columns",
+			query:   "delete from t where a = 'b' returning *, a",
+			wantErr: true,
+		},
+		{
+			name:    "no columns",
+			query:   "delete from t where a = 'b' returning;",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rewritten, err := returning.RewriteReturning(tc.query)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("RewriteReturning() error = %v, want error %t", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if tc.want == nil {
+				if rewritten != tc.query {
+					t.Fatalf("RewriteReturning() = '%s', want query unchanged", rewritten)
+				}
+				return
+			}
+			got, isReturning := returning.GetColumns(getDirectives(t, rewritten))
+			if !isReturning || !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("RewriteReturning() = '%s', with columns %v, want %v", rewritten, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRewriteReturningRetainsDirectives(t *testing.T) {
+	rewritten, err := returning.RewriteReturning("insert /*+ AWAIT */ into t(a) select 'x' returning t")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	directives := getDirectives(t, rewritten)
+	if !directives.IsSet("AWAIT") {
+		t.Fatalf("RewriteReturning() = '%s', which is not awaited", rewritten)
+	}
+	// A column that reads as a boolean is not confused for one.
+	if got, isReturning := returning.GetColumns(directives); !isReturning || !reflect.DeepEqual(got, []string{"t"}) {
+		t.Fatalf("RewriteReturning() = '%s', with columns %v", rewritten, got)
+	}
+}
+
+func TestGetColumns(t *testing.T) {
+	if _, isReturning := returning.GetColumns(nil); isReturning {
+		t.Fatalf("GetColumns(nil) is returning")
+	}
+	if _, isReturning := returning.GetColumns(sqlparser.CommentDirectives{returning.DirectiveName: true}); isReturning {
+		t.Fatalf("GetColumns() of a valueless directive is returning")
+	}
+	if !returning.IsAllColumns([]string{returning.AllColumns}) || returning.IsAllColumns([]string{"a"}) {
+		t.Fatalf("IsAllColumns() is incorrect")
+	}
+}
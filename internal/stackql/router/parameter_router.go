@@ -1,6 +1,7 @@
 package router
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
@@ -16,6 +17,8 @@ import (
 	"github.com/stackql/stackql/internal/stackql/taxonomy"
 )
 
+const subsystem logging.Subsystem = "router"
+
 var (
 	_ ParameterRouter = &standardParameterRouter{}
 )
@@ -59,6 +62,7 @@ type ParameterRouter interface {
 }
 
 type standardParameterRouter struct {
+	ctx                           context.Context
 	annotatedAST                  annotatedast.AnnotatedAst
 	tablesAliasMap                parserutil.TableAliasMap
 	tableMap                      parserutil.TableExprMap
@@ -74,6 +78,7 @@ type standardParameterRouter struct {
 }
 
 func NewParameterRouter(
+	ctx context.Context,
 	annotatedAST annotatedast.AnnotatedAst,
 	tablesAliasMap parserutil.TableAliasMap,
 	tableMap parserutil.TableExprMap,
@@ -84,6 +89,7 @@ func NewParameterRouter(
 	astFormatter sqlparser.NodeFormatter,
 ) ParameterRouter {
 	return &standardParameterRouter{
+		ctx:                           ctx,
 		tablesAliasMap:                tablesAliasMap,
 		tableMap:                      tableMap,
 		whereParamMap:                 whereParamMap,
@@ -112,7 +118,7 @@ func (pr *standardParameterRouter) AnalyzeDependencies() error {
 func (pr *standardParameterRouter) GetOnConditionsToRewrite() map[*sqlparser.ComparisonExpr]struct{} {
 	rv := make(map[*sqlparser.ComparisonExpr]struct{})
 	for k, _ := range pr.comparisonToTableDependencies {
-		logging.GetLogger().Debugf("%v\n", k)
+		subsystem.GetContextLogger(pr.ctx).Debugf("%v\n", k)
 	}
 	return rv
 }
@@ -141,6 +147,7 @@ func (pr *standardParameterRouter) extractDataFlowDependency(input sqlparser.Exp
 
 func (pr *standardParameterRouter) extractFromFunctionExpr(f *sqlparser.FuncExpr) (taxonomy.AnnotationCtx, sqlparser.TableExpr, error) {
 	sv := astvisit.NewLeftoverReferencesAstVisitor(
+		pr.ctx,
 		pr.annotatedAST,
 		pr.colRefs,
 		pr.tableToAnnotationCtx,
@@ -157,7 +164,7 @@ func (pr *standardParameterRouter) extractFromFunctionExpr(f *sqlparser.FuncExpr
 }
 
 func (pr *standardParameterRouter) GetOnConditionDataFlows() (dataflow.DataFlowCollection, error) {
-	rv := dataflow.NewStandardDataFlowCollection()
+	rv := dataflow.NewStandardDataFlowCollection(pr.ctx)
 	for k, destinationTable := range pr.comparisonToTableDependencies {
 		selfTableCited := false
 		destHierarchy, ok := pr.tableToAnnotationCtx[destinationTable]
@@ -276,9 +283,9 @@ func (pr *standardParameterRouter) getAvailableParameters(tb sqlparser.TableExpr
 		val := v.GetVal()
 		switch val := val.(type) {
 		case *sqlparser.ColName:
-			logging.GetLogger().Debugf("%v\n", val)
+			subsystem.GetContextLogger(pr.ctx).Debugf("%v\n", val)
 			rhsAlias := val.Qualifier.GetRawVal()
-			logging.GetLogger().Debugf("%v\n", rhsAlias)
+			subsystem.GetContextLogger(pr.ctx).Debugf("%v\n", rhsAlias)
 			foundTable, ok := pr.tablesAliasMap[rhsAlias]
 			if ok && foundTable != tb {
 				//
@@ -324,7 +331,7 @@ func (pr *standardParameterRouter) Route(tb sqlparser.TableExpr, handlerCtx hand
 
 func (pr *standardParameterRouter) route(tb sqlparser.TableExpr, handlerCtx handler.HandlerContext) (taxonomy.AnnotationCtx, error) {
 	for k, v := range pr.whereParamMap.GetMap() {
-		logging.GetLogger().Infof("%v\n", v)
+		subsystem.GetContextLogger(pr.ctx).Infof("%v\n", v)
 		alias := k.Alias()
 		if alias == "" {
 			continue
@@ -342,7 +349,7 @@ func (pr *standardParameterRouter) route(tb sqlparser.TableExpr, handlerCtx hand
 		}
 	}
 	for k, v := range pr.onParamMap.GetMap() {
-		logging.GetLogger().Infof("%v\n", v)
+		subsystem.GetContextLogger(pr.ctx).Infof("%v\n", v)
 		alias := k.Alias()
 		if alias == "" {
 			continue
@@ -410,7 +417,7 @@ func (pr *standardParameterRouter) route(tb sqlparser.TableExpr, handlerCtx hand
 	// }
 	// reconstitutedConsumedParams := tpc.Minus(runParamters)
 	// reconstitutedConsumedParams := priorParameters.Minus(runParamters)
-	logging.GetLogger().Debugf("%v\n", priorParameters)
+	subsystem.GetContextLogger(pr.ctx).Debugf("%v\n", priorParameters)
 	// TODO: need to get ALL the required stuff in here,
 	//       BUT not send the wrong things for dataflow analysis.
 	reconstitutedConsumedParams := runParamters
@@ -429,7 +436,7 @@ func (pr *standardParameterRouter) route(tb sqlparser.TableExpr, handlerCtx hand
 	// }
 	onConsumed := reconstitutedConsumedParams.GetOnCoupling()
 	pms := onConsumed.GetAllParameters()
-	logging.GetLogger().Infof("onConsumed = '%+v'", onConsumed)
+	subsystem.GetContextLogger(pr.ctx).Infof("onConsumed = '%+v'", onConsumed)
 	for _, kv := range pms {
 		// In this stanza:
 		//   1. [*] mark comparisons for rewriting
@@ -440,7 +447,7 @@ func (pr *standardParameterRouter) route(tb sqlparser.TableExpr, handlerCtx hand
 			return nil, fmt.Errorf("data flow violation detected: ON comparison expression '%s' is a  dependency for tables '%s' and '%s'", sqlparser.String(p), sqlparser.String(existingTable), sqlparser.String(tb))
 		}
 		pr.comparisonToTableDependencies[p] = tb
-		logging.GetLogger().Infof("%v", kv)
+		subsystem.GetContextLogger(pr.ctx).Infof("%v", kv)
 	}
 	indirect, _ := pr.annotatedAST.GetIndirect(tb)
 	hrView, hrViewPresent := hr.GetHeirarchyIds().GetView()
@@ -7,7 +7,6 @@ import (
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 
 	"github.com/stackql/stackql/internal/stackql/handler"
-	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/parserutil"
 	"github.com/stackql/stackql/internal/stackql/tablemetadata"
 	"github.com/stackql/stackql/internal/stackql/taxonomy"
@@ -324,7 +323,7 @@ func (v *standardTableRouteAstVisitor) Visit(node sqlparser.SQLNode) error {
 		numParams := len(node.Params)
 		if numParams != 0 {
 			for i, p := range node.Params {
-				logging.GetLogger().Debugf("%v\n", p)
+				subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", p)
 				if i != 0 {
 				}
 			}
@@ -435,7 +434,7 @@ func (v *standardTableRouteAstVisitor) Visit(node sqlparser.SQLNode) error {
 
 	case sqlparser.Columns:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", n)
 		}
 
 	case sqlparser.Partitions:
@@ -443,7 +442,7 @@ func (v *standardTableRouteAstVisitor) Visit(node sqlparser.SQLNode) error {
 			return nil
 		}
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", n)
 		}
 
 	case sqlparser.TableExprs:
@@ -522,7 +521,7 @@ func (v *standardTableRouteAstVisitor) Visit(node sqlparser.SQLNode) error {
 		if len(node.Indexes) == 0 {
 		} else {
 			for _, n := range node.Indexes {
-				logging.GetLogger().Debugf("%v\n", n)
+				subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", n)
 			}
 		}
 
@@ -534,7 +533,7 @@ func (v *standardTableRouteAstVisitor) Visit(node sqlparser.SQLNode) error {
 
 	case sqlparser.Exprs:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", n)
 		}
 
 	case *sqlparser.AndExpr:
@@ -658,7 +657,7 @@ func (v *standardTableRouteAstVisitor) Visit(node sqlparser.SQLNode) error {
 		if node.Expr != nil {
 		}
 		for _, when := range node.Whens {
-			logging.GetLogger().Debugf("%v\n", when)
+			subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", when)
 		}
 		if node.Else != nil {
 		}
@@ -671,17 +670,17 @@ func (v *standardTableRouteAstVisitor) Visit(node sqlparser.SQLNode) error {
 
 	case sqlparser.GroupBy:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", n)
 		}
 
 	case sqlparser.OrderBy:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", n)
 		}
 
 	case *sqlparser.Order:
 		if node, ok := node.Expr.(*sqlparser.NullVal); ok {
-			logging.GetLogger().Debugf("%v\n", node)
+			subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", node)
 			return nil
 		}
 		if node, ok := node.Expr.(*sqlparser.FuncExpr); ok {
@@ -699,19 +698,19 @@ func (v *standardTableRouteAstVisitor) Visit(node sqlparser.SQLNode) error {
 
 	case sqlparser.Values:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", n)
 		}
 
 	case sqlparser.UpdateExprs:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", n)
 		}
 
 	case *sqlparser.UpdateExpr:
 
 	case sqlparser.SetExprs:
 		for _, n := range node {
-			logging.GetLogger().Debugf("%v\n", n)
+			subsystem.GetContextLogger(v.handlerCtx.GetContext()).Debugf("%v\n", n)
 		}
 
 	case *sqlparser.SetExpr:
@@ -0,0 +1,218 @@
+package safetypolicy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/stackql/go-openapistackql/openapistackql"
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/dto"
+	"github.com/stackql/stackql/internal/stackql/iqlerror"
+	"github.com/stackql/stackql/internal/stackql/parserutil"
+)
+
+const (
+	// nameColumn conventionally identifies a resource, and so
+	// may stand for one key parameter, as for bulk mutations.
+	nameColumn string = "name"
+)
+
+var (
+	_ SafetyPolicy = &standardSafetyPolicy{}
+)
+
+// Confirmer asks whether to proceed and reports the answer.
+type Confirmer func(prompt string) bool
+
+// SafetyPolicy guards destructive statements.
+//
+// Read only mode, sourced from runtime config or from the auth
+// context of a provider, refuses mutating methods at plan time.
+// A DELETE must constrain the key of the resource in its WHERE
+// clause.  The rows affected by a single statement may be capped
+// and, where a Confirmer is set, confirmed above a threshold.
+// Limits <= 0 are not enforced.
+type SafetyPolicy interface {
+	CheckAffectedRows(action string, rows int) error
+	CheckDeleteKey(where *sqlparser.Where, keys ...[]string) error
+	CheckMethod(providerName string, action string, method *openapistackql.OperationStore) error
+	SetConfirmer(Confirmer)
+}
+
+func NewSafetyPolicy(runtimeCtx dto.RuntimeCtx, authContexts map[string]*dto.AuthCtx) SafetyPolicy {
+	return &standardSafetyPolicy{
+		readOnly:            runtimeCtx.ReadOnly,
+		deleteRequiresKey:   runtimeCtx.SafetyDeleteRequiresKey,
+		maxAffectedRows:     runtimeCtx.SafetyMaxAffectedRows,
+		confirmAffectedRows: runtimeCtx.SafetyConfirmAffectedRows,
+		authContexts:        authContexts,
+	}
+}
+
+type standardSafetyPolicy struct {
+	readOnly            bool
+	deleteRequiresKey   bool
+	maxAffectedRows     int
+	confirmAffectedRows int
+	authContexts        map[string]*dto.AuthCtx
+	confirmer           Confirmer
+	mutex               sync.Mutex
+}
+
+// IsMutatingMethod is true of all methods other than those
+// of the safe HTTP verbs, ie: GET, HEAD and OPTIONS.
+func IsMutatingMethod(method *openapistackql.OperationStore) bool {
+	if method == nil || method.OperationRef == nil {
+		return true
+	}
+	switch strings.ToLower(method.OperationRef.ExtractMethodItem()) {
+	case "get", "head", "options":
+		return false
+	}
+	return true
+}
+
+func (sp *standardSafetyPolicy) SetConfirmer(confirmer Confirmer) {
+	sp.mutex.Lock()
+	defer sp.mutex.Unlock()
+	sp.confirmer = confirmer
+}
+
+func (sp *standardSafetyPolicy) isReadOnly(providerName string) bool {
+	if sp.readOnly {
+		return true
+	}
+	authCtx, ok := sp.authContexts[providerName]
+	return ok && authCtx != nil && authCtx.ReadOnly
+}
+
+// CheckMethod refuses, in read only mode, INSERT, UPDATE and DELETE
+// outright and EXEC of mutating methods.
+func (sp *standardSafetyPolicy) CheckMethod(providerName string, action string, method *openapistackql.OperationStore) error {
+	if !sp.isReadOnly(providerName) {
+		return nil
+	}
+	if strings.ToLower(action) == "exec" && !IsMutatingMethod(method) {
+		return nil
+	}
+	return iqlerror.GetSafetyPolicyError(
+		dto.ReadOnlyKey,
+		fmt.Sprintf("%s via mutating method '%s' of provider '%s'", strings.ToUpper(action), method.GetName(), providerName),
+	)
+}
+
+// CheckDeleteKey is satisfied by a WHERE clause that, for each parameter
+// of any one key, or all but one alongside the name column, has a top
+// level conjunct equating it to a literal or to a list of literals.
+// Conditions that may be weakened, such as those within OR, do not count.
+func (sp *standardSafetyPolicy) CheckDeleteKey(where *sqlparser.Where, keys ...[]string) error {
+	if !sp.deleteRequiresKey {
+		return nil
+	}
+	if where == nil {
+		return iqlerror.GetSafetyPolicyError(dto.SafetyDeleteRequiresKeyKey, "DELETE without WHERE clause")
+	}
+	constrained := getConstrainedColumns(where.Expr)
+	_, isNameConstrained := constrained[nameColumn]
+	var shortfall []string
+	for _, key := range keys {
+		var absent []string
+		for _, k := range key {
+			if _, ok := constrained[k]; !ok {
+				absent = append(absent, k)
+			}
+		}
+		if len(absent) == 0 || (len(absent) == 1 && isNameConstrained) {
+			return nil
+		}
+		sort.Strings(absent)
+		shortfall = append(shortfall, strings.Join(absent, ", "))
+	}
+	if len(shortfall) == 0 {
+		return nil
+	}
+	return iqlerror.GetSafetyPolicyError(
+		dto.SafetyDeleteRequiresKeyKey,
+		fmt.Sprintf("DELETE whose WHERE clause does not constrain key: %s", strings.Join(shortfall, " or ")),
+	)
+}
+
+// getConstrainedColumns returns the columns of top level
+// conjuncts of the form "<column> = <literal>" or
+// "<column> IN (<literal>, ...)".
+func getConstrainedColumns(expr sqlparser.Expr) map[string]struct{} {
+	rv := make(map[string]struct{})
+	for _, conjunct := range parserutil.SplitConjunction(expr) {
+		comparison, isComparison := conjunct.(*sqlparser.ComparisonExpr)
+		if !isComparison {
+			continue
+		}
+		col, isCol := comparison.Left.(*sqlparser.ColName)
+		switch comparison.Operator {
+		case sqlparser.EqualStr:
+			if isCol && isLiteral(comparison.Right) {
+				rv[col.Name.GetRawVal()] = struct{}{}
+			}
+			// literal = column
+			if rightCol, isRightCol := comparison.Right.(*sqlparser.ColName); isRightCol && isLiteral(comparison.Left) {
+				rv[rightCol.Name.GetRawVal()] = struct{}{}
+			}
+		case sqlparser.InStr:
+			tuple, isTuple := comparison.Right.(sqlparser.ValTuple)
+			if !isCol || !isTuple || len(tuple) == 0 {
+				continue
+			}
+			isLiteralTuple := true
+			for _, val := range tuple {
+				isLiteralTuple = isLiteralTuple && isLiteral(val)
+			}
+			if isLiteralTuple {
+				rv[col.Name.GetRawVal()] = struct{}{}
+			}
+		}
+	}
+	return rv
+}
+
+func isLiteral(expr sqlparser.Expr) bool {
+	val, isVal := expr.(*sqlparser.SQLVal)
+	return isVal && val.Type != sqlparser.ValArg
+}
+
+// CheckAffectedRows is called before the calls of a statement are made.
+func (sp *standardSafetyPolicy) CheckAffectedRows(action string, rows int) error {
+	if sp.maxAffectedRows > 0 && rows > sp.maxAffectedRows {
+		return iqlerror.GetSafetyPolicyError(
+			dto.SafetyMaxAffectedRowsKey,
+			fmt.Sprintf("%s affecting %d rows, limit = %d", strings.ToUpper(action), rows, sp.maxAffectedRows),
+		)
+	}
+	if sp.confirmAffectedRows <= 0 || rows <= sp.confirmAffectedRows {
+		return nil
+	}
+	sp.mutex.Lock()
+	defer sp.mutex.Unlock()
+	if sp.confirmer == nil {
+		return nil
+	}
+	if !sp.confirmer(fmt.Sprintf("%s will affect %d rows; proceed? [y/N] ", strings.ToUpper(action), rows)) {
+		return iqlerror.GetSafetyPolicyError(
+			dto.SafetyConfirmAffectedRowsKey,
+			fmt.Sprintf("%s affecting %d rows was not confirmed", strings.ToUpper(action), rows),
+		)
+	}
+	return nil
+}
+
+// GetMethodKey returns the required parameters of the
+// method, which together identify the resource.
+func GetMethodKey(method *openapistackql.OperationStore) []string {
+	var rv []string
+	for k := range method.GetRequiredParameters() {
+		rv = append(rv, k)
+	}
+	sort.Strings(rv)
+	return rv
+}
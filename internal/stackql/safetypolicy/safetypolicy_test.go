@@ -0,0 +1,159 @@
+package safetypolicy_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stackql/go-openapistackql/openapistackql"
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/dto"
+	"github.com/stackql/stackql/internal/stackql/safetypolicy"
+)
+
+func getWhere(t *testing.T, query string) *sqlparser.Where {
+	t.Helper()
+	statement, err := sqlparser.Parse(query)
+	if err != nil {
+		t.Fatalf("cannot parse '%s': %v", query, err)
+	}
+	node, isDelete := statement.(*sqlparser.Delete)
+	if !isDelete {
+		t.Fatalf("'%s' is not a delete", query)
+	}
+	return node.Where
+}
+
+func TestCheckDeleteKey(t *testing.T) {
+	key := []string{"instance", "project", "zone"}
+	testCases := []struct {
+		name    string
+		query   string
+		keys    [][]string
+		wantErr bool
+	}{
+		{
+			name:  "each key parameter equals a literal",
+			query: "delete from t where project = 'p' and zone = 'z' and instance = 'i'",
+			keys:  [][]string{key},
+		},
+		{
+			name:  "literal equals column",
+			query: "delete from t where 'p' = project and zone = 'z' and instance = 'i'",
+			keys:  [][]string{key},
+		},
+		{
+			name:  "list of literals",
+			query: "delete from t where project = 'p' and zone = 'z' and instance in ('a', 'b')",
+			keys:  [][]string{key},
+		},
+		{
+			name:  "name stands for one key parameter",
+			query: "delete from t where project = 'p' and zone = 'z' and name in ('a', 'b')",
+			keys:  [][]string{key},
+		},
+		{
+			name:  "any one key",
+			query: "delete from t where id = 1",
+			keys:  [][]string{key, {"id"}},
+		},
+		{
+			name:    "key parameter within a disjunction",
+			query:   "delete from t where project = 'p' and zone = 'z' and (instance = 'i' or 1 = 1)",
+			keys:    [][]string{key},
+			wantErr: true,
+		},
+		{
+			name:    "disjunction at the top level",
+			query:   "delete from t where project = 'p' and zone = 'z' and instance = 'i' or status = 'RUNNING'",
+			keys:    [][]string{key},
+			wantErr: true,
+		},
+		{
+			name:    "key parameter by pattern",
+			query:   "delete from t where project = 'p' and zone = 'z' and name like 'build-%'",
+			keys:    [][]string{key},
+			wantErr: true,
+		},
+		{
+			name:    "key parameter by inequality",
+			query:   "delete from t where project = 'p' and zone = 'z' and instance != 'i'",
+			keys:    [][]string{key},
+			wantErr: true,
+		},
+		{
+			name:    "key parameter equals a column",
+			query:   "delete from t where project = 'p' and zone = 'z' and instance = name",
+			keys:    [][]string{key},
+			wantErr: true,
+		},
+		{
+			name:    "key parameter absent",
+			query:   "delete from t where project = 'p' and zone = 'z' and status = 'UNATTACHED'",
+			keys:    [][]string{key},
+			wantErr: true,
+		},
+		{
+			name:    "no where clause",
+			query:   "delete from t",
+			keys:    [][]string{key},
+			wantErr: true,
+		},
+	}
+	policy := safetypolicy.NewSafetyPolicy(dto.RuntimeCtx{SafetyDeleteRequiresKey: true}, nil)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := policy.CheckDeleteKey(getWhere(t, tc.query), tc.keys...)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("CheckDeleteKey() error = %v, want error %t", err, tc.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), dto.SafetyDeleteRequiresKeyKey) {
+				t.Fatalf("CheckDeleteKey() error = %v, which does not name the setting", err)
+			}
+		})
+	}
+	permissive := safetypolicy.NewSafetyPolicy(dto.RuntimeCtx{}, nil)
+	if err := permissive.CheckDeleteKey(getWhere(t, "delete from t where status = 'UNATTACHED'"), key); err != nil {
+		t.Fatalf("CheckDeleteKey() without deleteRequiresKey error = %v", err)
+	}
+}
+
+func TestCheckAffectedRows(t *testing.T) {
+	policy := safetypolicy.NewSafetyPolicy(dto.RuntimeCtx{SafetyMaxAffectedRows: 3, SafetyConfirmAffectedRows: 1}, nil)
+	if err := policy.CheckAffectedRows("delete", 4); err == nil {
+		t.Fatalf("CheckAffectedRows() above the limit error = nil")
+	}
+	if err := policy.CheckAffectedRows("delete", 2); err != nil {
+		t.Fatalf("CheckAffectedRows() without a confirmer error = %v", err)
+	}
+	var prompts []string
+	isConfirmed := false
+	policy.SetConfirmer(func(prompt string) bool {
+		prompts = append(prompts, prompt)
+		return isConfirmed
+	})
+	if err := policy.CheckAffectedRows("delete", 1); err != nil || len(prompts) != 0 {
+		t.Fatalf("CheckAffectedRows() at the threshold = (%v, %v)", err, prompts)
+	}
+	if err := policy.CheckAffectedRows("delete", 2); err == nil || len(prompts) != 1 {
+		t.Fatalf("CheckAffectedRows() when refused = (%v, %v)", err, prompts)
+	}
+	isConfirmed = true
+	if err := policy.CheckAffectedRows("delete", 2); err != nil {
+		t.Fatalf("CheckAffectedRows() when confirmed error = %v", err)
+	}
+}
+
+func TestCheckMethod(t *testing.T) {
+	method := &openapistackql.OperationStore{}
+	policy := safetypolicy.NewSafetyPolicy(dto.RuntimeCtx{}, map[string]*dto.AuthCtx{"google": {ReadOnly: true}})
+	if err := policy.CheckMethod("google", "delete", method); err == nil {
+		t.Fatalf("CheckMethod() of a read only provider error = nil")
+	}
+	if err := policy.CheckMethod("aws", "delete", method); err != nil {
+		t.Fatalf("CheckMethod() of another provider error = %v", err)
+	}
+	readOnly := safetypolicy.NewSafetyPolicy(dto.RuntimeCtx{ReadOnly: true}, nil)
+	if err := readOnly.CheckMethod("aws", "insert", method); err == nil {
+		t.Fatalf("CheckMethod() in read only mode error = nil")
+	}
+}
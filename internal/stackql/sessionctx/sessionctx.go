@@ -0,0 +1,79 @@
+package sessionctx
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// SessionIDParameter is the client parameter, supplied by the
+// server upon connection, by which queries identify their session.
+const SessionIDParameter string = "stackql_session_id"
+
+type sessionKey struct{}
+
+type queryIDKey struct{}
+
+// Notifier relays messages, such as the progress of long running
+// operations, to the client while its query is underway.
+type Notifier interface {
+	Notify(message string) error
+}
+
+// Session identifies the client on whose behalf
+// queries are run, and is known in server mode only.
+type Session struct {
+	ID       string
+	User     string
+	Notifier Notifier
+}
+
+func WithSession(ctx context.Context, session Session) context.Context {
+	return context.WithValue(ctx, sessionKey{}, session)
+}
+
+func GetSession(ctx context.Context) (Session, bool) {
+	session, ok := ctx.Value(sessionKey{}).(Session)
+	return session, ok
+}
+
+// Notify relays the message to the client of the session, if any.
+// It returns false if the message is not relayed, in which
+// case callers fall back to their own output.
+func Notify(ctx context.Context, message string) bool {
+	session, ok := GetSession(ctx)
+	if !ok || session.Notifier == nil {
+		return false
+	}
+	return session.Notifier.Notify(message) == nil
+}
+
+// WithQueryID identifies the query being run, for
+// correlation of logs, in all modes.
+func WithQueryID(ctx context.Context, queryID string) context.Context {
+	return context.WithValue(ctx, queryIDKey{}, queryID)
+}
+
+func GetQueryID(ctx context.Context) (string, bool) {
+	queryID, ok := ctx.Value(queryIDKey{}).(string)
+	return queryID, ok
+}
+
+// NewSessionID returns a random identifier, in hex.
+func NewSessionID() (string, error) {
+	return newID(16)
+}
+
+// NewQueryID returns a random identifier, in hex,
+// shorter than that of sessions as it is logged often.
+func NewQueryID() (string, error) {
+	return newID(8)
+}
+
+func newID(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
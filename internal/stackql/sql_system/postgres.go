@@ -1,6 +1,7 @@
 package sql_system
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"reflect"
@@ -15,7 +16,6 @@ import (
 	"github.com/stackql/stackql/internal/stackql/dto"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/relationaldto"
-	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/sqlcontrol"
 	"github.com/stackql/stackql/internal/stackql/sqlengine"
 )
@@ -103,6 +103,25 @@ func (eng *postgresSystem) generateDropTableStatement(relationalTable relational
 	return fmt.Sprintf(`drop table if exists "%s"`, tableName), nil
 }
 
+func (eng *postgresSystem) ComposeUnnestJoin(isLeftJoin bool, jsonExpr string, alias string, onCondition string) string {
+	return eng.composeUnnestJoin(isLeftJoin, jsonExpr, alias, onCondition)
+}
+
+// composeUnnestJoin expands arrays and objects alike,
+// keying array elements by zero based index, per SQLite `json_each()`.
+func (eng *postgresSystem) composeUnnestJoin(isLeftJoin bool, jsonExpr string, alias string, onCondition string) string {
+	jsonVal := fmt.Sprintf("cast(%s AS json)", jsonExpr)
+	tableExpr := fmt.Sprintf(
+		`LATERAL (SELECT cast("ordinality" - 1 AS text) AS "key", "value" FROM json_array_elements_text(CASE WHEN json_typeof(%s) = 'array' THEN %s ELSE '[]' END) WITH ORDINALITY AS "stackql_elements"("value", "ordinality") UNION ALL SELECT "key", "value" FROM json_each_text(CASE WHEN json_typeof(%s) = 'object' THEN %s ELSE '{}' END)) AS "%s"`,
+		jsonVal,
+		jsonVal,
+		jsonVal,
+		jsonVal,
+		alias,
+	)
+	return composeJoin(isLeftJoin, tableExpr, onCondition, "true")
+}
+
 func (eng *postgresSystem) GetFullyQualifiedTableName(unqualifiedTableName string) (string, error) {
 	return eng.getFullyQualifiedTableName(unqualifiedTableName)
 }
@@ -695,7 +714,7 @@ func (sl *postgresSystem) gCCollectObsoleted(minTransactionID int) error {
 	if err != nil {
 		return err
 	}
-	return sl.readExecGeneratedQueries(deleteQueryResultSet)
+	return recordGCReclaimedRows(sl.readExecGeneratedQueries(deleteQueryResultSet))
 }
 
 func (sl *postgresSystem) GCCollectAll() error {
@@ -731,7 +750,7 @@ func (sl *postgresSystem) gCCollectAll() error {
 	if err != nil {
 		return err
 	}
-	return sl.readExecGeneratedQueries(deleteQueryResultSet)
+	return recordGCReclaimedRows(sl.readExecGeneratedQueries(deleteQueryResultSet))
 }
 
 func (sl *postgresSystem) GCControlTablesPurge() error {
@@ -799,7 +818,8 @@ func (sl *postgresSystem) gcControlTablesPurge() error {
 	if err != nil {
 		return err
 	}
-	return sl.readExecGeneratedQueries(deleteQueryResultSet)
+	_, err = sl.readExecGeneratedQueries(deleteQueryResultSet)
+	return err
 }
 
 func (sl *postgresSystem) GCPurgeEphemeral() error {
@@ -814,6 +834,12 @@ func (sl *postgresSystem) GetName() string {
 	return constants.SQLDialectPostgres
 }
 
+func (sl *postgresSystem) GetDBSize() (int64, error) {
+	var size int64
+	err := sl.sqlEngine.QueryRow(`SELECT pg_database_size(current_database())`).Scan(&size)
+	return size, err
+}
+
 func (sl *postgresSystem) gcPurgeCache() error {
 	query := `
 	select distinct 
@@ -833,7 +859,8 @@ func (sl *postgresSystem) gcPurgeCache() error {
 	if err != nil {
 		return err
 	}
-	return sl.readExecGeneratedQueries(rows)
+	_, err = sl.readExecGeneratedQueries(rows)
+	return err
 }
 
 func (sl *postgresSystem) gcPurgeEphemeral() error {
@@ -852,12 +879,15 @@ func (sl *postgresSystem) gcPurgeEphemeral() error {
 		table_name NOT like $3
 		and 
 		table_name not like '__iql__%' 
+		and 
+		table_name not like 'stackql_history.%' 
 	`
 	rows, err := sl.sqlEngine.Query(query, sl.tableCatalog, sl.tableSchema, sl.analyticsNamespaceLikeString)
 	if err != nil {
 		return err
 	}
-	return sl.readExecGeneratedQueries(rows)
+	_, err = sl.readExecGeneratedQueries(rows)
+	return err
 }
 
 func (sl *postgresSystem) PurgeAll() error {
@@ -887,10 +917,11 @@ func (sl *postgresSystem) purgeAll() error {
 	if err != nil {
 		return err
 	}
-	return sl.readExecGeneratedQueries(deleteQueryResultSet)
+	_, err = sl.readExecGeneratedQueries(deleteQueryResultSet)
+	return err
 }
 
-func (sl *postgresSystem) readExecGeneratedQueries(queryResultSet *sql.Rows) error {
+func (sl *postgresSystem) readExecGeneratedQueries(queryResultSet *sql.Rows) (int64, error) {
 	defer queryResultSet.Close()
 	var queries []string
 	for {
@@ -901,12 +932,11 @@ func (sl *postgresSystem) readExecGeneratedQueries(queryResultSet *sql.Rows) err
 		var s string
 		err := queryResultSet.Scan(&s)
 		if err != nil {
-			return err
+			return 0, err
 		}
 		queries = append(queries, s)
 	}
-	err := sl.sqlEngine.ExecInTxn(queries)
-	return err
+	return sl.sqlEngine.ExecInTxn(queries)
 }
 
 func (eng *postgresSystem) GetRelationalType(discoType string) string {
@@ -980,8 +1010,8 @@ func (se *postgresSystem) getTable(tableHeirarchyIDs internaldto.HeirarchyIdenti
 	return internaldto.NewDBTable(tableName, tableNameStump, tableHeirarchyIDs.GetTableName(), discoveryId, tableHeirarchyIDs), err
 }
 
-func (se *postgresSystem) GetCurrentTable(tableHeirarchyIDs internaldto.HeirarchyIdentifiers) (internaldto.DBTable, error) {
-	return se.getCurrentTable(tableHeirarchyIDs)
+func (se *postgresSystem) GetCurrentTable(ctx context.Context, tableHeirarchyIDs internaldto.HeirarchyIdentifiers) (internaldto.DBTable, error) {
+	return se.getCurrentTable(ctx, tableHeirarchyIDs)
 }
 
 // In postgres, 63 chars is default length for IDs such as table names
@@ -995,7 +1025,7 @@ func (se *postgresSystem) getTableNameStump(tableHeirarchyIDs internaldto.Heirar
 	return rawTableName, nil
 }
 
-func (se *postgresSystem) getCurrentTable(tableHeirarchyIDs internaldto.HeirarchyIdentifiers) (internaldto.DBTable, error) {
+func (se *postgresSystem) getCurrentTable(ctx context.Context, tableHeirarchyIDs internaldto.HeirarchyIdentifiers) (internaldto.DBTable, error) {
 	var tableName string
 	var discoID int
 	tableNameStump, err := se.getTableNameStump(tableHeirarchyIDs)
@@ -1022,7 +1052,7 @@ func (se *postgresSystem) getCurrentTable(tableHeirarchyIDs internaldto.Heirarch
 	`, tableNameLHSRemove, tableNamePattern)
 	err = res.Scan(&tableName, &discoID)
 	if err != nil {
-		logging.GetLogger().Errorln(fmt.Sprintf("err = %v for tableNamePattern = '%s' and tableNameLHSRemove = '%s'", err, tableNamePattern, tableNameLHSRemove))
+		subsystem.GetContextLogger(ctx).Errorln(fmt.Sprintf("err = %v for tableNamePattern = '%s' and tableNameLHSRemove = '%s'", err, tableNamePattern, tableNameLHSRemove))
 	}
 	return internaldto.NewDBTable(tableName, tableNameStump, tableHeirarchyIDs.GetTableName(), discoID, tableHeirarchyIDs), err
 }
@@ -7,3 +7,6 @@ var sqLiteEngineSetupDDL string
 
 //go:embed sql/postgres/sqlengine-setup.ddl
 var postgresEngineSetupDDL string
+
+//go:embed sql/duckdb/sqlengine-setup.ddl
+var duckDBEngineSetupDDL string
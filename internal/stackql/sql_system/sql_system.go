@@ -1,6 +1,7 @@
 package sql_system
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"reflect"
@@ -15,12 +16,18 @@ import (
 	"github.com/stackql/stackql/internal/stackql/dto"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/relationaldto"
+	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/sqlcontrol"
 	"github.com/stackql/stackql/internal/stackql/sqlengine"
 )
 
+const subsystem logging.Subsystem = "sql_system"
+
 type SQLSystem interface {
 	ComposeSelectQuery([]relationaldto.RelationalColumn, []string, string, string, string) (string, error)
+	// ComposeUnnestJoin() renders a join onto the rows of a JSON array or object,
+	// aliased per the supplied alias and having columns "key" and "value".
+	ComposeUnnestJoin(isLeftJoin bool, jsonExpr string, alias string, onCondition string) string
 	DelimitGroupByColumn(term string) string
 	DelimitOrderByColumn(term string) string
 	// GCAdd() will record a Txn as active
@@ -53,6 +60,8 @@ type SQLSystem interface {
 	GetOperatorOr() string
 	GetOperatorStringConcat() string
 	GetName() string
+	// GetDBSize() reports the size of the DB, in bytes.
+	GetDBSize() (int64, error)
 	GetGolangKind(string) reflect.Kind
 	GetGolangValue(string) interface{}
 	GetRelationalType(string) string
@@ -62,7 +71,7 @@ type SQLSystem interface {
 	IsTablePresent(string, string, string) bool
 	TableOldestUpdateUTC(string, string, string, string) (time.Time, internaldto.TxnControlCounters)
 
-	GetCurrentTable(internaldto.HeirarchyIdentifiers) (internaldto.DBTable, error)
+	GetCurrentTable(context.Context, internaldto.HeirarchyIdentifiers) (internaldto.DBTable, error)
 	GetTable(internaldto.HeirarchyIdentifiers, int) (internaldto.DBTable, error)
 
 	// Views
@@ -77,12 +86,30 @@ type SQLSystem interface {
 }
 
 func getNodeFormatter(name string) sqlparser.NodeFormatter {
-	if name == constants.SQLDialectPostgres {
+	switch name {
+	case constants.SQLDialectPostgres:
 		return astformat.PostgresSelectExprsFormatter
+	case constants.SQLDialectDuckDB:
+		return astformat.DuckDBSelectExprsFormatter
 	}
 	return astformat.DefaultSelectExprsFormatter
 }
 
+// composeJoin renders a join onto a table expression.
+// Absent a condition, an inner join is a cross join.
+func composeJoin(isLeftJoin bool, tableExpr string, onCondition string, trueLiteral string) string {
+	if isLeftJoin {
+		if onCondition == "" {
+			onCondition = trueLiteral
+		}
+		return fmt.Sprintf(" LEFT OUTER JOIN %s ON %s", tableExpr, onCondition)
+	}
+	if onCondition == "" {
+		return fmt.Sprintf(" CROSS JOIN %s", tableExpr)
+	}
+	return fmt.Sprintf(" INNER JOIN %s ON %s", tableExpr, onCondition)
+}
+
 func NewSQLSystem(sqlEngine sqlengine.SQLEngine, analyticsNamespaceLikeString string, controlAttributes sqlcontrol.ControlAttributes, sqlCfg dto.SQLBackendCfg, authCfg map[string]*dto.AuthCtx) (SQLSystem, error) {
 	name := sqlCfg.SQLSystem
 	nameLowered := strings.ToLower(name)
@@ -92,6 +119,8 @@ func NewSQLSystem(sqlEngine sqlengine.SQLEngine, analyticsNamespaceLikeString st
 		return newSQLiteSystem(sqlEngine, analyticsNamespaceLikeString, controlAttributes, formatter, sqlCfg, authCfg)
 	case constants.SQLDialectPostgres:
 		return newPostgresSystem(sqlEngine, analyticsNamespaceLikeString, controlAttributes, formatter, sqlCfg, authCfg)
+	case constants.SQLDialectDuckDB:
+		return newDuckDBSystem(sqlEngine, analyticsNamespaceLikeString, controlAttributes, formatter, sqlCfg, authCfg)
 	default:
 		return nil, fmt.Errorf("cannot initialise sql system: cannot accomodate sql dialect '%s'", name)
 	}
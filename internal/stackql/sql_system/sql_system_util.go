@@ -4,6 +4,8 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+
+	"github.com/stackql/stackql/internal/stackql/metrics"
 )
 
 func singleColRowsToString(rows *sql.Rows) (string, error) {
@@ -17,3 +19,13 @@ func singleColRowsToString(rows *sql.Rows) (string, error) {
 	}
 	return strings.Join(acc, " "), nil
 }
+
+// recordGCReclaimedRows records the count of rows
+// reclaimed by garbage collection deletions.
+func recordGCReclaimedRows(reclaimed int64, err error) error {
+	if err != nil {
+		return err
+	}
+	metrics.AddGCReclaimedRows(reclaimed)
+	return nil
+}
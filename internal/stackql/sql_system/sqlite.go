@@ -1,6 +1,7 @@
 package sql_system
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"reflect"
@@ -15,7 +16,6 @@ import (
 	"github.com/stackql/stackql/internal/stackql/dto"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/relationaldto"
-	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/sqlcontrol"
 	"github.com/stackql/stackql/internal/stackql/sqlengine"
 )
@@ -71,15 +71,15 @@ func (se *sqLiteSystem) getTable(tableHeirarchyIDs internaldto.HeirarchyIdentifi
 	return internaldto.NewDBTable(tableName, tableNameStump, tableHeirarchyIDs.GetTableName(), discoveryId, tableHeirarchyIDs), err
 }
 
-func (se *sqLiteSystem) GetCurrentTable(tableHeirarchyIDs internaldto.HeirarchyIdentifiers) (internaldto.DBTable, error) {
-	return se.getCurrentTable(tableHeirarchyIDs)
+func (se *sqLiteSystem) GetCurrentTable(ctx context.Context, tableHeirarchyIDs internaldto.HeirarchyIdentifiers) (internaldto.DBTable, error) {
+	return se.getCurrentTable(ctx, tableHeirarchyIDs)
 }
 
 func (se *sqLiteSystem) getTableNameStump(tableHeirarchyIDs internaldto.HeirarchyIdentifiers) (string, error) {
 	return tableHeirarchyIDs.GetTableName(), nil
 }
 
-func (se *sqLiteSystem) getCurrentTable(tableHeirarchyIDs internaldto.HeirarchyIdentifiers) (internaldto.DBTable, error) {
+func (se *sqLiteSystem) getCurrentTable(ctx context.Context, tableHeirarchyIDs internaldto.HeirarchyIdentifiers) (internaldto.DBTable, error) {
 	var tableName string
 	var discoID int
 	tableNameStump, err := se.getTableNameStump(tableHeirarchyIDs)
@@ -94,7 +94,7 @@ func (se *sqLiteSystem) getCurrentTable(tableHeirarchyIDs internaldto.HeirarchyI
 	res := se.sqlEngine.QueryRow(`select name, CAST(REPLACE(name, ?, '') AS INTEGER) from sqlite_schema where type = 'table' and name like ? ORDER BY name DESC limit 1`, tableNameLHSRemove, tableNamePattern)
 	err = res.Scan(&tableName, &discoID)
 	if err != nil {
-		logging.GetLogger().Errorln(fmt.Sprintf("err = %v for tableNamePattern = '%s' and tableNameLHSRemove = '%s'", err, tableNamePattern, tableNameLHSRemove))
+		subsystem.GetContextLogger(ctx).Errorln(fmt.Sprintf("err = %v for tableNamePattern = '%s' and tableNameLHSRemove = '%s'", err, tableNamePattern, tableNameLHSRemove))
 	}
 	return internaldto.NewDBTable(tableName, tableNameStump, tableHeirarchyIDs.GetTableName(), discoID, tableHeirarchyIDs), nil
 }
@@ -103,6 +103,12 @@ func (sl *sqLiteSystem) GetName() string {
 	return constants.SQLDialectSQLite3
 }
 
+func (sl *sqLiteSystem) GetDBSize() (int64, error) {
+	var size int64
+	err := sl.sqlEngine.QueryRow(`SELECT page_count * page_size FROM pragma_page_count(), pragma_page_size()`).Scan(&size)
+	return size, err
+}
+
 func (sl *sqLiteSystem) GetASTFormatter() sqlparser.NodeFormatter {
 	return sl.formatter
 }
@@ -359,7 +365,7 @@ func (sl *sqLiteSystem) gCCollectObsoleted(minTransactionID int) error {
 	if err != nil {
 		return err
 	}
-	return sl.readExecGeneratedQueries(deleteQueryResultSet)
+	return recordGCReclaimedRows(sl.readExecGeneratedQueries(deleteQueryResultSet))
 }
 
 func (sl *sqLiteSystem) GCCollectAll() error {
@@ -387,7 +393,7 @@ func (sl *sqLiteSystem) gCCollectAll() error {
 	if err != nil {
 		return err
 	}
-	return sl.readExecGeneratedQueries(deleteQueryResultSet)
+	return recordGCReclaimedRows(sl.readExecGeneratedQueries(deleteQueryResultSet))
 }
 
 func (eng *sqLiteSystem) generateDropTableStatement(relationalTable relationaldto.RelationalTable) (string, error) {
@@ -647,6 +653,24 @@ func (eng *sqLiteSystem) composeSelectQuery(columns []relationaldto.RelationalCo
 	return eng.sanitizeQueryString(query)
 }
 
+func (eng *sqLiteSystem) ComposeUnnestJoin(isLeftJoin bool, jsonExpr string, alias string, onCondition string) string {
+	return eng.composeUnnestJoin(isLeftJoin, jsonExpr, alias, onCondition)
+}
+
+// composeUnnestJoin aligns `json_each()` with the other backends,
+// which SQLite cannot express as a lateral subquery: the expansion is
+// re-encoded as an object of text keys and values, with booleans
+// spelled out, and scalars are not expanded.
+func (eng *sqLiteSystem) composeUnnestJoin(isLeftJoin bool, jsonExpr string, alias string, onCondition string) string {
+	tableExpr := fmt.Sprintf(
+		`json_each((SELECT json_group_object(cast("key" AS text), CASE "type" WHEN 'true' THEN 'true' WHEN 'false' THEN 'false' ELSE cast("value" AS text) END) FROM json_each(%s) WHERE json_type(%s) IN ('array', 'object'))) AS "%s"`,
+		jsonExpr,
+		jsonExpr,
+		alias,
+	)
+	return composeJoin(isLeftJoin, tableExpr, onCondition, "1 = 1")
+}
+
 func (eng *sqLiteSystem) GetFullyQualifiedTableName(unqualifiedTableName string) (string, error) {
 	return eng.getFullyQualifiedTableName(unqualifiedTableName)
 }
@@ -764,7 +788,8 @@ func (sl *sqLiteSystem) gcControlTablesPurge() error {
 	if err != nil {
 		return err
 	}
-	return sl.readExecGeneratedQueries(deleteQueryResultSet)
+	_, err = sl.readExecGeneratedQueries(deleteQueryResultSet)
+	return err
 }
 
 func (sl *sqLiteSystem) GCPurgeEphemeral() error {
@@ -786,7 +811,8 @@ func (sl *sqLiteSystem) gcPurgeCache() error {
 	if err != nil {
 		return err
 	}
-	return sl.readExecGeneratedQueries(rows)
+	_, err = sl.readExecGeneratedQueries(rows)
+	return err
 }
 
 func (sl *sqLiteSystem) gcPurgeEphemeral() error {
@@ -803,12 +829,15 @@ func (sl *sqLiteSystem) gcPurgeEphemeral() error {
 		name not like '__iql__%' 
 		and
 		name NOT LIKE 'sqlite_%' 
+		and
+		name NOT LIKE 'stackql_history.%' 
 	`
 	rows, err := sl.sqlEngine.Query(query, sl.analyticsNamespaceLikeString)
 	if err != nil {
 		return err
 	}
-	return sl.readExecGeneratedQueries(rows)
+	_, err = sl.readExecGeneratedQueries(rows)
+	return err
 }
 
 func (sl *sqLiteSystem) PurgeAll() error {
@@ -840,7 +869,8 @@ func (sl *sqLiteSystem) purgeAll() error {
 	if err != nil {
 		return err
 	}
-	return sl.readExecGeneratedQueries(deleteQueryResultSet)
+	_, err = sl.readExecGeneratedQueries(deleteQueryResultSet)
+	return err
 }
 
 func (eng *sqLiteSystem) DelimitGroupByColumn(term string) string {
@@ -851,7 +881,7 @@ func (eng *sqLiteSystem) DelimitOrderByColumn(term string) string {
 	return term
 }
 
-func (sl *sqLiteSystem) readExecGeneratedQueries(queryResultSet *sql.Rows) error {
+func (sl *sqLiteSystem) readExecGeneratedQueries(queryResultSet *sql.Rows) (int64, error) {
 	defer queryResultSet.Close()
 	var queries []string
 	for {
@@ -862,12 +892,11 @@ func (sl *sqLiteSystem) readExecGeneratedQueries(queryResultSet *sql.Rows) error
 		var s string
 		err := queryResultSet.Scan(&s)
 		if err != nil {
-			return err
+			return 0, err
 		}
 		queries = append(queries, s)
 	}
-	err := sl.sqlEngine.ExecInTxn(queries)
-	return err
+	return sl.sqlEngine.ExecInTxn(queries)
 }
 
 func (eng *sqLiteSystem) GetRelationalType(discoType string) string {
@@ -0,0 +1,172 @@
+package sql_system
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	_ "github.com/marcboeker/go-duckdb"
+	_ "github.com/stackql/go-sqlite3"
+)
+
+var unnestDocuments = []sql.NullString{
+	{String: `["a", 1, 1.5, true, false, null, {"x": 1}, [1, 2]]`, Valid: true},
+	{String: `{"k": "v", "b": true, "n": null, "o": {"y": [1]}}`, Valid: true},
+	{String: `"s"`, Valid: true},
+	{String: `3`, Valid: true},
+	{},
+	{String: `[]`, Valid: true},
+}
+
+// wantUnnestRows are the rows of the inner join, rendered as
+// "<id>|<key>|<value>"; scalars, NULL and empty arrays expand to no rows.
+var wantUnnestRows = []string{
+	`1|0|a`,
+	`1|1|1`,
+	`1|2|1.5`,
+	`1|3|true`,
+	`1|4|false`,
+	`1|5|NULL`,
+	`1|6|{"x":1}`,
+	`1|7|[1,2]`,
+	`2|k|v`,
+	`2|b|true`,
+	`2|n|NULL`,
+	`2|o|{"y":[1]}`,
+}
+
+type unnestDialect struct {
+	name       string
+	driverName string
+	initQuery  string
+	// jsonProbeQuery fails where JSON functions are unavailable.
+	jsonProbeQuery string
+	system         interface {
+		ComposeUnnestJoin(isLeftJoin bool, jsonExpr string, alias string, onCondition string) string
+	}
+}
+
+func queryUnnestRows(t *testing.T, db *sql.DB, query string) []string {
+	t.Helper()
+	rows, err := db.Query(query)
+	if err != nil {
+		t.Fatalf("cannot execute '%s': %v", query, err)
+	}
+	defer rows.Close()
+	var rv []string
+	for rows.Next() {
+		var id int
+		var key, value sql.NullString
+		if err := rows.Scan(&id, &key, &value); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		cells := []string{fmt.Sprintf("%d", id), "NULL", "NULL"}
+		if key.Valid {
+			cells[1] = key.String
+		}
+		if value.Valid {
+			cells[2] = value.String
+		}
+		rv = append(rv, strings.Join(cells, "|"))
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sort.Strings(rv)
+	return rv
+}
+
+// TestComposeUnnestJoinConformance expands the same documents
+// in each embedded backend, for identical rows.
+// Backends built without JSON functions are skipped.
+func TestComposeUnnestJoinConformance(t *testing.T) {
+	wantInner := append([]string{}, wantUnnestRows...)
+	sort.Strings(wantInner)
+	wantLeft := append([]string{`3|NULL|NULL`, `4|NULL|NULL`, `5|NULL|NULL`, `6|NULL|NULL`}, wantUnnestRows...)
+	sort.Strings(wantLeft)
+	for _, d := range []unnestDialect{
+		{
+			name:           "sqlite",
+			driverName:     "sqlite3",
+			jsonProbeQuery: "select json_each.value from json_each('[]')",
+			system:         &sqLiteSystem{},
+		},
+		{
+			name:           "duckdb",
+			driverName:     "duckdb",
+			initQuery:      "SET autoinstall_known_extensions = true; SET autoload_known_extensions = true;",
+			jsonProbeQuery: "select json_type('[]')",
+			system:         &duckDBSystem{},
+		},
+	} {
+		t.Run(d.name, func(t *testing.T) {
+			db, err := sql.Open(d.driverName, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer db.Close()
+			db.SetMaxOpenConns(1)
+			if d.initQuery != "" {
+				if _, err := db.Exec(d.initQuery); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			if _, err := db.Exec(d.jsonProbeQuery); err != nil {
+				t.Skipf("JSON functions unavailable in this build of %s: %v", d.name, err)
+			}
+			if _, err := db.Exec(`create table b (id integer, j text)`); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for i, doc := range unnestDocuments {
+				if _, err := db.Exec(`insert into b (id, j) values (?, ?)`, i+1, doc); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			for _, isLeftJoin := range []bool{false, true} {
+				query := `SELECT "b"."id", "u"."key", "u"."value" FROM "b"` + d.system.ComposeUnnestJoin(isLeftJoin, `"b"."j"`, "u", "")
+				want := wantInner
+				if isLeftJoin {
+					want = wantLeft
+				}
+				if got := queryUnnestRows(t, db, query); !reflect.DeepEqual(got, want) {
+					t.Fatalf("'%s' = %v, want %v", query, got, want)
+				}
+			}
+			// A condition filters expanded rows, but not the outer rows of a left join.
+			query := `SELECT "b"."id", "u"."key", "u"."value" FROM "b"` + d.system.ComposeUnnestJoin(true, `"b"."j"`, "u", `"u"."key" = 'k'`) + ` WHERE "b"."id" IN (2, 3)`
+			want := []string{`2|k|v`, `3|NULL|NULL`}
+			if got := queryUnnestRows(t, db, query); !reflect.DeepEqual(got, want) {
+				t.Fatalf("'%s' = %v, want %v", query, got, want)
+			}
+		})
+	}
+}
+
+func TestComposeUnnestJoinRendering(t *testing.T) {
+	testCases := []struct {
+		name        string
+		system      SQLSystem
+		isLeftJoin  bool
+		onCondition string
+		wantPrefix  string
+		wantSuffix  string
+	}{
+		{name: "sqlite cross", system: &sqLiteSystem{}, wantPrefix: ` CROSS JOIN json_each(`, wantSuffix: `) AS "u"`},
+		{name: "sqlite left", system: &sqLiteSystem{}, isLeftJoin: true, wantPrefix: ` LEFT OUTER JOIN json_each(`, wantSuffix: `) AS "u" ON 1 = 1`},
+		{name: "postgres cross", system: &postgresSystem{}, wantPrefix: ` CROSS JOIN LATERAL (`, wantSuffix: `) AS "u"`},
+		{name: "postgres left", system: &postgresSystem{}, isLeftJoin: true, wantPrefix: ` LEFT OUTER JOIN LATERAL (`, wantSuffix: `) AS "u" ON true`},
+		{name: "postgres inner", system: &postgresSystem{}, onCondition: `"u"."key" = 'k'`, wantPrefix: ` INNER JOIN LATERAL (`, wantSuffix: `) AS "u" ON "u"."key" = 'k'`},
+		{name: "duckdb left", system: &duckDBSystem{}, isLeftJoin: true, wantPrefix: ` LEFT OUTER JOIN LATERAL (`, wantSuffix: `) AS "u" ON true`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.system.ComposeUnnestJoin(tc.isLeftJoin, `"b"."j"`, "u", tc.onCondition)
+			if !strings.HasPrefix(got, tc.wantPrefix) || !strings.HasSuffix(got, tc.wantSuffix) {
+				t.Fatalf("ComposeUnnestJoin() = %s, want %s...%s", got, tc.wantPrefix, tc.wantSuffix)
+			}
+		})
+	}
+}
@@ -0,0 +1,388 @@
+package sqlengine
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/stdlib"
+
+	"github.com/stackql/stackql/internal/stackql/constants"
+	"github.com/stackql/stackql/internal/stackql/sqlpushdown"
+)
+
+const (
+	// SQLite permits at most 32766 host parameters per statement.
+	valuesBulkInsertMaxArgs int = 32766
+	// SQL Server permits at most 2100 parameters per request,
+	// short of which some are kept in reserve, and at most 1000
+	// rows per table value constructor.
+	sqlServerBulkInsertMaxArgs int = 2000
+	sqlServerBulkInsertMaxRows int = 1000
+	// Text format null marker, for both postgres COPY and snowflake CSV.
+	bulkInsertNullMarker string = `\N`
+)
+
+var (
+	_ BulkInsertTxn = &valuesBulkInsertTxn{}
+	_ BulkInsertTxn = &postgresCopyBulkInsertTxn{}
+	_ BulkInsertTxn = &snowflakeStageBulkInsertTxn{}
+)
+
+// BulkInsertTxn writes batches of rows into a single table,
+// all within the one transaction.
+// Row values are ordered as per the column names
+// supplied to BeginBulkInsert().
+// Exec runs other statements within the transaction; since some
+// dialects load batches only upon Commit(), such statements
+// must not depend upon the inserted rows.
+type BulkInsertTxn interface {
+	Exec(query string, args ...interface{}) error
+	InsertBatch(rows [][]interface{}) error
+	Commit() error
+	Rollback() error
+}
+
+// NewBulkInsertTxn begins a bulk insert into a table of an external
+// database, by the means best suited to its dialect.
+func NewBulkInsertTxn(ctx context.Context, db *sql.DB, dbName string, qualifiedTableName string, columnNames []string) (BulkInsertTxn, error) {
+	switch dbName {
+	case constants.SQLDbNamePostgres:
+		return newPostgresCopyBulkInsertTxn(ctx, db, qualifiedTableName, columnNames)
+	case constants.SQLDbNameSnowflake:
+		return newSnowflakeStageBulkInsertTxn(ctx, db, qualifiedTableName, columnNames)
+	default:
+		return newValuesBulkInsertTxn(ctx, db, nil, dbName, qualifiedTableName, columnNames)
+	}
+}
+
+func quoteColumnNames(columnNames []string) []string {
+	var rv []string
+	for _, c := range columnNames {
+		rv = append(rv, fmt.Sprintf(`"%s"`, c))
+	}
+	return rv
+}
+
+// getValuesPlaceholder renders the bind parameter of
+// the given ordinal, as expected by the dialect's driver.
+func getValuesPlaceholder(dbName string, ordinal int) string {
+	if dbName == constants.SQLDbNameSQLServer {
+		return fmt.Sprintf("@p%d", ordinal)
+	}
+	return "?"
+}
+
+// getValuesBulkInsertRowsPerStatement returns the most rows
+// that a single VALUES insert may carry in the dialect.
+func getValuesBulkInsertRowsPerStatement(dbName string, columnCount int) int {
+	maxArgs, maxRows := valuesBulkInsertMaxArgs, 0
+	if dbName == constants.SQLDbNameSQLServer {
+		maxArgs, maxRows = sqlServerBulkInsertMaxArgs, sqlServerBulkInsertMaxRows
+	}
+	rv := maxArgs / columnCount
+	if maxRows > 0 && rv > maxRows {
+		rv = maxRows
+	}
+	if rv < 1 {
+		rv = 1
+	}
+	return rv
+}
+
+// valuesBulkInsertTxn issues multi-row VALUES inserts.
+// An optional mutex serializes bulk transactions,
+// for engines that take table level write locks.
+type valuesBulkInsertTxn struct {
+	ctx         context.Context
+	tx          *sql.Tx
+	dbName      string
+	tableName   string
+	columnNames []string
+	mutex       *sync.Mutex
+}
+
+func newValuesBulkInsertTxn(ctx context.Context, db *sql.DB, mutex *sync.Mutex, dbName string, qualifiedTableName string, columnNames []string) (BulkInsertTxn, error) {
+	if mutex != nil {
+		mutex.Lock()
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		if mutex != nil {
+			mutex.Unlock()
+		}
+		return nil, err
+	}
+	return &valuesBulkInsertTxn{
+		ctx:         ctx,
+		tx:          tx,
+		dbName:      dbName,
+		tableName:   qualifiedTableName,
+		columnNames: columnNames,
+		mutex:       mutex,
+	}, nil
+}
+
+func (bt *valuesBulkInsertTxn) Exec(query string, args ...interface{}) error {
+	_, err := bt.tx.ExecContext(bt.ctx, query, args...)
+	return err
+}
+
+func (bt *valuesBulkInsertTxn) InsertBatch(rows [][]interface{}) error {
+	columnCount := len(bt.columnNames)
+	if columnCount == 0 {
+		return fmt.Errorf("cannot bulk insert into '%s' with zero columns", bt.tableName)
+	}
+	rowsPerStatement := getValuesBulkInsertRowsPerStatement(bt.dbName, columnCount)
+	var quotedNames []string
+	for _, c := range bt.columnNames {
+		quotedNames = append(quotedNames, sqlpushdown.QuoteIdentifier(bt.dbName, c))
+	}
+	for start := 0; start < len(rows); start += rowsPerStatement {
+		end := start + rowsPerStatement
+		if end > len(rows) {
+			end = len(rows)
+		}
+		var placeholders []string
+		var args []interface{}
+		for _, row := range rows[start:end] {
+			if len(row) != columnCount {
+				return fmt.Errorf("cannot bulk insert into '%s': row has %d values for %d columns", bt.tableName, len(row), columnCount)
+			}
+			var rowPlaceholders []string
+			for i := range row {
+				rowPlaceholders = append(rowPlaceholders, getValuesPlaceholder(bt.dbName, len(args)+i+1))
+			}
+			args = append(args, row...)
+			placeholders = append(placeholders, fmt.Sprintf("(%s)", strings.Join(rowPlaceholders, ", ")))
+		}
+		q := fmt.Sprintf(
+			`INSERT INTO %s (%s) VALUES %s`,
+			bt.tableName,
+			strings.Join(quotedNames, ", "),
+			strings.Join(placeholders, ", "),
+		)
+		_, err := bt.tx.ExecContext(bt.ctx, q, args...)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (bt *valuesBulkInsertTxn) Commit() error {
+	defer bt.release()
+	return bt.tx.Commit()
+}
+
+func (bt *valuesBulkInsertTxn) Rollback() error {
+	defer bt.release()
+	return bt.tx.Rollback()
+}
+
+func (bt *valuesBulkInsertTxn) release() {
+	if bt.mutex != nil {
+		bt.mutex.Unlock()
+		bt.mutex = nil
+	}
+}
+
+// postgresCopyBulkInsertTxn streams batches through `COPY ... FROM STDIN`.
+// The transaction is explicit on a dedicated connection,
+// because `*sql.Tx` does not expose the driver connection.
+type postgresCopyBulkInsertTxn struct {
+	ctx     context.Context
+	conn    *sql.Conn
+	copySQL string
+}
+
+func newPostgresCopyBulkInsertTxn(ctx context.Context, db *sql.DB, qualifiedTableName string, columnNames []string) (BulkInsertTxn, error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, err = conn.ExecContext(ctx, "BEGIN")
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &postgresCopyBulkInsertTxn{
+		ctx:     ctx,
+		conn:    conn,
+		copySQL: fmt.Sprintf(`COPY %s (%s) FROM STDIN`, qualifiedTableName, strings.Join(quoteColumnNames(columnNames), ", ")),
+	}, nil
+}
+
+func (bt *postgresCopyBulkInsertTxn) Exec(query string, args ...interface{}) error {
+	_, err := bt.conn.ExecContext(bt.ctx, query, args...)
+	return err
+}
+
+func (bt *postgresCopyBulkInsertTxn) InsertBatch(rows [][]interface{}) error {
+	copyData := formatPostgresCopyData(rows)
+	return bt.conn.Raw(func(driverConn interface{}) error {
+		stdlibConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("cannot execute COPY on driver connection of type '%T'", driverConn)
+		}
+		_, err := stdlibConn.Conn().PgConn().CopyFrom(bt.ctx, strings.NewReader(copyData), bt.copySQL)
+		return err
+	})
+}
+
+func formatPostgresCopyData(rows [][]interface{}) string {
+	var sb strings.Builder
+	for _, row := range rows {
+		for i, v := range row {
+			if i > 0 {
+				sb.WriteString("\t")
+			}
+			sb.WriteString(formatPostgresCopyValue(v))
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func (bt *postgresCopyBulkInsertTxn) Commit() error {
+	defer bt.conn.Close()
+	_, err := bt.conn.ExecContext(bt.ctx, "COMMIT")
+	return err
+}
+
+// The rollback must proceed even if the query context is cancelled.
+func (bt *postgresCopyBulkInsertTxn) Rollback() error {
+	defer bt.conn.Close()
+	_, err := bt.conn.ExecContext(context.Background(), "ROLLBACK")
+	return err
+}
+
+// COPY text format, as per https://www.postgresql.org/docs/current/sql-copy.html
+func formatPostgresCopyValue(v interface{}) string {
+	if v == nil {
+		return bulkInsertNullMarker
+	}
+	s := formatBulkInsertScalar(v)
+	return strings.NewReplacer(
+		`\`, `\\`,
+		"\t", `\t`,
+		"\n", `\n`,
+		"\r", `\r`,
+	).Replace(s)
+}
+
+func formatBulkInsertScalar(v interface{}) string {
+	switch vt := v.(type) {
+	case string:
+		return vt
+	case []byte:
+		return string(vt)
+	case bool:
+		return strconv.FormatBool(vt)
+	case float64:
+		return strconv.FormatFloat(vt, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(vt), 'f', -1, 32)
+	case time.Time:
+		return vt.Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprintf("%v", vt)
+	}
+}
+
+// snowflakeStageBulkInsertTxn PUTs each batch as a CSV file to a
+// private location in the user stage, then a single COPY INTO
+// loads all batches upon commit.
+type snowflakeStageBulkInsertTxn struct {
+	ctx           context.Context
+	tx            *sql.Tx
+	tableName     string
+	columnNames   []string
+	stageLocation string
+}
+
+func newSnowflakeStageBulkInsertTxn(ctx context.Context, db *sql.DB, qualifiedTableName string, columnNames []string) (BulkInsertTxn, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &snowflakeStageBulkInsertTxn{
+		ctx:           ctx,
+		tx:            tx,
+		tableName:     qualifiedTableName,
+		columnNames:   columnNames,
+		stageLocation: fmt.Sprintf("@~/stackql_bulk_insert/%d", time.Now().UnixNano()),
+	}, nil
+}
+
+func (bt *snowflakeStageBulkInsertTxn) Exec(query string, args ...interface{}) error {
+	_, err := bt.tx.ExecContext(bt.ctx, query, args...)
+	return err
+}
+
+func (bt *snowflakeStageBulkInsertTxn) InsertBatch(rows [][]interface{}) error {
+	f, err := os.CreateTemp("", "stackql_bulk_insert_*.csv")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	err = writeSnowflakeStageFile(f, rows)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+	_, err = bt.tx.ExecContext(bt.ctx, fmt.Sprintf(`PUT 'file://%s' %s AUTO_COMPRESS = TRUE`, filepath.ToSlash(f.Name()), bt.stageLocation))
+	return err
+}
+
+func writeSnowflakeStageFile(w io.Writer, rows [][]interface{}) error {
+	csvWriter := csv.NewWriter(w)
+	for _, row := range rows {
+		var record []string
+		for _, v := range row {
+			if v == nil {
+				record = append(record, bulkInsertNullMarker)
+				continue
+			}
+			record = append(record, formatBulkInsertScalar(v))
+		}
+		err := csvWriter.Write(record)
+		if err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+func (bt *snowflakeStageBulkInsertTxn) Commit() error {
+	q := fmt.Sprintf(
+		`COPY INTO %s (%s) FROM %s FILE_FORMAT = ( TYPE = CSV FIELD_OPTIONALLY_ENCLOSED_BY = '"' NULL_IF = ('\\N') EMPTY_FIELD_AS_NULL = FALSE ) PURGE = TRUE`,
+		bt.tableName,
+		strings.Join(quoteColumnNames(bt.columnNames), ", "),
+		bt.stageLocation,
+	)
+	_, err := bt.tx.ExecContext(bt.ctx, q)
+	if err != nil {
+		bt.Rollback()
+		return err
+	}
+	return bt.tx.Commit()
+}
+
+func (bt *snowflakeStageBulkInsertTxn) Rollback() error {
+	bt.tx.ExecContext(context.Background(), fmt.Sprintf(`REMOVE %s`, bt.stageLocation))
+	return bt.tx.Rollback()
+}
@@ -0,0 +1,393 @@
+package sqlengine
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stackql/stackql/internal/stackql/constants"
+)
+
+// recordingConnector records the statements executed against
+// it, for the bulk insert paths of engines not run in tests.
+type recordingConnector struct {
+	mutex      sync.Mutex
+	statements []string
+	args       [][]driver.NamedValue
+	// onExec, if set, is called upon each statement, eg: to read
+	// a file before it is removed.
+	onExec func(query string)
+}
+
+func (rc *recordingConnector) Connect(context.Context) (driver.Conn, error) {
+	return &recordingConn{connector: rc}, nil
+}
+
+func (rc *recordingConnector) Driver() driver.Driver {
+	return nil
+}
+
+func (rc *recordingConnector) record(query string, args []driver.NamedValue) {
+	rc.mutex.Lock()
+	rc.statements = append(rc.statements, query)
+	rc.args = append(rc.args, args)
+	onExec := rc.onExec
+	rc.mutex.Unlock()
+	if onExec != nil {
+		onExec(query)
+	}
+}
+
+func (rc *recordingConnector) getStatements() []string {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+	return append([]string{}, rc.statements...)
+}
+
+type recordingConn struct {
+	connector *recordingConnector
+}
+
+func (c *recordingConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("prepare not supported")
+}
+
+func (c *recordingConn) Close() error {
+	return nil
+}
+
+func (c *recordingConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *recordingConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	c.connector.record("BEGIN", nil)
+	return &recordingTx{connector: c.connector}, nil
+}
+
+func (c *recordingConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.connector.record(query, args)
+	return driver.RowsAffected(0), nil
+}
+
+type recordingTx struct {
+	connector *recordingConnector
+}
+
+func (tx *recordingTx) Commit() error {
+	tx.connector.record("COMMIT", nil)
+	return nil
+}
+
+func (tx *recordingTx) Rollback() error {
+	tx.connector.record("ROLLBACK", nil)
+	return nil
+}
+
+func newRecordingDB(t *testing.T) (*sql.DB, *recordingConnector) {
+	connector := &recordingConnector{}
+	db := sql.OpenDB(connector)
+	t.Cleanup(func() { db.Close() })
+	return db, connector
+}
+
+func assertStatements(t *testing.T, got []string, want []string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("statements = %q, want %q", got, want)
+	}
+	for i := range want {
+		if !regexp.MustCompile(want[i]).MatchString(got[i]) {
+			t.Fatalf("statement %d = %q, want match for %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestFormatPostgresCopyData(t *testing.T) {
+	testCases := []struct {
+		name string
+		rows [][]interface{}
+		want string
+	}{
+		{name: "no rows", want: ""},
+		{
+			name: "scalars",
+			rows: [][]interface{}{{"a", int64(1), 1.5, true}, {"b", int64(2), float32(0.25), false}},
+			want: "a\t1\t1.5\ttrue\nb\t2\t0.25\tfalse\n",
+		},
+		{
+			name: "null",
+			rows: [][]interface{}{{nil, "x"}},
+			want: "\\N\tx\n",
+		},
+		{
+			name: "escaped",
+			rows: [][]interface{}{{"tab\there", "line\nbreak\r", `back\slash`}},
+			want: "tab\\there\tline\\nbreak\\r\tback\\\\slash\n",
+		},
+		{
+			name: "literal null marker escaped",
+			rows: [][]interface{}{{`\N`}},
+			want: "\\\\N\n",
+		},
+		{
+			name: "time and bytes",
+			rows: [][]interface{}{{time.Date(2023, time.March, 1, 10, 0, 0, 0, time.UTC), []byte("raw")}},
+			want: "2023-03-01T10:00:00Z\traw\n",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := formatPostgresCopyData(tc.rows); got != tc.want {
+				t.Fatalf("formatPostgresCopyData() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPostgresCopyBulkInsertTxn(t *testing.T) {
+	testCases := []struct {
+		name           string
+		commit         bool
+		wantStatements []string
+	}{
+		{name: "commit", commit: true, wantStatements: []string{"^BEGIN$", "^COMMIT$"}},
+		{name: "rollback", wantStatements: []string{"^BEGIN$", "^ROLLBACK$"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, connector := newRecordingDB(t)
+			txn, err := newPostgresCopyBulkInsertTxn(context.Background(), db, `"stackql"."t"`, []string{"a", "b"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if copySQL := txn.(*postgresCopyBulkInsertTxn).copySQL; copySQL != `COPY "stackql"."t" ("a", "b") FROM STDIN` {
+				t.Fatalf("copy statement = %q", copySQL)
+			}
+			// COPY requires a pgx connection
+			err = txn.InsertBatch([][]interface{}{{"x", "y"}})
+			if err == nil || !strings.Contains(err.Error(), "cannot execute COPY") {
+				t.Fatalf("InsertBatch() error = %v, want COPY refused", err)
+			}
+			if tc.commit {
+				err = txn.Commit()
+			} else {
+				err = txn.Rollback()
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertStatements(t, connector.getStatements(), tc.wantStatements)
+		})
+	}
+}
+
+func TestSnowflakeStageBulkInsertTxn(t *testing.T) {
+	testCases := []struct {
+		name           string
+		batches        [][][]interface{}
+		commit         bool
+		wantFiles      []string
+		wantStatements []string
+	}{
+		{
+			name: "batches loaded upon commit",
+			batches: [][][]interface{}{
+				{{"a", int64(1)}, {nil, int64(2)}},
+				{{"with, comma", true}},
+			},
+			commit:    true,
+			wantFiles: []string{"a,1\n\\N,2\n", "\"with, comma\",true\n"},
+			wantStatements: []string{
+				"^BEGIN$",
+				`^PUT 'file://.*stackql_bulk_insert_.*\.csv' @~/stackql_bulk_insert/\d+ AUTO_COMPRESS = TRUE$`,
+				`^PUT 'file://.*stackql_bulk_insert_.*\.csv' @~/stackql_bulk_insert/\d+ AUTO_COMPRESS = TRUE$`,
+				`^COPY INTO "db"."t" \("a", "b"\) FROM @~/stackql_bulk_insert/\d+ FILE_FORMAT = \( TYPE = CSV .*NULL_IF = \('\\\\N'\).* PURGE = TRUE$`,
+				"^COMMIT$",
+			},
+		},
+		{
+			name:      "stage removed upon rollback",
+			batches:   [][][]interface{}{{{"a", int64(1)}}},
+			wantFiles: []string{"a,1\n"},
+			wantStatements: []string{
+				"^BEGIN$",
+				`^PUT 'file://`,
+				`^REMOVE @~/stackql_bulk_insert/\d+$`,
+				"^ROLLBACK$",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, connector := newRecordingDB(t)
+			var files []string
+			connector.onExec = func(query string) {
+				if !strings.HasPrefix(query, "PUT ") {
+					return
+				}
+				fileName := strings.TrimPrefix(strings.Fields(query)[1], "'file://")
+				b, err := os.ReadFile(strings.TrimSuffix(fileName, "'"))
+				if err != nil {
+					t.Errorf("cannot read staged file: %v", err)
+				}
+				files = append(files, string(b))
+			}
+			txn, err := newSnowflakeStageBulkInsertTxn(context.Background(), db, `"db"."t"`, []string{"a", "b"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, batch := range tc.batches {
+				if err := txn.InsertBatch(batch); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			if tc.commit {
+				err = txn.Commit()
+			} else {
+				err = txn.Rollback()
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			statements := connector.getStatements()
+			assertStatements(t, statements, tc.wantStatements)
+			if len(files) != len(tc.wantFiles) {
+				t.Fatalf("staged files = %q, want %q", files, tc.wantFiles)
+			}
+			for i := range files {
+				if files[i] != tc.wantFiles[i] {
+					t.Fatalf("staged file %d = %q, want %q", i, files[i], tc.wantFiles[i])
+				}
+			}
+			stage := strings.Fields(statements[1])[2]
+			for _, s := range statements[2 : len(statements)-1] {
+				if !strings.Contains(s, stage) {
+					t.Fatalf("statement %q not against stage '%s'", s, stage)
+				}
+			}
+		})
+	}
+}
+
+func TestValuesBulkInsertTxn(t *testing.T) {
+	testCases := []struct {
+		name           string
+		dbName         string
+		rows           [][]interface{}
+		wantErr        bool
+		wantStatements []string
+	}{
+		{
+			name:   "multi row insert",
+			dbName: constants.SQLDbNameSQLite,
+			rows:   [][]interface{}{{"a", int64(1)}, {"b", nil}},
+			wantStatements: []string{
+				"^BEGIN$",
+				`^INSERT INTO "t" \("a", "b"\) VALUES \(\?, \?\), \(\?, \?\)$`,
+				"^COMMIT$",
+			},
+		},
+		{
+			name:   "sqlserver delimiters and placeholders",
+			dbName: constants.SQLDbNameSQLServer,
+			rows:   [][]interface{}{{"a", int64(1)}, {"b", nil}},
+			wantStatements: []string{
+				"^BEGIN$",
+				`^INSERT INTO "t" \(\[a\], \[b\]\) VALUES \(@p1, @p2\), \(@p3, @p4\)$`,
+				"^COMMIT$",
+			},
+		},
+		{
+			name:           "short row refused",
+			dbName:         constants.SQLDbNameSQLite,
+			rows:           [][]interface{}{{"a", int64(1)}, {"b"}},
+			wantErr:        true,
+			wantStatements: []string{"^BEGIN$", "^ROLLBACK$"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, connector := newRecordingDB(t)
+			mutex := &sync.Mutex{}
+			txn, err := newValuesBulkInsertTxn(context.Background(), db, mutex, tc.dbName, `"t"`, []string{"a", "b"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			err = txn.InsertBatch(tc.rows)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("InsertBatch() error = %v, want error %t", err, tc.wantErr)
+			}
+			if err != nil {
+				txn.Rollback()
+			} else if err := txn.Commit(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertStatements(t, connector.getStatements(), tc.wantStatements)
+			if !mutex.TryLock() {
+				t.Fatalf("bulk insert mutex not released")
+			}
+		})
+	}
+}
+
+func TestValuesBulkInsertTxnBatching(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		dbName               string
+		columnCount          int
+		rowCount             int
+		wantRowsPerStatement []int
+	}{
+		{name: "sqlite parameter limit", dbName: constants.SQLDbNameSQLite, columnCount: 10, rowCount: 7000, wantRowsPerStatement: []int{3276, 3276, 448}},
+		{name: "sqlserver parameter limit", dbName: constants.SQLDbNameSQLServer, columnCount: 3, rowCount: 1500, wantRowsPerStatement: []int{666, 666, 168}},
+		{name: "sqlserver row limit", dbName: constants.SQLDbNameSQLServer, columnCount: 1, rowCount: 2500, wantRowsPerStatement: []int{1000, 1000, 500}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, connector := newRecordingDB(t)
+			var columnNames []string
+			for i := 0; i < tc.columnCount; i++ {
+				columnNames = append(columnNames, fmt.Sprintf("c%d", i))
+			}
+			rows := make([][]interface{}, tc.rowCount)
+			for i := range rows {
+				rows[i] = make([]interface{}, tc.columnCount)
+			}
+			txn, err := newValuesBulkInsertTxn(context.Background(), db, nil, tc.dbName, `"t"`, columnNames)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := txn.InsertBatch(rows); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := txn.Commit(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// BEGIN and COMMIT bracket the inserts
+			statements := connector.getStatements()
+			if len(statements) != len(tc.wantRowsPerStatement)+2 {
+				t.Fatalf("issued %d inserts, want %d", len(statements)-2, len(tc.wantRowsPerStatement))
+			}
+			for i, wantRows := range tc.wantRowsPerStatement {
+				statement, args := statements[i+1], connector.args[i+1]
+				if len(args) != wantRows*tc.columnCount {
+					t.Fatalf("insert %d has %d args, want %d", i, len(args), wantRows*tc.columnCount)
+				}
+				if got := strings.Count(statement, "("); got != wantRows+1 {
+					t.Fatalf("insert %d has %d rows, want %d", i, got-1, wantRows)
+				}
+				if tc.dbName == constants.SQLDbNameSQLServer && !strings.HasSuffix(statement, fmt.Sprintf("@p%d)", len(args))) {
+					t.Fatalf("insert %d placeholders do not run from @p1 to @p%d", i, len(args))
+				}
+			}
+		})
+	}
+}
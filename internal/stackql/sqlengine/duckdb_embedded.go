@@ -0,0 +1,272 @@
+package sqlengine
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	"github.com/marcboeker/go-duckdb"
+	"github.com/stackql/stackql/internal/stackql/constants"
+	"github.com/stackql/stackql/internal/stackql/dto"
+	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
+	"github.com/stackql/stackql/internal/stackql/sqlcontrol"
+	"github.com/stackql/stackql/internal/stackql/util"
+)
+
+var (
+	_ SQLEngine = &duckDBEmbeddedEngine{}
+)
+
+// JSON functions live in the duckdb `json` extension,
+// which is not statically linked into the driver.
+// Autoloading permits the extension to be sourced from
+// the local extension directory or, failing that, downloaded.
+const (
+	duckDBConnInitQuery string = `SET autoinstall_known_extensions = true; SET autoload_known_extensions = true;`
+)
+
+type duckDBEmbeddedEngine struct {
+	db                *sql.DB
+	dsn               string
+	controlAttributes sqlcontrol.ControlAttributes
+	ctrlMutex         *sync.Mutex
+	sessionMutex      *sync.Mutex
+	discoveryMutex    *sync.Mutex
+}
+
+func (se *duckDBEmbeddedEngine) IsMemory() bool {
+	return se.dsn == "" || strings.HasPrefix(se.dsn, ":memory:") || strings.HasPrefix(se.dsn, "?")
+}
+
+func (se *duckDBEmbeddedEngine) GetDB() (*sql.DB, error) {
+	return se.db, nil
+}
+
+func (se *duckDBEmbeddedEngine) GetTx() (*sql.Tx, error) {
+	return se.db.Begin()
+}
+
+func newDuckDBEmbeddedEngine(cfg dto.SQLBackendCfg, controlAttributes sqlcontrol.ControlAttributes) (*duckDBEmbeddedEngine, error) {
+	// DuckDB permits empty DSN, which is an in-memory database
+	// shared by all connections from the one connector.
+	dsn := cfg.GetDSN()
+	connector, err := duckdb.NewConnector(dsn, func(execer driver.ExecerContext) error {
+		_, execErr := execer.ExecContext(context.Background(), duckDBConnInitQuery, nil)
+		return execErr
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot open duckdb with dsn = '%s': %w", dsn, err)
+	}
+	db := sql.OpenDB(connector)
+	db.SetConnMaxLifetime(-1)
+	eng := &duckDBEmbeddedEngine{
+		db:                db,
+		dsn:               dsn,
+		controlAttributes: controlAttributes,
+		ctrlMutex:         &sync.Mutex{},
+		sessionMutex:      &sync.Mutex{},
+		discoveryMutex:    &sync.Mutex{},
+	}
+	if cfg.DbInitFilePath != "" {
+		err = eng.execFile(cfg.DbInitFilePath)
+	}
+	if err != nil {
+		return eng, err
+	}
+	subsystem.GetLogger().Infoln(fmt.Sprintf("opened duckdb with dsn = '%s'", dsn))
+	return eng, nil
+}
+
+func (eng *duckDBEmbeddedEngine) execFile(fileName string) error {
+	fileContents, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return err
+	}
+	_, err = eng.db.Exec(string(fileContents))
+	if err != nil {
+		return fmt.Errorf("stackql duckdb exec file error: %s", err.Error())
+	}
+	return nil
+}
+
+func (eng *duckDBEmbeddedEngine) execFileLocal(fileName string) error {
+	expF, err := util.GetFilePathFromRepositoryRoot(fileName)
+	if err != nil {
+		return err
+	}
+	return eng.execFile(expF)
+}
+
+func (eng *duckDBEmbeddedEngine) ExecFileLocal(fileName string) error {
+	return eng.execFileLocal(fileName)
+}
+
+func (eng *duckDBEmbeddedEngine) ExecFile(fileName string) error {
+	return eng.execFile(fileName)
+}
+
+func (se duckDBEmbeddedEngine) Exec(query string, varArgs ...interface{}) (sql.Result, error) {
+	return se.db.Exec(query, varArgs...)
+}
+
+func (se duckDBEmbeddedEngine) QueryRow(query string, varArgs ...interface{}) *sql.Row {
+	return se.db.QueryRow(query, varArgs...)
+}
+
+func (se duckDBEmbeddedEngine) ExecInTxn(queries []string) (int64, error) {
+	txn, err := se.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	var rowsAffected int64
+	for _, query := range queries {
+		res, err := txn.Exec(query)
+		if err != nil {
+			txn.Rollback()
+			return 0, err
+		}
+		if n, err := res.RowsAffected(); err == nil {
+			rowsAffected += n
+		}
+	}
+	err = txn.Commit()
+	if err != nil {
+		return 0, err
+	}
+	return rowsAffected, nil
+}
+
+func (se duckDBEmbeddedEngine) GetNextGenerationId() (int, error) {
+	se.ctrlMutex.Lock()
+	defer se.ctrlMutex.Unlock()
+	return se.getNextGenerationId()
+}
+
+func (se duckDBEmbeddedEngine) GetCurrentGenerationId() (int, error) {
+	se.ctrlMutex.Lock()
+	defer se.ctrlMutex.Unlock()
+	return se.getCurrentGenerationId()
+}
+
+func (se duckDBEmbeddedEngine) GetNextDiscoveryGenerationId(discoveryName string) (int, error) {
+	se.discoveryMutex.Lock()
+	defer se.discoveryMutex.Unlock()
+	return se.getNextProviderGenerationId(discoveryName)
+}
+
+func (se duckDBEmbeddedEngine) GetCurrentDiscoveryGenerationId(discoveryName string) (int, error) {
+	se.discoveryMutex.Lock()
+	defer se.discoveryMutex.Unlock()
+	return se.getCurrentProviderGenerationId(discoveryName)
+}
+
+func (se duckDBEmbeddedEngine) GetNextSessionId(generationId int) (int, error) {
+	se.sessionMutex.Lock()
+	defer se.sessionMutex.Unlock()
+	return se.getNextSessionId(generationId)
+}
+
+func (se duckDBEmbeddedEngine) GetCurrentSessionId(generationId int) (int, error) {
+	se.sessionMutex.Lock()
+	defer se.sessionMutex.Unlock()
+	return se.getCurrentSessionId(generationId)
+}
+
+func (se duckDBEmbeddedEngine) getCurrentGenerationId() (int, error) {
+	var retVal int
+	res := se.db.QueryRow(`SELECT lhs.iql_generation_id FROM "__iql__.control.generation" lhs INNER JOIN (SELECT max(created_dttm) AS max_dttm FROM "__iql__.control.generation" WHERE collected_dttm IS null) rhs ON  lhs.created_dttm = rhs.max_dttm WHERE lhs.collected_dttm IS null`)
+	err := res.Scan(&retVal)
+	return retVal, err
+}
+
+func (se duckDBEmbeddedEngine) getNextGenerationId() (int, error) {
+	var retVal int
+	res := se.db.QueryRow(`INSERT INTO "__iql__.control.generation" (generation_description, created_dttm) VALUES ('', CAST(current_timestamp AS TIMESTAMP)) RETURNING iql_generation_id`)
+	err := res.Scan(&retVal)
+	return retVal, err
+}
+
+func (se duckDBEmbeddedEngine) getCurrentProviderGenerationId(providerName string) (int, error) {
+	var retVal int
+	res := se.db.QueryRow(`SELECT lhs.iql_discovery_generation_id FROM "__iql__.control.discovery_generation" lhs INNER JOIN (SELECT discovery_name, max(created_dttm) AS max_dttm FROM "__iql__.control.discovery_generation" WHERE collected_dttm IS null GROUP BY discovery_name) rhs ON  lhs.created_dttm = rhs.max_dttm AND lhs.discovery_name = rhs.discovery_name WHERE lhs.collected_dttm IS null AND lhs.discovery_name = $1`, providerName)
+	err := res.Scan(&retVal)
+	return retVal, err
+}
+
+func (se duckDBEmbeddedEngine) getNextProviderGenerationId(providerName string) (int, error) {
+	var retVal int
+	res := se.db.QueryRow(`INSERT INTO "__iql__.control.discovery_generation" (discovery_name, created_dttm) VALUES ($1, CAST(current_timestamp AS TIMESTAMP)) RETURNING iql_discovery_generation_id`, providerName)
+	err := res.Scan(&retVal)
+	return retVal, err
+}
+
+func (se duckDBEmbeddedEngine) getCurrentSessionId(generationId int) (int, error) {
+	var retVal int
+	res := se.db.QueryRow(`SELECT lhs.iql_session_id FROM "__iql__.control.session" lhs INNER JOIN (SELECT iql_generation_id, max(created_dttm) AS max_dttm FROM "__iql__.control.session" WHERE collected_dttm IS null GROUP BY iql_generation_id) rhs ON  lhs.created_dttm = rhs.max_dttm AND lhs.iql_generation_id = rhs.iql_generation_id WHERE lhs.iql_generation_id = $1 AND lhs.collected_dttm IS null`, generationId)
+	err := res.Scan(&retVal)
+	return retVal, err
+}
+
+func (se duckDBEmbeddedEngine) getNextSessionId(generationId int) (int, error) {
+	var retVal int
+	res := se.db.QueryRow(`INSERT INTO "__iql__.control.session" (iql_generation_id, created_dttm) VALUES ($1, CAST(current_timestamp AS TIMESTAMP)) RETURNING iql_session_id`, generationId)
+	err := res.Scan(&retVal)
+	subsystem.GetLogger().Infoln(fmt.Sprintf("getNextSessionId(): generation id = %d, session id = %d", generationId, retVal))
+	return retVal, err
+}
+
+func (se duckDBEmbeddedEngine) CacheStoreGet(key string) ([]byte, error) {
+	var retVal []byte
+	res := se.db.QueryRow(`SELECT v FROM "__iql__.cache.key_val" WHERE k = $1`, key)
+	err := res.Scan(&retVal)
+	return retVal, err
+}
+
+func (se duckDBEmbeddedEngine) CacheStoreGetAll() ([]internaldto.KeyVal, error) {
+	var retVal []internaldto.KeyVal
+	res, err := se.db.Query(`SELECT k, v FROM "__iql__.cache.key_val"`)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+	for res.Next() {
+		var kv internaldto.KeyVal
+		err = res.Scan(&kv.K, &kv.V)
+		if err != nil {
+			return nil, err
+		}
+		retVal = append(retVal, kv)
+	}
+	return retVal, err
+}
+
+// DuckDB checks unique constraints eagerly, so that
+// delete and re-insert of a key in the one transaction fails.
+// Hence, upsert is used here.
+func (se duckDBEmbeddedEngine) CacheStorePut(key string, val []byte, tablespace string, tablespaceID int) error {
+	_, err := se.db.Exec(
+		`INSERT INTO "__iql__.cache.key_val" (k, v, tablespace, tablespace_id) VALUES($1, $2, $3, $4) ON CONFLICT (k) DO UPDATE SET v = excluded.v, tablespace = excluded.tablespace, tablespace_id = excluded.tablespace_id`,
+		key, val, tablespace, tablespaceID,
+	)
+	return err
+}
+
+func (se duckDBEmbeddedEngine) Query(query string, varArgs ...interface{}) (*sql.Rows, error) {
+	return se.db.Query(query, varArgs...)
+}
+
+func (se duckDBEmbeddedEngine) ExecContext(ctx context.Context, query string, varArgs ...interface{}) (sql.Result, error) {
+	return se.db.ExecContext(ctx, query, varArgs...)
+}
+
+func (se duckDBEmbeddedEngine) QueryContext(ctx context.Context, query string, varArgs ...interface{}) (*sql.Rows, error) {
+	return se.db.QueryContext(ctx, query, varArgs...)
+}
+
+func (se duckDBEmbeddedEngine) BeginBulkInsert(ctx context.Context, qualifiedTableName string, columnNames []string) (BulkInsertTxn, error) {
+	return newValuesBulkInsertTxn(ctx, se.db, nil, constants.SQLDbNameDuckDB, qualifiedTableName, columnNames)
+}
@@ -1,6 +1,7 @@
 package sqlengine
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"io/ioutil"
@@ -9,7 +10,6 @@ import (
 	"github.com/stackql/stackql/internal/stackql/db_util"
 	"github.com/stackql/stackql/internal/stackql/dto"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
-	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/sqlcontrol"
 	"github.com/stackql/stackql/internal/stackql/util"
 
@@ -112,20 +112,27 @@ func (se snowflakeTcpEngine) QueryRow(query string, varArgs ...interface{}) *sql
 	return res
 }
 
-func (se snowflakeTcpEngine) ExecInTxn(queries []string) error {
+func (se snowflakeTcpEngine) ExecInTxn(queries []string) (int64, error) {
 	txn, err := se.db.Begin()
 	if err != nil {
-		return err
+		return 0, err
 	}
+	var rowsAffected int64
 	for _, query := range queries {
-		_, err = txn.Exec(query)
+		res, err := txn.Exec(query)
 		if err != nil {
 			txn.Rollback()
-			return err
+			return 0, err
+		}
+		if n, err := res.RowsAffected(); err == nil {
+			rowsAffected += n
 		}
 	}
 	err = txn.Commit()
-	return err
+	if err != nil {
+		return 0, err
+	}
+	return rowsAffected, nil
 }
 
 func (se snowflakeTcpEngine) GetNextGenerationId() (int, error) {
@@ -203,7 +210,7 @@ func (se snowflakeTcpEngine) getNextSessionId(generationId int) (int, error) {
 	var retVal int
 	res := se.db.QueryRow(`INSERT INTO "__iql__.control.session" (iql_generation_id, created_dttm) VALUES ($1, current_timestamp) RETURNING iql_session_id`, generationId)
 	err := res.Scan(&retVal)
-	logging.GetLogger().Infoln(fmt.Sprintf("getNextSessionId(): generation id = %d, session id = %d", generationId, retVal))
+	subsystem.GetLogger().Infoln(fmt.Sprintf("getNextSessionId(): generation id = %d, session id = %d", generationId, retVal))
 	return retVal, err
 }
 
@@ -258,3 +265,15 @@ func (se snowflakeTcpEngine) query(query string, varArgs ...interface{}) (*sql.R
 	res, err := se.db.Query(query, varArgs...)
 	return res, err
 }
+
+func (se snowflakeTcpEngine) ExecContext(ctx context.Context, query string, varArgs ...interface{}) (sql.Result, error) {
+	return se.db.ExecContext(ctx, query, varArgs...)
+}
+
+func (se snowflakeTcpEngine) QueryContext(ctx context.Context, query string, varArgs ...interface{}) (*sql.Rows, error) {
+	return se.db.QueryContext(ctx, query, varArgs...)
+}
+
+func (se snowflakeTcpEngine) BeginBulkInsert(ctx context.Context, qualifiedTableName string, columnNames []string) (BulkInsertTxn, error) {
+	return newSnowflakeStageBulkInsertTxn(ctx, se.db, qualifiedTableName, columnNames)
+}
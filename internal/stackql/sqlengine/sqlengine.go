@@ -1,6 +1,7 @@
 package sqlengine
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
@@ -15,10 +16,13 @@ type SQLEngine interface {
 	GetTx() (*sql.Tx, error)
 	Exec(string, ...interface{}) (sql.Result, error)
 	Query(string, ...interface{}) (*sql.Rows, error)
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
 	QueryRow(query string, args ...any) *sql.Row
 	ExecFileLocal(string) error
 	ExecFile(string) error
-	ExecInTxn(queries []string) error
+	// ExecInTxn() executes queries in a single transaction and reports the count of rows affected.
+	ExecInTxn(queries []string) (int64, error)
 	GetCurrentGenerationId() (int, error)
 	GetNextGenerationId() (int, error)
 	GetCurrentSessionId(int) (int, error)
@@ -29,6 +33,7 @@ type SQLEngine interface {
 	CacheStoreGetAll() ([]internaldto.KeyVal, error)
 	CacheStorePut(string, []byte, string, int) error
 	IsMemory() bool
+	BeginBulkInsert(ctx context.Context, qualifiedTableName string, columnNames []string) (BulkInsertTxn, error)
 }
 
 func NewSQLEngine(cfg dto.SQLBackendCfg, controlAttributes sqlcontrol.ControlAttributes) (SQLEngine, error) {
@@ -39,6 +44,8 @@ func NewSQLEngine(cfg dto.SQLBackendCfg, controlAttributes sqlcontrol.ControlAtt
 		return newPostgresTcpEngine(cfg, controlAttributes)
 	case constants.SQLDialectSnowflake:
 		return newSnowflakeTcpEngine(cfg, controlAttributes)
+	case constants.DbEngineDuckDBEmbedded:
+		return newDuckDBEmbeddedEngine(cfg, controlAttributes)
 	default:
 		return nil, fmt.Errorf(`SQL backend DB Engine of type '%s' is not permitted`, cfg.DbEngine)
 	}
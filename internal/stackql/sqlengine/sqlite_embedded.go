@@ -1,12 +1,14 @@
 package sqlengine
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"io/ioutil"
 	"strings"
 	"sync"
 
+	"github.com/stackql/stackql/internal/stackql/constants"
 	"github.com/stackql/stackql/internal/stackql/dto"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
 	"github.com/stackql/stackql/internal/stackql/logging"
@@ -16,6 +18,8 @@ import (
 	_ "github.com/stackql/go-sqlite3"
 )
 
+const subsystem logging.Subsystem = "sqlengine"
+
 var (
 	_ SQLEngine = &sqLiteEmbeddedEngine{}
 )
@@ -27,6 +31,7 @@ type sqLiteEmbeddedEngine struct {
 	ctrlMutex         *sync.Mutex
 	sessionMutex      *sync.Mutex
 	discoveryMutex    *sync.Mutex
+	bulkInsertMutex   *sync.Mutex
 }
 
 func (se *sqLiteEmbeddedEngine) IsMemory() bool {
@@ -56,6 +61,7 @@ func newSQLiteEmbeddedEngine(cfg dto.SQLBackendCfg, controlAttributes sqlcontrol
 		ctrlMutex:         &sync.Mutex{},
 		sessionMutex:      &sync.Mutex{},
 		discoveryMutex:    &sync.Mutex{},
+		bulkInsertMutex:   &sync.Mutex{},
 	}
 	if err != nil {
 		return eng, err
@@ -66,7 +72,7 @@ func newSQLiteEmbeddedEngine(cfg dto.SQLBackendCfg, controlAttributes sqlcontrol
 	if err != nil {
 		return eng, err
 	}
-	logging.GetLogger().Infoln(fmt.Sprintf("opened db with file = '%s' and err  = '%v'", dsn, err))
+	subsystem.GetLogger().Infoln(fmt.Sprintf("opened db with file = '%s' and err  = '%v'", dsn, err))
 	if err != nil {
 		return eng, err
 	}
@@ -105,20 +111,27 @@ func (se sqLiteEmbeddedEngine) Exec(query string, varArgs ...interface{}) (sql.R
 	return res, err
 }
 
-func (se sqLiteEmbeddedEngine) ExecInTxn(queries []string) error {
+func (se sqLiteEmbeddedEngine) ExecInTxn(queries []string) (int64, error) {
 	txn, err := se.db.Begin()
 	if err != nil {
-		return err
+		return 0, err
 	}
+	var rowsAffected int64
 	for _, query := range queries {
-		_, err = txn.Exec(query)
+		res, err := txn.Exec(query)
 		if err != nil {
 			txn.Rollback()
-			return err
+			return 0, err
+		}
+		if n, err := res.RowsAffected(); err == nil {
+			rowsAffected += n
 		}
 	}
 	err = txn.Commit()
-	return err
+	if err != nil {
+		return 0, err
+	}
+	return rowsAffected, nil
 }
 
 func (se sqLiteEmbeddedEngine) GetNextGenerationId() (int, error) {
@@ -201,7 +214,7 @@ func (se sqLiteEmbeddedEngine) getNextSessionId(generationId int) (int, error) {
 	var retVal int
 	res := se.db.QueryRow(`INSERT INTO "__iql__.control.session" (iql_generation_id, created_dttm) VALUES (?, strftime('%s', 'now')) RETURNING iql_session_id`, generationId)
 	err := res.Scan(&retVal)
-	logging.GetLogger().Infoln(fmt.Sprintf("getNextSessionId(): generation id = %d, session id = %d", generationId, retVal))
+	subsystem.GetLogger().Infoln(fmt.Sprintf("getNextSessionId(): generation id = %d, session id = %d", generationId, retVal))
 	return retVal, err
 }
 
@@ -258,3 +271,17 @@ func (se sqLiteEmbeddedEngine) query(query string, varArgs ...interface{}) (*sql
 	// logging.GetLogger().Infoln(fmt.Sprintf("res= %v, err = %v", res, err))
 	return res, err
 }
+
+func (se sqLiteEmbeddedEngine) ExecContext(ctx context.Context, query string, varArgs ...interface{}) (sql.Result, error) {
+	return se.db.ExecContext(ctx, query, varArgs...)
+}
+
+func (se sqLiteEmbeddedEngine) QueryContext(ctx context.Context, query string, varArgs ...interface{}) (*sql.Rows, error) {
+	return se.db.QueryContext(ctx, query, varArgs...)
+}
+
+// Shared cache SQLite takes table level write locks,
+// so bulk insert transactions are serialized.
+func (se sqLiteEmbeddedEngine) BeginBulkInsert(ctx context.Context, qualifiedTableName string, columnNames []string) (BulkInsertTxn, error) {
+	return newValuesBulkInsertTxn(ctx, se.db, se.bulkInsertMutex, constants.SQLDbNameSQLite, qualifiedTableName, columnNames)
+}
@@ -0,0 +1,37 @@
+package sqlengine
+
+import (
+	"database/sql"
+	"testing"
+)
+
+func TestSQLiteExecInTxn(t *testing.T) {
+	db, err := sql.Open("sqlite3", "file::memory:?cache=shared")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer db.Close()
+	se := sqLiteEmbeddedEngine{db: db}
+	rowsAffected, err := se.ExecInTxn([]string{
+		`CREATE TABLE t (a INTEGER)`,
+		`INSERT INTO t (a) VALUES (1), (2), (3)`,
+		`DELETE FROM t WHERE a < 3`,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rowsAffected != 5 {
+		t.Fatalf("ExecInTxn() rows affected = %d, want 5", rowsAffected)
+	}
+	rowsAffected, err = se.ExecInTxn([]string{`DELETE FROM t`, `DELETE FROM absent`})
+	if err == nil || rowsAffected != 0 {
+		t.Fatalf("ExecInTxn() of a failing query = (%d, %v)", rowsAffected, err)
+	}
+	var count int
+	if err := db.QueryRow(`SELECT count(*) FROM t`).Scan(&count); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count != 1 {
+		t.Fatalf("ExecInTxn() of a failing query did not roll back: %d rows remain, want 1", count)
+	}
+}
@@ -0,0 +1,273 @@
+package sqlexport_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jeroenrinzema/psql-wire/pkg/sqldata"
+	"github.com/lib/pq/oid"
+
+	"github.com/stackql/stackql/internal/stackql/sqlexport"
+)
+
+func TestSplitKeys(t *testing.T) {
+	testCases := []struct {
+		keys string
+		want []string
+	}{
+		{keys: ""},
+		{keys: "id", want: []string{"id"}},
+		{keys: " id , zone ,, ", want: []string{"id", "zone"}},
+	}
+	for _, tc := range testCases {
+		got := sqlexport.SplitKeys(tc.keys)
+		if strings.Join(got, "|") != strings.Join(tc.want, "|") {
+			t.Fatalf("SplitKeys(%q) = %q, want %q", tc.keys, got, tc.want)
+		}
+	}
+}
+
+func TestNewDataSource(t *testing.T) {
+	testCases := []struct {
+		name    string
+		dsn     string
+		wantErr bool
+	}{
+		{name: "sqlite", dsn: "sqlite://" + filepath.Join(t.TempDir(), "x.db")},
+		{name: "no scheme", dsn: "/tmp/x.db", wantErr: true},
+		{name: "unsupported scheme", dsn: "oracle://host/db", wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := sqlexport.NewDataSource(tc.dsn)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("NewDataSource(%q) error = %v, want error %t", tc.dsn, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewExporter(t *testing.T) {
+	sqlDataSource, err := sqlexport.NewDataSource("sqlite://" + filepath.Join(t.TempDir(), "x.db"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	testCases := []struct {
+		name      string
+		tableName string
+		mode      string
+		keys      []string
+		wantErr   bool
+	}{
+		{name: "default mode", tableName: "t"},
+		{name: "qualified", tableName: "main.t", mode: sqlexport.ModeReplace},
+		{name: "upsert", tableName: "t", mode: sqlexport.ModeUpsert, keys: []string{"id"}},
+		{name: "over qualified", tableName: "a.b.t", wantErr: true},
+		{name: "empty part", tableName: "a.", wantErr: true},
+		{name: "unknown mode", tableName: "t", mode: "merge", wantErr: true},
+		{name: "upsert without keys", tableName: "t", mode: sqlexport.ModeUpsert, wantErr: true},
+		{name: "keys without upsert", tableName: "t", mode: sqlexport.ModeAppend, keys: []string{"id"}, wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := sqlexport.NewExporter(sqlDataSource, tc.tableName, tc.mode, tc.keys)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("NewExporter() error = %v, want error %t", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+// newResultStream presents rows as text, as do staged results,
+// save for the integer id.
+func newResultStream(rows ...[]interface{}) sqldata.ISQLResultStream {
+	table := sqldata.NewSQLTable(0, "t")
+	columns := []sqldata.ISQLColumn{
+		sqldata.NewSQLColumn(table, "id", 0, uint32(oid.T_int8), 8, 0, "text"),
+		sqldata.NewSQLColumn(table, "name", 0, uint32(oid.T_text), -1, 0, "text"),
+	}
+	var sqlRows []sqldata.ISQLRow
+	for _, row := range rows {
+		sqlRows = append(sqlRows, sqldata.NewSQLRow(row))
+	}
+	return sqldata.NewSimpleSQLResultStream(sqldata.NewSQLResult(columns, 0, 0, sqlRows))
+}
+
+func readTable(t *testing.T, fileName string) []string {
+	t.Helper()
+	db, err := sql.Open("sqlite3", fileName)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer db.Close()
+	r, err := db.Query(`SELECT "id", "name" FROM "t" ORDER BY "id"`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer r.Close()
+	var rv []string
+	for r.Next() {
+		var id int64
+		var name sql.NullString
+		if err := r.Scan(&id, &name); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		rv = append(rv, fmt.Sprintf("%d:%s", id, name.String))
+	}
+	return rv
+}
+
+func TestExport(t *testing.T) {
+	testCases := []struct {
+		name      string
+		mode      string
+		keys      []string
+		exports   [][][]interface{}
+		wantCount int
+		wantRows  []string
+		wantErr   bool
+	}{
+		{
+			name: "append accumulates",
+			exports: [][][]interface{}{
+				{{int64(1), "a"}, {int64(2), "b"}},
+				{{int64(1), "c"}},
+			},
+			wantCount: 1,
+			wantRows:  []string{"1:a", "1:c", "2:b"},
+		},
+		{
+			name: "replace clears upon first export only",
+			mode: sqlexport.ModeReplace,
+			exports: [][][]interface{}{
+				{{int64(1), "a"}, {int64(2), "b"}},
+				{{int64(3), "c"}},
+			},
+			wantCount: 1,
+			wantRows:  []string{"1:a", "2:b", "3:c"},
+		},
+		{
+			name: "upsert replaces rows by key",
+			mode: sqlexport.ModeUpsert,
+			keys: []string{"id"},
+			exports: [][][]interface{}{
+				{{int64(1), "a"}, {int64(2), "b"}},
+				{{int64(2), "x"}, {int64(3), "c"}, {int64(3), "y"}},
+			},
+			wantCount: 2,
+			wantRows:  []string{"1:a", "2:x", "3:y"},
+		},
+		{
+			name:    "upsert refuses null keys",
+			mode:    sqlexport.ModeUpsert,
+			keys:    []string{"id"},
+			exports: [][][]interface{}{{{nil, "a"}}},
+			wantErr: true,
+		},
+		{
+			name:    "upsert refuses unknown keys",
+			mode:    sqlexport.ModeUpsert,
+			keys:    []string{"zone"},
+			exports: [][][]interface{}{{{int64(1), "a"}}},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fileName := filepath.Join(t.TempDir(), "x.db")
+			sqlDataSource, err := sqlexport.NewDataSource("sqlite://" + fileName)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			exporter, err := sqlexport.NewExporter(sqlDataSource, "t", tc.mode, tc.keys)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var count int
+			for _, rows := range tc.exports {
+				count, err = exporter.Export(context.Background(), newResultStream(rows...))
+				if err != nil {
+					break
+				}
+			}
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Export() error = %v, want error %t", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if count != tc.wantCount {
+				t.Fatalf("Export() = %d, want %d", count, tc.wantCount)
+			}
+			if got := readTable(t, fileName); strings.Join(got, ",") != strings.Join(tc.wantRows, ",") {
+				t.Fatalf("exported rows = %q, want %q", got, tc.wantRows)
+			}
+		})
+	}
+}
+
+func TestExportReplaceExisting(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "x.db")
+	sqlDataSource, err := sqlexport.NewDataSource("sqlite://" + fileName)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, rows := range [][][]interface{}{{{int64(1), "a"}}, {{int64(2), "b"}}} {
+		exporter, err := sqlexport.NewExporter(sqlDataSource, "t", sqlexport.ModeReplace, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := exporter.Export(context.Background(), newResultStream(rows...)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if got := readTable(t, fileName); strings.Join(got, ",") != "2:b" {
+		t.Fatalf("exported rows = %q, want replaced", got)
+	}
+}
+
+// cancellingResultStream cancels the query upon being drained,
+// as would a cancel request arriving once results are read.
+type cancellingResultStream struct {
+	sqldata.ISQLResultStream
+	cancel context.CancelFunc
+}
+
+func (s *cancellingResultStream) Read() (sqldata.ISQLResult, error) {
+	s.cancel()
+	return s.ISQLResultStream.Read()
+}
+
+func TestExportCancelled(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "x.db")
+	sqlDataSource, err := sqlexport.NewDataSource("sqlite://" + fileName)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	exporter, err := sqlexport.NewExporter(sqlDataSource, "t", sqlexport.ModeAppend, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := exporter.Export(context.Background(), newResultStream([]interface{}{int64(1), "a"})); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, mode := range []string{sqlexport.ModeReplace, sqlexport.ModeAppend} {
+		exporter, err := sqlexport.NewExporter(sqlDataSource, "t", mode, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		res := &cancellingResultStream{ISQLResultStream: newResultStream([]interface{}{int64(2), "b"}), cancel: cancel}
+		if _, err := exporter.Export(ctx, res); !errors.Is(err, context.Canceled) {
+			t.Fatalf("Export() error = %v, want %v", err, context.Canceled)
+		}
+		if got := readTable(t, fileName); strings.Join(got, ",") != "1:a" {
+			t.Fatalf("exported rows after cancellation in mode '%s' = %q, want none committed", mode, got)
+		}
+	}
+}
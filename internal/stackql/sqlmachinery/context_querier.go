@@ -0,0 +1,28 @@
+package sqlmachinery
+
+import (
+	"context"
+	"database/sql"
+)
+
+type ContextQuerier interface {
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+}
+
+// NewContextQuerier binds a context to a Querier,
+// so that cancelling the query context aborts the statement.
+func NewContextQuerier(ctx context.Context, querier ContextQuerier) Querier {
+	return &contextQuerier{
+		ctx:     ctx,
+		querier: querier,
+	}
+}
+
+type contextQuerier struct {
+	ctx     context.Context
+	querier ContextQuerier
+}
+
+func (cq *contextQuerier) Query(query string, varArgs ...interface{}) (*sql.Rows, error) {
+	return cq.querier.QueryContext(cq.ctx, query, varArgs...)
+}
@@ -0,0 +1,673 @@
+package sqlpushdown
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/constants"
+)
+
+const (
+	classUnknown = iota
+	classNull
+	classNumeric
+	classText
+	classBoolean
+)
+
+// Table describes a table expression that is a candidate for pushdown.
+// Columns maps lower cased column names to their portable relational type.
+type Table struct {
+	Node    *sqlparser.AliasedTableExpr
+	Name    string
+	Alias   string
+	Columns map[string]string
+}
+
+// IsSupportedDialect reports whether queries can be rendered
+// for the nominated sql data source database.
+func IsSupportedDialect(dbName string) bool {
+	switch dbName {
+	case constants.SQLDbNamePostgres, constants.SQLDbNameMySQL, constants.SQLDbNameSQLServer, constants.SQLDbNameSnowflake, constants.SQLDbNameDuckDB:
+		return true
+	default:
+		return false
+	}
+}
+
+func QuoteIdentifier(dbName string, identifier string) string {
+	switch dbName {
+	case constants.SQLDbNameSnowflake:
+		return identifier
+	case constants.SQLDbNameMySQL:
+		return fmt.Sprintf("`%s`", strings.ReplaceAll(identifier, "`", "``"))
+	case constants.SQLDbNameSQLServer:
+		return fmt.Sprintf("[%s]", strings.ReplaceAll(identifier, "]", "]]"))
+	default:
+		return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+	}
+}
+
+// Output aliases are always delimited, so that column names
+// survive databases which fold unquoted identifiers.
+func quoteAlias(dbName string, alias string) string {
+	switch dbName {
+	case constants.SQLDbNameSnowflake:
+		return fmt.Sprintf(`"%s"`, strings.ReplaceAll(alias, `"`, `""`))
+	default:
+		return QuoteIdentifier(dbName, alias)
+	}
+}
+
+// RenderTableQuery renders the acquisition query for a single table.
+// A negative limit is not rendered.
+func RenderTableQuery(dbName string, tableName string, columns []string, predicates []string, limit int) string {
+	var colNames []string
+	for _, col := range columns {
+		colNames = append(colNames, QuoteIdentifier(dbName, col))
+	}
+	var sb strings.Builder
+	sb.WriteString("SELECT ")
+	if limit >= 0 && dbName == constants.SQLDbNameSQLServer {
+		sb.WriteString(fmt.Sprintf("TOP %d ", limit))
+	}
+	sb.WriteString(strings.Join(colNames, ", "))
+	sb.WriteString(" FROM ")
+	sb.WriteString(tableName)
+	if len(predicates) > 0 {
+		sb.WriteString(" WHERE ")
+		sb.WriteString(strings.Join(predicates, " AND "))
+	}
+	if limit >= 0 && dbName != constants.SQLDbNameSQLServer {
+		sb.WriteString(fmt.Sprintf(" LIMIT %d", limit))
+	}
+	return sb.String()
+}
+
+// SplitConjuncts flattens top level AND expressions.
+func SplitConjuncts(expr sqlparser.Expr) []sqlparser.Expr {
+	switch expr := expr.(type) {
+	case nil:
+		return nil
+	case *sqlparser.AndExpr:
+		return append(SplitConjuncts(expr.Left), SplitConjuncts(expr.Right)...)
+	default:
+		return []sqlparser.Expr{expr}
+	}
+}
+
+// RenderTableFilter renders those conjuncts of where that refer only
+// to the supplied table, with column qualifiers removed.
+// Pushed predicates never exclude rows that the local predicate admits;
+// they are retained locally and so may admit more.
+// The exact return reports whether the rendered predicates
+// admit precisely the locally admitted rows,
+// and complete reports whether every conjunct was rendered.
+func RenderTableFilter(dbName string, where sqlparser.Expr, table Table, allowUnqualified bool) ([]string, bool, bool) {
+	var rv []string
+	exact := true
+	complete := true
+	for _, conjunct := range SplitConjuncts(where) {
+		r := &renderer{
+			dbName:           dbName,
+			tables:           []Table{table},
+			allowUnqualified: allowUnqualified,
+			isFilter:         true,
+		}
+		rendered, _, ok := r.renderExpr(conjunct)
+		if !ok {
+			complete = false
+			continue
+		}
+		if r.isInexact {
+			exact = false
+		}
+		rv = append(rv, rendered)
+	}
+	return rv, exact, complete
+}
+
+// RenderSelect renders an entire select statement,
+// all of whose tables are supplied.
+// The boolean return is false where any part
+// of the statement cannot be pushed down.
+func RenderSelect(dbName string, sel *sqlparser.Select, tables []Table) (string, bool) {
+	r := &renderer{
+		dbName:           dbName,
+		tables:           tables,
+		allowUnqualified: true,
+		isQualified:      true,
+	}
+	return r.renderSelect(sel)
+}
+
+type renderer struct {
+	dbName           string
+	tables           []Table
+	allowUnqualified bool
+	isQualified      bool
+	isFilter         bool
+	isInexact        bool
+}
+
+func (r *renderer) isCaseInsensitive() bool {
+	return r.dbName == constants.SQLDbNameMySQL || r.dbName == constants.SQLDbNameSQLServer
+}
+
+func (r *renderer) renderSelect(sel *sqlparser.Select) (string, bool) {
+	if sel.Lock != "" || sel.StraightJoinHint || sel.SQLCalcFoundRows || sel.Cache != nil {
+		return "", false
+	}
+	var sb strings.Builder
+	sb.WriteString("SELECT ")
+	if sel.Distinct {
+		sb.WriteString("DISTINCT ")
+	}
+	limitStr := ""
+	if sel.Limit != nil {
+		rowCount, ok := renderIntLiteral(sel.Limit.Rowcount)
+		if !ok {
+			return "", false
+		}
+		offset := ""
+		if sel.Limit.Offset != nil {
+			offset, ok = renderIntLiteral(sel.Limit.Offset)
+			if !ok {
+				return "", false
+			}
+		}
+		if r.dbName == constants.SQLDbNameSQLServer {
+			if offset != "" {
+				return "", false
+			}
+			sb.WriteString(fmt.Sprintf("TOP %s ", rowCount))
+		} else {
+			limitStr = fmt.Sprintf(" LIMIT %s", rowCount)
+			if offset != "" {
+				limitStr = fmt.Sprintf("%s OFFSET %s", limitStr, offset)
+			}
+		}
+	}
+	var projection []string
+	for _, selectExpr := range sel.SelectExprs {
+		switch selectExpr := selectExpr.(type) {
+		case *sqlparser.StarExpr:
+			if len(r.tables) != 1 || !selectExpr.TableName.IsEmpty() {
+				return "", false
+			}
+			projection = append(projection, "*")
+		case *sqlparser.AliasedExpr:
+			rendered, _, ok := r.renderExpr(selectExpr.Expr)
+			if !ok {
+				return "", false
+			}
+			alias := selectExpr.As.GetRawVal()
+			if alias == "" {
+				switch expr := selectExpr.Expr.(type) {
+				case *sqlparser.ColName:
+					alias = expr.Name.GetRawVal()
+				default:
+					alias = sqlparser.String(expr)
+				}
+			}
+			projection = append(projection, fmt.Sprintf("%s AS %s", rendered, quoteAlias(r.dbName, alias)))
+		default:
+			return "", false
+		}
+	}
+	sb.WriteString(strings.Join(projection, ", "))
+	if len(sel.From) != 1 {
+		return "", false
+	}
+	from, ok := r.renderTableExpr(sel.From[0])
+	if !ok {
+		return "", false
+	}
+	sb.WriteString(" FROM ")
+	sb.WriteString(from)
+	if sel.Where != nil && sel.Where.Expr != nil {
+		where, _, ok := r.renderExpr(sel.Where.Expr)
+		if !ok {
+			return "", false
+		}
+		sb.WriteString(" WHERE ")
+		sb.WriteString(where)
+	}
+	if len(sel.GroupBy) > 0 {
+		var groupings []string
+		for _, expr := range sel.GroupBy {
+			rendered, _, ok := r.renderExpr(expr)
+			if !ok {
+				return "", false
+			}
+			groupings = append(groupings, rendered)
+		}
+		sb.WriteString(" GROUP BY ")
+		sb.WriteString(strings.Join(groupings, ", "))
+	}
+	if sel.Having != nil && sel.Having.Expr != nil {
+		having, _, ok := r.renderExpr(sel.Having.Expr)
+		if !ok {
+			return "", false
+		}
+		sb.WriteString(" HAVING ")
+		sb.WriteString(having)
+	}
+	if len(sel.OrderBy) > 0 {
+		var orderings []string
+		for _, order := range sel.OrderBy {
+			rendered, _, ok := r.renderExpr(order.Expr)
+			if !ok {
+				return "", false
+			}
+			orderings = append(orderings, fmt.Sprintf("%s %s", rendered, strings.ToUpper(order.Direction)))
+		}
+		sb.WriteString(" ORDER BY ")
+		sb.WriteString(strings.Join(orderings, ", "))
+	}
+	sb.WriteString(limitStr)
+	return sb.String(), true
+}
+
+func (r *renderer) renderTableExpr(tableExpr sqlparser.TableExpr) (string, bool) {
+	switch tableExpr := tableExpr.(type) {
+	case *sqlparser.AliasedTableExpr:
+		for _, tbl := range r.tables {
+			if tbl.Node == tableExpr {
+				return fmt.Sprintf("%s AS %s", tbl.Name, QuoteIdentifier(r.dbName, tbl.Alias)), true
+			}
+		}
+		return "", false
+	case *sqlparser.ParenTableExpr:
+		if len(tableExpr.Exprs) != 1 {
+			return "", false
+		}
+		rendered, ok := r.renderTableExpr(tableExpr.Exprs[0])
+		if !ok {
+			return "", false
+		}
+		return fmt.Sprintf("(%s)", rendered), true
+	case *sqlparser.JoinTableExpr:
+		var joinStr string
+		switch tableExpr.Join {
+		case sqlparser.JoinStr:
+			joinStr = "INNER JOIN"
+		case sqlparser.LeftJoinStr:
+			joinStr = "LEFT JOIN"
+		case sqlparser.RightJoinStr:
+			joinStr = "RIGHT JOIN"
+		default:
+			return "", false
+		}
+		if tableExpr.Condition.On == nil || len(tableExpr.Condition.Using) > 0 {
+			return "", false
+		}
+		lhs, ok := r.renderTableExpr(tableExpr.LeftExpr)
+		if !ok {
+			return "", false
+		}
+		rhs, ok := r.renderTableExpr(tableExpr.RightExpr)
+		if !ok {
+			return "", false
+		}
+		on, _, ok := r.renderExpr(tableExpr.Condition.On)
+		if !ok {
+			return "", false
+		}
+		return fmt.Sprintf("%s %s %s ON %s", lhs, joinStr, rhs, on), true
+	default:
+		return "", false
+	}
+}
+
+func (r *renderer) resolveColumn(col *sqlparser.ColName) (string, int, bool) {
+	colName := strings.ToLower(col.Name.GetRawVal())
+	var matched []Table
+	if col.Qualifier.IsEmpty() {
+		if !r.allowUnqualified {
+			return "", classUnknown, false
+		}
+		for _, tbl := range r.tables {
+			if _, ok := tbl.Columns[colName]; ok {
+				matched = append(matched, tbl)
+			}
+		}
+	} else {
+		qualifier := col.Qualifier.Name.GetRawVal()
+		for _, tbl := range r.tables {
+			if strings.EqualFold(tbl.Alias, qualifier) {
+				matched = append(matched, tbl)
+			}
+		}
+	}
+	if len(matched) != 1 {
+		return "", classUnknown, false
+	}
+	relationalType, ok := matched[0].Columns[colName]
+	if !ok {
+		return "", classUnknown, false
+	}
+	rendered := QuoteIdentifier(r.dbName, col.Name.GetRawVal())
+	if r.isQualified {
+		rendered = fmt.Sprintf("%s.%s", QuoteIdentifier(r.dbName, matched[0].Alias), rendered)
+	}
+	return rendered, getTypeClass(relationalType), true
+}
+
+func getTypeClass(relationalType string) int {
+	switch strings.ToLower(relationalType) {
+	case "bigint", "int", "integer", "smallint", "tinyint", "numeric", "decimal", "real", "double", "double precision", "float":
+		return classNumeric
+	case "text", "varchar", "char", "character varying", "string":
+		return classText
+	case "boolean", "bool":
+		return classBoolean
+	default:
+		return classUnknown
+	}
+}
+
+func areComparable(lhs, rhs int) bool {
+	if lhs == classUnknown || rhs == classUnknown {
+		return false
+	}
+	return lhs == rhs || lhs == classNull || rhs == classNull
+}
+
+func renderIntLiteral(expr sqlparser.Expr) (string, bool) {
+	val, ok := expr.(*sqlparser.SQLVal)
+	if !ok || val.Type != sqlparser.IntVal {
+		return "", false
+	}
+	return string(val.Val), true
+}
+
+func (r *renderer) renderStringLiteral(s string) string {
+	s = strings.ReplaceAll(s, `'`, `''`)
+	if r.dbName == constants.SQLDbNameMySQL {
+		s = strings.ReplaceAll(s, `\`, `\\`)
+	}
+	return fmt.Sprintf("'%s'", s)
+}
+
+func (r *renderer) renderExpr(expr sqlparser.Expr) (string, int, bool) {
+	switch expr := expr.(type) {
+	case *sqlparser.ColName:
+		return r.resolveColumn(expr)
+	case *sqlparser.SQLVal:
+		switch expr.Type {
+		case sqlparser.StrVal:
+			return r.renderStringLiteral(string(expr.Val)), classText, true
+		case sqlparser.IntVal, sqlparser.FloatVal:
+			return string(expr.Val), classNumeric, true
+		default:
+			return "", classUnknown, false
+		}
+	case *sqlparser.NullVal:
+		return "NULL", classNull, true
+	case sqlparser.BoolVal:
+		if r.dbName == constants.SQLDbNameSQLServer {
+			return "", classUnknown, false
+		}
+		if expr {
+			return "TRUE", classBoolean, true
+		}
+		return "FALSE", classBoolean, true
+	case *sqlparser.AndExpr:
+		return r.renderLogical("AND", expr.Left, expr.Right)
+	case *sqlparser.OrExpr:
+		return r.renderLogical("OR", expr.Left, expr.Right)
+	case *sqlparser.NotExpr:
+		// Negation would invert any widening of a filter.
+		if r.isFilter {
+			return "", classUnknown, false
+		}
+		rendered, _, ok := r.renderExpr(expr.Expr)
+		if !ok {
+			return "", classUnknown, false
+		}
+		return fmt.Sprintf("NOT (%s)", rendered), classBoolean, true
+	case *sqlparser.ComparisonExpr:
+		return r.renderComparison(expr)
+	case *sqlparser.RangeCond:
+		lhs, lhsClass, ok := r.renderExpr(expr.Left)
+		if !ok {
+			return "", classUnknown, false
+		}
+		from, fromClass, ok := r.renderExpr(expr.From)
+		if !ok || !areComparable(lhsClass, fromClass) {
+			return "", classUnknown, false
+		}
+		to, toClass, ok := r.renderExpr(expr.To)
+		if !ok || !areComparable(lhsClass, toClass) {
+			return "", classUnknown, false
+		}
+		if r.isFilter && lhsClass != classNumeric {
+			return "", classUnknown, false
+		}
+		switch expr.Operator {
+		case sqlparser.BetweenStr:
+			return fmt.Sprintf("%s BETWEEN %s AND %s", lhs, from, to), classBoolean, true
+		case sqlparser.NotBetweenStr:
+			return fmt.Sprintf("%s NOT BETWEEN %s AND %s", lhs, from, to), classBoolean, true
+		default:
+			return "", classUnknown, false
+		}
+	case *sqlparser.IsExpr:
+		rendered, _, ok := r.renderExpr(expr.Expr)
+		if !ok {
+			return "", classUnknown, false
+		}
+		switch expr.Operator {
+		case sqlparser.IsNullStr:
+			return fmt.Sprintf("%s IS NULL", rendered), classBoolean, true
+		case sqlparser.IsNotNullStr:
+			return fmt.Sprintf("%s IS NOT NULL", rendered), classBoolean, true
+		default:
+			return "", classUnknown, false
+		}
+	case *sqlparser.BinaryExpr:
+		switch expr.Operator {
+		case sqlparser.PlusStr, sqlparser.MinusStr, sqlparser.MultStr:
+		default:
+			return "", classUnknown, false
+		}
+		lhs, lhsClass, ok := r.renderExpr(expr.Left)
+		if !ok || lhsClass != classNumeric {
+			return "", classUnknown, false
+		}
+		rhs, rhsClass, ok := r.renderExpr(expr.Right)
+		if !ok || rhsClass != classNumeric {
+			return "", classUnknown, false
+		}
+		return fmt.Sprintf("(%s %s %s)", lhs, expr.Operator, rhs), classNumeric, true
+	case *sqlparser.UnaryExpr:
+		if expr.Operator != sqlparser.UMinusStr {
+			return "", classUnknown, false
+		}
+		rendered, class, ok := r.renderExpr(expr.Expr)
+		if !ok || class != classNumeric {
+			return "", classUnknown, false
+		}
+		return fmt.Sprintf("-%s", rendered), classNumeric, true
+	case *sqlparser.FuncExpr:
+		if r.isFilter {
+			return "", classUnknown, false
+		}
+		return r.renderFunc(expr)
+	default:
+		return "", classUnknown, false
+	}
+}
+
+func (r *renderer) renderLogical(operator string, left, right sqlparser.Expr) (string, int, bool) {
+	lhs, _, ok := r.renderExpr(left)
+	if !ok {
+		return "", classUnknown, false
+	}
+	rhs, _, ok := r.renderExpr(right)
+	if !ok {
+		return "", classUnknown, false
+	}
+	// The parser does not retain parentheses; AND binds
+	// more tightly than OR, so only the latter is delimited.
+	if operator == "OR" {
+		return fmt.Sprintf("(%s %s %s)", lhs, operator, rhs), classBoolean, true
+	}
+	return fmt.Sprintf("%s %s %s", lhs, operator, rhs), classBoolean, true
+}
+
+func (r *renderer) renderComparison(expr *sqlparser.ComparisonExpr) (string, int, bool) {
+	if expr.Escape != nil {
+		return "", classUnknown, false
+	}
+	lhs, lhsClass, ok := r.renderExpr(expr.Left)
+	if !ok {
+		return "", classUnknown, false
+	}
+	var rhs string
+	var rhsClass int
+	switch right := expr.Right.(type) {
+	case sqlparser.ValTuple:
+		var elements []string
+		for _, element := range right {
+			rendered, class, ok := r.renderExpr(element)
+			if !ok || !areComparable(lhsClass, class) {
+				return "", classUnknown, false
+			}
+			elements = append(elements, rendered)
+		}
+		if len(elements) == 0 {
+			return "", classUnknown, false
+		}
+		rhs, rhsClass = fmt.Sprintf("(%s)", strings.Join(elements, ", ")), lhsClass
+	default:
+		rhs, rhsClass, ok = r.renderExpr(right)
+		if !ok {
+			return "", classUnknown, false
+		}
+	}
+	if !areComparable(lhsClass, rhsClass) {
+		return "", classUnknown, false
+	}
+	isText := lhsClass == classText || rhsClass == classText
+	var operator string
+	switch expr.Operator {
+	case sqlparser.EqualStr, sqlparser.InStr:
+		operator = strings.ToUpper(expr.Operator)
+		if r.isFilter && isText && r.isCaseInsensitive() {
+			r.isInexact = true
+		}
+	case sqlparser.NotEqualStr, sqlparser.NotInStr:
+		operator = "<>"
+		if expr.Operator == sqlparser.NotInStr {
+			operator = "NOT IN"
+		}
+		if r.isFilter && isText && r.isCaseInsensitive() {
+			return "", classUnknown, false
+		}
+	case sqlparser.LessThanStr, sqlparser.GreaterThanStr, sqlparser.LessEqualStr, sqlparser.GreaterEqualStr:
+		operator = expr.Operator
+		// Collation of text differs between databases.
+		if r.isFilter && isText {
+			return "", classUnknown, false
+		}
+	case sqlparser.LikeStr:
+		if lhsClass != classText {
+			return "", classUnknown, false
+		}
+		operator = "LIKE"
+		if r.isFilter {
+			// The local backend may match case insensitively,
+			// so the remote match must be at least as wide.
+			r.isInexact = true
+			if !r.isCaseInsensitive() {
+				operator = "ILIKE"
+			}
+		}
+	case sqlparser.NotLikeStr:
+		if lhsClass != classText || r.isFilter {
+			return "", classUnknown, false
+		}
+		operator = "NOT LIKE"
+	default:
+		return "", classUnknown, false
+	}
+	return fmt.Sprintf("%s %s %s", lhs, operator, rhs), classBoolean, true
+}
+
+func (r *renderer) renderFunc(expr *sqlparser.FuncExpr) (string, int, bool) {
+	if !expr.Qualifier.IsEmpty() {
+		return "", classUnknown, false
+	}
+	funcName := expr.Name.Lowered()
+	if expr.Distinct && funcName != "count" {
+		return "", classUnknown, false
+	}
+	if funcName == "count" && len(expr.Exprs) == 1 {
+		if star, isStar := expr.Exprs[0].(*sqlparser.StarExpr); isStar {
+			if !star.TableName.IsEmpty() || expr.Distinct {
+				return "", classUnknown, false
+			}
+			return "COUNT(*)", classNumeric, true
+		}
+	}
+	var args []string
+	var argClasses []int
+	for _, arg := range expr.Exprs {
+		aliased, ok := arg.(*sqlparser.AliasedExpr)
+		if !ok || !aliased.As.IsEmpty() {
+			return "", classUnknown, false
+		}
+		rendered, class, ok := r.renderExpr(aliased.Expr)
+		if !ok {
+			return "", classUnknown, false
+		}
+		args = append(args, rendered)
+		argClasses = append(argClasses, class)
+	}
+	argStr := strings.Join(args, ", ")
+	if expr.Distinct {
+		argStr = fmt.Sprintf("DISTINCT %s", argStr)
+	}
+	rendered := fmt.Sprintf("%s(%s)", strings.ToUpper(funcName), argStr)
+	switch funcName {
+	case "count":
+		if len(args) != 1 {
+			return "", classUnknown, false
+		}
+		return rendered, classNumeric, true
+	case "sum", "avg", "abs":
+		if len(args) != 1 || argClasses[0] != classNumeric {
+			return "", classUnknown, false
+		}
+		return rendered, classNumeric, true
+	case "min", "max":
+		if len(args) != 1 || argClasses[0] == classUnknown || argClasses[0] == classBoolean {
+			return "", classUnknown, false
+		}
+		return rendered, argClasses[0], true
+	case "lower", "upper":
+		if len(args) != 1 || argClasses[0] != classText {
+			return "", classUnknown, false
+		}
+		return rendered, classText, true
+	case "coalesce":
+		if len(args) < 1 {
+			return "", classUnknown, false
+		}
+		class := classNull
+		for _, argClass := range argClasses {
+			if !areComparable(class, argClass) {
+				return "", classUnknown, false
+			}
+			if argClass != classNull {
+				class = argClass
+			}
+		}
+		return rendered, class, true
+	default:
+		return "", classUnknown, false
+	}
+}
@@ -0,0 +1,326 @@
+package sqlpushdown_test
+
+import (
+	"testing"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/constants"
+	"github.com/stackql/stackql/internal/stackql/sqlpushdown"
+)
+
+var (
+	testColumns map[string]string = map[string]string{
+		"id":     "bigint",
+		"name":   "text",
+		"active": "boolean",
+		"blob":   "bytea",
+	}
+)
+
+func parseSelect(t *testing.T, query string) *sqlparser.Select {
+	t.Helper()
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		t.Fatalf("cannot parse '%s': %v", query, err)
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		t.Fatalf("statement of type '%T' is not a select", stmt)
+	}
+	return sel
+}
+
+// getTables describes each aliased table of the select
+// as a remote table of the same name and test columns.
+func getTables(sel *sqlparser.Select) []sqlpushdown.Table {
+	var rv []sqlpushdown.Table
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if tableExpr, ok := node.(*sqlparser.AliasedTableExpr); ok {
+			tableName := tableExpr.Expr.(sqlparser.TableName).Name.GetRawVal()
+			alias := tableExpr.As.GetRawVal()
+			if alias == "" {
+				alias = tableName
+			}
+			rv = append(rv, sqlpushdown.Table{
+				Node:    tableExpr,
+				Name:    tableName,
+				Alias:   alias,
+				Columns: testColumns,
+			})
+		}
+		return true, nil
+	}, sel.From)
+	return rv
+}
+
+func TestQuoteIdentifier(t *testing.T) {
+	testCases := []struct {
+		dbName     string
+		identifier string
+		want       string
+	}{
+		{dbName: constants.SQLDbNamePostgres, identifier: `a"b`, want: `"a""b"`},
+		{dbName: constants.SQLDbNameDuckDB, identifier: "ab", want: `"ab"`},
+		{dbName: constants.SQLDbNameMySQL, identifier: "a`b", want: "`a``b`"},
+		{dbName: constants.SQLDbNameSQLServer, identifier: "a]b", want: "[a]]b]"},
+		{dbName: constants.SQLDbNameSnowflake, identifier: "ab", want: "ab"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.dbName, func(t *testing.T) {
+			if got := sqlpushdown.QuoteIdentifier(tc.dbName, tc.identifier); got != tc.want {
+				t.Fatalf("QuoteIdentifier() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsSupportedDialect(t *testing.T) {
+	for _, dbName := range []string{constants.SQLDbNamePostgres, constants.SQLDbNameMySQL, constants.SQLDbNameSQLServer, constants.SQLDbNameSnowflake, constants.SQLDbNameDuckDB} {
+		if !sqlpushdown.IsSupportedDialect(dbName) {
+			t.Fatalf("dialect '%s' not supported", dbName)
+		}
+	}
+	if sqlpushdown.IsSupportedDialect("oracle") {
+		t.Fatalf("unexpected support for dialect 'oracle'")
+	}
+}
+
+func TestRenderTableQuery(t *testing.T) {
+	testCases := []struct {
+		name       string
+		dbName     string
+		predicates []string
+		limit      int
+		want       string
+	}{
+		{
+			name:   "unbounded",
+			dbName: constants.SQLDbNamePostgres,
+			limit:  -1,
+			want:   `SELECT "id", "name" FROM t`,
+		},
+		{
+			name:       "predicates and limit",
+			dbName:     constants.SQLDbNamePostgres,
+			predicates: []string{`"id" = 1`, `"name" IS NOT NULL`},
+			limit:      10,
+			want:       `SELECT "id", "name" FROM t WHERE "id" = 1 AND "name" IS NOT NULL LIMIT 10`,
+		},
+		{
+			name:   "sqlserver top",
+			dbName: constants.SQLDbNameSQLServer,
+			limit:  10,
+			want:   `SELECT TOP 10 [id], [name] FROM t`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := sqlpushdown.RenderTableQuery(tc.dbName, "t", []string{"id", "name"}, tc.predicates, tc.limit)
+			if got != tc.want {
+				t.Fatalf("RenderTableQuery() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRenderTableFilter(t *testing.T) {
+	testCases := []struct {
+		name         string
+		dbName       string
+		where        string
+		want         []string
+		wantExact    bool
+		wantComplete bool
+	}{
+		{
+			name:         "equality and range",
+			dbName:       constants.SQLDbNamePostgres,
+			where:        "t.id = 1 and t.id between 0 and 5",
+			want:         []string{`"id" = 1`, `"id" BETWEEN 0 AND 5`},
+			wantExact:    true,
+			wantComplete: true,
+		},
+		{
+			name:         "string literal escaped",
+			dbName:       constants.SQLDbNamePostgres,
+			where:        "t.name = 'o''brien'",
+			want:         []string{`"name" = 'o''brien'`},
+			wantExact:    true,
+			wantComplete: true,
+		},
+		{
+			name:         "mysql backslash escaped",
+			dbName:       constants.SQLDbNameMySQL,
+			where:        `t.name = 'a\\b'`,
+			want:         []string{"`name` = 'a\\\\b'"},
+			wantComplete: true,
+		},
+		{
+			name:         "text equality inexact where case insensitive",
+			dbName:       constants.SQLDbNameSQLServer,
+			where:        "t.name = 'x'",
+			want:         []string{`[name] = 'x'`},
+			wantComplete: true,
+		},
+		{
+			name:         "text inequality retained locally where case insensitive",
+			dbName:       constants.SQLDbNameMySQL,
+			where:        "t.name != 'x' and t.id > 1",
+			want:         []string{"`id` > 1"},
+			wantExact:    true,
+			wantComplete: false,
+		},
+		{
+			name:         "like widened",
+			dbName:       constants.SQLDbNamePostgres,
+			where:        "t.name like 'a%'",
+			want:         []string{`"name" ILIKE 'a%'`},
+			wantComplete: true,
+		},
+		{
+			name:         "text ordering retained locally",
+			dbName:       constants.SQLDbNamePostgres,
+			where:        "t.name > 'a'",
+			wantExact:    true,
+			wantComplete: false,
+		},
+		{
+			name:         "negation retained locally",
+			dbName:       constants.SQLDbNamePostgres,
+			where:        "not t.id = 1",
+			wantExact:    true,
+			wantComplete: false,
+		},
+		{
+			name:         "function retained locally",
+			dbName:       constants.SQLDbNamePostgres,
+			where:        "lower(t.name) = 'a'",
+			wantExact:    true,
+			wantComplete: false,
+		},
+		{
+			name:         "mismatched types retained locally",
+			dbName:       constants.SQLDbNamePostgres,
+			where:        "t.id = 'a'",
+			wantExact:    true,
+			wantComplete: false,
+		},
+		{
+			name:         "unknown type retained locally",
+			dbName:       constants.SQLDbNamePostgres,
+			where:        "t.blob = 'x' and t.id in (1, 2)",
+			want:         []string{`"id" IN (1, 2)`},
+			wantExact:    true,
+			wantComplete: false,
+		},
+		{
+			name:         "disjunction",
+			dbName:       constants.SQLDbNamePostgres,
+			where:        "t.id = 1 or t.id = 2",
+			want:         []string{`("id" = 1 OR "id" = 2)`},
+			wantExact:    true,
+			wantComplete: true,
+		},
+		{
+			name:         "boolean literal refused by sqlserver",
+			dbName:       constants.SQLDbNameSQLServer,
+			where:        "t.active = true",
+			wantExact:    true,
+			wantComplete: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sel := parseSelect(t, "select id from t where "+tc.where)
+			got, exact, complete := sqlpushdown.RenderTableFilter(tc.dbName, sel.Where.Expr, getTables(sel)[0], false)
+			if len(got) != len(tc.want) {
+				t.Fatalf("RenderTableFilter() = %q, want %q", got, tc.want)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Fatalf("RenderTableFilter() = %q, want %q", got, tc.want)
+				}
+			}
+			if exact != tc.wantExact || complete != tc.wantComplete {
+				t.Fatalf("exact, complete = %t, %t, want %t, %t", exact, complete, tc.wantExact, tc.wantComplete)
+			}
+		})
+	}
+}
+
+func TestRenderTableFilterUnqualified(t *testing.T) {
+	sel := parseSelect(t, "select id from t where id = 1")
+	table := getTables(sel)[0]
+	if got, _, complete := sqlpushdown.RenderTableFilter(constants.SQLDbNamePostgres, sel.Where.Expr, table, false); len(got) != 0 || complete {
+		t.Fatalf("unqualified column rendered where disallowed: %q", got)
+	}
+	if got, _, complete := sqlpushdown.RenderTableFilter(constants.SQLDbNamePostgres, sel.Where.Expr, table, true); len(got) != 1 || !complete {
+		t.Fatalf("unqualified column not rendered where allowed: %q", got)
+	}
+}
+
+func TestRenderSelect(t *testing.T) {
+	testCases := []struct {
+		name   string
+		dbName string
+		query  string
+		want   string
+		wantOk bool
+	}{
+		{
+			name:   "aggregate with grouping and ordering",
+			dbName: constants.SQLDbNamePostgres,
+			query:  "select name, count(*) as cnt from t where id > 1 group by name having count(*) > 2 order by name desc limit 5",
+			want:   `SELECT "t"."name" AS "name", COUNT(*) AS "cnt" FROM t AS "t" WHERE "t"."id" > 1 GROUP BY "t"."name" HAVING COUNT(*) > 2 ORDER BY "t"."name" DESC LIMIT 5`,
+			wantOk: true,
+		},
+		{
+			name:   "join",
+			dbName: constants.SQLDbNamePostgres,
+			query:  "select a.id, b.name from t1 a inner join t2 b on a.id = b.id",
+			want:   `SELECT "a"."id" AS "id", "b"."name" AS "name" FROM t1 AS "a" INNER JOIN t2 AS "b" ON "a"."id" = "b"."id"`,
+			wantOk: true,
+		},
+		{
+			name:   "sqlserver top",
+			dbName: constants.SQLDbNameSQLServer,
+			query:  "select id from t limit 3",
+			want:   `SELECT TOP 3 [t].[id] AS [id] FROM t AS [t]`,
+			wantOk: true,
+		},
+		{
+			name:   "sqlserver offset refused",
+			dbName: constants.SQLDbNameSQLServer,
+			query:  "select id from t limit 3 offset 1",
+		},
+		{
+			name:   "snowflake aliases delimited",
+			dbName: constants.SQLDbNameSnowflake,
+			query:  "select upper(name) as n from t",
+			want:   `SELECT UPPER(t.name) AS "n" FROM t AS t`,
+			wantOk: true,
+		},
+		{
+			name:   "unsupported function refused",
+			dbName: constants.SQLDbNamePostgres,
+			query:  "select json_extract(name, '$.a') from t",
+		},
+		{
+			name:   "ambiguous column refused",
+			dbName: constants.SQLDbNamePostgres,
+			query:  "select id from t1 a inner join t2 b on a.id = b.id",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sel := parseSelect(t, tc.query)
+			got, ok := sqlpushdown.RenderSelect(tc.dbName, sel, getTables(sel))
+			if ok != tc.wantOk {
+				t.Fatalf("RenderSelect() ok = %t, want %t: %s", ok, tc.wantOk, got)
+			}
+			if ok && got != tc.want {
+				t.Fatalf("RenderSelect() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package sqlstream
 
 import (
+	"context"
 	"io"
 
 	"github.com/stackql/stackql/internal/stackql/drm"
@@ -10,6 +11,7 @@ import (
 )
 
 type SimpleSQLMapStream struct {
+	ctx             context.Context
 	selectCtx       drm.PreparedStatementCtx
 	insertContainer tableinsertioncontainer.TableInsertionContainer
 	drmCfg          drm.DRMConfig
@@ -19,12 +21,14 @@ type SimpleSQLMapStream struct {
 }
 
 func NewSimpleSQLMapStream(
+	ctx context.Context,
 	selectCtx drm.PreparedStatementCtx,
 	insertContainer tableinsertioncontainer.TableInsertionContainer,
 	drmCfg drm.DRMConfig,
 	sqlEngine sqlengine.SQLEngine,
 ) streaming.MapStream {
 	return &SimpleSQLMapStream{
+		ctx:             ctx,
 		selectCtx:       selectCtx,
 		insertContainer: insertContainer,
 		drmCfg:          drmCfg,
@@ -40,6 +44,7 @@ func (ss *SimpleSQLMapStream) Read() ([]map[string]interface{}, error) {
 	var rv []map[string]interface{}
 	nonControlColumns := ss.selectCtx.GetNonControlColumns()
 	r, sqlErr := ss.drmCfg.QueryDML(
+		ss.ctx,
 		ss.sqlEngine,
 		drm.NewPreparedStatementParameterized(ss.selectCtx, nil, true),
 	)
@@ -6,7 +6,6 @@ import (
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 
 	"github.com/stackql/go-suffix-map/pkg/suffixmap"
-	"github.com/stackql/stackql/internal/stackql/logging"
 )
 
 type SymTabEntry struct {
@@ -44,7 +43,6 @@ func NewHashMapTreeSymTab() *HashMapTreeSymTab {
 func (st *HashMapTreeSymTab) GetSymbol(k interface{}) (SymTabEntry, error) {
 	switch k := k.(type) {
 	case *sqlparser.ColName:
-		logging.GetLogger().Infoln(fmt.Sprintf("reading from symbol table using ColIdent %v", k))
 		return st.GetSymbol(k.Name.GetRawVal())
 	}
 	v, ok := st.tab[k]
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
+	"github.com/stackql/stackql/internal/stackql/metrics"
 	"github.com/stackql/stackql/internal/stackql/sql_system"
 	"github.com/stackql/stackql/internal/stackql/sqlengine"
 	"github.com/stackql/stackql/internal/stackql/templatenamespace"
@@ -77,6 +78,12 @@ func (stc *regexTableNamespaceConfigurator) Match(tableString string, requestEnc
 	if !isAllowed {
 		return nil, false
 	}
+	tcc, isMatch := stc.match(tableString, requestEncoding, lastModifiedColName, requestEncodingColName)
+	metrics.ObserveAnalyticsCacheLookup(isMatch)
+	return tcc, isMatch
+}
+
+func (stc *regexTableNamespaceConfigurator) match(tableString string, requestEncoding string, lastModifiedColName string, requestEncodingColName string) (internaldto.TxnControlCounters, bool) {
 	actualTableName, err := stc.templateNamespaceConfigurator.RenderTemplate(tableString)
 	if err != nil {
 		return nil, false
@@ -5,7 +5,6 @@ import (
 	"github.com/stackql/stackql/internal/stackql/handler"
 	"github.com/stackql/stackql/internal/stackql/httpbuild"
 	"github.com/stackql/stackql/internal/stackql/internal_data_transfer/internaldto"
-	"github.com/stackql/stackql/internal/stackql/logging"
 	"github.com/stackql/stackql/internal/stackql/streaming"
 	"github.com/stackql/stackql/internal/stackql/tablemetadata"
 	"github.com/stackql/stackql/internal/stackql/util"
@@ -95,11 +94,11 @@ func (ac *standardAnnotationCtx) Prepare(
 		viewDTO, isView := ac.GetView()
 		// TODO: fill this out
 		if isView {
-			logging.GetLogger().Debugf("viewDTO = %v\n", viewDTO)
+			subsystem.GetContextLogger(handlerCtx.GetContext()).Debugf("viewDTO = %v\n", viewDTO)
 		}
 		ac.tableMeta.WithGetHttpArmoury(
 			func() (httpbuild.HTTPArmoury, error) {
-				httpArmoury, err := httpbuild.BuildHTTPRequestCtxFromAnnotation(stream, pr, opStore, svc, nil, nil)
+				httpArmoury, err := httpbuild.BuildHTTPRequestCtxFromAnnotation(handlerCtx.GetContext(), stream, pr, opStore, svc, nil, nil)
 				return httpArmoury, err
 			},
 		)
@@ -110,7 +109,7 @@ func (ac *standardAnnotationCtx) Prepare(
 	ac.tableMeta.WithGetHttpArmoury(
 		func() (httpbuild.HTTPArmoury, error) {
 			// need to dynamically generate stream, otherwise repeated calls result in empty body
-			parametersCleaned, err := util.TransformSQLRawParameters(ac.GetParameters())
+			parametersCleaned, err := util.TransformSQLRawParameters(handlerCtx.GetContext(), ac.GetParameters())
 			if err != nil {
 				return nil, err
 			}
@@ -119,7 +118,7 @@ func (ac *standardAnnotationCtx) Prepare(
 					parametersCleaned,
 				},
 			)
-			httpArmoury, err := httpbuild.BuildHTTPRequestCtxFromAnnotation(stream, pr, opStore, svc, nil, nil)
+			httpArmoury, err := httpbuild.BuildHTTPRequestCtxFromAnnotation(handlerCtx.GetContext(), stream, pr, opStore, svc, nil, nil)
 			if err != nil {
 				return nil, err
 			}
@@ -17,6 +17,8 @@ import (
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 )
 
+const subsystem logging.Subsystem = "taxonomy"
+
 func GetHeirarchyIDsFromParserNode(handlerCtx handler.HandlerContext, node sqlparser.SQLNode) (internaldto.HeirarchyIdentifiers, error) {
 	return getHids(handlerCtx, node)
 }
@@ -57,13 +59,13 @@ func getHids(handlerCtx handler.HandlerContext, node sqlparser.SQLNode) (interna
 	case *sqlparser.Insert:
 		hIds = internaldto.ResolveResourceTerminalHeirarchyIdentifiers(n.Table)
 	case *sqlparser.Update:
-		currentSvcRsc, err := parserutil.ExtractSingleTableFromTableExprs(n.TableExprs)
+		currentSvcRsc, err := parserutil.ExtractSingleTableFromTableExprs(handlerCtx.GetContext(), n.TableExprs)
 		if err != nil {
 			return nil, err
 		}
 		hIds = internaldto.ResolveResourceTerminalHeirarchyIdentifiers(*currentSvcRsc)
 	case *sqlparser.Delete:
-		currentSvcRsc, err := parserutil.ExtractSingleTableFromTableExprs(n.TableExprs)
+		currentSvcRsc, err := parserutil.ExtractSingleTableFromTableExprs(handlerCtx.GetContext(), n.TableExprs)
 		if err != nil {
 			return nil, err
 		}
@@ -173,7 +175,7 @@ func GetHeirarchyFromStatement(handlerCtx handler.HandlerContext, node sqlparser
 	retVal.SetProvider(prov)
 	viewDTO, isView := retVal.GetView()
 	if isView {
-		logging.GetLogger().Debugf("viewDTO = %v\n", viewDTO)
+		subsystem.GetContextLogger(handlerCtx.GetContext()).Debugf("viewDTO = %v\n", viewDTO)
 		return retVal, nil
 	}
 	if err != nil {
@@ -243,7 +245,7 @@ func GetHeirarchyFromStatement(handlerCtx handler.HandlerContext, node sqlparser
 		}
 		for _, srv := range svcHdl.Servers {
 			for k := range srv.Variables {
-				logging.GetLogger().Debugf("server parameter = '%s'\n", k)
+				subsystem.GetContextLogger(handlerCtx.GetContext()).Debugf("server parameter = '%s'\n", k)
 			}
 		}
 		method = meth
@@ -0,0 +1,144 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/stackql/stackql/internal/stackql/dto"
+	"github.com/stackql/stackql/internal/stackql/redaction"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	ExporterOTLP string = "otlp"
+	ExporterFile string = "file"
+
+	tracerName  string = "github.com/stackql/stackql"
+	serviceName string = "stackql"
+)
+
+var (
+	ProviderKey     attribute.Key = attribute.Key("stackql.provider")
+	ServiceKey      attribute.Key = attribute.Key("stackql.service")
+	ResourceKey     attribute.Key = attribute.Key("stackql.resource")
+	MethodKey       attribute.Key = attribute.Key("stackql.method")
+	PrimitiveKey    attribute.Key = attribute.Key("stackql.primitive")
+	PrimitiveIDKey  attribute.Key = attribute.Key("stackql.primitive.id")
+	RowsKey         attribute.Key = attribute.Key("stackql.rows_acquired")
+	HTTPRequestsKey attribute.Key = attribute.Key("stackql.http_requests")
+	StatementKey    attribute.Key = semconv.DBStatementKey
+
+	// redactor applies to the text of recorded errors,
+	// which may quote provider error response bodies
+	redactor redaction.Redactor = redaction.NewRedactor(redaction.DefaultFields, redaction.DefaultHeaders, redaction.DefaultQueryKeys)
+)
+
+// Init installs the global tracer provider as per "--tracing.exporter".
+// Absent an exporter, the global provider is the default no-op, and
+// spans cost next to nothing.  The returned function flushes and
+// stops export, and should be called before exit.
+func Init(runtimeCtx dto.RuntimeCtx) (func(context.Context) error, error) {
+	redactor = redaction.NewRedactor(runtimeCtx.RedactFields, runtimeCtx.RedactHeaders, runtimeCtx.RedactQueryKeys)
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch strings.ToLower(runtimeCtx.TracingExporter) {
+	case "":
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		exporter, err = newOTLPExporter(runtimeCtx.TracingEndpoint)
+	case ExporterFile:
+		exporter, err = newFileExporter(runtimeCtx.TracingFile)
+	default:
+		return nil, fmt.Errorf("unsupported tracing exporter '%s', must be (%s | %s)", runtimeCtx.TracingExporter, ExporterOTLP, ExporterFile)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var processorOpt sdktrace.TracerProviderOption
+	switch strings.ToLower(runtimeCtx.TracingExporter) {
+	case ExporterFile:
+		// spans are written as they end, so
+		// that none are lost on abrupt exit
+		processorOpt = sdktrace.WithSyncer(exporter)
+	default:
+		processorOpt = sdktrace.WithBatcher(exporter)
+	}
+	tp := sdktrace.NewTracerProvider(
+		processorOpt,
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceNameKey.String(serviceName))),
+	)
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+// newOTLPExporter exports over OTLP/HTTP.  An empty endpoint defers
+// to the OTEL_EXPORTER_OTLP_* environment variables and defaults.
+func newOTLPExporter(endpoint string) (sdktrace.SpanExporter, error) {
+	var opts []otlptracehttp.Option
+	if endpoint != "" {
+		u, err := url.Parse(endpoint)
+		if err != nil || u.Host == "" {
+			return nil, fmt.Errorf("tracing endpoint '%s' must be a URL, eg: 'http://localhost:4318'", endpoint)
+		}
+		opts = append(opts, otlptracehttp.WithEndpoint(u.Host))
+		if u.Path != "" && u.Path != "/" {
+			opts = append(opts, otlptracehttp.WithURLPath(u.Path))
+		}
+		if u.Scheme == "http" {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+	}
+	return otlptracehttp.New(context.Background(), opts...)
+}
+
+// newFileExporter appends spans to a file, as JSON lines.
+func newFileExporter(filePath string) (sdktrace.SpanExporter, error) {
+	if filePath == "" {
+		return nil, fmt.Errorf("tracing exporter '%s' requires '--%s'", ExporterFile, dto.TracingFileKey)
+	}
+	f, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open tracing file: %w", err)
+	}
+	return stdouttrace.New(stdouttrace.WithWriter(f))
+}
+
+func Start(ctx context.Context, spanName string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, spanName, trace.WithAttributes(attrs...))
+}
+
+func StartClient(ctx context.Context, spanName string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, spanName, trace.WithAttributes(attrs...), trace.WithSpanKind(trace.SpanKindClient))
+}
+
+// WithParentSpan returns ctx, such that spans started from it are
+// children of the span of parent, if any.  The values, deadline and
+// cancellation of ctx are retained.
+func WithParentSpan(ctx context.Context, parent context.Context) context.Context {
+	span := trace.SpanFromContext(parent)
+	if !span.SpanContext().IsValid() {
+		return ctx
+	}
+	return trace.ContextWithSpan(ctx, span)
+}
+
+// End records the error, if any, redacted, and ends the span.
+func End(span trace.Span, err error) {
+	if err != nil {
+		err = redaction.RedactError(redactor, err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,99 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stackql/stackql/internal/stackql/dto"
+	"github.com/stackql/stackql/internal/stackql/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// useSpanRecorder installs a tracer provider that
+// records spans, for the duration of the test.
+func useSpanRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+	})
+	return recorder
+}
+
+func TestEnd(t *testing.T) {
+	recorder := useSpanRecorder(t)
+	_, span := tracing.Start(context.Background(), "failed")
+	tracing.End(span, errors.New("cannot GET /v1?key=abc"))
+	_, span = tracing.Start(context.Background(), "succeeded")
+	tracing.End(span, nil)
+	ended := recorder.Ended()
+	if len(ended) != 2 {
+		t.Fatalf("%d spans ended, want 2", len(ended))
+	}
+	if status := ended[0].Status(); status.Code != codes.Error || status.Description != "cannot GET /v1?key=[REDACTED]" {
+		t.Fatalf("End() status = %v, want the redacted error", status)
+	}
+	if events := ended[0].Events(); len(events) != 1 || events[0].Name != "exception" {
+		t.Fatalf("End() events = %v, want the error recorded", events)
+	}
+	if status := ended[1].Status(); status.Code != codes.Unset {
+		t.Fatalf("End() status = %v, want unset", status)
+	}
+}
+
+func TestWithParentSpan(t *testing.T) {
+	recorder := useSpanRecorder(t)
+	type key struct{}
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), key{}, "v"))
+	parentCtx, parent := tracing.Start(context.Background(), "primitive")
+	if got := tracing.WithParentSpan(ctx, context.Background()); got != ctx {
+		t.Fatalf("WithParentSpan() without a parent span altered the context")
+	}
+	childCtx := tracing.WithParentSpan(ctx, parentCtx)
+	_, child := tracing.StartClient(childCtx, "HTTP GET")
+	tracing.End(child, nil)
+	tracing.End(parent, nil)
+	if childCtx.Value(key{}) != "v" {
+		t.Fatalf("WithParentSpan() did not retain the values of the context")
+	}
+	cancel()
+	if childCtx.Err() == nil {
+		t.Fatalf("WithParentSpan() did not retain the cancellation of the context")
+	}
+	ended := recorder.Ended()
+	if len(ended) != 2 || ended[0].Parent().SpanID() != ended[1].SpanContext().SpanID() {
+		t.Fatalf("WithParentSpan() span is not a child of the parent span")
+	}
+}
+
+func TestInit(t *testing.T) {
+	testCases := []struct {
+		name       string
+		runtimeCtx dto.RuntimeCtx
+		wantErr    bool
+	}{
+		{name: "disabled"},
+		{name: "unsupported exporter", runtimeCtx: dto.RuntimeCtx{TracingExporter: "zipkin"}, wantErr: true},
+		{name: "file exporter without file", runtimeCtx: dto.RuntimeCtx{TracingExporter: tracing.ExporterFile}, wantErr: true},
+		{name: "otlp endpoint that is not a URL", runtimeCtx: dto.RuntimeCtx{TracingExporter: tracing.ExporterOTLP, TracingEndpoint: "localhost"}, wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			shutdown, err := tracing.Init(tc.runtimeCtx)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Init() error = %v, want error %t", err, tc.wantErr)
+			}
+			if err == nil {
+				if err := shutdown(context.Background()); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+		})
+	}
+}
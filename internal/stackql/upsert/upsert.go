@@ -0,0 +1,254 @@
+package upsert
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/parserutil"
+)
+
+const (
+	// DryRunDirectiveName denotes that planned actions
+	// are to be reported rather than performed.
+	DryRunDirectiveName string = "DRYRUN"
+	// ActionUpdate and ActionNothing are the
+	// conflict actions, per "DO UPDATE" and "DO NOTHING".
+	ActionUpdate  string = "update"
+	ActionNothing string = "nothing"
+	// conflictQualifier qualifies the assignments of the
+	// "ON DUPLICATE KEY UPDATE" clause into which
+	// RewriteOnConflict rewrites the ON CONFLICT clause.
+	conflictQualifier        string = "stackql_conflict"
+	conflictActionName       string = "action"
+	conflictKeyColumnName    string = "key_column"
+	conflictUpdateColumnName string = "update_column"
+)
+
+// Conflict describes an "ON CONFLICT (<keys>) DO UPDATE | DO NOTHING" clause.
+type Conflict interface {
+	// GetKeyColumns returns the insert columns that identify a resource.
+	GetKeyColumns() []string
+	// GetAction returns ActionUpdate or ActionNothing.
+	GetAction() string
+	// GetUpdateColumns returns the columns named by "DO UPDATE SET",
+	// or nil where every inserted column is to be reconciled.
+	GetUpdateColumns() []string
+}
+
+type standardConflict struct {
+	keyColumns    []string
+	action        string
+	updateColumns []string
+}
+
+func (c *standardConflict) GetKeyColumns() []string {
+	return c.keyColumns
+}
+
+func (c *standardConflict) GetAction() string {
+	return c.action
+}
+
+func (c *standardConflict) GetUpdateColumns() []string {
+	return c.updateColumns
+}
+
+// RewriteOnConflict rewrites a trailing
+// "ON CONFLICT (<keys>) DO UPDATE [SET <col> = EXCLUDED.<col>, ...] | DO NOTHING"
+// clause of an INSERT, which the parser does not support, into an
+// "ON DUPLICATE KEY UPDATE" clause of qualified assignments that ParseConflict
+// decodes.  The clause is recognised from tokens, and so not within string
+// literals, comments or parentheses.  Other queries, and queries that do not
+// tokenize, are returned unaltered.
+func RewriteOnConflict(query string) (string, error) {
+	tokens, err := parserutil.ScanTokens(query)
+	if err != nil {
+		return query, nil
+	}
+	verbIndex := parserutil.NextToken(tokens, -1)
+	if verbIndex < 0 || tokens[verbIndex].Type != sqlparser.INSERT {
+		return query, nil
+	}
+	clauseIndex := findConflictClause(query, tokens)
+	if clauseIndex < 0 {
+		return query, nil
+	}
+	conflict, err := parseConflictClause(query, tokens, parserutil.NextToken(tokens, clauseIndex))
+	if err != nil {
+		return "", err
+	}
+	assignments := []string{
+		fmt.Sprintf("%s.%s = '%s'", conflictQualifier, conflictActionName, conflict.action),
+	}
+	for _, col := range conflict.keyColumns {
+		assignments = append(assignments, fmt.Sprintf(`%s.%s = values("%s")`, conflictQualifier, conflictKeyColumnName, col))
+	}
+	for _, col := range conflict.updateColumns {
+		assignments = append(assignments, fmt.Sprintf(`%s.%s = values("%s")`, conflictQualifier, conflictUpdateColumnName, col))
+	}
+	return fmt.Sprintf(
+		"%sON DUPLICATE KEY UPDATE %s",
+		query[:tokens[clauseIndex].Start],
+		strings.Join(assignments, ", "),
+	), nil
+}
+
+// ParseConflict decodes the ON CONFLICT clause of an insert,
+// as rewritten by RewriteOnConflict, if any.
+func ParseConflict(node *sqlparser.Insert) (Conflict, bool, error) {
+	if len(node.OnDup) == 0 {
+		return nil, false, nil
+	}
+	rv := &standardConflict{}
+	for _, updateExpr := range node.OnDup {
+		if updateExpr.Name.Qualifier.Name.GetRawVal() != conflictQualifier {
+			return nil, false, fmt.Errorf("ON DUPLICATE KEY UPDATE is not supported, use ON CONFLICT")
+		}
+		switch updateExpr.Name.Name.GetRawVal() {
+		case conflictActionName:
+			if val, isVal := updateExpr.Expr.(*sqlparser.SQLVal); isVal {
+				rv.action = string(val.Val)
+			}
+		case conflictKeyColumnName:
+			if valuesFunc, isValuesFunc := updateExpr.Expr.(*sqlparser.ValuesFuncExpr); isValuesFunc {
+				rv.keyColumns = append(rv.keyColumns, valuesFunc.Name.Name.GetRawVal())
+			}
+		case conflictUpdateColumnName:
+			if valuesFunc, isValuesFunc := updateExpr.Expr.(*sqlparser.ValuesFuncExpr); isValuesFunc {
+				rv.updateColumns = append(rv.updateColumns, valuesFunc.Name.Name.GetRawVal())
+			}
+		}
+	}
+	if len(rv.keyColumns) == 0 || (rv.action != ActionUpdate && rv.action != ActionNothing) {
+		return nil, false, fmt.Errorf("ON DUPLICATE KEY UPDATE is not supported, use ON CONFLICT")
+	}
+	return rv, true, nil
+}
+
+// IsDryRun determines whether planned actions are to be reported only.
+func IsDryRun(directives sqlparser.CommentDirectives) bool {
+	return directives.IsSet(DryRunDirectiveName)
+}
+
+// findConflictClause returns the index of the ON keyword of the
+// last "ON CONFLICT" outside of parentheses, or -1 where there is none.
+func findConflictClause(query string, tokens []parserutil.Token) int {
+	depth := 0
+	rv := -1
+	for i, tkn := range tokens {
+		switch tkn.Type {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case sqlparser.ON:
+			next := parserutil.NextToken(tokens, i)
+			if depth == 0 && next >= 0 && isWord(query, tokens[next], "CONFLICT") {
+				rv = i
+			}
+		}
+	}
+	return rv
+}
+
+// parseConflictClause parses the ON CONFLICT clause
+// that follows the CONFLICT keyword at index i.
+func parseConflictClause(query string, tokens []parserutil.Token, i int) (*standardConflict, error) {
+	rv := &standardConflict{}
+	i = parserutil.NextToken(tokens, i)
+	if i < 0 || tokens[i].Type != '(' {
+		return nil, fmt.Errorf("ON CONFLICT requires key columns, eg: ON CONFLICT (project, zone, data__name) DO UPDATE")
+	}
+	for {
+		i = parserutil.NextToken(tokens, i)
+		if i < 0 {
+			return nil, fmt.Errorf("unbalanced parentheses in ON CONFLICT clause")
+		}
+		col, isColumn := tokens[i].GetColumnName(query)
+		if !isColumn {
+			return nil, fmt.Errorf("ON CONFLICT supports only column names, not '%s'", tokens[i].GetRaw(query))
+		}
+		rv.keyColumns = append(rv.keyColumns, col)
+		i = parserutil.NextToken(tokens, i)
+		if i < 0 {
+			return nil, fmt.Errorf("unbalanced parentheses in ON CONFLICT clause")
+		}
+		if tokens[i].Type == ')' {
+			break
+		}
+		if tokens[i].Type != ',' {
+			return nil, fmt.Errorf("ON CONFLICT supports only column names, not '%s'", tokens[i].GetRaw(query))
+		}
+	}
+	actionStart := parserutil.NextToken(tokens, i)
+	i = actionStart
+	if i < 0 || !isWord(query, tokens[i], "DO") {
+		return nil, fmt.Errorf("ON CONFLICT supports only DO UPDATE or DO NOTHING")
+	}
+	i = parserutil.NextToken(tokens, i)
+	switch {
+	case i >= 0 && isWord(query, tokens[i], "NOTHING"):
+		rv.action = ActionNothing
+		i = parserutil.NextToken(tokens, i)
+	case i >= 0 && tokens[i].Type == sqlparser.UPDATE:
+		rv.action = ActionUpdate
+		i = parserutil.NextToken(tokens, i)
+		if i >= 0 && tokens[i].Type == sqlparser.SET {
+			var err error
+			rv.updateColumns, i, err = parseAssignments(query, tokens, i)
+			if err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("ON CONFLICT supports only DO UPDATE or DO NOTHING, not '%s'", query[tokens[actionStart].Start:])
+	}
+	if i >= 0 && tokens[i].Type == ';' {
+		i = parserutil.NextToken(tokens, i)
+	}
+	if i >= 0 {
+		return nil, fmt.Errorf("ON CONFLICT must be the last clause of an INSERT, unexpected '%s'", query[tokens[i].Start:])
+	}
+	return rv, nil
+}
+
+// parseAssignments parses "SET <col> = EXCLUDED.<col>, ..." from the SET
+// keyword at index i, returning the columns and the index that follows.
+func parseAssignments(query string, tokens []parserutil.Token, i int) ([]string, int, error) {
+	var rv []string
+	for {
+		start := parserutil.NextToken(tokens, i)
+		if start < 0 {
+			return nil, -1, fmt.Errorf("DO UPDATE SET requires assignments of the form 'col = EXCLUDED.col'")
+		}
+		var assignment []int
+		for j := start; j >= 0 && len(assignment) < 5; j = parserutil.NextToken(tokens, j) {
+			assignment = append(assignment, j)
+		}
+		if len(assignment) < 5 ||
+			tokens[assignment[1]].Type != '=' ||
+			!isWord(query, tokens[assignment[2]], "EXCLUDED") ||
+			tokens[assignment[3]].Type != '.' {
+			return nil, -1, fmt.Errorf("DO UPDATE SET supports only assignments of the form 'col = EXCLUDED.col', not '%s'", query[tokens[start].Start:])
+		}
+		col, isColumn := tokens[assignment[0]].GetColumnName(query)
+		excludedCol, isExcludedColumn := tokens[assignment[4]].GetColumnName(query)
+		if !isColumn || !isExcludedColumn {
+			return nil, -1, fmt.Errorf("DO UPDATE SET supports only assignments of the form 'col = EXCLUDED.col', not '%s'", query[tokens[start].Start:])
+		}
+		if col != excludedCol {
+			return nil, -1, fmt.Errorf("DO UPDATE SET column '%s' must be assigned EXCLUDED.%s", col, col)
+		}
+		rv = append(rv, col)
+		i = parserutil.NextToken(tokens, assignment[4])
+		if i < 0 || tokens[i].Type != ',' {
+			return rv, i, nil
+		}
+	}
+}
+
+// isWord reports whether a token is the unquoted keyword or identifier word.
+func isWord(query string, tkn parserutil.Token, word string) bool {
+	return tkn.Type != sqlparser.STRING && strings.EqualFold(tkn.GetRaw(query), word)
+}
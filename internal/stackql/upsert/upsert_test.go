@@ -0,0 +1,140 @@
+package upsert_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stackql/stackql-parser/go/vt/sqlparser"
+	"github.com/stackql/stackql/internal/stackql/upsert"
+)
+
+func parseInsert(t *testing.T, query string) *sqlparser.Insert {
+	t.Helper()
+	statement, err := sqlparser.Parse(query)
+	if err != nil {
+		t.Fatalf("cannot parse rewritten query '%s': %v", query, err)
+	}
+	node, isInsert := statement.(*sqlparser.Insert)
+	if !isInsert {
+		t.Fatalf("rewritten query '%s' is not an insert", query)
+	}
+	return node
+}
+
+func TestRewriteOnConflict(t *testing.T) {
+	testCases := []struct {
+		name              string
+		query             string
+		wantKeys          []string
+		wantAction        string
+		wantUpdateColumns []string
+		wantErr           bool
+	}{
+		{
+			name:       "do update",
+			query:      "INSERT INTO google.compute.instances(project, zone, instance, data__name) SELECT 'p', 'z', 'i', 'i' ON CONFLICT (project, zone, instance) DO UPDATE;",
+			wantKeys:   []string{"project", "zone", "instance"},
+			wantAction: upsert.ActionUpdate,
+		},
+		{
+			name:              "do update set",
+			query:             `insert into t(project, data__name, "key") select 'p', 'n', 'k' on conflict (project, data__name) do update set "key" = excluded."key", data__name = EXCLUDED.data__name`,
+			wantKeys:          []string{"project", "data__name"},
+			wantAction:        upsert.ActionUpdate,
+			wantUpdateColumns: []string{"key", "data__name"},
+		},
+		{
+			name:       "do nothing with comments and values",
+			query:      "insert /*+ AWAIT */ into t(a, b) values ('on conflict (x)', 'b') -- on conflict\non /* c */ conflict (a) do nothing",
+			wantKeys:   []string{"a"},
+			wantAction: upsert.ActionNothing,
+		},
+		{
+			name:       "last clause outside parentheses",
+			query:      "insert into t(a) select x from s inner join u on s.id = u.id on conflict (a) do nothing",
+			wantKeys:   []string{"a"},
+			wantAction: upsert.ActionNothing,
+		},
+		{
+			name:  "no clause",
+			query: "insert into t(a) select x from s inner join u on s.id = u.id",
+		},
+		{
+			name:  "select is not rewritten",
+			query: "select 1 from s inner join u on conflict = 1",
+		},
+		{
+			name:    "no key columns",
+			query:   "insert into t(a) select 'x' on conflict do nothing",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported action",
+			query:   "insert into t(a) select 'x' on conflict (a) do replace",
+			wantErr: true,
+		},
+		{
+			name:    "assignment other than excluded",
+			query:   "insert into t(a) select 'x' on conflict (a) do update set a = 'y'",
+			wantErr: true,
+		},
+		{
+			name:    "assignment of another column",
+			query:   "insert into t(a, b) select 'x', 'y' on conflict (a) do update set a = excluded.b",
+			wantErr: true,
+		},
+		{
+			name:    "trailing clause",
+			query:   "insert into t(a) select 'x' on conflict (a) do nothing where 1 = 1",
+			wantErr: true,
+		},
+		{
+			name:    "unbalanced",
+			query:   "insert into t(a) select 'x' on conflict (a, b",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rewritten, err := upsert.RewriteOnConflict(tc.query)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("RewriteOnConflict() error = %v, want error %t", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if tc.wantKeys == nil {
+				if rewritten != tc.query {
+					t.Fatalf("RewriteOnConflict() = '%s', want query unchanged", rewritten)
+				}
+				return
+			}
+			node := parseInsert(t, rewritten)
+			conflict, isConflict, err := upsert.ParseConflict(node)
+			if err != nil || !isConflict {
+				t.Fatalf("ParseConflict() of '%s' = (%t, %v)", rewritten, isConflict, err)
+			}
+			if !reflect.DeepEqual(conflict.GetKeyColumns(), tc.wantKeys) ||
+				conflict.GetAction() != tc.wantAction ||
+				!reflect.DeepEqual(conflict.GetUpdateColumns(), tc.wantUpdateColumns) {
+				t.Fatalf("ParseConflict() of '%s' = (%v, %s, %v)", rewritten, conflict.GetKeyColumns(), conflict.GetAction(), conflict.GetUpdateColumns())
+			}
+		})
+	}
+}
+
+func TestParseConflict(t *testing.T) {
+	if _, isConflict, err := upsert.ParseConflict(parseInsert(t, "insert into t(a) select 'x'")); isConflict || err != nil {
+		t.Fatalf("ParseConflict() of a plain insert = (%t, %v)", isConflict, err)
+	}
+	if _, _, err := upsert.ParseConflict(parseInsert(t, "insert into t(a) select 'x' on duplicate key update a = values(a)")); err == nil {
+		t.Fatalf("ParseConflict() accepted ON DUPLICATE KEY UPDATE")
+	}
+}
+
+func TestIsDryRun(t *testing.T) {
+	node := parseInsert(t, "insert /*+ DRYRUN */ into t(a) select 'x'")
+	if !upsert.IsDryRun(sqlparser.ExtractCommentDirectives(node.Comments)) {
+		t.Fatalf("IsDryRun() = false, want true")
+	}
+}
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"sort"
@@ -16,6 +17,8 @@ import (
 	"github.com/stackql/stackql-parser/go/vt/sqlparser"
 )
 
+const subsystem logging.Subsystem = "util"
+
 var defaultColSortArr []string = []string{
 	"id",
 	"name",
@@ -43,7 +46,7 @@ func extractExecParams(node *sqlparser.Exec) (map[string]interface{}, error) {
 	return paramMap, err
 }
 
-func extractInsertParams(insert *sqlparser.Insert, insertValOnlyRows map[int]map[int]interface{}) (map[int]map[string]interface{}, error) {
+func extractInsertParams(ctx context.Context, insert *sqlparser.Insert, insertValOnlyRows map[int]map[int]interface{}) (map[int]map[string]interface{}, error) {
 	retVal := make(map[int]map[string]interface{})
 	var err error
 	if len(insertValOnlyRows) < 1 {
@@ -52,7 +55,7 @@ func extractInsertParams(insert *sqlparser.Insert, insertValOnlyRows map[int]map
 	for i, row := range insertValOnlyRows {
 		rowVal := make(map[string]interface{})
 		if len(insert.Columns) != len(row) {
-			logging.GetLogger().Infoln(fmt.Sprintf("row = %v", row))
+			subsystem.GetContextLogger(ctx).Infoln(fmt.Sprintf("row = %v", row))
 			return nil, fmt.Errorf("disparity in fields to insert and supplied data")
 		}
 		for idx, col := range insert.Columns {
@@ -117,13 +120,13 @@ func extractUpdateParams(update *sqlparser.Update, insertValOnlyRows map[int]map
 	return retVal, err
 }
 
-func ExtractSQLNodeParams(statement sqlparser.SQLNode, insertValOnlyRows map[int]map[int]interface{}) (map[int]map[string]interface{}, error) {
+func ExtractSQLNodeParams(ctx context.Context, statement sqlparser.SQLNode, insertValOnlyRows map[int]map[int]interface{}) (map[int]map[string]interface{}, error) {
 	switch stmt := statement.(type) {
 	case *sqlparser.Exec:
 		val, err := extractExecParams(stmt)
 		return map[int]map[string]interface{}{0: val}, err
 	case *sqlparser.Insert:
-		return extractInsertParams(stmt, insertValOnlyRows)
+		return extractInsertParams(ctx, stmt, insertValOnlyRows)
 	case *sqlparser.Update:
 		return extractUpdateParams(stmt, insertValOnlyRows)
 	}
@@ -159,17 +162,17 @@ func ExtractSQLNodeParams(statement sqlparser.SQLNode, insertValOnlyRows map[int
 	return map[int]map[string]interface{}{0: paramMap}, err
 }
 
-func TransformSQLRawParameters(input map[string]interface{}) (map[string]interface{}, error) {
+func TransformSQLRawParameters(ctx context.Context, input map[string]interface{}) (map[string]interface{}, error) {
 	rv := make(map[string]interface{})
 	for k, v := range input {
 		switch v := v.(type) {
 		case *sqlparser.FuncExpr:
-			logging.GetLogger().Infof("%v\n", v)
+			subsystem.GetContextLogger(ctx).Infof("%v\n", v)
 			continue
 		case parserutil.ParameterMetadata:
 			switch t := v.GetVal().(type) {
 			case *sqlparser.FuncExpr:
-				logging.GetLogger().Infof("%v\n", t)
+				subsystem.GetContextLogger(ctx).Infof("%v\n", t)
 				continue
 			}
 		}
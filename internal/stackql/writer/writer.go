@@ -9,6 +9,8 @@ import (
 	"github.com/stackql/stackql/internal/stackql/logging"
 )
 
+const subsystem logging.Subsystem = "writer"
+
 const (
 	StdOutStr string = "stdout"
 	StdErrStr string = "stderr"
@@ -68,6 +70,6 @@ func (ssw *StdStreamWriter) enclose(p []byte) []byte {
 }
 
 func (ssw *StdStreamWriter) Write(p []byte) (n int, err error) {
-	logging.GetLogger().Infoln("stylised write called")
+	subsystem.GetLogger().Infoln("stylised write called")
 	return ssw.writer.Write(ssw.enclose(p))
 }
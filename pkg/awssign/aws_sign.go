@@ -13,6 +13,8 @@ import (
 	"github.com/stackql/stackql/internal/stackql/logging"
 )
 
+const subsystem logging.Subsystem = "awssign"
+
 var (
 	_ AwsSignTransport = &standardAwsSignTransport{}
 )
@@ -69,7 +71,7 @@ func (t *standardAwsSignTransport) RoundTrip(req *http.Request) (*http.Response,
 		rgnStr,
 		time.Now(),
 	)
-	logging.GetLogger().Infof("header = %v\n", header)
+	subsystem.GetContextLogger(req.Context()).Infof("header = %v\n", header)
 	if err != nil {
 		return nil, err
 	}
@@ -13,7 +13,7 @@ type PrettyPrintContext struct {
 	Indentation     int
 	BaseIndentation int
 	Delimiter       string
-	Logger          *logrus.Logger
+	Logger          logrus.FieldLogger
 }
 
 type PrettyPrinter struct {
@@ -21,7 +21,7 @@ type PrettyPrinter struct {
 	currentIndentation int
 }
 
-func NewPrettyPrintContext(isPrettyPrint bool, indentation int, baseIndentation int, delimiter string, logger *logrus.Logger) PrettyPrintContext {
+func NewPrettyPrintContext(isPrettyPrint bool, indentation int, baseIndentation int, delimiter string, logger logrus.FieldLogger) PrettyPrintContext {
 	return PrettyPrintContext{
 		PrettyPrint:     isPrettyPrint,
 		Indentation:     indentation,
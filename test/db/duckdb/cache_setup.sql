@@ -0,0 +1,14281 @@
+BEGIN TRANSACTION;
+
+create table if not exists "stackql_analytics_github.repos.repos.minimal-repository" ( 
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id" BIGINT , 
+  "iql_generation_id" INTEGER  , 
+  "iql_session_id" INTEGER  , 
+  "iql_txn_id" INTEGER  , 
+  "iql_max_txn_id" INTEGER  , 
+  "iql_insert_id" INTEGER  , 
+  "iql_insert_encoded" TEXT  , 
+  "iql_last_modified" TIMESTAMP NOT NULL DEFAULT CAST(CURRENT_TIMESTAMP AS TIMESTAMP)  , 
+  "iql_gc_status" SMALLINT NOT NULL DEFAULT 1  , 
+  "branches_url" text , 
+  "collaborators_url" text , 
+  "deployments_url" text , 
+  "description" text , 
+  "milestones_url" text , 
+  "downloads_url" text , 
+  "keys_url" text , 
+  "open_issues" integer , 
+  "permissions" text , 
+  "stargazers_count" integer , 
+  "has_pages" boolean , 
+  "merges_url" text , 
+  "mirror_url" text , 
+  "archived" boolean , 
+  "blobs_url" text , 
+  "forks_count" integer , 
+  "git_commits_url" text , 
+  "languages_url" text , 
+  "stargazers_url" text , 
+  "comments_url" text , 
+  "compare_url" text , 
+  "forks_url" text , 
+  "git_url" text , 
+  "has_downloads" boolean , 
+  "node_id" text , 
+  "open_issues_count" integer , 
+  "tags_url" text , 
+  "watchers" integer , 
+  "code_of_conduct" text , 
+  "contents_url" text , 
+  "has_wiki" boolean , 
+  "labels_url" text , 
+  "network_count" integer , 
+  "svn_url" text , 
+  "allow_forking" boolean , 
+  "assignees_url" text , 
+  "commits_url" text , 
+  "issue_events_url" text , 
+  "owner" text , 
+  "events_url" text , 
+  "has_issues" boolean , 
+  "is_template" boolean , 
+  "private" boolean , 
+  "created_at" text , 
+  "delete_branch_on_merge" boolean , 
+  "full_name" text , 
+  "html_url" text , 
+  "issue_comment_url" text , 
+  "issues_url" text , 
+  "pushed_at" text , 
+  "temp_clone_token" text , 
+  "size" integer , 
+  "subscribers_url" text , 
+  "subscription_url" text , 
+  "updated_at" text , 
+  "default_branch" text , 
+  "git_refs_url" text , 
+  "has_projects" boolean , 
+  "statuses_url" text , 
+  "topics" text , 
+  "url" text , 
+  "git_tags_url" text , 
+  "releases_url" text , 
+  "ssh_url" text , 
+  "teams_url" text , 
+  "template_repository" text , 
+  "homepage" text , 
+  "pulls_url" text , 
+  "role_name" text , 
+  "disabled" boolean , 
+  "id" integer , 
+  "language" text , 
+  "license" text , 
+  "subscribers_count" integer , 
+  "trees_url" text , 
+  "watchers_count" integer , 
+  "archive_url" text , 
+  "clone_url" text , 
+  "contributors_url" text , 
+  "fork" boolean , 
+  "forks" integer , 
+  "hooks_url" text , 
+  "name" text , 
+  "notifications_url" text , 
+  "visibility" text , 
+  "repo" text , 
+  "data__new_owner" text , 
+  "username" text , 
+  "org" text 
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  
+(
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)
+VALUES(
+2,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/fullstackchronicles.io/git/refs{/sha}',
+'git@github.com:stackql/fullstackchronicles.io.git',
+'["blog", "docusaurus", "docusaurus-v2", "javascript", "markdown", "react", "static-site-generator"]',
+'https://api.github.com/repos/stackql/fullstackchronicles.io',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/collaborators{/collaborator}',
+'Full Stack Chronicles blog',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/downloads',
+'https://github.com/stackql/fullstackchronicles.io',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/teams',
+'public',
+false,
+'git://github.com/stackql/fullstackchronicles.io.git',
+409393414,
+'https://api.github.com/repos/stackql/fullstackchronicles.io/events',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/forks',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/pulls{/number}',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/stargazers',
+'{"admin":true, "maintain":true, "pull":true, "push":true, "triage":true}',
+12,
+'https://api.github.com/repos/stackql/fullstackchronicles.io/subscription',
+'2022-09-06T00:15:03Z',
+NULL,
+'',
+NULL,
+false,
+1,
+NULL,
+'https://api.github.com/repos/stackql/fullstackchronicles.io/milestones{/number}',
+12,
+NULL,
+'stackql/fullstackchronicles.io',
+'https://github.com/stackql/fullstackchronicles.io',
+'main',
+true,
+'https://api.github.com/repos/stackql/fullstackchronicles.io/statuses/{sha}',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/git/trees{/sha}',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/fullstackchronicles.io/comments{/number}',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/contributors',
+'R_kgDOGGbZBg',
+true,
+'https://api.github.com/repos/stackql/fullstackchronicles.io/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/assignees{/user}',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/keys{/key_id}',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/languages',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/merges',
+'2022-09-05T05:59:41Z',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/releases{/id}',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/git/commits{/sha}',
+true,
+true,
+'JavaScript',
+31076,
+NULL,
+NULL,
+'https://github.com/stackql/fullstackchronicles.io.git',
+'2021-09-23T00:13:52Z',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4", "events_url":"https://api.github.com/users/stackql/events{/privacy}", "followers_url":"https://api.github.com/users/stackql/followers", "following_url":"https://api.github.com/users/stackql/following{/other_user}", "gists_url":"https://api.github.com/users/stackql/gists{/gist_id}", "gravatar_id":"", "html_url":"https://github.com/stackql", "id":95105302, "login":"stackql", "node_id":"O_kgDOBasxFg", "organizations_url":"https://api.github.com/users/stackql/orgs", "received_events_url":"https://api.github.com/users/stackql/received_events", "repos_url":"https://api.github.com/users/stackql/repos", "site_admin":false, "starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}", "subscriptions_url":"https://api.github.com/users/stackql/subscriptions", "type":"Organization", "url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/fullstackchronicles.io/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/fullstackchronicles.io/labels{/name}',
+'fullstackchronicles.io',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/tags',
+1,
+true,
+false,
+'https://api.github.com/repos/stackql/fullstackchronicles.io/notifications{?since,all,participating}',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/branches{/branch}',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/git/tags{/sha}',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/issues/comments{/number}',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/hooks',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/fullstackchronicles.io/subscribers',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/contents/{+path}',
+'https://api.github.com/repos/stackql/fullstackchronicles.io/deployments',
+false,
+12,
+'stackql'
+);
+
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+3,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/git/refs{/sha}',
+'git@github.com:stackql/docusaurus-plugin-hubspot.git',
+'["docusaurus",
+"docusaurus-v2",
+"hubspot",
+"javascript",
+"plugin",
+"react"]',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/collaborators{/collaborator}',
+'Plugin to enable Hubspot with Docusaurus',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/downloads',
+'https://github.com/stackql/docusaurus-plugin-hubspot',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/teams',
+'public',
+false,
+'git://github.com/stackql/docusaurus-plugin-hubspot.git',
+424079013,
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/events',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/forks',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/pulls{/number}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/stargazers',
+'{"admin":true, "maintain":true, "pull":true, "push":true, "triage":true}',
+6,
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/subscription',
+'2022-06-12T23:41:35Z',
+NULL,
+NULL,
+NULL,
+false,
+0,
+'{"key":"mit", "name":"MIT License", "node_id":"MDc6TGljZW5zZTEz", "spdx_id":"MIT", "url":"https://api.github.com/licenses/mit"}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/milestones{/number}',
+6,
+NULL,
+'stackql/docusaurus-plugin-hubspot',
+'https://github.com/stackql/docusaurus-plugin-hubspot',
+'main',
+true,
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/statuses/{sha}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/git/trees{/sha}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/comments{/number}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/contributors',
+'R_kgDOGUbupQ',
+true,
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/assignees{/user}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/keys{/key_id}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/languages',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/merges',
+'2022-05-02T01:56:13Z',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/releases{/id}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/git/commits{/sha}',
+true,
+true,
+'JavaScript',
+6,
+NULL,
+NULL,
+'https://github.com/stackql/docusaurus-plugin-hubspot.git',
+'2021-11-03T03:23:49Z',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/labels{/name}',
+'docusaurus-plugin-hubspot',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/branches{/branch}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/git/tags{/sha}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/issues/comments{/number}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/hooks',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/subscribers',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/contents/{+path}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-hubspot/deployments',
+false,
+6,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+4,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/git/refs{/sha}',
+'git@github.com:stackql/gatsby-plugin-smartlook.git',
+'["gatsby", "gatsby-plugin", "gatsbyjs", "javascript", "react", "smartlook"]',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/collaborators{/collaborator}',
+'Plugin to enable Smartlook session replay and web analytics software with a Gatsby site',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/downloads',
+'https://github.com/stackql/gatsby-plugin-smartlook',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/teams',
+'public',
+false,
+'git://github.com/stackql/gatsby-plugin-smartlook.git',
+425113738,
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/events',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/forks',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/pulls{/number}',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/stargazers',
+'{"admin":true, "maintain":true, "pull":true, "push":true, "triage":true}',
+4,
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/subscription',
+'2022-06-12T23:43:07Z',
+NULL,
+NULL,
+NULL,
+false,
+0,
+'{"key":"mit", "name":"MIT License", "node_id":"MDc6TGljZW5zZTEz", "spdx_id":"MIT", "url":"https://api.github.com/licenses/mit"}',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/milestones{/number}',
+4,
+NULL,
+'stackql/gatsby-plugin-smartlook',
+'https://github.com/stackql/gatsby-plugin-smartlook',
+'main',
+true,
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/statuses/{sha}',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/git/trees{/sha}',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/comments{/number}',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/contributors',
+'R_kgDOGVa4ig',
+true,
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/assignees{/user}',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/keys{/key_id}',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/languages',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/merges',
+'2022-05-02T01:46:06Z',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/releases{/id}',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/git/commits{/sha}',
+true,
+true,
+'JavaScript',
+307,
+NULL,
+NULL,
+'https://github.com/stackql/gatsby-plugin-smartlook.git',
+'2021-11-05T23:51:20Z',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/labels{/name}',
+'gatsby-plugin-smartlook',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/branches{/branch}',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/git/tags{/sha}',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/issues/comments{/number}',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/hooks',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/subscribers',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/contents/{+path}',
+'https://api.github.com/repos/stackql/gatsby-plugin-smartlook/deployments',
+false,
+4,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+5,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/git/refs{/sha}',
+'git@github.com:stackql/docusaurus-plugin-smartlook.git',
+'["docusaurus", "docusaurus-v2", "javascript", "plugin", "react", "smartlook"]',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/collaborators{/collaborator}',
+'A plugin which adds your Smartlook tracking code to your docusaurus site.',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/downloads',
+'https://github.com/stackql/docusaurus-plugin-smartlook',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/teams',
+'public',
+false,
+'git://github.com/stackql/docusaurus-plugin-smartlook.git',
+425366372,
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/events',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/forks',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/pulls{/number}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+5,
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/subscription',
+'2022-06-12T23:41:43Z',
+NULL,
+NULL,
+NULL,
+false,
+0,
+'{"key":"mit", "name":"MIT License", "node_id":"MDc6TGljZW5zZTEz", "spdx_id":"MIT", "url":"https://api.github.com/licenses/mit"}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/milestones{/number}',
+5,
+NULL,
+'stackql/docusaurus-plugin-smartlook',
+'https://github.com/stackql/docusaurus-plugin-smartlook',
+'main',
+true,
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/statuses/{sha}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/git/trees{/sha}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/comments{/number}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/contributors',
+'R_kgDOGVqTZA',
+true,
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/assignees{/user}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/keys{/key_id}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/languages',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/merges',
+'2022-05-02T01:53:12Z',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/releases{/id}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/git/commits{/sha}',
+true,
+true,
+'JavaScript',
+3,
+NULL,
+NULL,
+'https://github.com/stackql/docusaurus-plugin-smartlook.git',
+'2021-11-06T22:58:08Z',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/labels{/name}',
+'docusaurus-plugin-smartlook',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/branches{/branch}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/git/tags{/sha}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/issues/comments{/number}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/hooks',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/subscribers',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/contents/{+path}',
+'https://api.github.com/repos/stackql/docusaurus-plugin-smartlook/deployments',
+false,
+5,
+'stackql'
+);
+
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+6,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/stackql.io/git/refs{/sha}',
+'git@github.com:stackql/stackql.io.git',
+'[]',
+'https://api.github.com/repos/stackql/stackql.io',
+'https://api.github.com/repos/stackql/stackql.io/collaborators{/collaborator}',
+NULL,
+'https://api.github.com/repos/stackql/stackql.io/downloads',
+'https://github.com/stackql/stackql.io',
+'https://api.github.com/repos/stackql/stackql.io/teams',
+'private',
+false,
+'git://github.com/stackql/stackql.io.git',
+435085734,
+'https://api.github.com/repos/stackql/stackql.io/events',
+'https://api.github.com/repos/stackql/stackql.io/forks',
+'https://api.github.com/repos/stackql/stackql.io/pulls{/number}',
+'https://api.github.com/repos/stackql/stackql.io/stargazers',
+'{"admin":true, "maintain":true, "pull":true, "push":true, "triage":true}',
+0,
+'https://api.github.com/repos/stackql/stackql.io/subscription',
+'2022-01-18T22:23:59Z',
+NULL,
+NULL,
+NULL,
+true,
+0,
+NULL,
+'https://api.github.com/repos/stackql/stackql.io/milestones{/number}',
+0,
+NULL,
+'stackql/stackql.io',
+'https://github.com/stackql/stackql.io',
+'main',
+true,
+'https://api.github.com/repos/stackql/stackql.io/statuses/{sha}',
+'https://api.github.com/repos/stackql/stackql.io/git/trees{/sha}',
+'https://api.github.com/repos/stackql/stackql.io/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/stackql.io/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/stackql.io/comments{/number}',
+'https://api.github.com/repos/stackql/stackql.io/contributors',
+'R_kgDOGe7hpg',
+false,
+'https://api.github.com/repos/stackql/stackql.io/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/stackql.io/assignees{/user}',
+'https://api.github.com/repos/stackql/stackql.io/keys{/key_id}',
+'https://api.github.com/repos/stackql/stackql.io/languages',
+'https://api.github.com/repos/stackql/stackql.io/merges',
+'2022-09-19T02:15:18Z',
+'https://api.github.com/repos/stackql/stackql.io/releases{/id}',
+'https://api.github.com/repos/stackql/stackql.io/git/commits{/sha}',
+true,
+true,
+'CSS',
+36926,
+NULL,
+NULL,
+'https://github.com/stackql/stackql.io.git',
+'2021-12-05T05:53:03Z',
+'https://api.github.com/repos/stackql/stackql.io/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4", "events_url":"https://api.github.com/users/stackql/events{/privacy}", "followers_url":"https://api.github.com/users/stackql/followers", "following_url":"https://api.github.com/users/stackql/following{/other_user}", "gists_url":"https://api.github.com/users/stackql/gists{/gist_id}", "gravatar_id":"", "html_url":"https://github.com/stackql", "id":95105302, "login":"stackql", "node_id":"O_kgDOBasxFg", "organizations_url":"https://api.github.com/users/stackql/orgs", "received_events_url":"https://api.github.com/users/stackql/received_events", "repos_url":"https://api.github.com/users/stackql/repos", "site_admin":false, "starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}", "subscriptions_url":"https://api.github.com/users/stackql/subscriptions", "type":"Organization", "url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/stackql.io/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/stackql.io/labels{/name}',
+'stackql.io',
+'https://api.github.com/repos/stackql/stackql.io/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/stackql.io/notifications{?since,all,participating}',
+'https://api.github.com/repos/stackql/stackql.io/branches{/branch}',
+'https://api.github.com/repos/stackql/stackql.io/git/tags{/sha}',
+'https://api.github.com/repos/stackql/stackql.io/issues/comments{/number}',
+'https://api.github.com/repos/stackql/stackql.io/hooks',
+'https://api.github.com/repos/stackql/stackql.io/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/stackql.io/subscribers',
+'https://api.github.com/repos/stackql/stackql.io/contents/{+path}',
+'https://api.github.com/repos/stackql/stackql.io/deployments',
+false,
+0,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+7,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/stackql-provider-registry/git/refs{/sha}',
+'git@github.com:stackql/stackql-provider-registry.git',
+'["asset-management", "cloud", "cloud-automation", "cloud-security", "devops", "devsecops", "gcp", "github", "netlify", "okta", "openapi3", "sql", "stackql"]',
+'https://api.github.com/repos/stackql/stackql-provider-registry',
+'https://api.github.com/repos/stackql/stackql-provider-registry/collaborators{/collaborator}',
+'Registry for cloud and SaaS providers for StackQL, generated from extensions to the providers OpenAPI3 specification',
+'https://api.github.com/repos/stackql/stackql-provider-registry/downloads',
+'https://github.com/stackql/stackql-provider-registry',
+'https://api.github.com/repos/stackql/stackql-provider-registry/teams',
+'public',
+false,
+'git://github.com/stackql/stackql-provider-registry.git',
+441087132,
+'https://api.github.com/repos/stackql/stackql-provider-registry/events',
+'https://api.github.com/repos/stackql/stackql-provider-registry/forks',
+'https://api.github.com/repos/stackql/stackql-provider-registry/pulls{/number}',
+'https://api.github.com/repos/stackql/stackql-provider-registry/stargazers',
+'{"admin":true, "maintain":true, "pull":true, "push":true, "triage":true}',
+13,
+'https://api.github.com/repos/stackql/stackql-provider-registry/subscription',
+'2022-09-05T06:17:10Z',
+NULL,
+'https://stackql.io/',
+NULL,
+false,
+1,
+NULL,
+'https://api.github.com/repos/stackql/stackql-provider-registry/milestones{/number}',
+13,
+NULL,
+'stackql/stackql-provider-registry',
+'https://github.com/stackql/stackql-provider-registry',
+'dev',
+true,
+'https://api.github.com/repos/stackql/stackql-provider-registry/statuses/{sha}',
+'https://api.github.com/repos/stackql/stackql-provider-registry/git/trees{/sha}',
+'https://api.github.com/repos/stackql/stackql-provider-registry/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/stackql-provider-registry/compare/{base}...{head}',
+1,
+'https://api.github.com/repos/stackql/stackql-provider-registry/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-provider-registry/contributors',
+'R_kgDOGkp0nA',
+true,
+'https://api.github.com/repos/stackql/stackql-provider-registry/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/stackql-provider-registry/assignees{/user}',
+'https://api.github.com/repos/stackql/stackql-provider-registry/keys{/key_id}',
+'https://api.github.com/repos/stackql/stackql-provider-registry/languages',
+'https://api.github.com/repos/stackql/stackql-provider-registry/merges',
+'2022-09-23T01:06:49Z',
+'https://api.github.com/repos/stackql/stackql-provider-registry/releases{/id}',
+'https://api.github.com/repos/stackql/stackql-provider-registry/git/commits{/sha}',
+true,
+true,
+'Standard ML',
+78516,
+NULL,
+NULL,
+'https://github.com/stackql/stackql-provider-registry.git',
+'2021-12-23T06:39:01Z',
+'https://api.github.com/repos/stackql/stackql-provider-registry/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4", "events_url":"https://api.github.com/users/stackql/events{/privacy}", "followers_url":"https://api.github.com/users/stackql/followers", "following_url":"https://api.github.com/users/stackql/following{/other_user}", "gists_url":"https://api.github.com/users/stackql/gists{/gist_id}", "gravatar_id":"", "html_url":"https://github.com/stackql", "id":95105302, "login":"stackql", "node_id":"O_kgDOBasxFg", "organizations_url":"https://api.github.com/users/stackql/orgs", "received_events_url":"https://api.github.com/users/stackql/received_events", "repos_url":"https://api.github.com/users/stackql/repos", "site_admin":false, "starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}", "subscriptions_url":"https://api.github.com/users/stackql/subscriptions", "type":"Organization", "url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/stackql-provider-registry/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/stackql-provider-registry/labels{/name}',
+'stackql-provider-registry',
+'https://api.github.com/repos/stackql/stackql-provider-registry/tags',
+1,
+false,
+false,
+'https://api.github.com/repos/stackql/stackql-provider-registry/notifications{?since,all,participating}',
+'https://api.github.com/repos/stackql/stackql-provider-registry/branches{/branch}',
+'https://api.github.com/repos/stackql/stackql-provider-registry/git/tags{/sha}',
+'https://api.github.com/repos/stackql/stackql-provider-registry/issues/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-provider-registry/hooks',
+'https://api.github.com/repos/stackql/stackql-provider-registry/issues{/number}',
+1,
+NULL,
+'https://api.github.com/repos/stackql/stackql-provider-registry/subscribers',
+'https://api.github.com/repos/stackql/stackql-provider-registry/contents/{+path}',
+'https://api.github.com/repos/stackql/stackql-provider-registry/deployments',
+false,
+13,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+8,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/releases.stackql.io/git/refs{/sha}',
+'git@github.com:stackql/releases.stackql.io.git',
+'[]',
+'https://api.github.com/repos/stackql/releases.stackql.io',
+'https://api.github.com/repos/stackql/releases.stackql.io/collaborators{/collaborator}',
+'StackQL Releases Service (App Engine Standard/Cloud Storage)',
+'https://api.github.com/repos/stackql/releases.stackql.io/downloads',
+'https://github.com/stackql/releases.stackql.io',
+'https://api.github.com/repos/stackql/releases.stackql.io/teams',
+'private',
+false,
+'git://github.com/stackql/releases.stackql.io.git',
+443979486,
+'https://api.github.com/repos/stackql/releases.stackql.io/events',
+'https://api.github.com/repos/stackql/releases.stackql.io/forks',
+'https://api.github.com/repos/stackql/releases.stackql.io/pulls{/number}',
+'https://api.github.com/repos/stackql/releases.stackql.io/stargazers',
+'{"admin":true, "maintain":true, "pull":true, "push":true, "triage":true}',
+0,
+'https://api.github.com/repos/stackql/releases.stackql.io/subscription',
+'2022-01-06T03:10:36Z',
+NULL,
+NULL,
+NULL,
+true,
+0,
+NULL,
+'https://api.github.com/repos/stackql/releases.stackql.io/milestones{/number}',
+0,
+NULL,
+'stackql/releases.stackql.io',
+'https://github.com/stackql/releases.stackql.io',
+'main',
+true,
+'https://api.github.com/repos/stackql/releases.stackql.io/statuses/{sha}',
+'https://api.github.com/repos/stackql/releases.stackql.io/git/trees{/sha}',
+'https://api.github.com/repos/stackql/releases.stackql.io/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/releases.stackql.io/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/releases.stackql.io/comments{/number}',
+'https://api.github.com/repos/stackql/releases.stackql.io/contributors',
+'R_kgDOGnaW3g',
+false,
+'https://api.github.com/repos/stackql/releases.stackql.io/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/releases.stackql.io/assignees{/user}',
+'https://api.github.com/repos/stackql/releases.stackql.io/keys{/key_id}',
+'https://api.github.com/repos/stackql/releases.stackql.io/languages',
+'https://api.github.com/repos/stackql/releases.stackql.io/merges',
+'2022-08-30T22:31:45Z',
+'https://api.github.com/repos/stackql/releases.stackql.io/releases{/id}',
+'https://api.github.com/repos/stackql/releases.stackql.io/git/commits{/sha}',
+true,
+true,
+'JavaScript',
+83,
+NULL,
+NULL,
+'https://github.com/stackql/releases.stackql.io.git',
+'2022-01-03T07:45:35Z',
+'https://api.github.com/repos/stackql/releases.stackql.io/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4", "events_url":"https://api.github.com/users/stackql/events{/privacy}", "followers_url":"https://api.github.com/users/stackql/followers", "following_url":"https://api.github.com/users/stackql/following{/other_user}", "gists_url":"https://api.github.com/users/stackql/gists{/gist_id}", "gravatar_id":"", "html_url":"https://github.com/stackql", "id":95105302, "login":"stackql", "node_id":"O_kgDOBasxFg", "organizations_url":"https://api.github.com/users/stackql/orgs", "received_events_url":"https://api.github.com/users/stackql/received_events", "repos_url":"https://api.github.com/users/stackql/repos", "site_admin":false, "starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}", "subscriptions_url":"https://api.github.com/users/stackql/subscriptions", "type":"Organization", "url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/releases.stackql.io/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/releases.stackql.io/labels{/name}',
+'releases.stackql.io',
+'https://api.github.com/repos/stackql/releases.stackql.io/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/releases.stackql.io/notifications{?since,all,participating}',
+'https://api.github.com/repos/stackql/releases.stackql.io/branches{/branch}',
+'https://api.github.com/repos/stackql/releases.stackql.io/git/tags{/sha}',
+'https://api.github.com/repos/stackql/releases.stackql.io/issues/comments{/number}',
+'https://api.github.com/repos/stackql/releases.stackql.io/hooks',
+'https://api.github.com/repos/stackql/releases.stackql.io/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/releases.stackql.io/subscribers',
+'https://api.github.com/repos/stackql/releases.stackql.io/contents/{+path}',
+'https://api.github.com/repos/stackql/releases.stackql.io/deployments',
+false,
+0,
+'stackql'
+);
+
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+9,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/stackql/git/refs{/sha}',
+'git@github.com:stackql/stackql.git',
+'["asset-management", "cloud", "cloud-automation", "cloud-config", "cloud-operations", "cloud-security", "cspm", "devops", "devsecops", "infrastructure-as-code", "openapi3", "sql", "stackql"]',
+'https://api.github.com/repos/stackql/stackql',
+'https://api.github.com/repos/stackql/stackql/collaborators{/collaborator}',
+'Query,
+provision and operate Cloud and SaaS resources and APIs using an extensible SQL based framework',
+'https://api.github.com/repos/stackql/stackql/downloads',
+'https://github.com/stackql/stackql',
+'https://api.github.com/repos/stackql/stackql/teams',
+'public',
+false,
+'git://github.com/stackql/stackql.git',
+443987542,
+'https://api.github.com/repos/stackql/stackql/events',
+'https://api.github.com/repos/stackql/stackql/forks',
+'https://api.github.com/repos/stackql/stackql/pulls{/number}',
+'https://api.github.com/repos/stackql/stackql/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+44,
+'https://api.github.com/repos/stackql/stackql/subscription',
+'2022-09-01T03:46:53Z',
+NULL,
+'https://stackql.io/',
+NULL,
+false,
+0,
+'{"key":"mit", "name":"MIT License", "node_id":"MDc6TGljZW5zZTEz", "spdx_id":"MIT", "url":"https://api.github.com/licenses/mit"}',
+'https://api.github.com/repos/stackql/stackql/milestones{/number}',
+44,
+NULL,
+'stackql/stackql',
+'https://github.com/stackql/stackql',
+'main',
+true,
+'https://api.github.com/repos/stackql/stackql/statuses/{sha}',
+'https://api.github.com/repos/stackql/stackql/git/trees{/sha}',
+'https://api.github.com/repos/stackql/stackql/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/stackql/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/stackql/comments{/number}',
+'https://api.github.com/repos/stackql/stackql/contributors',
+'R_kgDOGna2Vg',
+true,
+'https://api.github.com/repos/stackql/stackql/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/stackql/assignees{/user}',
+'https://api.github.com/repos/stackql/stackql/keys{/key_id}',
+'https://api.github.com/repos/stackql/stackql/languages',
+'https://api.github.com/repos/stackql/stackql/merges',
+'2022-09-20T12:41:16Z',
+'https://api.github.com/repos/stackql/stackql/releases{/id}',
+'https://api.github.com/repos/stackql/stackql/git/commits{/sha}',
+true,
+true,
+'Go',
+12143,
+NULL,
+NULL,
+'https://github.com/stackql/stackql.git',
+'2022-01-03T08:20:06Z',
+'https://api.github.com/repos/stackql/stackql/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/stackql/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/stackql/labels{/name}',
+'stackql',
+'https://api.github.com/repos/stackql/stackql/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/stackql/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/stackql/branches{/branch}',
+'https://api.github.com/repos/stackql/stackql/git/tags{/sha}',
+'https://api.github.com/repos/stackql/stackql/issues/comments{/number}',
+'https://api.github.com/repos/stackql/stackql/hooks',
+'https://api.github.com/repos/stackql/stackql/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/stackql/subscribers',
+'https://api.github.com/repos/stackql/stackql/contents/{+path}',
+'https://api.github.com/repos/stackql/stackql/deployments',
+false,
+44,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+10,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/go-openapistackql/git/refs{/sha}',
+'git@github.com:stackql/go-openapistackql.git',
+'[]',
+'https://api.github.com/repos/stackql/go-openapistackql',
+'https://api.github.com/repos/stackql/go-openapistackql/collaborators{/collaborator}',
+NULL,
+'https://api.github.com/repos/stackql/go-openapistackql/downloads',
+'https://github.com/stackql/go-openapistackql',
+'https://api.github.com/repos/stackql/go-openapistackql/teams',
+'public',
+false,
+'git://github.com/stackql/go-openapistackql.git',
+446769762,
+'https://api.github.com/repos/stackql/go-openapistackql/events',
+'https://api.github.com/repos/stackql/go-openapistackql/forks',
+'https://api.github.com/repos/stackql/go-openapistackql/pulls{/number}',
+'https://api.github.com/repos/stackql/go-openapistackql/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+3,
+'https://api.github.com/repos/stackql/go-openapistackql/subscription',
+'2022-05-02T22:20:13Z',
+NULL,
+NULL,
+NULL,
+false,
+0,
+'{"key":"mit", "name":"MIT License", "node_id":"MDc6TGljZW5zZTEz", "spdx_id":"MIT", "url":"https://api.github.com/licenses/mit"}',
+'https://api.github.com/repos/stackql/go-openapistackql/milestones{/number}',
+3,
+NULL,
+'stackql/go-openapistackql',
+'https://github.com/stackql/go-openapistackql',
+'main',
+true,
+'https://api.github.com/repos/stackql/go-openapistackql/statuses/{sha}',
+'https://api.github.com/repos/stackql/go-openapistackql/git/trees{/sha}',
+'https://api.github.com/repos/stackql/go-openapistackql/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/go-openapistackql/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/go-openapistackql/comments{/number}',
+'https://api.github.com/repos/stackql/go-openapistackql/contributors',
+'R_kgDOGqEqYg',
+true,
+'https://api.github.com/repos/stackql/go-openapistackql/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/go-openapistackql/assignees{/user}',
+'https://api.github.com/repos/stackql/go-openapistackql/keys{/key_id}',
+'https://api.github.com/repos/stackql/go-openapistackql/languages',
+'https://api.github.com/repos/stackql/go-openapistackql/merges',
+'2022-09-20T12:43:59Z',
+'https://api.github.com/repos/stackql/go-openapistackql/releases{/id}',
+'https://api.github.com/repos/stackql/go-openapistackql/git/commits{/sha}',
+true,
+true,
+'Go',
+25233,
+NULL,
+NULL,
+'https://github.com/stackql/go-openapistackql.git',
+'2022-01-11T10:11:24Z',
+'https://api.github.com/repos/stackql/go-openapistackql/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/go-openapistackql/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/go-openapistackql/labels{/name}',
+'go-openapistackql',
+'https://api.github.com/repos/stackql/go-openapistackql/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/go-openapistackql/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/go-openapistackql/branches{/branch}',
+'https://api.github.com/repos/stackql/go-openapistackql/git/tags{/sha}',
+'https://api.github.com/repos/stackql/go-openapistackql/issues/comments{/number}',
+'https://api.github.com/repos/stackql/go-openapistackql/hooks',
+'https://api.github.com/repos/stackql/go-openapistackql/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/go-openapistackql/subscribers',
+'https://api.github.com/repos/stackql/go-openapistackql/contents/{+path}',
+'https://api.github.com/repos/stackql/go-openapistackql/deployments',
+false,
+3,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+11,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/go-spew/git/refs{/sha}',
+'git@github.com:stackql/go-spew.git',
+'[]',
+'https://api.github.com/repos/stackql/go-spew',
+'https://api.github.com/repos/stackql/go-spew/collaborators{/collaborator}',
+NULL,
+'https://api.github.com/repos/stackql/go-spew/downloads',
+'https://github.com/stackql/go-spew',
+'https://api.github.com/repos/stackql/go-spew/teams',
+'public',
+false,
+'git://github.com/stackql/go-spew.git',
+447051137,
+'https://api.github.com/repos/stackql/go-spew/events',
+'https://api.github.com/repos/stackql/go-spew/forks',
+'https://api.github.com/repos/stackql/go-spew/pulls{/number}',
+'https://api.github.com/repos/stackql/go-spew/stargazers',
+'{"admin":true, "maintain":true, "pull":true, "push":true, "triage":true}',
+0,
+'https://api.github.com/repos/stackql/go-spew/subscription',
+'2022-01-31T09:11:06Z',
+NULL,
+NULL,
+NULL,
+false,
+0,
+'{"key":"isc", "name":"ISC License", "node_id":"MDc6TGljZW5zZTEw", "spdx_id":"ISC", "url":"https://api.github.com/licenses/isc"}',
+'https://api.github.com/repos/stackql/go-spew/milestones{/number}',
+0,
+NULL,
+'stackql/go-spew',
+'https://github.com/stackql/go-spew',
+'master',
+true,
+'https://api.github.com/repos/stackql/go-spew/statuses/{sha}',
+'https://api.github.com/repos/stackql/go-spew/git/trees{/sha}',
+'https://api.github.com/repos/stackql/go-spew/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/go-spew/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/go-spew/comments{/number}',
+'https://api.github.com/repos/stackql/go-spew/contributors',
+'R_kgDOGqV1gQ',
+true,
+'https://api.github.com/repos/stackql/go-spew/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/go-spew/assignees{/user}',
+'https://api.github.com/repos/stackql/go-spew/keys{/key_id}',
+'https://api.github.com/repos/stackql/go-spew/languages',
+'https://api.github.com/repos/stackql/go-spew/merges',
+'2022-01-13T08:03:11Z',
+'https://api.github.com/repos/stackql/go-spew/releases{/id}',
+'https://api.github.com/repos/stackql/go-spew/git/commits{/sha}',
+true,
+true,
+'Go',
+384,
+NULL,
+NULL,
+'https://github.com/stackql/go-spew.git',
+'2022-01-12T02:32:43Z',
+'https://api.github.com/repos/stackql/go-spew/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/go-spew/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/go-spew/labels{/name}',
+'go-spew',
+'https://api.github.com/repos/stackql/go-spew/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/go-spew/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/go-spew/branches{/branch}',
+'https://api.github.com/repos/stackql/go-spew/git/tags{/sha}',
+'https://api.github.com/repos/stackql/go-spew/issues/comments{/number}',
+'https://api.github.com/repos/stackql/go-spew/hooks',
+'https://api.github.com/repos/stackql/go-spew/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/go-spew/subscribers',
+'https://api.github.com/repos/stackql/go-spew/contents/{+path}',
+'https://api.github.com/repos/stackql/go-spew/deployments',
+false,
+0,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+12,
+1,
+3,
+2,
+1,
+
+'%path%org%stackql%%region%region%%',
+
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/stackql-devel/git/refs{/sha}',
+'git@github.com:stackql/stackql-devel.git',
+'[]',
+'https://api.github.com/repos/stackql/stackql-devel',
+'https://api.github.com/repos/stackql/stackql-devel/collaborators{/collaborator}',
+NULL,
+'https://api.github.com/repos/stackql/stackql-devel/downloads',
+'https://github.com/stackql/stackql-devel',
+'https://api.github.com/repos/stackql/stackql-devel/teams',
+'private',
+false,
+'git://github.com/stackql/stackql-devel.git',
+447890554,
+'https://api.github.com/repos/stackql/stackql-devel/events',
+'https://api.github.com/repos/stackql/stackql-devel/forks',
+'https://api.github.com/repos/stackql/stackql-devel/pulls{/number}',
+'https://api.github.com/repos/stackql/stackql-devel/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+0,
+'https://api.github.com/repos/stackql/stackql-devel/subscription',
+'2022-01-14T08:49:22Z',
+NULL,
+NULL,
+NULL,
+true,
+0,
+'{"key":"mit", "name":"MIT License", "node_id":"MDc6TGljZW5zZTEz", "spdx_id":"MIT", "url":"https://api.github.com/licenses/mit"}',
+'https://api.github.com/repos/stackql/stackql-devel/milestones{/number}',
+0,
+NULL,
+'stackql/stackql-devel',
+'https://github.com/stackql/stackql-devel',
+'main',
+true,
+'https://api.github.com/repos/stackql/stackql-devel/statuses/{sha}',
+'https://api.github.com/repos/stackql/stackql-devel/git/trees{/sha}',
+'https://api.github.com/repos/stackql/stackql-devel/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/stackql-devel/compare/{base}...{head}',
+26,
+'https://api.github.com/repos/stackql/stackql-devel/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-devel/contributors',
+'R_kgDOGrJEeg',
+false,
+'https://api.github.com/repos/stackql/stackql-devel/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/stackql-devel/assignees{/user}',
+'https://api.github.com/repos/stackql/stackql-devel/keys{/key_id}',
+'https://api.github.com/repos/stackql/stackql-devel/languages',
+'https://api.github.com/repos/stackql/stackql-devel/merges',
+'2022-09-25T04:06:14Z',
+'https://api.github.com/repos/stackql/stackql-devel/releases{/id}',
+'https://api.github.com/repos/stackql/stackql-devel/git/commits{/sha}',
+true,
+true,
+'Go',
+18843,
+NULL,
+NULL,
+'https://github.com/stackql/stackql-devel.git',
+'2022-01-14T08:14:28Z',
+'https://api.github.com/repos/stackql/stackql-devel/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/stackql-devel/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/stackql-devel/labels{/name}',
+'stackql-devel',
+'https://api.github.com/repos/stackql/stackql-devel/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/stackql-devel/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/stackql-devel/branches{/branch}',
+'https://api.github.com/repos/stackql/stackql-devel/git/tags{/sha}',
+'https://api.github.com/repos/stackql/stackql-devel/issues/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-devel/hooks',
+'https://api.github.com/repos/stackql/stackql-devel/issues{/number}',
+26,
+NULL,
+'https://api.github.com/repos/stackql/stackql-devel/subscribers',
+'https://api.github.com/repos/stackql/stackql-devel/contents/{+path}',
+'https://api.github.com/repos/stackql/stackql-devel/deployments',
+false,
+0,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+13,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/go-sqlite3/git/refs{/sha}',
+'git@github.com:stackql/go-sqlite3.git',
+'[]',
+'https://api.github.com/repos/stackql/go-sqlite3',
+'https://api.github.com/repos/stackql/go-sqlite3/collaborators{/collaborator}',
+NULL,
+'https://api.github.com/repos/stackql/go-sqlite3/downloads',
+'https://github.com/stackql/go-sqlite3',
+'https://api.github.com/repos/stackql/go-sqlite3/teams',
+'public',
+false,
+'git://github.com/stackql/go-sqlite3.git',
+448123925,
+'https://api.github.com/repos/stackql/go-sqlite3/events',
+'https://api.github.com/repos/stackql/go-sqlite3/forks',
+'https://api.github.com/repos/stackql/go-sqlite3/pulls{/number}',
+'https://api.github.com/repos/stackql/go-sqlite3/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+0,
+'https://api.github.com/repos/stackql/go-sqlite3/subscription',
+'2022-01-31T09:09:43Z',
+NULL,
+NULL,
+NULL,
+false,
+0,
+'{"key":"mit", "name":"MIT License", "node_id":"MDc6TGljZW5zZTEz", "spdx_id":"MIT", "url":"https://api.github.com/licenses/mit"}',
+'https://api.github.com/repos/stackql/go-sqlite3/milestones{/number}',
+0,
+NULL,
+'stackql/go-sqlite3',
+'https://github.com/stackql/go-sqlite3',
+'feature/stackql-develop',
+true,
+'https://api.github.com/repos/stackql/go-sqlite3/statuses/{sha}',
+'https://api.github.com/repos/stackql/go-sqlite3/git/trees{/sha}',
+'https://api.github.com/repos/stackql/go-sqlite3/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/go-sqlite3/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/go-sqlite3/comments{/number}',
+'https://api.github.com/repos/stackql/go-sqlite3/contributors',
+'R_kgDOGrXUFQ',
+true,
+'https://api.github.com/repos/stackql/go-sqlite3/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/go-sqlite3/assignees{/user}',
+'https://api.github.com/repos/stackql/go-sqlite3/keys{/key_id}',
+'https://api.github.com/repos/stackql/go-sqlite3/languages',
+'https://api.github.com/repos/stackql/go-sqlite3/merges',
+'2022-09-20T05:02:18Z',
+'https://api.github.com/repos/stackql/go-sqlite3/releases{/id}',
+'https://api.github.com/repos/stackql/go-sqlite3/git/commits{/sha}',
+true,
+true,
+'C',
+30334,
+NULL,
+NULL,
+'https://github.com/stackql/go-sqlite3.git',
+'2022-01-14T22:27:22Z',
+'https://api.github.com/repos/stackql/go-sqlite3/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/go-sqlite3/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/go-sqlite3/labels{/name}',
+'go-sqlite3',
+'https://api.github.com/repos/stackql/go-sqlite3/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/go-sqlite3/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/go-sqlite3/branches{/branch}',
+'https://api.github.com/repos/stackql/go-sqlite3/git/tags{/sha}',
+'https://api.github.com/repos/stackql/go-sqlite3/issues/comments{/number}',
+'https://api.github.com/repos/stackql/go-sqlite3/hooks',
+'https://api.github.com/repos/stackql/go-sqlite3/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/go-sqlite3/subscribers',
+'https://api.github.com/repos/stackql/go-sqlite3/contents/{+path}',
+'https://api.github.com/repos/stackql/go-sqlite3/deployments',
+false,
+0,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+14,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/vitess/git/refs{/sha}',
+'git@github.com:stackql/vitess.git',
+'[]',
+'https://api.github.com/repos/stackql/vitess',
+'https://api.github.com/repos/stackql/vitess/collaborators{/collaborator}',
+NULL,
+'https://api.github.com/repos/stackql/vitess/downloads',
+'https://github.com/stackql/vitess',
+'https://api.github.com/repos/stackql/vitess/teams',
+'public',
+false,
+'git://github.com/stackql/vitess.git',
+448126348,
+'https://api.github.com/repos/stackql/vitess/events',
+'https://api.github.com/repos/stackql/vitess/forks',
+'https://api.github.com/repos/stackql/vitess/pulls{/number}',
+'https://api.github.com/repos/stackql/vitess/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+0,
+'https://api.github.com/repos/stackql/vitess/subscription',
+'2022-01-31T09:09:09Z',
+NULL,
+NULL,
+NULL,
+false,
+0,
+'{"key":"apache-2.0", "name":"Apache License 2.0", "node_id":"MDc6TGljZW5zZTI=", "spdx_id":"Apache-2.0", "url":"https://api.github.com/licenses/apache-2.0"}',
+'https://api.github.com/repos/stackql/vitess/milestones{/number}',
+0,
+NULL,
+'stackql/vitess',
+'https://github.com/stackql/vitess',
+'stackql-develop',
+true,
+'https://api.github.com/repos/stackql/vitess/statuses/{sha}',
+'https://api.github.com/repos/stackql/vitess/git/trees{/sha}',
+'https://api.github.com/repos/stackql/vitess/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/vitess/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/vitess/comments{/number}',
+'https://api.github.com/repos/stackql/vitess/contributors',
+'R_kgDOGrXdjA',
+true,
+'https://api.github.com/repos/stackql/vitess/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/vitess/assignees{/user}',
+'https://api.github.com/repos/stackql/vitess/keys{/key_id}',
+'https://api.github.com/repos/stackql/vitess/languages',
+'https://api.github.com/repos/stackql/vitess/merges',
+'2022-09-11T04:40:02Z',
+'https://api.github.com/repos/stackql/vitess/releases{/id}',
+'https://api.github.com/repos/stackql/vitess/git/commits{/sha}',
+true,
+true,
+'Go',
+158812,
+NULL,
+NULL,
+'https://github.com/stackql/vitess.git',
+'2022-01-14T22:40:55Z',
+'https://api.github.com/repos/stackql/vitess/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/vitess/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/vitess/labels{/name}',
+'vitess',
+'https://api.github.com/repos/stackql/vitess/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/vitess/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/vitess/branches{/branch}',
+'https://api.github.com/repos/stackql/vitess/git/tags{/sha}',
+'https://api.github.com/repos/stackql/vitess/issues/comments{/number}',
+'https://api.github.com/repos/stackql/vitess/hooks',
+'https://api.github.com/repos/stackql/vitess/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/vitess/subscribers',
+'https://api.github.com/repos/stackql/vitess/contents/{+path}',
+'https://api.github.com/repos/stackql/vitess/deployments',
+false,
+0,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+15,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/readline/git/refs{/sha}',
+'git@github.com:stackql/readline.git',
+'[]',
+'https://api.github.com/repos/stackql/readline',
+'https://api.github.com/repos/stackql/readline/collaborators{/collaborator}',
+NULL,
+'https://api.github.com/repos/stackql/readline/downloads',
+'https://github.com/stackql/readline',
+'https://api.github.com/repos/stackql/readline/teams',
+'public',
+false,
+'git://github.com/stackql/readline.git',
+448127673,
+'https://api.github.com/repos/stackql/readline/events',
+'https://api.github.com/repos/stackql/readline/forks',
+'https://api.github.com/repos/stackql/readline/pulls{/number}',
+'https://api.github.com/repos/stackql/readline/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+0,
+'https://api.github.com/repos/stackql/readline/subscription',
+'2022-01-31T09:10:12Z',
+NULL,
+NULL,
+NULL,
+false,
+0,
+'{"key":"mit", "name":"MIT License", "node_id":"MDc6TGljZW5zZTEz", "spdx_id":"MIT", "url":"https://api.github.com/licenses/mit"}',
+'https://api.github.com/repos/stackql/readline/milestones{/number}',
+0,
+NULL,
+'stackql/readline',
+'https://github.com/stackql/readline',
+'feature/infraql-develop',
+true,
+'https://api.github.com/repos/stackql/readline/statuses/{sha}',
+'https://api.github.com/repos/stackql/readline/git/trees{/sha}',
+'https://api.github.com/repos/stackql/readline/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/readline/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/readline/comments{/number}',
+'https://api.github.com/repos/stackql/readline/contributors',
+'R_kgDOGrXiuQ',
+true,
+'https://api.github.com/repos/stackql/readline/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/readline/assignees{/user}',
+'https://api.github.com/repos/stackql/readline/keys{/key_id}',
+'https://api.github.com/repos/stackql/readline/languages',
+'https://api.github.com/repos/stackql/readline/merges',
+'2022-09-06T00:19:07Z',
+'https://api.github.com/repos/stackql/readline/releases{/id}',
+'https://api.github.com/repos/stackql/readline/git/commits{/sha}',
+true,
+true,
+'Go',
+3550,
+NULL,
+NULL,
+'https://github.com/stackql/readline.git',
+'2022-01-14T22:48:24Z',
+'https://api.github.com/repos/stackql/readline/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/readline/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/readline/labels{/name}',
+'readline',
+'https://api.github.com/repos/stackql/readline/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/readline/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/readline/branches{/branch}',
+'https://api.github.com/repos/stackql/readline/git/tags{/sha}',
+'https://api.github.com/repos/stackql/readline/issues/comments{/number}',
+'https://api.github.com/repos/stackql/readline/hooks',
+'https://api.github.com/repos/stackql/readline/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/readline/subscribers',
+'https://api.github.com/repos/stackql/readline/contents/{+path}',
+'https://api.github.com/repos/stackql/readline/deployments',
+false,
+0,
+'stackql');
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+16,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/color/git/refs{/sha}',
+'git@github.com:stackql/color.git',
+'[]',
+'https://api.github.com/repos/stackql/color',
+'https://api.github.com/repos/stackql/color/collaborators{/collaborator}',
+NULL,
+'https://api.github.com/repos/stackql/color/downloads',
+'https://github.com/stackql/color',
+'https://api.github.com/repos/stackql/color/teams',
+'public',
+false,
+'git://github.com/stackql/color.git',
+448127756,
+'https://api.github.com/repos/stackql/color/events',
+'https://api.github.com/repos/stackql/color/forks',
+'https://api.github.com/repos/stackql/color/pulls{/number}',
+'https://api.github.com/repos/stackql/color/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+0,
+'https://api.github.com/repos/stackql/color/subscription',
+'2022-01-31T09:10:35Z',
+NULL,
+NULL,
+NULL,
+false,
+0,
+'{"key":"mit", "name":"MIT License", "node_id":"MDc6TGljZW5zZTEz", "spdx_id":"MIT", "url":"https://api.github.com/licenses/mit"}',
+'https://api.github.com/repos/stackql/color/milestones{/number}',
+0,
+NULL,
+'stackql/color',
+'https://github.com/stackql/color',
+'feature/infraql-develop',
+true,
+'https://api.github.com/repos/stackql/color/statuses/{sha}',
+'https://api.github.com/repos/stackql/color/git/trees{/sha}',
+'https://api.github.com/repos/stackql/color/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/color/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/color/comments{/number}',
+'https://api.github.com/repos/stackql/color/contributors',
+'R_kgDOGrXjDA',
+true,
+'https://api.github.com/repos/stackql/color/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/color/assignees{/user}',
+'https://api.github.com/repos/stackql/color/keys{/key_id}',
+'https://api.github.com/repos/stackql/color/languages',
+'https://api.github.com/repos/stackql/color/merges',
+'2022-03-21T04:53:45Z',
+'https://api.github.com/repos/stackql/color/releases{/id}',
+'https://api.github.com/repos/stackql/color/git/commits{/sha}',
+true,
+true,
+'Go',
+2038,
+NULL,
+NULL,
+'https://github.com/stackql/color.git',
+'2022-01-14T22:48:55Z',
+'https://api.github.com/repos/stackql/color/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/color/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/color/labels{/name}',
+'color',
+'https://api.github.com/repos/stackql/color/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/color/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/color/branches{/branch}',
+'https://api.github.com/repos/stackql/color/git/tags{/sha}',
+'https://api.github.com/repos/stackql/color/issues/comments{/number}',
+'https://api.github.com/repos/stackql/color/hooks',
+'https://api.github.com/repos/stackql/color/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/color/subscribers',
+'https://api.github.com/repos/stackql/color/contents/{+path}',
+'https://api.github.com/repos/stackql/color/deployments',
+false,
+0,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+17,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/stackql-artwork/git/refs{/sha}',
+'git@github.com:stackql/stackql-artwork.git',
+'[]',
+'https://api.github.com/repos/stackql/stackql-artwork',
+'https://api.github.com/repos/stackql/stackql-artwork/collaborators{/collaborator}',
+'StackQL assets and artwork',
+'https://api.github.com/repos/stackql/stackql-artwork/downloads',
+'https://github.com/stackql/stackql-artwork',
+'https://api.github.com/repos/stackql/stackql-artwork/teams',
+'private',
+false,
+'git://github.com/stackql/stackql-artwork.git',
+449062093,
+'https://api.github.com/repos/stackql/stackql-artwork/events',
+'https://api.github.com/repos/stackql/stackql-artwork/forks',
+'https://api.github.com/repos/stackql/stackql-artwork/pulls{/number}',
+'https://api.github.com/repos/stackql/stackql-artwork/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+0,
+'https://api.github.com/repos/stackql/stackql-artwork/subscription',
+'2022-01-17T22:08:46Z',
+NULL,
+NULL,
+NULL,
+true,
+0,
+NULL,
+'https://api.github.com/repos/stackql/stackql-artwork/milestones{/number}',
+0,
+NULL,
+'stackql/stackql-artwork',
+'https://github.com/stackql/stackql-artwork',
+'main',
+true,
+'https://api.github.com/repos/stackql/stackql-artwork/statuses/{sha}',
+'https://api.github.com/repos/stackql/stackql-artwork/git/trees{/sha}',
+'https://api.github.com/repos/stackql/stackql-artwork/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/stackql-artwork/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/stackql-artwork/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-artwork/contributors',
+'R_kgDOGsQkzQ',
+false,
+'https://api.github.com/repos/stackql/stackql-artwork/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/stackql-artwork/assignees{/user}',
+'https://api.github.com/repos/stackql/stackql-artwork/keys{/key_id}',
+'https://api.github.com/repos/stackql/stackql-artwork/languages',
+'https://api.github.com/repos/stackql/stackql-artwork/merges',
+'2022-07-10T22:18:31Z',
+'https://api.github.com/repos/stackql/stackql-artwork/releases{/id}',
+'https://api.github.com/repos/stackql/stackql-artwork/git/commits{/sha}',
+true,
+true,
+'Java',
+4210,
+NULL,
+NULL,
+'https://github.com/stackql/stackql-artwork.git',
+'2022-01-17T22:05:34Z',
+'https://api.github.com/repos/stackql/stackql-artwork/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/stackql-artwork/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/stackql-artwork/labels{/name}',
+'stackql-artwork',
+'https://api.github.com/repos/stackql/stackql-artwork/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/stackql-artwork/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/stackql-artwork/branches{/branch}',
+'https://api.github.com/repos/stackql/stackql-artwork/git/tags{/sha}',
+'https://api.github.com/repos/stackql/stackql-artwork/issues/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-artwork/hooks',
+'https://api.github.com/repos/stackql/stackql-artwork/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/stackql-artwork/subscribers',
+'https://api.github.com/repos/stackql/stackql-artwork/contents/{+path}',
+'https://api.github.com/repos/stackql/stackql-artwork/deployments',
+false,
+0,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+18,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/pystackql/git/refs{/sha}',
+'git@github.com:stackql/pystackql.git',
+'["python",
+"stackql",
+"wrapper"]',
+'https://api.github.com/repos/stackql/pystackql',
+'https://api.github.com/repos/stackql/pystackql/collaborators{/collaborator}',
+'Python wrapper for StackQL',
+'https://api.github.com/repos/stackql/pystackql/downloads',
+'https://github.com/stackql/pystackql',
+'https://api.github.com/repos/stackql/pystackql/teams',
+'public',
+false,
+'git://github.com/stackql/pystackql.git',
+455730530,
+'https://api.github.com/repos/stackql/pystackql/events',
+'https://api.github.com/repos/stackql/pystackql/forks',
+'https://api.github.com/repos/stackql/pystackql/pulls{/number}',
+'https://api.github.com/repos/stackql/pystackql/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+6,
+'https://api.github.com/repos/stackql/pystackql/subscription',
+'2022-07-01T08:29:57Z',
+NULL,
+NULL,
+NULL,
+false,
+0,
+NULL,
+'https://api.github.com/repos/stackql/pystackql/milestones{/number}',
+6,
+NULL,
+'stackql/pystackql',
+'https://github.com/stackql/pystackql',
+'main',
+true,
+'https://api.github.com/repos/stackql/pystackql/statuses/{sha}',
+'https://api.github.com/repos/stackql/pystackql/git/trees{/sha}',
+'https://api.github.com/repos/stackql/pystackql/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/pystackql/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/pystackql/comments{/number}',
+'https://api.github.com/repos/stackql/pystackql/contributors',
+'R_kgDOGynlYg',
+true,
+'https://api.github.com/repos/stackql/pystackql/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/pystackql/assignees{/user}',
+'https://api.github.com/repos/stackql/pystackql/keys{/key_id}',
+'https://api.github.com/repos/stackql/pystackql/languages',
+'https://api.github.com/repos/stackql/pystackql/merges',
+'2022-06-06T05:10:04Z',
+'https://api.github.com/repos/stackql/pystackql/releases{/id}',
+'https://api.github.com/repos/stackql/pystackql/git/commits{/sha}',
+true,
+true,
+'Python',
+9,
+NULL,
+NULL,
+'https://github.com/stackql/pystackql.git',
+'2022-02-04T23:51:14Z',
+'https://api.github.com/repos/stackql/pystackql/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/pystackql/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/pystackql/labels{/name}',
+'pystackql',
+'https://api.github.com/repos/stackql/pystackql/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/pystackql/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/pystackql/branches{/branch}',
+'https://api.github.com/repos/stackql/pystackql/git/tags{/sha}',
+'https://api.github.com/repos/stackql/pystackql/issues/comments{/number}',
+'https://api.github.com/repos/stackql/pystackql/hooks',
+'https://api.github.com/repos/stackql/pystackql/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/pystackql/subscribers',
+'https://api.github.com/repos/stackql/pystackql/contents/{+path}',
+'https://api.github.com/repos/stackql/pystackql/deployments',
+false,
+6,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+19,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/git/refs{/sha}',
+'git@github.com:stackql/stackql-jupyter-demo.git',
+'["docker",
+"jupyter",
+"jupyter-notebook",
+"python",
+"stackql"]',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/collaborators{/collaborator}',
+'StackQL Jupyter Notebook Demo',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/downloads',
+'https://github.com/stackql/stackql-jupyter-demo',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/teams',
+'public',
+false,
+'git://github.com/stackql/stackql-jupyter-demo.git',
+456722161,
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/events',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/forks',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/pulls{/number}',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+7,
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/subscription',
+'2022-09-01T03:47:07Z',
+NULL,
+NULL,
+NULL,
+false,
+0,
+'{"key":"mit", "name":"MIT License", "node_id":"MDc6TGljZW5zZTEz", "spdx_id":"MIT", "url":"https://api.github.com/licenses/mit"}',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/milestones{/number}',
+7,
+NULL,
+'stackql/stackql-jupyter-demo',
+'https://github.com/stackql/stackql-jupyter-demo',
+'main',
+true,
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/statuses/{sha}',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/git/trees{/sha}',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/contributors',
+'R_kgDOGzkG8Q',
+true,
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/assignees{/user}',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/keys{/key_id}',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/languages',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/merges',
+'2022-06-06T03:33:40Z',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/releases{/id}',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/git/commits{/sha}',
+true,
+true,
+'Jupyter Notebook',
+93,
+NULL,
+NULL,
+'https://github.com/stackql/stackql-jupyter-demo.git',
+'2022-02-08T00:08:08Z',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/labels{/name}',
+'stackql-jupyter-demo',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/branches{/branch}',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/git/tags{/sha}',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/issues/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/hooks',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/subscribers',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/contents/{+path}',
+'https://api.github.com/repos/stackql/stackql-jupyter-demo/deployments',
+false,
+7,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+20,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/stackql-mac-installer/git/refs{/sha}',
+'git@github.com:stackql/stackql-mac-installer.git',
+'[]',
+'https://api.github.com/repos/stackql/stackql-mac-installer',
+'https://api.github.com/repos/stackql/stackql-mac-installer/collaborators{/collaborator}',
+NULL,
+'https://api.github.com/repos/stackql/stackql-mac-installer/downloads',
+'https://github.com/stackql/stackql-mac-installer',
+'https://api.github.com/repos/stackql/stackql-mac-installer/teams',
+'private',
+false,
+'git://github.com/stackql/stackql-mac-installer.git',
+467302044,
+'https://api.github.com/repos/stackql/stackql-mac-installer/events',
+'https://api.github.com/repos/stackql/stackql-mac-installer/forks',
+'https://api.github.com/repos/stackql/stackql-mac-installer/pulls{/number}',
+'https://api.github.com/repos/stackql/stackql-mac-installer/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+0,
+'https://api.github.com/repos/stackql/stackql-mac-installer/subscription',
+'2022-03-08T00:11:44Z',
+NULL,
+NULL,
+NULL,
+true,
+0,
+NULL,
+'https://api.github.com/repos/stackql/stackql-mac-installer/milestones{/number}',
+0,
+NULL,
+'stackql/stackql-mac-installer',
+'https://github.com/stackql/stackql-mac-installer',
+'main',
+true,
+'https://api.github.com/repos/stackql/stackql-mac-installer/statuses/{sha}',
+'https://api.github.com/repos/stackql/stackql-mac-installer/git/trees{/sha}',
+'https://api.github.com/repos/stackql/stackql-mac-installer/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/stackql-mac-installer/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/stackql-mac-installer/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-mac-installer/contributors',
+'R_kgDOG9p2nA',
+false,
+'https://api.github.com/repos/stackql/stackql-mac-installer/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/stackql-mac-installer/assignees{/user}',
+'https://api.github.com/repos/stackql/stackql-mac-installer/keys{/key_id}',
+'https://api.github.com/repos/stackql/stackql-mac-installer/languages',
+'https://api.github.com/repos/stackql/stackql-mac-installer/merges',
+'2022-03-08T03:22:06Z',
+'https://api.github.com/repos/stackql/stackql-mac-installer/releases{/id}',
+'https://api.github.com/repos/stackql/stackql-mac-installer/git/commits{/sha}',
+true,
+true,
+'Shell',
+58,
+NULL,
+NULL,
+'https://github.com/stackql/stackql-mac-installer.git',
+'2022-03-08T00:09:54Z',
+'https://api.github.com/repos/stackql/stackql-mac-installer/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/stackql-mac-installer/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/stackql-mac-installer/labels{/name}',
+'stackql-mac-installer',
+'https://api.github.com/repos/stackql/stackql-mac-installer/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/stackql-mac-installer/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/stackql-mac-installer/branches{/branch}',
+'https://api.github.com/repos/stackql/stackql-mac-installer/git/tags{/sha}',
+'https://api.github.com/repos/stackql/stackql-mac-installer/issues/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-mac-installer/hooks',
+'https://api.github.com/repos/stackql/stackql-mac-installer/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/stackql-mac-installer/subscribers',
+'https://api.github.com/repos/stackql/stackql-mac-installer/contents/{+path}',
+'https://api.github.com/repos/stackql/stackql-mac-installer/deployments',
+false,
+0,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+  21,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/pg-server-thin/git/refs{/sha}',
+'git@github.com:stackql/pg-server-thin.git',
+'[]',
+'https://api.github.com/repos/stackql/pg-server-thin',
+'https://api.github.com/repos/stackql/pg-server-thin/collaborators{/collaborator}',
+NULL,
+'https://api.github.com/repos/stackql/pg-server-thin/downloads',
+'https://github.com/stackql/pg-server-thin',
+'https://api.github.com/repos/stackql/pg-server-thin/teams',
+'private',
+false,
+'git://github.com/stackql/pg-server-thin.git',
+469297871,
+'https://api.github.com/repos/stackql/pg-server-thin/events',
+'https://api.github.com/repos/stackql/pg-server-thin/forks',
+'https://api.github.com/repos/stackql/pg-server-thin/pulls{/number}',
+'https://api.github.com/repos/stackql/pg-server-thin/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+0,
+'https://api.github.com/repos/stackql/pg-server-thin/subscription',
+'2022-03-14T00:12:09Z',
+NULL,
+NULL,
+NULL,
+true,
+0,
+NULL,
+'https://api.github.com/repos/stackql/pg-server-thin/milestones{/number}',
+0,
+NULL,
+'stackql/pg-server-thin',
+'https://github.com/stackql/pg-server-thin',
+'main',
+true,
+'https://api.github.com/repos/stackql/pg-server-thin/statuses/{sha}',
+'https://api.github.com/repos/stackql/pg-server-thin/git/trees{/sha}',
+'https://api.github.com/repos/stackql/pg-server-thin/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/pg-server-thin/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/pg-server-thin/comments{/number}',
+'https://api.github.com/repos/stackql/pg-server-thin/contributors',
+'R_kgDOG_jqzw',
+false,
+'https://api.github.com/repos/stackql/pg-server-thin/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/pg-server-thin/assignees{/user}',
+'https://api.github.com/repos/stackql/pg-server-thin/keys{/key_id}',
+'https://api.github.com/repos/stackql/pg-server-thin/languages',
+'https://api.github.com/repos/stackql/pg-server-thin/merges',
+'2022-03-16T05:02:32Z',
+'https://api.github.com/repos/stackql/pg-server-thin/releases{/id}',
+'https://api.github.com/repos/stackql/pg-server-thin/git/commits{/sha}',
+true,
+true,
+'Go',
+31,
+NULL,
+NULL,
+'https://github.com/stackql/pg-server-thin.git',
+'2022-03-13T07:13:35Z',
+'https://api.github.com/repos/stackql/pg-server-thin/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/pg-server-thin/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/pg-server-thin/labels{/name}',
+'pg-server-thin',
+'https://api.github.com/repos/stackql/pg-server-thin/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/pg-server-thin/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/pg-server-thin/branches{/branch}',
+'https://api.github.com/repos/stackql/pg-server-thin/git/tags{/sha}',
+'https://api.github.com/repos/stackql/pg-server-thin/issues/comments{/number}',
+'https://api.github.com/repos/stackql/pg-server-thin/hooks',
+'https://api.github.com/repos/stackql/pg-server-thin/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/pg-server-thin/subscribers',
+'https://api.github.com/repos/stackql/pg-server-thin/contents/{+path}',
+'https://api.github.com/repos/stackql/pg-server-thin/deployments',
+false,
+0,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+22,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/psql-wire/git/refs{/sha}',
+'git@github.com:stackql/psql-wire.git',
+'[]',
+'https://api.github.com/repos/stackql/psql-wire',
+'https://api.github.com/repos/stackql/psql-wire/collaborators{/collaborator}',
+'PostgreSQL server wire protocol. Build your own server and start serving connections.',
+'https://api.github.com/repos/stackql/psql-wire/downloads',
+'https://github.com/stackql/psql-wire',
+'https://api.github.com/repos/stackql/psql-wire/teams',
+'public',
+false,
+'git://github.com/stackql/psql-wire.git',
+469681593,
+'https://api.github.com/repos/stackql/psql-wire/events',
+'https://api.github.com/repos/stackql/psql-wire/forks',
+'https://api.github.com/repos/stackql/psql-wire/pulls{/number}',
+'https://api.github.com/repos/stackql/psql-wire/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+0,
+'https://api.github.com/repos/stackql/psql-wire/subscription',
+'2022-03-21T03:29:14Z',
+NULL,
+'',
+NULL,
+false,
+0,
+'{"key":"mpl-2.0", "name":"Mozilla Public License 2.0", "node_id":"MDc6TGljZW5zZTE0", "spdx_id":"MPL-2.0", "url":"https://api.github.com/licenses/mpl-2.0"}',
+'https://api.github.com/repos/stackql/psql-wire/milestones{/number}',
+0,
+NULL,
+'stackql/psql-wire',
+'https://github.com/stackql/psql-wire',
+'main',
+true,
+'https://api.github.com/repos/stackql/psql-wire/statuses/{sha}',
+'https://api.github.com/repos/stackql/psql-wire/git/trees{/sha}',
+'https://api.github.com/repos/stackql/psql-wire/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/psql-wire/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/psql-wire/comments{/number}',
+'https://api.github.com/repos/stackql/psql-wire/contributors',
+'R_kgDOG_7FuQ',
+true,
+'https://api.github.com/repos/stackql/psql-wire/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/psql-wire/assignees{/user}',
+'https://api.github.com/repos/stackql/psql-wire/keys{/key_id}',
+'https://api.github.com/repos/stackql/psql-wire/languages',
+'https://api.github.com/repos/stackql/psql-wire/merges',
+'2022-09-14T13:20:51Z',
+'https://api.github.com/repos/stackql/psql-wire/releases{/id}',
+'https://api.github.com/repos/stackql/psql-wire/git/commits{/sha}',
+true,
+true,
+'Go',
+95,
+NULL,
+NULL,
+'https://github.com/stackql/psql-wire.git',
+'2022-03-14T10:21:01Z',
+'https://api.github.com/repos/stackql/psql-wire/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/psql-wire/commits{/sha}',
+true,
+false,
+'https://api.github.com/repos/stackql/psql-wire/labels{/name}',
+'psql-wire',
+'https://api.github.com/repos/stackql/psql-wire/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/psql-wire/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/psql-wire/branches{/branch}',
+'https://api.github.com/repos/stackql/psql-wire/git/tags{/sha}',
+'https://api.github.com/repos/stackql/psql-wire/issues/comments{/number}',
+'https://api.github.com/repos/stackql/psql-wire/hooks',
+'https://api.github.com/repos/stackql/psql-wire/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/psql-wire/subscribers',
+'https://api.github.com/repos/stackql/psql-wire/contents/{+path}',
+'https://api.github.com/repos/stackql/psql-wire/deployments',
+false,
+0,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+23,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/pg-server-various/git/refs{/sha}',
+'git@github.com:stackql/pg-server-various.git',
+'[]',
+'https://api.github.com/repos/stackql/pg-server-various',
+'https://api.github.com/repos/stackql/pg-server-various/collaborators{/collaborator}',
+NULL,
+'https://api.github.com/repos/stackql/pg-server-various/downloads',
+'https://github.com/stackql/pg-server-various',
+'https://api.github.com/repos/stackql/pg-server-various/teams',
+'private',
+false,
+'git://github.com/stackql/pg-server-various.git',
+470466801,
+'https://api.github.com/repos/stackql/pg-server-various/events',
+'https://api.github.com/repos/stackql/pg-server-various/forks',
+'https://api.github.com/repos/stackql/pg-server-various/pulls{/number}',
+'https://api.github.com/repos/stackql/pg-server-various/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+0,
+'https://api.github.com/repos/stackql/pg-server-various/subscription',
+'2022-03-16T06:56:23Z',
+NULL,
+NULL,
+NULL,
+true,
+0,
+NULL,
+'https://api.github.com/repos/stackql/pg-server-various/milestones{/number}',
+0,
+NULL,
+'stackql/pg-server-various',
+'https://github.com/stackql/pg-server-various',
+'main',
+true,
+'https://api.github.com/repos/stackql/pg-server-various/statuses/{sha}',
+'https://api.github.com/repos/stackql/pg-server-various/git/trees{/sha}',
+'https://api.github.com/repos/stackql/pg-server-various/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/pg-server-various/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/pg-server-various/comments{/number}',
+'https://api.github.com/repos/stackql/pg-server-various/contributors',
+'R_kgDOHArA8Q',
+false,
+'https://api.github.com/repos/stackql/pg-server-various/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/pg-server-various/assignees{/user}',
+'https://api.github.com/repos/stackql/pg-server-various/keys{/key_id}',
+'https://api.github.com/repos/stackql/pg-server-various/languages',
+'https://api.github.com/repos/stackql/pg-server-various/merges',
+'2022-03-17T22:01:59Z',
+'https://api.github.com/repos/stackql/pg-server-various/releases{/id}',
+'https://api.github.com/repos/stackql/pg-server-various/git/commits{/sha}',
+true,
+true,
+'Go',
+198,
+NULL,
+NULL,
+'https://github.com/stackql/pg-server-various.git',
+'2022-03-16T06:55:39Z',
+'https://api.github.com/repos/stackql/pg-server-various/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/pg-server-various/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/pg-server-various/labels{/name}',
+'pg-server-various',
+'https://api.github.com/repos/stackql/pg-server-various/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/pg-server-various/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/pg-server-various/branches{/branch}',
+'https://api.github.com/repos/stackql/pg-server-various/git/tags{/sha}',
+'https://api.github.com/repos/stackql/pg-server-various/issues/comments{/number}',
+'https://api.github.com/repos/stackql/pg-server-various/hooks',
+'https://api.github.com/repos/stackql/pg-server-various/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/pg-server-various/subscribers',
+'https://api.github.com/repos/stackql/pg-server-various/contents/{+path}',
+'https://api.github.com/repos/stackql/pg-server-various/deployments',
+false,
+0,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+24,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/provider-doc-util/git/refs{/sha}',
+'git@github.com:stackql/provider-doc-util.git',
+'[]',
+'https://api.github.com/repos/stackql/provider-doc-util',
+'https://api.github.com/repos/stackql/provider-doc-util/collaborators{/collaborator}',
+'DEPRECATED moved to stackql/openapi-doc-util',
+'https://api.github.com/repos/stackql/provider-doc-util/downloads',
+'https://github.com/stackql/provider-doc-util',
+'https://api.github.com/repos/stackql/provider-doc-util/teams',
+'public',
+true,
+'git://github.com/stackql/provider-doc-util.git',
+472680056,
+'https://api.github.com/repos/stackql/provider-doc-util/events',
+'https://api.github.com/repos/stackql/provider-doc-util/forks',
+'https://api.github.com/repos/stackql/provider-doc-util/pulls{/number}',
+'https://api.github.com/repos/stackql/provider-doc-util/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+4,
+'https://api.github.com/repos/stackql/provider-doc-util/subscription',
+'2022-06-12T23:48:47Z',
+NULL,
+'https://github.com/stackql/openapi-doc-util/blob/main/README.md',
+NULL,
+false,
+0,
+'{"key":"mit", "name":"MIT License", "node_id":"MDc6TGljZW5zZTEz", "spdx_id":"MIT", "url":"https://api.github.com/licenses/mit"}',
+'https://api.github.com/repos/stackql/provider-doc-util/milestones{/number}',
+4,
+NULL,
+'stackql/provider-doc-util',
+'https://github.com/stackql/provider-doc-util',
+'main',
+true,
+'https://api.github.com/repos/stackql/provider-doc-util/statuses/{sha}',
+'https://api.github.com/repos/stackql/provider-doc-util/git/trees{/sha}',
+'https://api.github.com/repos/stackql/provider-doc-util/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/provider-doc-util/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/provider-doc-util/comments{/number}',
+'https://api.github.com/repos/stackql/provider-doc-util/contributors',
+'R_kgDOHCyGeA',
+true,
+'https://api.github.com/repos/stackql/provider-doc-util/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/provider-doc-util/assignees{/user}',
+'https://api.github.com/repos/stackql/provider-doc-util/keys{/key_id}',
+'https://api.github.com/repos/stackql/provider-doc-util/languages',
+'https://api.github.com/repos/stackql/provider-doc-util/merges',
+'2022-06-12T23:47:19Z',
+'https://api.github.com/repos/stackql/provider-doc-util/releases{/id}',
+'https://api.github.com/repos/stackql/provider-doc-util/git/commits{/sha}',
+true,
+true,
+'JavaScript',
+85,
+NULL,
+NULL,
+'https://github.com/stackql/provider-doc-util.git',
+'2022-03-22T08:42:27Z',
+'https://api.github.com/repos/stackql/provider-doc-util/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/provider-doc-util/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/provider-doc-util/labels{/name}',
+'provider-doc-util',
+'https://api.github.com/repos/stackql/provider-doc-util/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/provider-doc-util/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/provider-doc-util/branches{/branch}',
+'https://api.github.com/repos/stackql/provider-doc-util/git/tags{/sha}',
+'https://api.github.com/repos/stackql/provider-doc-util/issues/comments{/number}',
+'https://api.github.com/repos/stackql/provider-doc-util/hooks',
+'https://api.github.com/repos/stackql/provider-doc-util/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/provider-doc-util/subscribers',
+'https://api.github.com/repos/stackql/provider-doc-util/contents/{+path}',
+'https://api.github.com/repos/stackql/provider-doc-util/deployments',
+false,
+4,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+25,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/go-suffix-map/git/refs{/sha}',
+'git@github.com:stackql/go-suffix-map.git',
+'[]',
+'https://api.github.com/repos/stackql/go-suffix-map',
+'https://api.github.com/repos/stackql/go-suffix-map/collaborators{/collaborator}',
+NULL,
+'https://api.github.com/repos/stackql/go-suffix-map/downloads',
+'https://github.com/stackql/go-suffix-map',
+'https://api.github.com/repos/stackql/go-suffix-map/teams',
+'public',
+false,
+'git://github.com/stackql/go-suffix-map.git',
+474504182,
+'https://api.github.com/repos/stackql/go-suffix-map/events',
+'https://api.github.com/repos/stackql/go-suffix-map/forks',
+'https://api.github.com/repos/stackql/go-suffix-map/pulls{/number}',
+'https://api.github.com/repos/stackql/go-suffix-map/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+3,
+'https://api.github.com/repos/stackql/go-suffix-map/subscription',
+'2022-05-02T22:22:41Z',
+NULL,
+NULL,
+NULL,
+false,
+0,
+NULL,
+'https://api.github.com/repos/stackql/go-suffix-map/milestones{/number}',
+3,
+NULL,
+'stackql/go-suffix-map',
+'https://github.com/stackql/go-suffix-map',
+'main',
+true,
+'https://api.github.com/repos/stackql/go-suffix-map/statuses/{sha}',
+'https://api.github.com/repos/stackql/go-suffix-map/git/trees{/sha}',
+'https://api.github.com/repos/stackql/go-suffix-map/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/go-suffix-map/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/go-suffix-map/comments{/number}',
+'https://api.github.com/repos/stackql/go-suffix-map/contributors',
+'R_kgDOHEhb9g',
+true,
+'https://api.github.com/repos/stackql/go-suffix-map/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/go-suffix-map/assignees{/user}',
+'https://api.github.com/repos/stackql/go-suffix-map/keys{/key_id}',
+'https://api.github.com/repos/stackql/go-suffix-map/languages',
+'https://api.github.com/repos/stackql/go-suffix-map/merges',
+'2022-03-27T01:11:18Z',
+'https://api.github.com/repos/stackql/go-suffix-map/releases{/id}',
+'https://api.github.com/repos/stackql/go-suffix-map/git/commits{/sha}',
+true,
+true,
+'Go',
+2,
+NULL,
+NULL,
+'https://github.com/stackql/go-suffix-map.git',
+'2022-03-27T01:10:23Z',
+'https://api.github.com/repos/stackql/go-suffix-map/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/go-suffix-map/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/go-suffix-map/labels{/name}',
+'go-suffix-map',
+'https://api.github.com/repos/stackql/go-suffix-map/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/go-suffix-map/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/go-suffix-map/branches{/branch}',
+'https://api.github.com/repos/stackql/go-suffix-map/git/tags{/sha}',
+'https://api.github.com/repos/stackql/go-suffix-map/issues/comments{/number}',
+'https://api.github.com/repos/stackql/go-suffix-map/hooks',
+'https://api.github.com/repos/stackql/go-suffix-map/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/go-suffix-map/subscribers',
+'https://api.github.com/repos/stackql/go-suffix-map/contents/{+path}',
+'https://api.github.com/repos/stackql/go-suffix-map/deployments',
+false,
+3,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+26,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/okta-pkce-login/git/refs{/sha}',
+'git@github.com:stackql/okta-pkce-login.git',
+'["authentication",
+"cli",
+"golang",
+"oidc",
+"okta",
+"pkce"]',
+'https://api.github.com/repos/stackql/okta-pkce-login',
+'https://api.github.com/repos/stackql/okta-pkce-login/collaborators{/collaborator}',
+'Command line utility to authenticate to an OIDC application using PKCE',
+'https://api.github.com/repos/stackql/okta-pkce-login/downloads',
+'https://github.com/stackql/okta-pkce-login',
+'https://api.github.com/repos/stackql/okta-pkce-login/teams',
+'public',
+false,
+'git://github.com/stackql/okta-pkce-login.git',
+476492741,
+'https://api.github.com/repos/stackql/okta-pkce-login/events',
+'https://api.github.com/repos/stackql/okta-pkce-login/forks',
+'https://api.github.com/repos/stackql/okta-pkce-login/pulls{/number}',
+'https://api.github.com/repos/stackql/okta-pkce-login/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+0,
+'https://api.github.com/repos/stackql/okta-pkce-login/subscription',
+'2022-06-12T23:43:41Z',
+NULL,
+NULL,
+NULL,
+false,
+0,
+'{"key":"other", "name":"Other", "node_id":"MDc6TGljZW5zZTA=", "spdx_id":"NOASSERTION", "url":null}',
+'https://api.github.com/repos/stackql/okta-pkce-login/milestones{/number}',
+0,
+NULL,
+'stackql/okta-pkce-login',
+'https://github.com/stackql/okta-pkce-login',
+'main',
+true,
+'https://api.github.com/repos/stackql/okta-pkce-login/statuses/{sha}',
+'https://api.github.com/repos/stackql/okta-pkce-login/git/trees{/sha}',
+'https://api.github.com/repos/stackql/okta-pkce-login/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/okta-pkce-login/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/okta-pkce-login/comments{/number}',
+'https://api.github.com/repos/stackql/okta-pkce-login/contributors',
+'R_kgDOHGazxQ',
+true,
+'https://api.github.com/repos/stackql/okta-pkce-login/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/okta-pkce-login/assignees{/user}',
+'https://api.github.com/repos/stackql/okta-pkce-login/keys{/key_id}',
+'https://api.github.com/repos/stackql/okta-pkce-login/languages',
+'https://api.github.com/repos/stackql/okta-pkce-login/merges',
+'2022-04-18T00:36:59Z',
+'https://api.github.com/repos/stackql/okta-pkce-login/releases{/id}',
+'https://api.github.com/repos/stackql/okta-pkce-login/git/commits{/sha}',
+true,
+true,
+'Go',
+6447,
+NULL,
+NULL,
+'https://github.com/stackql/okta-pkce-login.git',
+'2022-03-31T22:05:18Z',
+'https://api.github.com/repos/stackql/okta-pkce-login/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/okta-pkce-login/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/okta-pkce-login/labels{/name}',
+'okta-pkce-login',
+'https://api.github.com/repos/stackql/okta-pkce-login/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/okta-pkce-login/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/okta-pkce-login/branches{/branch}',
+'https://api.github.com/repos/stackql/okta-pkce-login/git/tags{/sha}',
+'https://api.github.com/repos/stackql/okta-pkce-login/issues/comments{/number}',
+'https://api.github.com/repos/stackql/okta-pkce-login/hooks',
+'https://api.github.com/repos/stackql/okta-pkce-login/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/okta-pkce-login/subscribers',
+'https://api.github.com/repos/stackql/okta-pkce-login/contents/{+path}',
+'https://api.github.com/repos/stackql/okta-pkce-login/deployments',
+false,
+0,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+27,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/github-oauth-login/git/refs{/sha}',
+'git@github.com:stackql/github-oauth-login.git',
+'[]',
+'https://api.github.com/repos/stackql/github-oauth-login',
+'https://api.github.com/repos/stackql/github-oauth-login/collaborators{/collaborator}',
+NULL,
+'https://api.github.com/repos/stackql/github-oauth-login/downloads',
+'https://github.com/stackql/github-oauth-login',
+'https://api.github.com/repos/stackql/github-oauth-login/teams',
+'private',
+false,
+'git://github.com/stackql/github-oauth-login.git',
+476547285,
+'https://api.github.com/repos/stackql/github-oauth-login/events',
+'https://api.github.com/repos/stackql/github-oauth-login/forks',
+'https://api.github.com/repos/stackql/github-oauth-login/pulls{/number}',
+'https://api.github.com/repos/stackql/github-oauth-login/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+0,
+'https://api.github.com/repos/stackql/github-oauth-login/subscription',
+'2022-04-01T02:30:52Z',
+NULL,
+NULL,
+NULL,
+true,
+0,
+'{"key":"other", "name":"Other", "node_id":"MDc6TGljZW5zZTA=", "spdx_id":"NOASSERTION", "url":null}',
+'https://api.github.com/repos/stackql/github-oauth-login/milestones{/number}',
+0,
+NULL,
+'stackql/github-oauth-login',
+'https://github.com/stackql/github-oauth-login',
+'main',
+true,
+'https://api.github.com/repos/stackql/github-oauth-login/statuses/{sha}',
+'https://api.github.com/repos/stackql/github-oauth-login/git/trees{/sha}',
+'https://api.github.com/repos/stackql/github-oauth-login/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/github-oauth-login/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/github-oauth-login/comments{/number}',
+'https://api.github.com/repos/stackql/github-oauth-login/contributors',
+'R_kgDOHGeI1Q',
+false,
+'https://api.github.com/repos/stackql/github-oauth-login/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/github-oauth-login/assignees{/user}',
+'https://api.github.com/repos/stackql/github-oauth-login/keys{/key_id}',
+'https://api.github.com/repos/stackql/github-oauth-login/languages',
+'https://api.github.com/repos/stackql/github-oauth-login/merges',
+'2022-04-01T02:33:04Z',
+'https://api.github.com/repos/stackql/github-oauth-login/releases{/id}',
+'https://api.github.com/repos/stackql/github-oauth-login/git/commits{/sha}',
+true,
+true,
+'Go',
+6393,
+NULL,
+NULL,
+'https://github.com/stackql/github-oauth-login.git',
+'2022-04-01T02:29:02Z',
+'https://api.github.com/repos/stackql/github-oauth-login/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/github-oauth-login/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/github-oauth-login/labels{/name}',
+'github-oauth-login',
+'https://api.github.com/repos/stackql/github-oauth-login/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/github-oauth-login/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/github-oauth-login/branches{/branch}',
+'https://api.github.com/repos/stackql/github-oauth-login/git/tags{/sha}',
+'https://api.github.com/repos/stackql/github-oauth-login/issues/comments{/number}',
+'https://api.github.com/repos/stackql/github-oauth-login/hooks',
+'https://api.github.com/repos/stackql/github-oauth-login/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/github-oauth-login/subscribers',
+'https://api.github.com/repos/stackql/github-oauth-login/contents/{+path}',
+'https://api.github.com/repos/stackql/github-oauth-login/deployments',
+false,
+0,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(28,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/local-registry/git/refs{/sha}',
+'git@github.com:stackql/local-registry.git',
+'[]',
+'https://api.github.com/repos/stackql/local-registry',
+'https://api.github.com/repos/stackql/local-registry/collaborators{/collaborator}',
+'for development of new provider docs',
+'https://api.github.com/repos/stackql/local-registry/downloads',
+'https://github.com/stackql/local-registry',
+'https://api.github.com/repos/stackql/local-registry/teams',
+'private',
+false,
+'git://github.com/stackql/local-registry.git',
+482108450,
+'https://api.github.com/repos/stackql/local-registry/events',
+'https://api.github.com/repos/stackql/local-registry/forks',
+'https://api.github.com/repos/stackql/local-registry/pulls{/number}',
+'https://api.github.com/repos/stackql/local-registry/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+0,
+'https://api.github.com/repos/stackql/local-registry/subscription',
+'2022-07-04T03:42:49Z',
+NULL,
+NULL,
+NULL,
+true,
+0,
+NULL,
+'https://api.github.com/repos/stackql/local-registry/milestones{/number}',
+0,
+NULL,
+'stackql/local-registry',
+'https://github.com/stackql/local-registry',
+'main',
+true,
+'https://api.github.com/repos/stackql/local-registry/statuses/{sha}',
+'https://api.github.com/repos/stackql/local-registry/git/trees{/sha}',
+'https://api.github.com/repos/stackql/local-registry/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/local-registry/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/local-registry/comments{/number}',
+'https://api.github.com/repos/stackql/local-registry/contributors',
+'R_kgDOHLxkIg',
+false,
+'https://api.github.com/repos/stackql/local-registry/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/local-registry/assignees{/user}',
+'https://api.github.com/repos/stackql/local-registry/keys{/key_id}',
+'https://api.github.com/repos/stackql/local-registry/languages',
+'https://api.github.com/repos/stackql/local-registry/merges',
+'2022-07-04T07:19:14Z',
+'https://api.github.com/repos/stackql/local-registry/releases{/id}',
+'https://api.github.com/repos/stackql/local-registry/git/commits{/sha}',
+true,
+true,
+'Python',
+29592,
+NULL,
+NULL,
+'https://github.com/stackql/local-registry.git',
+'2022-04-15T23:23:03Z',
+'https://api.github.com/repos/stackql/local-registry/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/local-registry/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/local-registry/labels{/name}',
+'local-registry',
+'https://api.github.com/repos/stackql/local-registry/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/local-registry/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/local-registry/branches{/branch}',
+'https://api.github.com/repos/stackql/local-registry/git/tags{/sha}',
+'https://api.github.com/repos/stackql/local-registry/issues/comments{/number}',
+'https://api.github.com/repos/stackql/local-registry/hooks',
+'https://api.github.com/repos/stackql/local-registry/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/local-registry/subscribers',
+'https://api.github.com/repos/stackql/local-registry/contents/{+path}',
+'https://api.github.com/repos/stackql/local-registry/deployments',
+false,
+0,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(29,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/cloudy-redirect-app/git/refs{/sha}',
+'git@github.com:stackql/cloudy-redirect-app.git',
+'[]',
+'https://api.github.com/repos/stackql/cloudy-redirect-app',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/collaborators{/collaborator}',
+NULL,
+'https://api.github.com/repos/stackql/cloudy-redirect-app/downloads',
+'https://github.com/stackql/cloudy-redirect-app',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/teams',
+'private',
+false,
+'git://github.com/stackql/cloudy-redirect-app.git',
+482685901,
+'https://api.github.com/repos/stackql/cloudy-redirect-app/events',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/forks',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/pulls{/number}',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+0,
+'https://api.github.com/repos/stackql/cloudy-redirect-app/subscription',
+'2022-04-18T02:16:37Z',
+NULL,
+NULL,
+NULL,
+true,
+0,
+NULL,
+'https://api.github.com/repos/stackql/cloudy-redirect-app/milestones{/number}',
+0,
+NULL,
+'stackql/cloudy-redirect-app',
+'https://github.com/stackql/cloudy-redirect-app',
+'main',
+true,
+'https://api.github.com/repos/stackql/cloudy-redirect-app/statuses/{sha}',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/git/trees{/sha}',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/cloudy-redirect-app/comments{/number}',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/contributors',
+'R_kgDOHMUzzQ',
+false,
+'https://api.github.com/repos/stackql/cloudy-redirect-app/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/assignees{/user}',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/keys{/key_id}',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/languages',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/merges',
+'2022-04-18T02:27:50Z',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/releases{/id}',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/git/commits{/sha}',
+true,
+true,
+'JavaScript',
+32,
+NULL,
+NULL,
+'https://github.com/stackql/cloudy-redirect-app.git',
+'2022-04-18T02:13:34Z',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/cloudy-redirect-app/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/cloudy-redirect-app/labels{/name}',
+'cloudy-redirect-app',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/cloudy-redirect-app/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/branches{/branch}',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/git/tags{/sha}',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/issues/comments{/number}',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/hooks',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/cloudy-redirect-app/subscribers',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/contents/{+path}',
+'https://api.github.com/repos/stackql/cloudy-redirect-app/deployments',
+false,
+0,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+30,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/openapi-doc-util/git/refs{/sha}',
+'git@github.com:stackql/openapi-doc-util.git',
+'["cli",
+"javascript",
+"nodejs",
+"openapi3",
+"stackql"]',
+'https://api.github.com/repos/stackql/openapi-doc-util',
+'https://api.github.com/repos/stackql/openapi-doc-util/collaborators{/collaborator}',
+'Command line utility to split OpenAPI documents into smaller,
+
+self contained,
+
+service oriented documents and prepare StackQL provider interfaces',
+'https://api.github.com/repos/stackql/openapi-doc-util/downloads',
+'https://github.com/stackql/openapi-doc-util',
+'https://api.github.com/repos/stackql/openapi-doc-util/teams',
+'public',
+false,
+'git://github.com/stackql/openapi-doc-util.git',
+487689765,
+'https://api.github.com/repos/stackql/openapi-doc-util/events',
+'https://api.github.com/repos/stackql/openapi-doc-util/forks',
+'https://api.github.com/repos/stackql/openapi-doc-util/pulls{/number}',
+'https://api.github.com/repos/stackql/openapi-doc-util/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+8,
+'https://api.github.com/repos/stackql/openapi-doc-util/subscription',
+'2022-07-22T10:46:44Z',
+NULL,
+'https://www.npmjs.com/package/@stackql/openapi-doc-util',
+NULL,
+false,
+0,
+'{"key":"mit", "name":"MIT License", "node_id":"MDc6TGljZW5zZTEz", "spdx_id":"MIT", "url":"https://api.github.com/licenses/mit"}',
+'https://api.github.com/repos/stackql/openapi-doc-util/milestones{/number}',
+8,
+NULL,
+'stackql/openapi-doc-util',
+'https://github.com/stackql/openapi-doc-util',
+'main',
+true,
+'https://api.github.com/repos/stackql/openapi-doc-util/statuses/{sha}',
+'https://api.github.com/repos/stackql/openapi-doc-util/git/trees{/sha}',
+'https://api.github.com/repos/stackql/openapi-doc-util/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/openapi-doc-util/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/openapi-doc-util/comments{/number}',
+'https://api.github.com/repos/stackql/openapi-doc-util/contributors',
+'R_kgDOHRGOJQ',
+true,
+'https://api.github.com/repos/stackql/openapi-doc-util/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/openapi-doc-util/assignees{/user}',
+'https://api.github.com/repos/stackql/openapi-doc-util/keys{/key_id}',
+'https://api.github.com/repos/stackql/openapi-doc-util/languages',
+'https://api.github.com/repos/stackql/openapi-doc-util/merges',
+'2022-09-22T21:57:07Z',
+'https://api.github.com/repos/stackql/openapi-doc-util/releases{/id}',
+'https://api.github.com/repos/stackql/openapi-doc-util/git/commits{/sha}',
+true,
+true,
+'JavaScript',
+192,
+NULL,
+NULL,
+'https://github.com/stackql/openapi-doc-util.git',
+'2022-05-02T02:01:26Z',
+'https://api.github.com/repos/stackql/openapi-doc-util/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/openapi-doc-util/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/openapi-doc-util/labels{/name}',
+'openapi-doc-util',
+'https://api.github.com/repos/stackql/openapi-doc-util/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/openapi-doc-util/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/openapi-doc-util/branches{/branch}',
+'https://api.github.com/repos/stackql/openapi-doc-util/git/tags{/sha}',
+'https://api.github.com/repos/stackql/openapi-doc-util/issues/comments{/number}',
+'https://api.github.com/repos/stackql/openapi-doc-util/hooks',
+'https://api.github.com/repos/stackql/openapi-doc-util/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/openapi-doc-util/subscribers',
+'https://api.github.com/repos/stackql/openapi-doc-util/contents/{+path}',
+'https://api.github.com/repos/stackql/openapi-doc-util/deployments',
+false,
+8,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+31,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/git/refs{/sha}',
+'git@github.com:stackql/stackql-jupyter-google-okta-iam.git',
+'[]',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/collaborators{/collaborator}',
+'Jupyter notebook for Google,
+
+Okta IAM analysis',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/downloads',
+'https://github.com/stackql/stackql-jupyter-google-okta-iam',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/teams',
+'private',
+false,
+'git://github.com/stackql/stackql-jupyter-google-okta-iam.git',
+500677528,
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/events',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/forks',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/pulls{/number}',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+0,
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/subscription',
+'2022-06-07T03:38:10Z',
+NULL,
+NULL,
+NULL,
+true,
+0,
+'{"key":"mit", "name":"MIT License", "node_id":"MDc6TGljZW5zZTEz", "spdx_id":"MIT", "url":"https://api.github.com/licenses/mit"}',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/milestones{/number}',
+0,
+NULL,
+'stackql/stackql-jupyter-google-okta-iam',
+'https://github.com/stackql/stackql-jupyter-google-okta-iam',
+'main',
+true,
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/statuses/{sha}',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/git/trees{/sha}',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/contributors',
+'R_kgDOHde7mA',
+false,
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/assignees{/user}',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/keys{/key_id}',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/languages',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/merges',
+'2022-06-07T05:01:01Z',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/releases{/id}',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/git/commits{/sha}',
+true,
+true,
+'Jupyter Notebook',
+4,
+NULL,
+NULL,
+'https://github.com/stackql/stackql-jupyter-google-okta-iam.git',
+'2022-06-07T03:35:57Z',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/labels{/name}',
+'stackql-jupyter-google-okta-iam',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/branches{/branch}',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/git/tags{/sha}',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/issues/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/hooks',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/subscribers',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/contents/{+path}',
+'https://api.github.com/repos/stackql/stackql-jupyter-google-okta-iam/deployments',
+false,
+0,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+32,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/git/refs{/sha}',
+'git@github.com:stackql/stackql-gcp-foundations.git',
+'["gcp",
+"gcp-foundations",
+"scaffolding",
+"stackql",
+"terraform"]',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/collaborators{/collaborator}',
+'GCP foundations using StackQL',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/downloads',
+'https://github.com/stackql/stackql-gcp-foundations',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/teams',
+'public',
+false,
+'git://github.com/stackql/stackql-gcp-foundations.git',
+501046061,
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/events',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/forks',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/pulls{/number}',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+0,
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/subscription',
+'2022-07-20T01:38:54Z',
+NULL,
+NULL,
+NULL,
+false,
+0,
+'{"key":"mit", "name":"MIT License", "node_id":"MDc6TGljZW5zZTEz", "spdx_id":"MIT", "url":"https://api.github.com/licenses/mit"}',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/milestones{/number}',
+0,
+NULL,
+'stackql/stackql-gcp-foundations',
+'https://github.com/stackql/stackql-gcp-foundations',
+'main',
+true,
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/statuses/{sha}',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/git/trees{/sha}',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/contributors',
+'R_kgDOHd1bLQ',
+true,
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/assignees{/user}',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/keys{/key_id}',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/languages',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/merges',
+'2022-07-22T05:33:46Z',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/releases{/id}',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/git/commits{/sha}',
+true,
+true,
+'Jsonnet',
+16458,
+NULL,
+NULL,
+'https://github.com/stackql/stackql-gcp-foundations.git',
+'2022-06-08T00:28:04Z',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/labels{/name}',
+'stackql-gcp-foundations',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/branches{/branch}',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/git/tags{/sha}',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/issues/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/hooks',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/subscribers',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/contents/{+path}',
+'https://api.github.com/repos/stackql/stackql-gcp-foundations/deployments',
+false,
+0,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+33,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/stackql-demo/git/refs{/sha}',
+'git@github.com:stackql/stackql-demo.git',
+'[]',
+'https://api.github.com/repos/stackql/stackql-demo',
+'https://api.github.com/repos/stackql/stackql-demo/collaborators{/collaborator}',
+NULL,
+'https://api.github.com/repos/stackql/stackql-demo/downloads',
+'https://github.com/stackql/stackql-demo',
+'https://api.github.com/repos/stackql/stackql-demo/teams',
+'private',
+false,
+'git://github.com/stackql/stackql-demo.git',
+502547633,
+'https://api.github.com/repos/stackql/stackql-demo/events',
+'https://api.github.com/repos/stackql/stackql-demo/forks',
+'https://api.github.com/repos/stackql/stackql-demo/pulls{/number}',
+'https://api.github.com/repos/stackql/stackql-demo/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+0,
+'https://api.github.com/repos/stackql/stackql-demo/subscription',
+'2022-06-12T07:32:18Z',
+NULL,
+NULL,
+NULL,
+true,
+0,
+NULL,
+'https://api.github.com/repos/stackql/stackql-demo/milestones{/number}',
+0,
+NULL,
+'stackql/stackql-demo',
+'https://github.com/stackql/stackql-demo',
+'main',
+true,
+'https://api.github.com/repos/stackql/stackql-demo/statuses/{sha}',
+'https://api.github.com/repos/stackql/stackql-demo/git/trees{/sha}',
+'https://api.github.com/repos/stackql/stackql-demo/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/stackql-demo/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/stackql-demo/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-demo/contributors',
+'R_kgDOHfREsQ',
+false,
+'https://api.github.com/repos/stackql/stackql-demo/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/stackql-demo/assignees{/user}',
+'https://api.github.com/repos/stackql/stackql-demo/keys{/key_id}',
+'https://api.github.com/repos/stackql/stackql-demo/languages',
+'https://api.github.com/repos/stackql/stackql-demo/merges',
+'2022-06-12T22:55:08Z',
+'https://api.github.com/repos/stackql/stackql-demo/releases{/id}',
+'https://api.github.com/repos/stackql/stackql-demo/git/commits{/sha}',
+true,
+true,
+'Jupyter Notebook',
+9,
+NULL,
+NULL,
+'https://github.com/stackql/stackql-demo.git',
+'2022-06-12T07:26:04Z',
+'https://api.github.com/repos/stackql/stackql-demo/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/stackql-demo/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/stackql-demo/labels{/name}',
+'stackql-demo',
+'https://api.github.com/repos/stackql/stackql-demo/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/stackql-demo/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/stackql-demo/branches{/branch}',
+'https://api.github.com/repos/stackql/stackql-demo/git/tags{/sha}',
+'https://api.github.com/repos/stackql/stackql-demo/issues/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-demo/hooks',
+'https://api.github.com/repos/stackql/stackql-demo/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/stackql-demo/subscribers',
+'https://api.github.com/repos/stackql/stackql-demo/contents/{+path}',
+'https://api.github.com/repos/stackql/stackql-demo/deployments',
+false,
+0,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+34,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/registry.stackql.io/git/refs{/sha}',
+'git@github.com:stackql/registry.stackql.io.git',
+'["cloud",
+"cloudprovider",
+"openapi",
+"openapi3",
+"saas",
+"sql",
+"stackql"]',
+'https://api.github.com/repos/stackql/registry.stackql.io',
+'https://api.github.com/repos/stackql/registry.stackql.io/collaborators{/collaborator}',
+'StackQL provider registry documentation,
+
+built with static site generator - Docusaurus 2',
+'https://api.github.com/repos/stackql/registry.stackql.io/downloads',
+'https://github.com/stackql/registry.stackql.io',
+'https://api.github.com/repos/stackql/registry.stackql.io/teams',
+'public',
+false,
+'git://github.com/stackql/registry.stackql.io.git',
+504338261,
+'https://api.github.com/repos/stackql/registry.stackql.io/events',
+'https://api.github.com/repos/stackql/registry.stackql.io/forks',
+'https://api.github.com/repos/stackql/registry.stackql.io/pulls{/number}',
+'https://api.github.com/repos/stackql/registry.stackql.io/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+1,
+'https://api.github.com/repos/stackql/registry.stackql.io/subscription',
+'2022-07-10T22:45:23Z',
+NULL,
+'https://registry.stackql.io/',
+NULL,
+false,
+0,
+NULL,
+'https://api.github.com/repos/stackql/registry.stackql.io/milestones{/number}',
+1,
+NULL,
+'stackql/registry.stackql.io',
+'https://github.com/stackql/registry.stackql.io',
+'main',
+true,
+'https://api.github.com/repos/stackql/registry.stackql.io/statuses/{sha}',
+'https://api.github.com/repos/stackql/registry.stackql.io/git/trees{/sha}',
+'https://api.github.com/repos/stackql/registry.stackql.io/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/registry.stackql.io/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/registry.stackql.io/comments{/number}',
+'https://api.github.com/repos/stackql/registry.stackql.io/contributors',
+'R_kgDOHg-XVQ',
+true,
+'https://api.github.com/repos/stackql/registry.stackql.io/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/registry.stackql.io/assignees{/user}',
+'https://api.github.com/repos/stackql/registry.stackql.io/keys{/key_id}',
+'https://api.github.com/repos/stackql/registry.stackql.io/languages',
+'https://api.github.com/repos/stackql/registry.stackql.io/merges',
+'2022-09-19T01:00:18Z',
+'https://api.github.com/repos/stackql/registry.stackql.io/releases{/id}',
+'https://api.github.com/repos/stackql/registry.stackql.io/git/commits{/sha}',
+true,
+true,
+'CSS',
+8360,
+NULL,
+NULL,
+'https://github.com/stackql/registry.stackql.io.git',
+'2022-06-16T23:52:36Z',
+'https://api.github.com/repos/stackql/registry.stackql.io/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/registry.stackql.io/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/registry.stackql.io/labels{/name}',
+'registry.stackql.io',
+'https://api.github.com/repos/stackql/registry.stackql.io/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/registry.stackql.io/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/registry.stackql.io/branches{/branch}',
+'https://api.github.com/repos/stackql/registry.stackql.io/git/tags{/sha}',
+'https://api.github.com/repos/stackql/registry.stackql.io/issues/comments{/number}',
+'https://api.github.com/repos/stackql/registry.stackql.io/hooks',
+'https://api.github.com/repos/stackql/registry.stackql.io/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/registry.stackql.io/subscribers',
+'https://api.github.com/repos/stackql/registry.stackql.io/contents/{+path}',
+'https://api.github.com/repos/stackql/registry.stackql.io/deployments',
+false,
+1,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+35,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/stackql-docs/git/refs{/sha}',
+'git@github.com:stackql/stackql-docs.git',
+'[]',
+'https://api.github.com/repos/stackql/stackql-docs',
+'https://api.github.com/repos/stackql/stackql-docs/collaborators{/collaborator}',
+NULL,
+'https://api.github.com/repos/stackql/stackql-docs/downloads',
+'https://github.com/stackql/stackql-docs',
+'https://api.github.com/repos/stackql/stackql-docs/teams',
+'private',
+false,
+'git://github.com/stackql/stackql-docs.git',
+506053440,
+'https://api.github.com/repos/stackql/stackql-docs/events',
+'https://api.github.com/repos/stackql/stackql-docs/forks',
+'https://api.github.com/repos/stackql/stackql-docs/pulls{/number}',
+'https://api.github.com/repos/stackql/stackql-docs/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+0,
+'https://api.github.com/repos/stackql/stackql-docs/subscription',
+'2022-07-24T11:55:11Z',
+NULL,
+NULL,
+NULL,
+true,
+0,
+NULL,
+'https://api.github.com/repos/stackql/stackql-docs/milestones{/number}',
+0,
+NULL,
+'stackql/stackql-docs',
+'https://github.com/stackql/stackql-docs',
+'main',
+true,
+'https://api.github.com/repos/stackql/stackql-docs/statuses/{sha}',
+'https://api.github.com/repos/stackql/stackql-docs/git/trees{/sha}',
+'https://api.github.com/repos/stackql/stackql-docs/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/stackql-docs/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/stackql-docs/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-docs/contributors',
+'R_kgDOHinDQA',
+false,
+'https://api.github.com/repos/stackql/stackql-docs/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/stackql-docs/assignees{/user}',
+'https://api.github.com/repos/stackql/stackql-docs/keys{/key_id}',
+'https://api.github.com/repos/stackql/stackql-docs/languages',
+'https://api.github.com/repos/stackql/stackql-docs/merges',
+'2022-07-24T11:55:09Z',
+'https://api.github.com/repos/stackql/stackql-docs/releases{/id}',
+'https://api.github.com/repos/stackql/stackql-docs/git/commits{/sha}',
+true,
+true,
+'Python',
+135,
+NULL,
+NULL,
+'https://github.com/stackql/stackql-docs.git',
+'2022-06-22T01:08:42Z',
+'https://api.github.com/repos/stackql/stackql-docs/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/stackql-docs/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/stackql-docs/labels{/name}',
+'stackql-docs',
+'https://api.github.com/repos/stackql/stackql-docs/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/stackql-docs/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/stackql-docs/branches{/branch}',
+'https://api.github.com/repos/stackql/stackql-docs/git/tags{/sha}',
+'https://api.github.com/repos/stackql/stackql-docs/issues/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-docs/hooks',
+'https://api.github.com/repos/stackql/stackql-docs/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/stackql-docs/subscribers',
+'https://api.github.com/repos/stackql/stackql-docs/contents/{+path}',
+'https://api.github.com/repos/stackql/stackql-docs/deployments',
+false,
+0,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+36,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/git/refs{/sha}',
+'git@github.com:stackql/stackql-provider-doc-generator.git',
+'[]',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/collaborators{/collaborator}',
+NULL,
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/downloads',
+'https://github.com/stackql/stackql-provider-doc-generator',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/teams',
+'private',
+false,
+'git://github.com/stackql/stackql-provider-doc-generator.git',
+506514240,
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/events',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/forks',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/pulls{/number}',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+0,
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/subscription',
+'2022-06-23T05:56:30Z',
+NULL,
+NULL,
+NULL,
+true,
+0,
+NULL,
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/milestones{/number}',
+0,
+NULL,
+'stackql/stackql-provider-doc-generator',
+'https://github.com/stackql/stackql-provider-doc-generator',
+'main',
+true,
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/statuses/{sha}',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/git/trees{/sha}',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/contributors',
+'R_kgDOHjDLQA',
+false,
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/assignees{/user}',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/keys{/key_id}',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/languages',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/merges',
+'2022-07-08T05:56:38Z',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/releases{/id}',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/git/commits{/sha}',
+true,
+true,
+'Python',
+6,
+NULL,
+NULL,
+'https://github.com/stackql/stackql-provider-doc-generator.git',
+'2022-06-23T05:55:24Z',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/labels{/name}',
+'stackql-provider-doc-generator',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/branches{/branch}',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/git/tags{/sha}',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/issues/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/hooks',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/subscribers',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/contents/{+path}',
+'https://api.github.com/repos/stackql/stackql-provider-doc-generator/deployments',
+false,
+0,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+37,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/git/refs{/sha}',
+'git@github.com:stackql/google-discovery-to-openapi.git',
+'[]',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/collaborators{/collaborator}',
+'Convert Google discovery API documents to fully compliant OpenAPI3 specifications',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/downloads',
+'https://github.com/stackql/google-discovery-to-openapi',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/teams',
+'public',
+false,
+'git://github.com/stackql/google-discovery-to-openapi.git',
+506876993,
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/events',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/forks',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/pulls{/number}',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+3,
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/subscription',
+'2022-08-22T04:05:48Z',
+NULL,
+NULL,
+NULL,
+false,
+1,
+NULL,
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/milestones{/number}',
+3,
+NULL,
+'stackql/google-discovery-to-openapi',
+'https://github.com/stackql/google-discovery-to-openapi',
+'main',
+true,
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/statuses/{sha}',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/git/trees{/sha}',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/comments{/number}',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/contributors',
+'R_kgDOHjZUQQ',
+true,
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/assignees{/user}',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/keys{/key_id}',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/languages',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/merges',
+'2022-07-27T01:26:02Z',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/releases{/id}',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/git/commits{/sha}',
+true,
+true,
+'Python',
+39,
+NULL,
+NULL,
+'https://github.com/stackql/google-discovery-to-openapi.git',
+'2022-06-24T04:29:04Z',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/labels{/name}',
+'google-discovery-to-openapi',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/tags',
+1,
+false,
+false,
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/branches{/branch}',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/git/tags{/sha}',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/issues/comments{/number}',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/hooks',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/subscribers',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/contents/{+path}',
+'https://api.github.com/repos/stackql/google-discovery-to-openapi/deployments',
+false,
+3,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+38,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/stackql-azure-openapi/git/refs{/sha}',
+'git@github.com:stackql/stackql-azure-openapi.git',
+'["autorest",
+"azure",
+"azurerm",
+"javascript",
+"nodejs",
+"openapi",
+"openapi3",
+"stackql",
+"typescript"]',
+'https://api.github.com/repos/stackql/stackql-azure-openapi',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/collaborators{/collaborator}',
+'Generates a single OpenAPI document for each Azure Resource Manager service from a set of OpenAPI specifications sourced from Azure REST API specs',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/downloads',
+'https://github.com/stackql/stackql-azure-openapi',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/teams',
+'public',
+false,
+'git://github.com/stackql/stackql-azure-openapi.git',
+522469749,
+'https://api.github.com/repos/stackql/stackql-azure-openapi/events',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/forks',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/pulls{/number}',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+1,
+'https://api.github.com/repos/stackql/stackql-azure-openapi/subscription',
+'2022-08-10T23:27:09Z',
+NULL,
+'',
+NULL,
+false,
+0,
+NULL,
+'https://api.github.com/repos/stackql/stackql-azure-openapi/milestones{/number}',
+1,
+NULL,
+'stackql/stackql-azure-openapi',
+'https://github.com/stackql/stackql-azure-openapi',
+'main',
+true,
+'https://api.github.com/repos/stackql/stackql-azure-openapi/statuses/{sha}',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/git/trees{/sha}',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/stackql-azure-openapi/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/contributors',
+'R_kgDOHyRBdQ',
+true,
+'https://api.github.com/repos/stackql/stackql-azure-openapi/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/assignees{/user}',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/keys{/key_id}',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/languages',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/merges',
+'2022-09-22T20:36:29Z',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/releases{/id}',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/git/commits{/sha}',
+true,
+true,
+'JavaScript',
+13001,
+NULL,
+NULL,
+'https://github.com/stackql/stackql-azure-openapi.git',
+'2022-08-08T08:41:58Z',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/stackql-azure-openapi/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/stackql-azure-openapi/labels{/name}',
+'stackql-azure-openapi',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/stackql-azure-openapi/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/branches{/branch}',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/git/tags{/sha}',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/issues/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/hooks',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/stackql-azure-openapi/subscribers',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/contents/{+path}',
+'https://api.github.com/repos/stackql/stackql-azure-openapi/deployments',
+false,
+1,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(
+39,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/aws2openapi/git/refs{/sha}',
+'git@github.com:stackql/aws2openapi.git',
+'[]',
+'https://api.github.com/repos/stackql/aws2openapi',
+'https://api.github.com/repos/stackql/aws2openapi/collaborators{/collaborator}',
+NULL,
+'https://api.github.com/repos/stackql/aws2openapi/downloads',
+'https://github.com/stackql/aws2openapi',
+'https://api.github.com/repos/stackql/aws2openapi/teams',
+'private',
+false,
+'git://github.com/stackql/aws2openapi.git',
+523200625,
+'https://api.github.com/repos/stackql/aws2openapi/events',
+'https://api.github.com/repos/stackql/aws2openapi/forks',
+'https://api.github.com/repos/stackql/aws2openapi/pulls{/number}',
+'https://api.github.com/repos/stackql/aws2openapi/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+0,
+'https://api.github.com/repos/stackql/aws2openapi/subscription',
+'2022-08-10T04:32:43Z',
+NULL,
+NULL,
+NULL,
+true,
+0,
+'{"key":"mit", "name":"MIT License", "node_id":"MDc6TGljZW5zZTEz", "spdx_id":"MIT", "url":"https://api.github.com/licenses/mit"}',
+'https://api.github.com/repos/stackql/aws2openapi/milestones{/number}',
+0,
+NULL,
+'stackql/aws2openapi',
+'https://github.com/stackql/aws2openapi',
+'feature/stackql-compatible',
+true,
+'https://api.github.com/repos/stackql/aws2openapi/statuses/{sha}',
+'https://api.github.com/repos/stackql/aws2openapi/git/trees{/sha}',
+'https://api.github.com/repos/stackql/aws2openapi/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/aws2openapi/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/aws2openapi/comments{/number}',
+'https://api.github.com/repos/stackql/aws2openapi/contributors',
+'R_kgDOHy9ocQ',
+false,
+'https://api.github.com/repos/stackql/aws2openapi/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/aws2openapi/assignees{/user}',
+'https://api.github.com/repos/stackql/aws2openapi/keys{/key_id}',
+'https://api.github.com/repos/stackql/aws2openapi/languages',
+'https://api.github.com/repos/stackql/aws2openapi/merges',
+'2022-08-29T12:16:22Z',
+'https://api.github.com/repos/stackql/aws2openapi/releases{/id}',
+'https://api.github.com/repos/stackql/aws2openapi/git/commits{/sha}',
+true,
+true,
+'JavaScript',
+193,
+NULL,
+NULL,
+'https://github.com/stackql/aws2openapi.git',
+'2022-08-10T04:31:17Z',
+'https://api.github.com/repos/stackql/aws2openapi/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/aws2openapi/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/aws2openapi/labels{/name}',
+'aws2openapi',
+'https://api.github.com/repos/stackql/aws2openapi/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/aws2openapi/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/aws2openapi/branches{/branch}',
+'https://api.github.com/repos/stackql/aws2openapi/git/tags{/sha}',
+'https://api.github.com/repos/stackql/aws2openapi/issues/comments{/number}',
+'https://api.github.com/repos/stackql/aws2openapi/hooks',
+'https://api.github.com/repos/stackql/aws2openapi/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/aws2openapi/subscribers',
+'https://api.github.com/repos/stackql/aws2openapi/contents/{+path}',
+'https://api.github.com/repos/stackql/aws2openapi/deployments',
+false,
+0,
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.repos.minimal-repository"  (
+  "iql_stackql_analytics_github.repos.repos.minimal-repository_id",
+  "iql_generation_id",
+  "iql_session_id",
+  "iql_txn_id",
+  "iql_insert_id",
+  "iql_insert_encoded",
+  "iql_last_modified",
+  "git_refs_url",
+  "ssh_url",
+  "topics",
+  "url",
+  "collaborators_url",
+  "description",
+  "downloads_url",
+  "svn_url",
+  "teams_url",
+  "visibility",
+  "archived",
+  "git_url",
+  "id",
+  "events_url",
+  "forks_url",
+  "pulls_url",
+  "stargazers_url",
+  "permissions",
+  "stargazers_count",
+  "subscription_url",
+  "updated_at",
+  "delete_branch_on_merge",
+  "homepage",
+  "network_count",
+  "private",
+  "forks_count",
+  "license",
+  "milestones_url",
+  "watchers",
+  "code_of_conduct",
+  "full_name",
+  "html_url",
+  "default_branch",
+  "has_projects",
+  "statuses_url",
+  "trees_url",
+  "blobs_url",
+  "compare_url",
+  "open_issues_count",
+  "comments_url",
+  "contributors_url",
+  "node_id",
+  "allow_forking",
+  "archive_url",
+  "assignees_url",
+  "keys_url",
+  "languages_url",
+  "merges_url",
+  "pushed_at",
+  "releases_url",
+  "git_commits_url",
+  "has_downloads",
+  "has_wiki",
+  "language",
+  "size",
+  "subscribers_count",
+  "template_repository",
+  "clone_url",
+  "created_at",
+  "issue_events_url",
+  "mirror_url",
+  "owner",
+  "temp_clone_token",
+  "commits_url",
+  "fork",
+  "has_issues",
+  "labels_url",
+  "name",
+  "tags_url",
+  "forks",
+  "has_pages",
+  "is_template",
+  "notifications_url",
+  "branches_url",
+  "git_tags_url",
+  "issue_comment_url",
+  "hooks_url",
+  "issues_url",
+  "open_issues",
+  "role_name",
+  "subscribers_url",
+  "contents_url",
+  "deployments_url",
+  "disabled",
+  "watchers_count",
+  "org" 
+)  VALUES(40,
+1,
+3,
+2,
+1,
+'%path%org%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'https://api.github.com/repos/stackql/stackql-middleware/git/refs{/sha}',
+'git@github.com:stackql/stackql-middleware.git',
+'[]',
+'https://api.github.com/repos/stackql/stackql-middleware',
+'https://api.github.com/repos/stackql/stackql-middleware/collaborators{/collaborator}',
+NULL,
+'https://api.github.com/repos/stackql/stackql-middleware/downloads',
+'https://github.com/stackql/stackql-middleware',
+'https://api.github.com/repos/stackql/stackql-middleware/teams',
+'public',
+false,
+'git://github.com/stackql/stackql-middleware.git',
+538821850,
+'https://api.github.com/repos/stackql/stackql-middleware/events',
+'https://api.github.com/repos/stackql/stackql-middleware/forks',
+'https://api.github.com/repos/stackql/stackql-middleware/pulls{/number}',
+'https://api.github.com/repos/stackql/stackql-middleware/stargazers',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+0,
+'https://api.github.com/repos/stackql/stackql-middleware/subscription',
+'2022-09-20T05:20:59Z',
+NULL,
+NULL,
+NULL,
+false,
+0,
+'{"key":"mit", "name":"MIT License", "node_id":"MDc6TGljZW5zZTEz", "spdx_id":"MIT", "url":"https://api.github.com/licenses/mit"}',
+'https://api.github.com/repos/stackql/stackql-middleware/milestones{/number}',
+0,
+NULL,
+'stackql/stackql-middleware',
+'https://github.com/stackql/stackql-middleware',
+'main',
+true,
+'https://api.github.com/repos/stackql/stackql-middleware/statuses/{sha}',
+'https://api.github.com/repos/stackql/stackql-middleware/git/trees{/sha}',
+'https://api.github.com/repos/stackql/stackql-middleware/git/blobs{/sha}',
+'https://api.github.com/repos/stackql/stackql-middleware/compare/{base}...{head}',
+0,
+'https://api.github.com/repos/stackql/stackql-middleware/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-middleware/contributors',
+'R_kgDOIB3E2g',
+true,
+'https://api.github.com/repos/stackql/stackql-middleware/{archive_format}{/ref}',
+'https://api.github.com/repos/stackql/stackql-middleware/assignees{/user}',
+'https://api.github.com/repos/stackql/stackql-middleware/keys{/key_id}',
+'https://api.github.com/repos/stackql/stackql-middleware/languages',
+'https://api.github.com/repos/stackql/stackql-middleware/merges',
+'2022-09-20T07:11:01Z',
+'https://api.github.com/repos/stackql/stackql-middleware/releases{/id}',
+'https://api.github.com/repos/stackql/stackql-middleware/git/commits{/sha}',
+true,
+true,
+NULL,
+5,
+NULL,
+NULL,
+'https://github.com/stackql/stackql-middleware.git',
+'2022-09-20T05:20:59Z',
+'https://api.github.com/repos/stackql/stackql-middleware/issues/events{/number}',
+NULL,
+'{"avatar_url":"https://avatars.githubusercontent.com/u/95105302?v=4",
+"events_url":"https://api.github.com/users/stackql/events{/privacy}",
+"followers_url":"https://api.github.com/users/stackql/followers",
+"following_url":"https://api.github.com/users/stackql/following{/other_user}",
+"gists_url":"https://api.github.com/users/stackql/gists{/gist_id}",
+"gravatar_id":"",
+"html_url":"https://github.com/stackql",
+"id":95105302,
+"login":"stackql",
+"node_id":"O_kgDOBasxFg",
+"organizations_url":"https://api.github.com/users/stackql/orgs",
+"received_events_url":"https://api.github.com/users/stackql/received_events",
+"repos_url":"https://api.github.com/users/stackql/repos",
+"site_admin":false,
+"starred_url":"https://api.github.com/users/stackql/starred{/owner}{/repo}",
+"subscriptions_url":"https://api.github.com/users/stackql/subscriptions",
+"type":"Organization",
+"url":"https://api.github.com/users/stackql"}',
+NULL,
+'https://api.github.com/repos/stackql/stackql-middleware/commits{/sha}',
+false,
+true,
+'https://api.github.com/repos/stackql/stackql-middleware/labels{/name}',
+'stackql-middleware',
+'https://api.github.com/repos/stackql/stackql-middleware/tags',
+0,
+false,
+false,
+'https://api.github.com/repos/stackql/stackql-middleware/notifications{?since,
+all,
+participating}',
+'https://api.github.com/repos/stackql/stackql-middleware/branches{/branch}',
+'https://api.github.com/repos/stackql/stackql-middleware/git/tags{/sha}',
+'https://api.github.com/repos/stackql/stackql-middleware/issues/comments{/number}',
+'https://api.github.com/repos/stackql/stackql-middleware/hooks',
+'https://api.github.com/repos/stackql/stackql-middleware/issues{/number}',
+0,
+NULL,
+'https://api.github.com/repos/stackql/stackql-middleware/subscribers',
+'https://api.github.com/repos/stackql/stackql-middleware/contents/{+path}',
+'https://api.github.com/repos/stackql/stackql-middleware/deployments',
+false,
+0,
+'stackql'
+);
+
+CREATE TABLE IF NOT EXISTS "stackql_analytics_github.repos.collaborators.collaborator" (
+  "iql_github.repos.collaborator.generation_1_id" BIGINT ,
+  "iql_generation_id" INTEGER  ,
+  "iql_session_id" INTEGER  ,
+  "iql_txn_id" INTEGER  ,
+  "iql_insert_id" INTEGER,
+  "iql_insert_encoded" text,
+  "iql_last_modified" TIMESTAMP NOT NULL DEFAULT CAST(CURRENT_TIMESTAMP AS TIMESTAMP) ,
+  "node_id" text ,
+  "permissions" text ,
+  "repos_url" text ,
+  "starred_url" text ,
+  "type" text ,
+  "name" text ,
+  "subscriptions_url" text ,
+  "url" text ,
+  "avatar_url" text ,
+  "followers_url" text ,
+  "gists_url" text ,
+  "html_url" text ,
+  "login" text ,
+  "site_admin" boolean ,
+  "following_url" text ,
+  "gravatar_id" text ,
+  "id" integer ,
+  "received_events_url" text ,
+  "role_name" text ,
+  "email" text ,
+  "events_url" text ,
+  "organizations_url" text ,
+  "owner" text ,
+  "repo" text 
+);
+
+
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(1,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%fullstackchronicles.io%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'fullstackchronicles.io');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(2,
+1,
+3,
+2,
+2,
+
+ '%path%owner%stackql%%path%repo%fullstackchronicles.io%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'fullstackchronicles.io');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(3,
+1,
+3,
+2,
+2,
+
+ '%path%owner%stackql%%path%repo%fullstackchronicles.io%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'fullstackchronicles.io');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(4,
+1,
+3,
+2,
+2,
+
+ '%path%owner%stackql%%path%repo%fullstackchronicles.io%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'fullstackchronicles.io');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(5,
+1,
+3,
+2,
+2,
+
+ '%path%owner%stackql%%path%repo%docusaurus-plugin-hubspot%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'docusaurus-plugin-hubspot');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(6,
+1,
+3,
+2,
+2,
+
+ '%path%owner%stackql%%path%repo%docusaurus-plugin-hubspot%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'docusaurus-plugin-hubspot');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(7,
+1,
+3,
+2,
+2,
+
+ '%path%owner%stackql%%path%repo%docusaurus-plugin-hubspot%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'docusaurus-plugin-hubspot');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(8,
+1,
+3,
+2,
+2,
+
+ '%path%owner%stackql%%path%repo%docusaurus-plugin-hubspot%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'docusaurus-plugin-hubspot');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(9,
+1,
+3,
+2,
+2,
+
+ '%path%owner%stackql%%path%repo%gatsby-plugin-smartlook%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'gatsby-plugin-smartlook');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(10,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%gatsby-plugin-smartlook%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'gatsby-plugin-smartlook');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(11,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%gatsby-plugin-smartlook%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'gatsby-plugin-smartlook');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(12,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%gatsby-plugin-smartlook%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'gatsby-plugin-smartlook');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(13,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%docusaurus-plugin-smartlook%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'docusaurus-plugin-smartlook');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(14,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%docusaurus-plugin-smartlook%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'docusaurus-plugin-smartlook');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(15,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%docusaurus-plugin-smartlook%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'docusaurus-plugin-smartlook');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(16,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%docusaurus-plugin-smartlook%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'docusaurus-plugin-smartlook');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(17,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql.io%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'stackql.io');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(18,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql.io%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'stackql.io');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(19,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql.io%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'stackql.io');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(20,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql.io%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'stackql.io');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(21,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql-provider-registry%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'stackql-provider-registry');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(22,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql-provider-registry%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'stackql-provider-registry');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(23,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql-provider-registry%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'stackql-provider-registry');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(24,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql-provider-registry%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'stackql-provider-registry');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(25,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%releases.stackql.io%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'releases.stackql.io');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(26,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%releases.stackql.io%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'releases.stackql.io');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(27,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%releases.stackql.io%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'releases.stackql.io');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(28,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%releases.stackql.io%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'releases.stackql.io');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(29,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(
+30,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(31,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(
+32,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'stackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(
+33,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%go-openapistackql%%region%region%%',
+CURRENT_TIMESTAMP,
+'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'go-openapistackql'
+);
+
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(34,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%go-openapistackql%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'go-openapistackql');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(35,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%go-openapistackql%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'go-openapistackql');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(36,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%go-openapistackql%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'go-openapistackql');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(37,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%go-spew%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'go-spew');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(38,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%go-spew%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'go-spew');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(39,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%go-spew%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'go-spew');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(40,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%go-spew%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'go-spew');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(41,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql-devel%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'stackql-devel');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(42,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql-devel%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'stackql-devel');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(43,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql-devel%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'stackql-devel');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(44,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql-devel%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'stackql-devel');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(45,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%go-sqlite3%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'go-sqlite3');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(46,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%go-sqlite3%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'go-sqlite3');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(47,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%go-sqlite3%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'go-sqlite3');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(48,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%go-sqlite3%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'go-sqlite3');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(49,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%vitess%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'vitess');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(50,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%vitess%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'vitess');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(51,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%vitess%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'vitess');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(52,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%vitess%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'vitess');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(53,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%readline%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'readline');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(54,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%readline%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'readline');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(55,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%readline%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'readline');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(56,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%readline%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'readline');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(57,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%color%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'color');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(58,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%color%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'color');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(59,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%color%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'color');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(60,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%color%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'color');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(61,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql-artwork%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'stackql-artwork');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(62,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql-artwork%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'stackql-artwork');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(63,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql-artwork%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'stackql-artwork');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(64,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql-artwork%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'stackql-artwork');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(65,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%pystackql%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'pystackql');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(66,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%pystackql%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'pystackql');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(67,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%pystackql%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'pystackql');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(68,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%pystackql%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'pystackql');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(69,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql-jupyter-demo%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'stackql-jupyter-demo');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(70,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql-jupyter-demo%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'stackql-jupyter-demo');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(71,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql-jupyter-demo%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'stackql-jupyter-demo');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(72,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql-jupyter-demo%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'stackql-jupyter-demo');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(73,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql-mac-installer%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'stackql-mac-installer');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(74,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql-mac-installer%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'stackql-mac-installer');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(75,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql-mac-installer%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'stackql-mac-installer');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(76,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%stackql-mac-installer%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'stackql-mac-installer');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(77,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%pg-server-thin%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'pg-server-thin');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(78,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%pg-server-thin%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'pg-server-thin');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(79,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%pg-server-thin%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'pg-server-thin');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(80,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%pg-server-thin%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'pg-server-thin');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(81,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%psql-wire%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'psql-wire');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(82,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%psql-wire%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'psql-wire');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(83,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%psql-wire%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'psql-wire');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(84,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%psql-wire%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'psql-wire');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(85,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%pg-server-various%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'pg-server-various');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(86,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%pg-server-various%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'pg-server-various');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(87,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%pg-server-various%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'pg-server-various');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(88,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%pg-server-various%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'pg-server-various');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(89,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%provider-doc-util%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'provider-doc-util');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(90,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%provider-doc-util%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'provider-doc-util');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(91,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%provider-doc-util%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'provider-doc-util');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(92,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%provider-doc-util%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'provider-doc-util');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(93,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%go-suffix-map%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'go-suffix-map');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(94,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%go-suffix-map%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'go-suffix-map');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(95,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%go-suffix-map%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'go-suffix-map');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(96,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%go-suffix-map%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'go-suffix-map');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(97,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%okta-pkce-login%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'okta-pkce-login');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(98,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%okta-pkce-login%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'okta-pkce-login');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(99,
+1,
+3,
+2,
+2,
+
+'%path%owner%stackql%%path%repo%okta-pkce-login%%region%region%%',
+
+CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'okta-pkce-login');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(100,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%okta-pkce-login%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'okta-pkce-login');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(101,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%github-oauth-login%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'github-oauth-login');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(102,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%github-oauth-login%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'github-oauth-login');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(103,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%github-oauth-login%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'github-oauth-login');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(104,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%github-oauth-login%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'github-oauth-login');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(105,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%local-registry%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'local-registry');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(106,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%local-registry%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'local-registry');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(107,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%local-registry%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'local-registry');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(108,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%local-registry%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'local-registry');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(109,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%cloudy-redirect-app%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'cloudy-redirect-app');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(110,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%cloudy-redirect-app%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'cloudy-redirect-app');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(111,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%cloudy-redirect-app%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'cloudy-redirect-app');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(112,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%cloudy-redirect-app%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'cloudy-redirect-app');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(113,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%openapi-doc-util%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'openapi-doc-util');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(114,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%openapi-doc-util%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'openapi-doc-util');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(115,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%openapi-doc-util%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'openapi-doc-util');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(116,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%openapi-doc-util%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'openapi-doc-util');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(117,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-jupyter-google-okta-iam%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'stackql-jupyter-google-okta-iam');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(118,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-jupyter-google-okta-iam%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'stackql-jupyter-google-okta-iam');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(119,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-jupyter-google-okta-iam%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'stackql-jupyter-google-okta-iam');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(120,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-jupyter-google-okta-iam%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'stackql-jupyter-google-okta-iam');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(121,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-gcp-foundations%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'stackql-gcp-foundations');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(122,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-gcp-foundations%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'stackql-gcp-foundations');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(123,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-gcp-foundations%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'stackql-gcp-foundations');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(124,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-gcp-foundations%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'stackql-gcp-foundations');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(125,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-demo%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'stackql-demo');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(126,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-demo%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'stackql-demo');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(127,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-demo%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'stackql-demo');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(128,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-demo%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'stackql-demo');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(129,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%registry.stackql.io%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'registry.stackql.io');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(130,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%registry.stackql.io%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'registry.stackql.io');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(131,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%registry.stackql.io%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'registry.stackql.io');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(132,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%registry.stackql.io%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'registry.stackql.io');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(133,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-docs%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'stackql-docs');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(134,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-docs%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'stackql-docs');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(135,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-docs%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'stackql-docs');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(136,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-docs%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'stackql-docs');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(137,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-provider-doc-generator%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'stackql-provider-doc-generator');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(138,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-provider-doc-generator%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'stackql-provider-doc-generator');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(139,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-provider-doc-generator%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'stackql-provider-doc-generator');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(140,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-provider-doc-generator%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'stackql-provider-doc-generator');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(141,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%google-discovery-to-openapi%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'google-discovery-to-openapi');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(142,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%google-discovery-to-openapi%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'google-discovery-to-openapi');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(143,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%google-discovery-to-openapi%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'google-discovery-to-openapi');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(144,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%google-discovery-to-openapi%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'google-discovery-to-openapi');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(145,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-azure-openapi%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'stackql-azure-openapi');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(146,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-azure-openapi%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'stackql-azure-openapi');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(147,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-azure-openapi%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'stackql-azure-openapi');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(148,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-azure-openapi%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'stackql-azure-openapi');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(149,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%aws2openapi%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'aws2openapi');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(150,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%aws2openapi%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'aws2openapi');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(151,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%aws2openapi%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'aws2openapi');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(152,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%aws2openapi%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'aws2openapi');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(153,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-middleware%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000001',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/joeblow/repos',
+'https://api.github.com/users/joeblow/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/joeblow/subscriptions',
+'https://api.github.com/users/joeblow',
+'https://avatars.githubusercontent.com/u/5146499?v=4',
+'https://api.github.com/users/joeblow/followers',
+'https://api.github.com/users/joeblow/gists{/gist_id}',
+'https://github.com/joeblow',
+'joeblow',
+false,
+'https://api.github.com/users/joeblow/following{/other_user}',
+'',
+5146499,
+'https://api.github.com/users/joeblow/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/joeblow/events{/privacy}',
+'https://api.github.com/users/joeblow/orgs',
+'stackql',
+'stackql-middleware');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(154,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-middleware%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000002',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/somejimbo/repos',
+'https://api.github.com/users/somejimbo/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/somejimbo/subscriptions',
+'https://api.github.com/users/somejimbo',
+'https://avatars.githubusercontent.com/u/20798510?v=4',
+'https://api.github.com/users/somejimbo/followers',
+'https://api.github.com/users/somejimbo/gists{/gist_id}',
+'https://github.com/somejimbo',
+'somejimbo',
+false,
+'https://api.github.com/users/somejimbo/following{/other_user}',
+'',
+20798510,
+'https://api.github.com/users/somejimbo/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/somejimbo/events{/privacy}',
+'https://api.github.com/users/somejimbo/orgs',
+'stackql',
+'stackql-middleware');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(155,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-middleware%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000003',
+'{"admin":false,
+"maintain":false,
+"pull":true,
+"push":false,
+"triage":false}',
+'https://api.github.com/users/johnsmith/repos',
+'https://api.github.com/users/johnsmith/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/johnsmith/subscriptions',
+'https://api.github.com/users/johnsmith',
+'https://avatars.githubusercontent.com/u/45674168?v=4',
+'https://api.github.com/users/johnsmith/followers',
+'https://api.github.com/users/johnsmith/gists{/gist_id}',
+'https://github.com/johnsmith',
+'johnsmith',
+false,
+'https://api.github.com/users/johnsmith/following{/other_user}',
+'',
+45674168,
+'https://api.github.com/users/johnsmith/received_events',
+'read',
+NULL,
+'https://api.github.com/users/johnsmith/events{/privacy}',
+'https://api.github.com/users/johnsmith/orgs',
+'stackql',
+'stackql-middleware');
+INSERT INTO "stackql_analytics_github.repos.collaborators.collaborator" VALUES(
+156,
+1,
+3,
+2,
+2,
+'%path%owner%stackql%%path%repo%stackql-middleware%%region%region%%',
+
+ CURRENT_TIMESTAMP,
+
+ 'SOMENODEID000000004',
+'{"admin":true,
+"maintain":true,
+"pull":true,
+"push":true,
+"triage":true}',
+'https://api.github.com/users/fredjones/repos',
+'https://api.github.com/users/fredjones/starred{/owner}{/repo}',
+'User',
+NULL,
+'https://api.github.com/users/fredjones/subscriptions',
+'https://api.github.com/users/fredjones',
+'https://avatars.githubusercontent.com/u/82620104?v=4',
+'https://api.github.com/users/fredjones/followers',
+'https://api.github.com/users/fredjones/gists{/gist_id}',
+'https://github.com/fredjones',
+'fredjones',
+false,
+'https://api.github.com/users/fredjones/following{/other_user}',
+'',
+82620104,
+'https://api.github.com/users/fredjones/received_events',
+'admin',
+NULL,
+'https://api.github.com/users/fredjones/events{/privacy}',
+'https://api.github.com/users/fredjones/orgs',
+'stackql',
+'stackql-middleware');
+
+-- DELETE FROM sqlite_sequence;
+
+-- INSERT INTO sqlite_sequence VALUES('stackql_analytics_github.repos.repos',
+-- 40);
+-- INSERT INTO sqlite_sequence VALUES('stackql_analytics_github.repos.collaborators',
+-- 156);
+
+CREATE INDEX if not exists "idx_github_repos_minimal-repository_generation_1_iql_generation_id" on "stackql_analytics_github.repos.repos.minimal-repository"  ( "iql_generation_id" ) ;
+CREATE INDEX if not exists "idx_github_repos_minimal-repository_generation_1_iql_session_id" on "stackql_analytics_github.repos.repos.minimal-repository"  ( "iql_session_id" ) ;
+CREATE INDEX if not exists "idx_github_repos_minimal-repository_generation_1_iql_txn_id" on "stackql_analytics_github.repos.repos.minimal-repository"  ( "iql_txn_id" ) ;
+CREATE INDEX if not exists "idx_github_repos_minimal-repository_generation_1_iql_insert_id" on "stackql_analytics_github.repos.repos.minimal-repository"  ( "iql_insert_id" ) ;
+CREATE INDEX if not exists "idx_github_repos_collaborator_generation_1_iql_generation_id" on "stackql_analytics_github.repos.collaborators.collaborator" ( "iql_generation_id" ) ;
+CREATE INDEX if not exists "idx_github_repos_collaborator_generation_1_iql_session_id" on "stackql_analytics_github.repos.collaborators.collaborator" ( "iql_session_id" ) ;
+CREATE INDEX if not exists "idx_github_repos_collaborator_generation_1_iql_txn_id" on "stackql_analytics_github.repos.collaborators.collaborator" ( "iql_txn_id" ) ;
+CREATE INDEX if not exists "idx_github_repos_collaborator_generation_1_iql_insert_id" on "stackql_analytics_github.repos.collaborators.collaborator" ( "iql_insert_id" ) ;
+
+COMMIT;
@@ -0,0 +1,19 @@
+
+IF DB_ID('finance') IS NULL CREATE DATABASE finance;
+GO
+
+USE finance;
+GO
+
+IF OBJECT_ID('dbo.cost_centres') IS NULL
+BEGIN
+  CREATE TABLE dbo.cost_centres (
+    environment NVARCHAR(64) NOT NULL,
+    cost_centre NVARCHAR(64) NOT NULL
+  );
+  INSERT INTO dbo.cost_centres (environment, cost_centre) VALUES
+    ('prod', 'CC-1001'),
+    ('dev', 'CC-2002')
+  ;
+END
+GO
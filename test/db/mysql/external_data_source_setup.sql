@@ -0,0 +1,12 @@
+
+CREATE TABLE IF NOT EXISTS cmdb.servers (
+  hostname VARCHAR(255) NOT NULL,
+  environment VARCHAR(64) NOT NULL,
+  cpu_count INT
+);
+
+INSERT INTO cmdb.servers (hostname, environment, cpu_count) VALUES
+  ('app-01', 'prod', 8),
+  ('app-02', 'prod', 8),
+  ('dev-01', 'dev', 2)
+;